@@ -0,0 +1,63 @@
+package entrypoints
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/flyteorg/flyte/datacatalog/pkg/config"
+	"github.com/flyteorg/flyte/datacatalog/pkg/rpc/cacheservice"
+	"github.com/flyteorg/flyte/datacatalog/pkg/rpc/datacatalogservice"
+	"github.com/flyteorg/flyte/datacatalog/pkg/runtime"
+	"github.com/flyteorg/flyte/flytestdlib/contextutils"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/otelutils"
+	"github.com/flyteorg/flyte/flytestdlib/profutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils/labeled"
+)
+
+var serveCacheCmd = &cobra.Command{
+	Use:   "serve-cache",
+	Short: "Launches the standalone key-value Cache Service server",
+	Long: `Launches a gRPC server for the CacheService API. It shares the database, storage and application
+configuration with the Data Catalog server; run 'migrate run' beforehand to create its tables.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		cfg := config.GetConfig()
+
+		// serve a http healthcheck endpoint
+		go func() {
+			err := datacatalogservice.ServeHTTPHealthCheck(ctx, cfg)
+			if err != nil {
+				logger.Errorf(ctx, "Unable to serve http", cfg.GetHTTPHostAddress(), err)
+			}
+		}()
+
+		// Serve profiling endpoint.
+		dataCatalogConfig := runtime.NewConfigurationProvider().ApplicationConfiguration().GetDataCatalogConfig()
+		go func() {
+			err := profutils.StartProfilingServerWithDefaultHandlers(
+				context.Background(), dataCatalogConfig.ProfilerPort, nil)
+			if err != nil {
+				logger.Panicf(context.Background(), "Failed to Start profiling and Metrics server. Error, %v", err)
+			}
+		}()
+
+		// Set Keys
+		labeled.SetMetricKeys(contextutils.AppNameKey, contextutils.ProjectKey, contextutils.DomainKey)
+
+		// register otel tracer providers
+		for _, serviceName := range []string{otelutils.DataCatalogGormTracer, otelutils.DataCatalogServerTracer} {
+			if err := otelutils.RegisterTracerProviderWithContext(ctx, serviceName, otelutils.GetConfig()); err != nil {
+				logger.Errorf(ctx, "Failed to create otel tracer provider. %v", err)
+				return err
+			}
+		}
+
+		return cacheservice.ServeInsecure(ctx, cfg)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(serveCacheCmd)
+}
@@ -0,0 +1,416 @@
+package impl
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/flyteorg/flyte/datacatalog/pkg/errors"
+	"github.com/flyteorg/flyte/datacatalog/pkg/manager/impl/validators"
+	"github.com/flyteorg/flyte/datacatalog/pkg/manager/interfaces"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories"
+	repo_errors "github.com/flyteorg/flyte/datacatalog/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/cacheservice"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils/labeled"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+const cachedOutputFile = "outputs.pb"
+
+type cacheMetrics struct {
+	scope                        promutils.Scope
+	cacheHit                     labeled.Counter
+	cacheMiss                    labeled.Counter
+	cachePut                     labeled.Counter
+	cacheDeleted                 labeled.Counter
+	outputsOffloaded             labeled.Counter
+	getFailure                   labeled.Counter
+	putFailure                   labeled.Counter
+	deleteFailure                labeled.Counter
+	reservationAcquired          labeled.Counter
+	reservationReleased          labeled.Counter
+	reservationAlreadyInProgress labeled.Counter
+	acquireReservationFailure    labeled.Counter
+	releaseReservationFailure    labeled.Counter
+	reservationDoesNotExist      labeled.Counter
+}
+
+type cacheManager struct {
+	repo                           repositories.RepositoryInterface
+	store                          *storage.DataStore
+	storagePrefix                  storage.DataReference
+	maxInlineSizeBytes             int64
+	heartbeatGracePeriodMultiplier time.Duration
+	maxHeartbeatInterval           time.Duration
+	now                            NowFunc
+	systemMetrics                  cacheMetrics
+}
+
+// NewCacheManager creates a new key-value cache manager. Outputs whose serialized size exceeds maxInlineSizeBytes are
+// offloaded to the blob store under storagePrefix instead of being stored in the database.
+func NewCacheManager(
+	repo repositories.RepositoryInterface,
+	store *storage.DataStore,
+	storagePrefix storage.DataReference,
+	maxInlineSizeBytes int64,
+	heartbeatGracePeriodMultiplier time.Duration,
+	maxHeartbeatInterval time.Duration,
+	nowFunc NowFunc, // Easier to mock time.Time for testing
+	cacheScope promutils.Scope,
+) interfaces.CacheManager {
+	systemMetrics := cacheMetrics{
+		scope:                        cacheScope,
+		cacheHit:                     labeled.NewCounter("cache_hit", "Number of cache lookups that found an entry", cacheScope),
+		cacheMiss:                    labeled.NewCounter("cache_miss", "Number of cache lookups that did not find an entry", cacheScope),
+		cachePut:                     labeled.NewCounter("cache_put", "Number of cache entries created or overwritten", cacheScope),
+		cacheDeleted:                 labeled.NewCounter("cache_deleted", "Number of cache entries deleted", cacheScope),
+		outputsOffloaded:             labeled.NewCounter("outputs_offloaded", "Number of cached outputs offloaded to the blob store", cacheScope),
+		getFailure:                   labeled.NewCounter("get_failure", "Number of times retrieving a cache entry failed", cacheScope),
+		putFailure:                   labeled.NewCounter("put_failure", "Number of times storing a cache entry failed", cacheScope),
+		deleteFailure:                labeled.NewCounter("delete_failure", "Number of times deleting a cache entry failed", cacheScope),
+		reservationAcquired:          labeled.NewCounter("reservation_acquired", "Number of times a reservation was acquired", cacheScope),
+		reservationReleased:          labeled.NewCounter("reservation_released", "Number of times a reservation was released", cacheScope),
+		reservationAlreadyInProgress: labeled.NewCounter("reservation_already_in_progress", "Number of times we try of acquire a reservation but the reservation is in progress", cacheScope),
+		acquireReservationFailure:    labeled.NewCounter("acquire_reservation_failure", "Number of times we failed to acquire reservation", cacheScope),
+		releaseReservationFailure:    labeled.NewCounter("release_reservation_failure", "Number of times we failed to release a reservation", cacheScope),
+		reservationDoesNotExist:      labeled.NewCounter("reservation_does_not_exist", "Number of times we attempt to modify a reservation that does not exist", cacheScope),
+	}
+
+	return &cacheManager{
+		repo:                           repo,
+		store:                          store,
+		storagePrefix:                  storagePrefix,
+		maxInlineSizeBytes:             maxInlineSizeBytes,
+		heartbeatGracePeriodMultiplier: heartbeatGracePeriodMultiplier,
+		maxHeartbeatInterval:           maxHeartbeatInterval,
+		now:                            nowFunc,
+		systemMetrics:                  systemMetrics,
+	}
+}
+
+// Get returns the cached output stored under the key. Outputs that were offloaded to the blob store are read back and
+// returned inline.
+func (m *cacheManager) Get(ctx context.Context, request *cacheservice.GetCacheRequest) (*cacheservice.GetCacheResponse, error) {
+	if err := validators.ValidateCacheKey(request.GetKey()); err != nil {
+		return nil, err
+	}
+
+	outputModel, err := m.repo.CachedOutputRepo().Get(ctx, request.GetKey())
+	if err != nil {
+		if errors.IsDoesNotExistError(err) {
+			m.systemMetrics.cacheMiss.Inc(ctx)
+		} else {
+			logger.Errorf(ctx, "Failed to get cached output for key %s, err: %v", request.GetKey(), err)
+			m.systemMetrics.getFailure.Inc(ctx)
+		}
+		return nil, err
+	}
+
+	output, err := m.fromCachedOutputModel(ctx, outputModel)
+	if err != nil {
+		logger.Errorf(ctx, "Failed to read cached output for key %s, err: %v", request.GetKey(), err)
+		m.systemMetrics.getFailure.Inc(ctx)
+		return nil, err
+	}
+
+	m.systemMetrics.cacheHit.Inc(ctx)
+	return &cacheservice.GetCacheResponse{Output: output}, nil
+}
+
+// Put stores the output under the key. Unless overwrite is set, storing a key that already exists fails with an
+// AlreadyExists error. Outputs offloaded by the entry being overwritten are deleted once the new entry is stored.
+func (m *cacheManager) Put(ctx context.Context, request *cacheservice.PutCacheRequest) (*cacheservice.PutCacheResponse, error) {
+	if err := validators.ValidatePutCacheRequest(request); err != nil {
+		m.systemMetrics.putFailure.Inc(ctx)
+		return nil, err
+	}
+
+	outputModel, err := m.toCachedOutputModel(ctx, request.GetKey(), request.GetOutput())
+	if err != nil {
+		logger.Errorf(ctx, "Failed to serialize cached output for key %s, err: %v", request.GetKey(), err)
+		m.systemMetrics.putFailure.Inc(ctx)
+		return nil, err
+	}
+
+	previous, err := m.storeCachedOutput(ctx, outputModel, request.GetOverwrite())
+	if err != nil {
+		if !errors.IsAlreadyExistsError(err) {
+			logger.Errorf(ctx, "Failed to store cached output for key %s, err: %v", request.GetKey(), err)
+		}
+		// No entry references the outputs offloaded for this request
+		m.deleteOffloadedOutputs(ctx, outputModel)
+		m.systemMetrics.putFailure.Inc(ctx)
+		return nil, err
+	}
+
+	m.deleteOffloadedOutputs(ctx, previous)
+	m.systemMetrics.cachePut.Inc(ctx)
+	return &cacheservice.PutCacheResponse{}, nil
+}
+
+// Delete removes the cached output stored under the key, including any outputs offloaded to the blob store.
+func (m *cacheManager) Delete(ctx context.Context, request *cacheservice.DeleteCacheRequest) (*cacheservice.DeleteCacheResponse, error) {
+	if err := validators.ValidateCacheKey(request.GetKey()); err != nil {
+		return nil, err
+	}
+
+	repo := m.repo.CachedOutputRepo()
+	outputModel, err := repo.Get(ctx, request.GetKey())
+	if err != nil {
+		if !errors.IsDoesNotExistError(err) {
+			m.systemMetrics.deleteFailure.Inc(ctx)
+		}
+		return nil, err
+	}
+
+	if err := repo.Delete(ctx, request.GetKey()); err != nil {
+		logger.Errorf(ctx, "Failed to delete cached output for key %s, err: %v", request.GetKey(), err)
+		m.systemMetrics.deleteFailure.Inc(ctx)
+		return nil, err
+	}
+
+	m.deleteOffloadedOutputs(ctx, outputModel)
+	m.systemMetrics.cacheDeleted.Inc(ctx)
+	return &cacheservice.DeleteCacheResponse{}, nil
+}
+
+// GetOrExtendReservation attempts to acquire a reservation for the key. If there is no active reservation, acquire it.
+// If you are the owner of the active reservation, extend it. If another owner, return the existing reservation.
+func (m *cacheManager) GetOrExtendReservation(ctx context.Context, request *cacheservice.GetOrExtendReservationRequest) (*cacheservice.GetOrExtendReservationResponse, error) {
+	if err := validators.ValidateCacheReservation(request.GetKey(), request.GetOwnerId()); err != nil {
+		return nil, err
+	}
+
+	// Use minimum of maxHeartbeatInterval and requested heartbeat interval
+	heartbeatInterval := m.maxHeartbeatInterval
+	requestHeartbeatInterval := request.GetHeartbeatInterval()
+	if requestHeartbeatInterval != nil && requestHeartbeatInterval.AsDuration() < heartbeatInterval {
+		heartbeatInterval = requestHeartbeatInterval.AsDuration()
+	}
+
+	reservation, err := m.tryAcquireReservation(ctx, request.GetKey(), request.GetOwnerId(), heartbeatInterval)
+	if err != nil {
+		m.systemMetrics.acquireReservationFailure.Inc(ctx)
+		return nil, err
+	}
+
+	return &cacheservice.GetOrExtendReservationResponse{
+		Reservation: reservation,
+	}, nil
+}
+
+// tryAcquireReservation fetches the reservation first and only creates or updates it if it does not exist or has
+// expired, see reservationManager.tryAcquireReservation.
+func (m *cacheManager) tryAcquireReservation(ctx context.Context, key, ownerID string, heartbeatInterval time.Duration) (*cacheservice.Reservation, error) {
+	repo := m.repo.CacheReservationRepo()
+	repoReservation, err := repo.Get(ctx, key)
+
+	reservationExists := true
+	if err != nil {
+		if errors.IsDoesNotExistError(err) {
+			// Reservation does not exist yet so let's create one
+			reservationExists = false
+		} else {
+			return nil, err
+		}
+	}
+
+	now := m.now()
+	newRepoReservation := models.CacheReservation{
+		Key:       key,
+		OwnerID:   ownerID,
+		ExpiresAt: now.Add(heartbeatInterval * m.heartbeatGracePeriodMultiplier),
+	}
+
+	// Conditional upsert on reservation. Race conditions are handled
+	// within the reservation repository Create and Update function calls.
+	var repoErr error
+	if !reservationExists {
+		repoErr = repo.Create(ctx, newRepoReservation, now)
+	} else if repoReservation.ExpiresAt.Before(now) || repoReservation.OwnerID == ownerID {
+		repoErr = repo.Update(ctx, newRepoReservation, now)
+	} else {
+		logger.Debugf(ctx, "Reservation: %s is held by %s", key, repoReservation.OwnerID)
+		m.systemMetrics.reservationAlreadyInProgress.Inc(ctx)
+		return toCacheReservation(repoReservation, heartbeatInterval), nil
+	}
+
+	if repoErr != nil {
+		if repoErr.Error() == repo_errors.AlreadyExists {
+			// Looks like someone else tried to obtain the reservation
+			// at the same time and they won. Let's find out who won.
+			rsv, err := repo.Get(ctx, key)
+			if err != nil {
+				return nil, err
+			}
+
+			m.systemMetrics.reservationAlreadyInProgress.Inc(ctx)
+			return toCacheReservation(rsv, heartbeatInterval), nil
+		}
+
+		return nil, repoErr
+	}
+
+	// Reservation has been acquired or extended without error
+	m.systemMetrics.reservationAcquired.Inc(ctx)
+	return toCacheReservation(newRepoReservation, heartbeatInterval), nil
+}
+
+// ReleaseReservation releases an active reservation with the specified owner. If one does not exist, gracefully return.
+func (m *cacheManager) ReleaseReservation(ctx context.Context, request *cacheservice.ReleaseReservationRequest) (*cacheservice.ReleaseReservationResponse, error) {
+	if err := validators.ValidateCacheReservation(request.GetKey(), request.GetOwnerId()); err != nil {
+		return nil, err
+	}
+
+	err := m.repo.CacheReservationRepo().Delete(ctx, request.GetKey(), request.GetOwnerId())
+	if err != nil {
+		if errors.IsDoesNotExistError(err) {
+			logger.Warnf(ctx, "Reservation does not exist key: %s, err %v", request.GetKey(), err)
+			m.systemMetrics.reservationDoesNotExist.Inc(ctx)
+			return &cacheservice.ReleaseReservationResponse{}, nil
+		}
+
+		logger.Errorf(ctx, "Failed to release reservation: %s, err: %v", request.GetKey(), err)
+		m.systemMetrics.releaseReservationFailure.Inc(ctx)
+		return nil, err
+	}
+
+	m.systemMetrics.reservationReleased.Inc(ctx)
+	return &cacheservice.ReleaseReservationResponse{}, nil
+}
+
+// storeCachedOutput creates the entry, or overwrites it if overwrite is set, and returns the entry it replaced if any.
+func (m *cacheManager) storeCachedOutput(ctx context.Context, outputModel models.CachedOutput, overwrite bool) (models.CachedOutput, error) {
+	repo := m.repo.CachedOutputRepo()
+	if !overwrite {
+		return models.CachedOutput{}, repo.Create(ctx, outputModel)
+	}
+
+	previous, err := repo.Get(ctx, outputModel.Key)
+	if err != nil && !errors.IsDoesNotExistError(err) {
+		return models.CachedOutput{}, err
+	}
+
+	err = repo.Update(ctx, outputModel)
+	if err != nil && errors.IsDoesNotExistError(err) {
+		// Overwriting an entry that doesn't exist yet is the same as creating it
+		err = repo.Create(ctx, outputModel)
+	}
+	return previous, err
+}
+
+// deleteOffloadedOutputs deletes the blob the outputs of the entry were offloaded to, if any. The entry no longer
+// references it by then, and a dangling blob only costs storage so failures are logged but not returned.
+func (m *cacheManager) deleteOffloadedOutputs(ctx context.Context, outputModel models.CachedOutput) {
+	if !outputModel.Offloaded {
+		return
+	}
+
+	if err := m.store.Delete(ctx, storage.DataReference(outputModel.OutputURI)); err != nil {
+		logger.Warnf(ctx, "Failed to delete offloaded outputs at %s for key %s, err: %v", outputModel.OutputURI, outputModel.Key, err)
+	}
+}
+
+func (m *cacheManager) getOffloadLocation(ctx context.Context, key string) (storage.DataReference, error) {
+	// Keys are free-form so hash them to get a valid and evenly distributed blob path. Every write gets its own blob so
+	// that a write which doesn't end up being stored never clobbers the outputs of the existing entry.
+	hash := sha256.Sum256([]byte(key))
+	writeID, err := uuid.NewV4()
+	if err != nil {
+		return "", err
+	}
+	return m.store.ConstructReference(ctx, m.storagePrefix, hex.EncodeToString(hash[:]), writeID.String(), cachedOutputFile)
+}
+
+func (m *cacheManager) toCachedOutputModel(ctx context.Context, key string, output *cacheservice.CachedOutput) (models.CachedOutput, error) {
+	outputModel := models.CachedOutput{
+		Key: key,
+	}
+
+	if output.GetMetadata() != nil {
+		serializedMetadata, err := proto.Marshal(output.GetMetadata())
+		if err != nil {
+			return models.CachedOutput{}, errors.NewDataCatalogErrorf(codes.InvalidArgument, "failed to serialize metadata, err %v", err)
+		}
+		outputModel.SerializedMetadata = serializedMetadata
+	}
+
+	if _, ok := output.GetOutput().(*cacheservice.CachedOutput_OutputUri); ok {
+		outputModel.OutputURI = output.GetOutputUri()
+		return outputModel, nil
+	}
+
+	literals := output.GetOutputLiterals()
+	if m.maxInlineSizeBytes > 0 && int64(proto.Size(literals)) > m.maxInlineSizeBytes {
+		location, err := m.getOffloadLocation(ctx, key)
+		if err != nil {
+			return models.CachedOutput{}, errors.NewDataCatalogErrorf(codes.Internal, "Unable to generate offload location for key %s, err %v", key, err)
+		}
+		if err := m.store.WriteProtobuf(ctx, location, storage.Options{}, literals); err != nil {
+			return models.CachedOutput{}, errors.NewDataCatalogErrorf(codes.Internal, "Unable to offload cached output to location %s, err %v", location.String(), err)
+		}
+
+		m.systemMetrics.outputsOffloaded.Inc(ctx)
+		outputModel.OutputURI = location.String()
+		outputModel.Offloaded = true
+		return outputModel, nil
+	}
+
+	serializedLiterals, err := proto.Marshal(literals)
+	if err != nil {
+		return models.CachedOutput{}, errors.NewDataCatalogErrorf(codes.InvalidArgument, "failed to serialize output literals, err %v", err)
+	}
+	outputModel.SerializedOutputLiterals = serializedLiterals
+	return outputModel, nil
+}
+
+func (m *cacheManager) fromCachedOutputModel(ctx context.Context, outputModel models.CachedOutput) (*cacheservice.CachedOutput, error) {
+	metadata := &cacheservice.Metadata{}
+	if len(outputModel.SerializedMetadata) > 0 {
+		if err := proto.Unmarshal(outputModel.SerializedMetadata, metadata); err != nil {
+			return nil, errors.NewDataCatalogErrorf(codes.Internal, "failed to deserialize metadata, err %v", err)
+		}
+	}
+	metadata.CreatedAt = timestamppb.New(outputModel.CreatedAt)
+	metadata.LastUpdatedAt = timestamppb.New(outputModel.UpdatedAt)
+
+	output := &cacheservice.CachedOutput{Metadata: metadata}
+	switch {
+	case outputModel.Offloaded:
+		literals := &core.LiteralMap{}
+		if err := m.store.ReadProtobuf(ctx, storage.DataReference(outputModel.OutputURI), literals); err != nil {
+			return nil, errors.NewDataCatalogErrorf(codes.Internal, "Unable to read offloaded cached output from location %s, err %v", outputModel.OutputURI, err)
+		}
+		output.Output = &cacheservice.CachedOutput_OutputLiterals{OutputLiterals: literals}
+	case len(outputModel.OutputURI) > 0:
+		output.Output = &cacheservice.CachedOutput_OutputUri{OutputUri: outputModel.OutputURI}
+	default:
+		literals := &core.LiteralMap{}
+		if err := proto.Unmarshal(outputModel.SerializedOutputLiterals, literals); err != nil {
+			return nil, errors.NewDataCatalogErrorf(codes.Internal, "failed to deserialize output literals, err %v", err)
+		}
+		output.Output = &cacheservice.CachedOutput_OutputLiterals{OutputLiterals: literals}
+	}
+
+	return output, nil
+}
+
+func toCacheReservation(reservation models.CacheReservation, heartbeatInterval time.Duration) *cacheservice.Reservation {
+	return &cacheservice.Reservation{
+		Key:               reservation.Key,
+		OwnerId:           reservation.OwnerID,
+		HeartbeatInterval: durationpb.New(heartbeatInterval),
+		ExpiresAt:         timestamppb.New(reservation.ExpiresAt),
+	}
+}
@@ -0,0 +1,351 @@
+package impl
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	errors2 "github.com/flyteorg/flyte/datacatalog/pkg/errors"
+	errors3 "github.com/flyteorg/flyte/datacatalog/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/mocks"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/cacheservice"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	mockScope "github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+const testCacheKey = "cache-key"
+
+func getTestCachedOutput(value string) *cacheservice.CachedOutput {
+	return &cacheservice.CachedOutput{
+		Output: &cacheservice.CachedOutput_OutputLiterals{
+			OutputLiterals: &core.LiteralMap{
+				Literals: map[string]*core.Literal{"o0": getTestStringLiteralWithValue(value)},
+			},
+		},
+		Metadata: &cacheservice.Metadata{
+			SourceIdentifier: &core.Identifier{Project: "p", Domain: "d", Name: "task", Version: "v"},
+		},
+	}
+}
+
+func newMockCacheRepo() *mocks.DataCatalogRepo {
+	return &mocks.DataCatalogRepo{
+		MockCachedOutputRepo:     &mocks.CachedOutputRepo{},
+		MockCacheReservationRepo: &mocks.CacheReservationRepo{},
+	}
+}
+
+func newTestCacheManager(t *testing.T, repo *mocks.DataCatalogRepo, maxInlineSizeBytes int64, now time.Time) (*cacheManager, *storage.DataStore) {
+	ctx := context.Background()
+	datastore := createInmemoryDataStore(t, mockScope.NewTestScope())
+	prefix, err := datastore.ConstructReference(ctx, datastore.GetBaseContainerFQN(ctx), "cache")
+	assert.NoError(t, err)
+
+	m := NewCacheManager(repo, datastore, prefix, maxInlineSizeBytes, heartbeatGracePeriodMultiplier, maxHeartbeatInterval,
+		func() time.Time { return now }, mockScope.NewTestScope())
+	return m.(*cacheManager), datastore
+}
+
+func TestCachePutAndGet(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("inline", func(t *testing.T) {
+		repo := newMockCacheRepo()
+		m, _ := newTestCacheManager(t, repo, 1024*1024, time.Now())
+
+		var stored models.CachedOutput
+		repo.MockCachedOutputRepo.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(
+			func(_ context.Context, output models.CachedOutput) error {
+				stored = output
+				return nil
+			})
+
+		expected := getTestCachedOutput("value")
+		_, err := m.Put(ctx, &cacheservice.PutCacheRequest{Key: testCacheKey, Output: expected})
+		assert.NoError(t, err)
+		assert.False(t, stored.Offloaded)
+		assert.Empty(t, stored.OutputURI)
+		assert.NotEmpty(t, stored.SerializedOutputLiterals)
+
+		repo.MockCachedOutputRepo.EXPECT().Get(mock.Anything, testCacheKey).Return(stored, nil)
+		resp, err := m.Get(ctx, &cacheservice.GetCacheRequest{Key: testCacheKey})
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(expected.GetOutputLiterals(), resp.GetOutput().GetOutputLiterals()))
+		assert.True(t, proto.Equal(expected.GetMetadata().GetSourceIdentifier(), resp.GetOutput().GetMetadata().GetSourceIdentifier()))
+		assert.NotNil(t, resp.GetOutput().GetMetadata().GetCreatedAt())
+	})
+
+	t.Run("offloaded", func(t *testing.T) {
+		repo := newMockCacheRepo()
+		m, datastore := newTestCacheManager(t, repo, 16, time.Now())
+
+		var stored models.CachedOutput
+		repo.MockCachedOutputRepo.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(
+			func(_ context.Context, output models.CachedOutput) error {
+				stored = output
+				return nil
+			})
+
+		expected := getTestCachedOutput(strings.Repeat("x", 64))
+		_, err := m.Put(ctx, &cacheservice.PutCacheRequest{Key: testCacheKey, Output: expected})
+		assert.NoError(t, err)
+		assert.True(t, stored.Offloaded)
+		assert.Empty(t, stored.SerializedOutputLiterals)
+
+		offloaded := &core.LiteralMap{}
+		assert.NoError(t, datastore.ReadProtobuf(ctx, storage.DataReference(stored.OutputURI), offloaded))
+		assert.True(t, proto.Equal(expected.GetOutputLiterals(), offloaded))
+
+		// Offloaded outputs are returned inline
+		repo.MockCachedOutputRepo.EXPECT().Get(mock.Anything, testCacheKey).Return(stored, nil)
+		resp, err := m.Get(ctx, &cacheservice.GetCacheRequest{Key: testCacheKey})
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(expected.GetOutputLiterals(), resp.GetOutput().GetOutputLiterals()))
+	})
+
+	t.Run("output uri", func(t *testing.T) {
+		repo := newMockCacheRepo()
+		m, _ := newTestCacheManager(t, repo, 16, time.Now())
+
+		var stored models.CachedOutput
+		repo.MockCachedOutputRepo.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(
+			func(_ context.Context, output models.CachedOutput) error {
+				stored = output
+				return nil
+			})
+
+		_, err := m.Put(ctx, &cacheservice.PutCacheRequest{Key: testCacheKey, Output: &cacheservice.CachedOutput{
+			Output: &cacheservice.CachedOutput_OutputUri{OutputUri: "s3://bucket/outputs.pb"},
+		}})
+		assert.NoError(t, err)
+		assert.False(t, stored.Offloaded)
+
+		repo.MockCachedOutputRepo.EXPECT().Get(mock.Anything, testCacheKey).Return(stored, nil)
+		resp, err := m.Get(ctx, &cacheservice.GetCacheRequest{Key: testCacheKey})
+		assert.NoError(t, err)
+		assert.Equal(t, "s3://bucket/outputs.pb", resp.GetOutput().GetOutputUri())
+	})
+}
+
+func TestCachePut(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("already exists", func(t *testing.T) {
+		repo := newMockCacheRepo()
+		m, _ := newTestCacheManager(t, repo, 1024, time.Now())
+		repo.MockCachedOutputRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(
+			errors2.NewDataCatalogError(codes.AlreadyExists, errors3.AlreadyExists))
+
+		_, err := m.Put(ctx, &cacheservice.PutCacheRequest{Key: testCacheKey, Output: getTestCachedOutput("value")})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("overwrite missing entry", func(t *testing.T) {
+		repo := newMockCacheRepo()
+		m, _ := newTestCacheManager(t, repo, 1024, time.Now())
+		repo.MockCachedOutputRepo.EXPECT().Get(mock.Anything, testCacheKey).Return(models.CachedOutput{},
+			errors3.GetMissingKeyError("CachedOutput", testCacheKey))
+		repo.MockCachedOutputRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(
+			errors3.GetMissingKeyError("CachedOutput", testCacheKey))
+		repo.MockCachedOutputRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+
+		_, err := m.Put(ctx, &cacheservice.PutCacheRequest{Key: testCacheKey, Output: getTestCachedOutput("value"), Overwrite: true})
+		assert.NoError(t, err)
+		repo.MockCachedOutputRepo.AssertExpectations(t)
+	})
+
+	t.Run("already exists offloaded", func(t *testing.T) {
+		repo := newMockCacheRepo()
+		m, datastore := newTestCacheManager(t, repo, 16, time.Now())
+
+		var stored, rejected models.CachedOutput
+		repo.MockCachedOutputRepo.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(
+			func(_ context.Context, output models.CachedOutput) error {
+				stored = output
+				return nil
+			}).Once()
+		repo.MockCachedOutputRepo.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(
+			func(_ context.Context, output models.CachedOutput) error {
+				rejected = output
+				return errors2.NewDataCatalogError(codes.AlreadyExists, errors3.AlreadyExists)
+			}).Once()
+
+		expected := getTestCachedOutput(strings.Repeat("x", 64))
+		_, err := m.Put(ctx, &cacheservice.PutCacheRequest{Key: testCacheKey, Output: expected})
+		assert.NoError(t, err)
+		_, err = m.Put(ctx, &cacheservice.PutCacheRequest{Key: testCacheKey, Output: getTestCachedOutput(strings.Repeat("y", 64))})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		assert.True(t, rejected.Offloaded)
+		assert.NotEqual(t, stored.OutputURI, rejected.OutputURI)
+
+		// The existing entry still reads its own outputs and the rejected ones are cleaned up
+		offloaded := &core.LiteralMap{}
+		assert.NoError(t, datastore.ReadProtobuf(ctx, storage.DataReference(stored.OutputURI), offloaded))
+		assert.True(t, proto.Equal(expected.GetOutputLiterals(), offloaded))
+		metadata, err := datastore.Head(ctx, storage.DataReference(rejected.OutputURI))
+		assert.NoError(t, err)
+		assert.False(t, metadata.Exists())
+	})
+
+	t.Run("overwrite offloaded entry", func(t *testing.T) {
+		repo := newMockCacheRepo()
+		m, datastore := newTestCacheManager(t, repo, 16, time.Now())
+
+		var previous models.CachedOutput
+		repo.MockCachedOutputRepo.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(
+			func(_ context.Context, output models.CachedOutput) error {
+				previous = output
+				return nil
+			})
+		_, err := m.Put(ctx, &cacheservice.PutCacheRequest{Key: testCacheKey, Output: getTestCachedOutput(strings.Repeat("x", 64))})
+		assert.NoError(t, err)
+
+		var stored models.CachedOutput
+		repo.MockCachedOutputRepo.EXPECT().Get(mock.Anything, testCacheKey).Return(previous, nil)
+		repo.MockCachedOutputRepo.EXPECT().Update(mock.Anything, mock.Anything).RunAndReturn(
+			func(_ context.Context, output models.CachedOutput) error {
+				stored = output
+				return nil
+			})
+		_, err = m.Put(ctx, &cacheservice.PutCacheRequest{Key: testCacheKey, Output: getTestCachedOutput("y"), Overwrite: true})
+		assert.NoError(t, err)
+		assert.False(t, stored.Offloaded)
+
+		// The outputs of the overwritten entry are no longer referenced
+		metadata, err := datastore.Head(ctx, storage.DataReference(previous.OutputURI))
+		assert.NoError(t, err)
+		assert.False(t, metadata.Exists())
+	})
+
+	t.Run("missing output", func(t *testing.T) {
+		m, _ := newTestCacheManager(t, newMockCacheRepo(), 1024, time.Now())
+		_, err := m.Put(ctx, &cacheservice.PutCacheRequest{Key: testCacheKey})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestCacheGetNotFound(t *testing.T) {
+	repo := newMockCacheRepo()
+	m, _ := newTestCacheManager(t, repo, 1024, time.Now())
+	repo.MockCachedOutputRepo.EXPECT().Get(mock.Anything, testCacheKey).Return(models.CachedOutput{},
+		errors3.GetMissingKeyError("CachedOutput", testCacheKey))
+
+	_, err := m.Get(context.Background(), &cacheservice.GetCacheRequest{Key: testCacheKey})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCacheDelete(t *testing.T) {
+	ctx := context.Background()
+	repo := newMockCacheRepo()
+	m, datastore := newTestCacheManager(t, repo, 16, time.Now())
+
+	var stored models.CachedOutput
+	repo.MockCachedOutputRepo.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, output models.CachedOutput) error {
+			stored = output
+			return nil
+		})
+	_, err := m.Put(ctx, &cacheservice.PutCacheRequest{Key: testCacheKey, Output: getTestCachedOutput(strings.Repeat("x", 64))})
+	assert.NoError(t, err)
+	assert.True(t, stored.Offloaded)
+
+	repo.MockCachedOutputRepo.EXPECT().Get(mock.Anything, testCacheKey).Return(stored, nil)
+	repo.MockCachedOutputRepo.EXPECT().Delete(mock.Anything, testCacheKey).Return(nil)
+	_, err = m.Delete(ctx, &cacheservice.DeleteCacheRequest{Key: testCacheKey})
+	assert.NoError(t, err)
+
+	metadata, err := datastore.Head(ctx, storage.DataReference(stored.OutputURI))
+	assert.NoError(t, err)
+	assert.False(t, metadata.Exists())
+}
+
+func TestCacheGetOrExtendReservation(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("create", func(t *testing.T) {
+		repo := newMockCacheRepo()
+		m, _ := newTestCacheManager(t, repo, 1024, now)
+		repo.MockCacheReservationRepo.EXPECT().Get(mock.Anything, testCacheKey).Return(models.CacheReservation{},
+			errors2.NewDataCatalogErrorf(codes.NotFound, "entry not found"))
+		repo.MockCacheReservationRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(reservation models.CacheReservation) bool {
+			return reservation.Key == testCacheKey && reservation.OwnerID == currentOwner &&
+				reservation.ExpiresAt.Equal(now.Add(heartbeatInterval*heartbeatGracePeriodMultiplier))
+		}), now).Return(nil)
+
+		resp, err := m.GetOrExtendReservation(ctx, &cacheservice.GetOrExtendReservationRequest{
+			Key: testCacheKey, OwnerId: currentOwner, HeartbeatInterval: durationpb.New(heartbeatInterval),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, currentOwner, resp.GetReservation().GetOwnerId())
+		assert.Equal(t, heartbeatInterval, resp.GetReservation().GetHeartbeatInterval().AsDuration())
+	})
+
+	t.Run("held by another owner", func(t *testing.T) {
+		repo := newMockCacheRepo()
+		m, _ := newTestCacheManager(t, repo, 1024, now)
+		repo.MockCacheReservationRepo.EXPECT().Get(mock.Anything, testCacheKey).Return(models.CacheReservation{
+			Key:       testCacheKey,
+			OwnerID:   prevOwner,
+			ExpiresAt: now.Add(time.Minute),
+		}, nil)
+
+		resp, err := m.GetOrExtendReservation(ctx, &cacheservice.GetOrExtendReservationRequest{
+			Key: testCacheKey, OwnerId: currentOwner, HeartbeatInterval: durationpb.New(time.Hour),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, prevOwner, resp.GetReservation().GetOwnerId())
+		// The requested interval is capped at the configured maximum
+		assert.Equal(t, maxHeartbeatInterval, resp.GetReservation().GetHeartbeatInterval().AsDuration())
+	})
+
+	t.Run("take over expired", func(t *testing.T) {
+		repo := newMockCacheRepo()
+		m, _ := newTestCacheManager(t, repo, 1024, now)
+		repo.MockCacheReservationRepo.EXPECT().Get(mock.Anything, testCacheKey).Return(models.CacheReservation{
+			Key:       testCacheKey,
+			OwnerID:   prevOwner,
+			ExpiresAt: now.Add(-time.Minute),
+		}, nil)
+		repo.MockCacheReservationRepo.EXPECT().Update(mock.Anything, mock.Anything, now).Return(nil)
+
+		resp, err := m.GetOrExtendReservation(ctx, &cacheservice.GetOrExtendReservationRequest{
+			Key: testCacheKey, OwnerId: currentOwner, HeartbeatInterval: durationpb.New(heartbeatInterval),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, currentOwner, resp.GetReservation().GetOwnerId())
+	})
+}
+
+func TestCacheReleaseReservation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		repo := newMockCacheRepo()
+		m, _ := newTestCacheManager(t, repo, 1024, time.Now())
+		repo.MockCacheReservationRepo.EXPECT().Delete(mock.Anything, testCacheKey, currentOwner).Return(nil)
+
+		_, err := m.ReleaseReservation(ctx, &cacheservice.ReleaseReservationRequest{Key: testCacheKey, OwnerId: currentOwner})
+		assert.NoError(t, err)
+	})
+
+	t.Run("does not exist", func(t *testing.T) {
+		repo := newMockCacheRepo()
+		m, _ := newTestCacheManager(t, repo, 1024, time.Now())
+		repo.MockCacheReservationRepo.EXPECT().Delete(mock.Anything, testCacheKey, currentOwner).Return(
+			errors3.GetMissingKeyError("CacheReservation", testCacheKey))
+
+		_, err := m.ReleaseReservation(ctx, &cacheservice.ReleaseReservationRequest{Key: testCacheKey, OwnerId: currentOwner})
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,35 @@
+package validators
+
+import (
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/cacheservice"
+)
+
+const (
+	cacheKeyEntity     = "key"
+	cacheOutputEntity  = "output"
+	cacheOwnerIDEntity = "ownerId"
+)
+
+func ValidatePutCacheRequest(request *cacheservice.PutCacheRequest) error {
+	if err := ValidateEmptyStringField(request.GetKey(), cacheKeyEntity); err != nil {
+		return err
+	}
+	if request.GetOutput().GetOutput() == nil {
+		return NewMissingArgumentError(cacheOutputEntity)
+	}
+	if _, ok := request.GetOutput().GetOutput().(*cacheservice.CachedOutput_OutputUri); ok && request.GetOutput().GetOutputUri() == "" {
+		return NewMissingArgumentError(cacheOutputEntity)
+	}
+	return nil
+}
+
+func ValidateCacheKey(key string) error {
+	return ValidateEmptyStringField(key, cacheKeyEntity)
+}
+
+func ValidateCacheReservation(key, ownerID string) error {
+	if err := ValidateEmptyStringField(key, cacheKeyEntity); err != nil {
+		return err
+	}
+	return ValidateEmptyStringField(ownerID, cacheOwnerIDEntity)
+}
@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/cacheservice"
+)
+
+// CacheManager is the interface to handle key-value cache requests.
+// You can find more details about the APIs in the cacheservice proto
+// in flyteidl
+type CacheManager interface {
+	Get(context.Context, *cacheservice.GetCacheRequest) (*cacheservice.GetCacheResponse, error)
+	Put(context.Context, *cacheservice.PutCacheRequest) (*cacheservice.PutCacheResponse, error)
+	Delete(context.Context, *cacheservice.DeleteCacheRequest) (*cacheservice.DeleteCacheResponse, error)
+	GetOrExtendReservation(context.Context, *cacheservice.GetOrExtendReservationRequest) (*cacheservice.GetOrExtendReservationResponse, error)
+	ReleaseReservation(context.Context, *cacheservice.ReleaseReservationRequest) (*cacheservice.ReleaseReservationResponse, error)
+}
@@ -32,3 +32,7 @@ func GetUnsupportedFilterExpressionErr(operator common.ComparisonOperator) error
 	return errors.NewDataCatalogErrorf(codes.InvalidArgument, "unsupported filter expression operator index: %v",
 		operator)
 }
+
+func GetMissingKeyError(entityType string, key string) error {
+	return errors.NewDataCatalogErrorf(codes.NotFound, notFound, entityType, key)
+}
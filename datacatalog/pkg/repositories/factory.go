@@ -29,6 +29,8 @@ type RepositoryInterface interface {
 	ArtifactRepo() interfaces.ArtifactRepo
 	TagRepo() interfaces.TagRepo
 	ReservationRepo() interfaces.ReservationRepo
+	CachedOutputRepo() interfaces.CachedOutputRepo
+	CacheReservationRepo() interfaces.CacheReservationRepo
 }
 
 func GetRepository(ctx context.Context, repoType RepoConfig, dbConfig database.DbConfig, scope promutils.Scope) RepositoryInterface {
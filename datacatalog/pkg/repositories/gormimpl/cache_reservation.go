@@ -0,0 +1,103 @@
+package gormimpl
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	datacatalog_error "github.com/flyteorg/flyte/datacatalog/pkg/errors"
+	errors2 "github.com/flyteorg/flyte/datacatalog/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+type cacheReservationRepo struct {
+	db               *gorm.DB
+	repoMetrics      gormMetrics
+	errorTransformer errors2.ErrorTransformer
+}
+
+// NewCacheReservationRepo creates a cacheReservationRepo
+func NewCacheReservationRepo(db *gorm.DB, errorTransformer errors2.ErrorTransformer, scope promutils.Scope) interfaces.CacheReservationRepo {
+	return &cacheReservationRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		repoMetrics:      newGormMetrics(scope),
+	}
+}
+
+func (r *cacheReservationRepo) Create(ctx context.Context, reservation models.CacheReservation, now time.Time) error {
+	timer := r.repoMetrics.CreateDuration.Start(ctx)
+	defer timer.Stop()
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&reservation)
+	if result.Error != nil {
+		return r.errorTransformer.ToDataCatalogError(result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return datacatalog_error.NewDataCatalogError(codes.FailedPrecondition, errors2.AlreadyExists)
+	}
+
+	return nil
+}
+
+func (r *cacheReservationRepo) Delete(ctx context.Context, key string, ownerID string) error {
+	timer := r.repoMetrics.DeleteDuration.Start(ctx)
+	defer timer.Stop()
+
+	var reservation models.CacheReservation
+
+	result := r.db.WithContext(ctx).Where(&models.CacheReservation{
+		Key:     key,
+		OwnerID: ownerID,
+	}).Delete(&reservation)
+	if result.Error != nil {
+		return r.errorTransformer.ToDataCatalogError(result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return errors2.GetMissingKeyError("CacheReservation", key)
+	}
+
+	return nil
+}
+
+func (r *cacheReservationRepo) Get(ctx context.Context, key string) (models.CacheReservation, error) {
+	timer := r.repoMetrics.GetDuration.Start(ctx)
+	defer timer.Stop()
+
+	var reservation models.CacheReservation
+
+	result := r.db.WithContext(ctx).Where(&models.CacheReservation{
+		Key: key,
+	}).Take(&reservation)
+
+	if result.Error != nil {
+		return reservation, r.errorTransformer.ToDataCatalogError(result.Error)
+	}
+
+	return reservation, nil
+}
+
+func (r *cacheReservationRepo) Update(ctx context.Context, reservation models.CacheReservation, now time.Time) error {
+	timer := r.repoMetrics.UpdateDuration.Start(ctx)
+	defer timer.Stop()
+
+	result := r.db.WithContext(ctx).Model(&models.CacheReservation{
+		Key: reservation.Key,
+	}).Where("expires_at<=? OR owner_id=?", now, reservation.OwnerID).Updates(reservation)
+	if result.Error != nil {
+		return r.errorTransformer.ToDataCatalogError(result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return datacatalog_error.NewDataCatalogError(codes.FailedPrecondition, errors2.AlreadyExists)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package gormimpl
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+func getTestCacheReservation() models.CacheReservation {
+	return models.CacheReservation{
+		Key:       "testKey",
+		OwnerID:   "batman",
+		ExpiresAt: time.Unix(1, 1),
+	}
+}
+
+func getCacheReservationRepo(t *testing.T) interfaces.CacheReservationRepo {
+	mocket.Catcher.Register()
+	sqlDB, err := sql.Open(mocket.DriverName, "blah")
+	assert.Nil(t, err)
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}))
+	if err != nil {
+		t.Fatalf("Failed to open mock db with err %v", err)
+	}
+
+	return NewCacheReservationRepo(db, errors.NewPostgresErrorTransformer(), promutils.NewTestScope())
+}
+
+func TestCacheReservationCreate(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	GlobalMock.NewMock().WithQuery(
+		`INSERT INTO "cache_reservations" ("created_at","updated_at","deleted_at","key","owner_id","expires_at") VALUES ($1,$2,$3,$4,$5,$6) ON CONFLICT DO NOTHING`,
+	).WithRowsNum(1)
+
+	err := getCacheReservationRepo(t).Create(context.Background(), getTestCacheReservation(), time.Now())
+	assert.NoError(t, err)
+}
+
+func TestCacheReservationGet(t *testing.T) {
+	expected := getTestCacheReservation()
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "cache_reservations" WHERE "cache_reservations"."key" = $1 LIMIT 1`,
+	).WithReply([]map[string]interface{}{
+		{
+			"key":        expected.Key,
+			"owner_id":   expected.OwnerID,
+			"expires_at": expected.ExpiresAt,
+		},
+	})
+
+	reservation, err := getCacheReservationRepo(t).Get(context.Background(), expected.Key)
+	assert.NoError(t, err)
+	assert.Equal(t, expected.Key, reservation.Key)
+	assert.Equal(t, expected.OwnerID, reservation.OwnerID)
+	assert.Equal(t, expected.ExpiresAt, reservation.ExpiresAt)
+}
+
+func TestCacheReservationUpdate(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	GlobalMock.NewMock().WithQuery(
+		`UPDATE "cache_reservations" SET "updated_at"=$1,"key"=$2,"owner_id"=$3,"expires_at"=$4 WHERE (expires_at<=$5 OR owner_id=$6) AND "key" = $7`,
+	).WithRowsNum(1)
+
+	err := getCacheReservationRepo(t).Update(context.Background(), getTestCacheReservation(), time.Now())
+	assert.NoError(t, err)
+}
+
+func TestCacheReservationUpdateFailure(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	GlobalMock.NewMock().WithQuery(
+		`UPDATE "cache_reservations" SET "updated_at"=$1,"key"=$2,"owner_id"=$3,"expires_at"=$4 WHERE (expires_at<=$5 OR owner_id=$6) AND "key" = $7`,
+	).WithRowsNum(0)
+
+	err := getCacheReservationRepo(t).Update(context.Background(), getTestCacheReservation(), time.Now())
+	assert.Error(t, err)
+	assert.Equal(t, "entity already exists", err.Error())
+}
+
+func TestCacheReservationDelete(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	GlobalMock.NewMock().WithQuery(
+		`DELETE FROM "cache_reservations" WHERE "cache_reservations"."key" = $1 AND "cache_reservations"."owner_id" = $2`,
+	).WithRowsNum(0)
+
+	err := getCacheReservationRepo(t).Delete(context.Background(), "testKey", "batman")
+	assert.Error(t, err)
+	assert.Equal(t, "missing entity of type CacheReservation with identifier testKey", err.Error())
+}
@@ -0,0 +1,102 @@
+package gormimpl
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	datacatalog_error "github.com/flyteorg/flyte/datacatalog/pkg/errors"
+	errors2 "github.com/flyteorg/flyte/datacatalog/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+type cachedOutputRepo struct {
+	db               *gorm.DB
+	repoMetrics      gormMetrics
+	errorTransformer errors2.ErrorTransformer
+}
+
+// NewCachedOutputRepo creates a cachedOutputRepo
+func NewCachedOutputRepo(db *gorm.DB, errorTransformer errors2.ErrorTransformer, scope promutils.Scope) interfaces.CachedOutputRepo {
+	return &cachedOutputRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		repoMetrics:      newGormMetrics(scope),
+	}
+}
+
+func (r *cachedOutputRepo) Create(ctx context.Context, output models.CachedOutput) error {
+	timer := r.repoMetrics.CreateDuration.Start(ctx)
+	defer timer.Stop()
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&output)
+	if result.Error != nil {
+		return r.errorTransformer.ToDataCatalogError(result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return datacatalog_error.NewDataCatalogError(codes.AlreadyExists, errors2.AlreadyExists)
+	}
+
+	return nil
+}
+
+func (r *cachedOutputRepo) Get(ctx context.Context, key string) (models.CachedOutput, error) {
+	timer := r.repoMetrics.GetDuration.Start(ctx)
+	defer timer.Stop()
+
+	var output models.CachedOutput
+
+	result := r.db.WithContext(ctx).Where(&models.CachedOutput{
+		Key: key,
+	}).Take(&output)
+
+	if result.Error != nil {
+		return output, r.errorTransformer.ToDataCatalogError(result.Error)
+	}
+
+	return output, nil
+}
+
+func (r *cachedOutputRepo) Update(ctx context.Context, output models.CachedOutput) error {
+	timer := r.repoMetrics.UpdateDuration.Start(ctx)
+	defer timer.Stop()
+
+	// Select the columns explicitly so that zero values (e.g. clearing the output URI) are written as well
+	result := r.db.WithContext(ctx).Model(&models.CachedOutput{
+		Key: output.Key,
+	}).Select("updated_at", "serialized_output_literals", "output_uri", "offloaded", "serialized_metadata").Updates(output)
+	if result.Error != nil {
+		return r.errorTransformer.ToDataCatalogError(result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return errors2.GetMissingKeyError("CachedOutput", output.Key)
+	}
+
+	return nil
+}
+
+func (r *cachedOutputRepo) Delete(ctx context.Context, key string) error {
+	timer := r.repoMetrics.DeleteDuration.Start(ctx)
+	defer timer.Stop()
+
+	var output models.CachedOutput
+
+	result := r.db.WithContext(ctx).Where(&models.CachedOutput{
+		Key: key,
+	}).Delete(&output)
+	if result.Error != nil {
+		return r.errorTransformer.ToDataCatalogError(result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return errors2.GetMissingKeyError("CachedOutput", key)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package gormimpl
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	apiErrors "github.com/flyteorg/flyte/datacatalog/pkg/errors"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+func getTestCachedOutput() models.CachedOutput {
+	return models.CachedOutput{
+		Key:                      "testKey",
+		SerializedOutputLiterals: []byte("literals"),
+		SerializedMetadata:       []byte("metadata"),
+	}
+}
+
+func getCachedOutputRepo(t *testing.T) interfaces.CachedOutputRepo {
+	mocket.Catcher.Register()
+	sqlDB, err := sql.Open(mocket.DriverName, "blah")
+	assert.Nil(t, err)
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}))
+	if err != nil {
+		t.Fatalf("Failed to open mock db with err %v", err)
+	}
+
+	return NewCachedOutputRepo(db, errors.NewPostgresErrorTransformer(), promutils.NewTestScope())
+}
+
+func TestCachedOutputCreate(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	GlobalMock.NewMock().WithQuery(
+		`INSERT INTO "cached_outputs" ("created_at","updated_at","deleted_at","key","serialized_output_literals","output_uri","offloaded","serialized_metadata") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) ON CONFLICT DO NOTHING`,
+	).WithRowsNum(1)
+
+	err := getCachedOutputRepo(t).Create(context.Background(), getTestCachedOutput())
+	assert.NoError(t, err)
+}
+
+func TestCachedOutputGet(t *testing.T) {
+	expected := getTestCachedOutput()
+
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	GlobalMock.NewMock().WithQuery(
+		`SELECT * FROM "cached_outputs" WHERE "cached_outputs"."key" = $1 LIMIT 1`,
+	).WithReply([]map[string]interface{}{
+		{
+			"key":                        expected.Key,
+			"serialized_output_literals": expected.SerializedOutputLiterals,
+			"serialized_metadata":        expected.SerializedMetadata,
+		},
+	})
+
+	output, err := getCachedOutputRepo(t).Get(context.Background(), expected.Key)
+	assert.NoError(t, err)
+	assert.Equal(t, expected.Key, output.Key)
+	assert.Equal(t, expected.SerializedOutputLiterals, output.SerializedOutputLiterals)
+	assert.Equal(t, expected.SerializedMetadata, output.SerializedMetadata)
+}
+
+func TestCachedOutputGetNotFound(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	GlobalMock.NewMock().WithError(gorm.ErrRecordNotFound)
+
+	_, err := getCachedOutputRepo(t).Get(context.Background(), "testKey")
+	assert.Error(t, err)
+	dcErr, ok := err.(apiErrors.DataCatalogError)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, dcErr.Code())
+}
+
+func TestCachedOutputUpdate(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	GlobalMock.NewMock().WithQuery(
+		`UPDATE "cached_outputs" SET "updated_at"=$1,"serialized_output_literals"=$2,"output_uri"=$3,"offloaded"=$4,"serialized_metadata"=$5 WHERE "key" = $6`,
+	).WithRowsNum(1)
+
+	err := getCachedOutputRepo(t).Update(context.Background(), getTestCachedOutput())
+	assert.NoError(t, err)
+}
+
+func TestCachedOutputUpdateNotFound(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	GlobalMock.NewMock().WithQuery(
+		`UPDATE "cached_outputs" SET "updated_at"=$1,"serialized_output_literals"=$2,"output_uri"=$3,"offloaded"=$4,"serialized_metadata"=$5 WHERE "key" = $6`,
+	).WithRowsNum(0)
+
+	err := getCachedOutputRepo(t).Update(context.Background(), getTestCachedOutput())
+	assert.Error(t, err)
+	assert.True(t, apiErrors.IsDoesNotExistError(err))
+}
+
+func TestCachedOutputDelete(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	GlobalMock.NewMock().WithQuery(
+		`DELETE FROM "cached_outputs" WHERE "cached_outputs"."key" = $1`,
+	).WithRowsNum(1)
+
+	err := getCachedOutputRepo(t).Delete(context.Background(), "testKey")
+	assert.NoError(t, err)
+}
+
+func TestCachedOutputDeleteNotFound(t *testing.T) {
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	GlobalMock.NewMock().WithQuery(
+		`DELETE FROM "cached_outputs" WHERE "cached_outputs"."key" = $1`,
+	).WithRowsNum(0)
+
+	err := getCachedOutputRepo(t).Delete(context.Background(), "testKey")
+	assert.Error(t, err)
+	assert.Equal(t, "missing entity of type CachedOutput with identifier testKey", err.Error())
+}
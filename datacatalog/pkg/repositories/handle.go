@@ -100,5 +100,13 @@ func (h *DBHandle) Migrate(ctx context.Context) error {
 		return err
 	}
 
+	if err := h.db.AutoMigrate(&models.CachedOutput{}); err != nil {
+		return err
+	}
+
+	if err := h.db.AutoMigrate(&models.CacheReservation{}); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -5,4 +5,6 @@ type DataCatalogRepo interface {
 	ArtifactRepo() ArtifactRepo
 	TagRepo() TagRepo
 	ReservationRepo() ReservationRepo
+	CachedOutputRepo() CachedOutputRepo
+	CacheReservationRepo() CacheReservationRepo
 }
@@ -0,0 +1,46 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories/models"
+)
+
+//go:generate mockery --name=CachedOutputRepo --output=../mocks --case=underscore --with-expecter
+
+// Interface to interact with the CachedOutput Table
+type CachedOutputRepo interface {
+
+	// Create a new cached output. Fails with an AlreadyExists error if the key is already populated.
+	Create(ctx context.Context, output models.CachedOutput) error
+
+	// Get the cached output stored under the key
+	Get(ctx context.Context, key string) (models.CachedOutput, error)
+
+	// Update overwrites an existing cached output
+	Update(ctx context.Context, output models.CachedOutput) error
+
+	// Delete the cached output stored under the key
+	Delete(ctx context.Context, key string) error
+}
+
+//go:generate mockery --name=CacheReservationRepo --output=../mocks --case=underscore --with-expecter
+
+// Interface to interact with the CacheReservation Table
+type CacheReservationRepo interface {
+
+	// Create a new reservation if the reservation does not already exist
+	Create(ctx context.Context, reservation models.CacheReservation, now time.Time) error
+
+	// Delete a reservation if it exists
+	Delete(ctx context.Context, key string, ownerID string) error
+
+	// Get reservation
+	Get(ctx context.Context, key string) (models.CacheReservation, error)
+
+	// Update an existing reservation. If called by the current owner, we update the
+	// expiresAt timestamp. If called by a new owner and the current reservation has
+	// expired, we attempt to take over the reservation.
+	Update(ctx context.Context, reservation models.CacheReservation, now time.Time) error
+}
@@ -3,10 +3,12 @@ package mocks
 import "github.com/flyteorg/flyte/datacatalog/pkg/repositories/interfaces"
 
 type DataCatalogRepo struct {
-	MockDatasetRepo     *DatasetRepo
-	MockArtifactRepo    *ArtifactRepo
-	MockTagRepo         *TagRepo
-	MockReservationRepo *ReservationRepo
+	MockDatasetRepo          *DatasetRepo
+	MockArtifactRepo         *ArtifactRepo
+	MockTagRepo              *TagRepo
+	MockReservationRepo      *ReservationRepo
+	MockCachedOutputRepo     *CachedOutputRepo
+	MockCacheReservationRepo *CacheReservationRepo
 }
 
 func (m *DataCatalogRepo) DatasetRepo() interfaces.DatasetRepo {
@@ -24,3 +26,11 @@ func (m *DataCatalogRepo) TagRepo() interfaces.TagRepo {
 func (m *DataCatalogRepo) ReservationRepo() interfaces.ReservationRepo {
 	return m.MockReservationRepo
 }
+
+func (m *DataCatalogRepo) CachedOutputRepo() interfaces.CachedOutputRepo {
+	return m.MockCachedOutputRepo
+}
+
+func (m *DataCatalogRepo) CacheReservationRepo() interfaces.CacheReservationRepo {
+	return m.MockCacheReservationRepo
+}
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// CachedOutput is a key-value cache entry for the outputs of a node execution
+type CachedOutput struct {
+	BaseModel
+	Key string `gorm:"primary_key"`
+
+	// Serialized core.LiteralMap of the outputs when they are small enough to be stored inline
+	SerializedOutputLiterals []byte
+	// Location of the outputs when they are referenced by URI rather than stored inline
+	OutputURI string
+	// Set when the server offloaded the output literals to OutputURI itself
+	Offloaded          bool
+	SerializedMetadata []byte
+}
+
+// CacheReservation tracks the owner of an in-flight computation of a cache entry
+type CacheReservation struct {
+	BaseModel
+	Key string `gorm:"primary_key"`
+
+	// Identifies who owns the reservation
+	OwnerID string
+
+	// When the reservation will expire
+	ExpiresAt time.Time
+}
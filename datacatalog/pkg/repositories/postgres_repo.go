@@ -10,10 +10,12 @@ import (
 )
 
 type PostgresRepo struct {
-	datasetRepo     interfaces.DatasetRepo
-	artifactRepo    interfaces.ArtifactRepo
-	tagRepo         interfaces.TagRepo
-	reservationRepo interfaces.ReservationRepo
+	datasetRepo          interfaces.DatasetRepo
+	artifactRepo         interfaces.ArtifactRepo
+	tagRepo              interfaces.TagRepo
+	reservationRepo      interfaces.ReservationRepo
+	cachedOutputRepo     interfaces.CachedOutputRepo
+	cacheReservationRepo interfaces.CacheReservationRepo
 }
 
 func (dc *PostgresRepo) DatasetRepo() interfaces.DatasetRepo {
@@ -32,11 +34,21 @@ func (dc *PostgresRepo) ReservationRepo() interfaces.ReservationRepo {
 	return dc.reservationRepo
 }
 
+func (dc *PostgresRepo) CachedOutputRepo() interfaces.CachedOutputRepo {
+	return dc.cachedOutputRepo
+}
+
+func (dc *PostgresRepo) CacheReservationRepo() interfaces.CacheReservationRepo {
+	return dc.cacheReservationRepo
+}
+
 func NewPostgresRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.DataCatalogRepo {
 	return &PostgresRepo{
-		datasetRepo:     gormimpl.NewDatasetRepo(db, errorTransformer, scope.NewSubScope("dataset")),
-		artifactRepo:    gormimpl.NewArtifactRepo(db, errorTransformer, scope.NewSubScope("artifact")),
-		tagRepo:         gormimpl.NewTagRepo(db, errorTransformer, scope.NewSubScope("tag")),
-		reservationRepo: gormimpl.NewReservationRepo(db, errorTransformer, scope.NewSubScope("reservation")),
+		datasetRepo:          gormimpl.NewDatasetRepo(db, errorTransformer, scope.NewSubScope("dataset")),
+		artifactRepo:         gormimpl.NewArtifactRepo(db, errorTransformer, scope.NewSubScope("artifact")),
+		tagRepo:              gormimpl.NewTagRepo(db, errorTransformer, scope.NewSubScope("tag")),
+		reservationRepo:      gormimpl.NewReservationRepo(db, errorTransformer, scope.NewSubScope("reservation")),
+		cachedOutputRepo:     gormimpl.NewCachedOutputRepo(db, errorTransformer, scope.NewSubScope("cached_output")),
+		cacheReservationRepo: gormimpl.NewCacheReservationRepo(db, errorTransformer, scope.NewSubScope("cache_reservation")),
 	}
 }
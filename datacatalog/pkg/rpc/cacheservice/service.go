@@ -0,0 +1,133 @@
+package cacheservice
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"runtime/debug"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/flyteorg/flyte/datacatalog/pkg/config"
+	"github.com/flyteorg/flyte/datacatalog/pkg/manager/impl"
+	"github.com/flyteorg/flyte/datacatalog/pkg/manager/interfaces"
+	"github.com/flyteorg/flyte/datacatalog/pkg/repositories"
+	"github.com/flyteorg/flyte/datacatalog/pkg/runtime"
+	cache "github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/cacheservice"
+	"github.com/flyteorg/flyte/flytestdlib/contextutils"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/otelutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+// CacheService serves key-value caching of node outputs without the dataset and artifact bookkeeping of DataCatalog.
+type CacheService struct {
+	cache.UnimplementedCacheServiceServer
+
+	CacheManager interfaces.CacheManager
+}
+
+func (s *CacheService) Get(ctx context.Context, request *cache.GetCacheRequest) (*cache.GetCacheResponse, error) {
+	return s.CacheManager.Get(ctx, request)
+}
+
+func (s *CacheService) Put(ctx context.Context, request *cache.PutCacheRequest) (*cache.PutCacheResponse, error) {
+	return s.CacheManager.Put(ctx, request)
+}
+
+func (s *CacheService) Delete(ctx context.Context, request *cache.DeleteCacheRequest) (*cache.DeleteCacheResponse, error) {
+	return s.CacheManager.Delete(ctx, request)
+}
+
+func (s *CacheService) GetOrExtendReservation(ctx context.Context, request *cache.GetOrExtendReservationRequest) (*cache.GetOrExtendReservationResponse, error) {
+	return s.CacheManager.GetOrExtendReservation(ctx, request)
+}
+
+func (s *CacheService) ReleaseReservation(ctx context.Context, request *cache.ReleaseReservationRequest) (*cache.ReleaseReservationResponse, error) {
+	return s.CacheManager.ReleaseReservation(ctx, request)
+}
+
+func NewCacheService() *CacheService {
+	configProvider := runtime.NewConfigurationProvider()
+	dataCatalogConfig := configProvider.ApplicationConfiguration().GetDataCatalogConfig()
+	cacheScope := promutils.NewScope(dataCatalogConfig.MetricsScope).NewSubScope("cacheservice")
+	ctx := contextutils.WithAppName(context.Background(), "cacheservice")
+
+	defer func() {
+		if err := recover(); err != nil {
+			cacheScope.MustNewCounter("initialization_panic",
+				"panics encountered initializing the cache service").Inc()
+			logger.Fatalf(context.Background(), fmt.Sprintf("caught panic: %v [%+v]", err, string(debug.Stack())))
+		}
+	}()
+
+	storeConfig := storage.GetConfig()
+	dataStorageClient, err := storage.NewDataStore(storeConfig, cacheScope.NewSubScope("storage"))
+	if err != nil {
+		logger.Errorf(ctx, "Failed to create DataStore %v, err %v", storeConfig, err)
+		panic(err)
+	}
+	logger.Infof(ctx, "Created data storage.")
+
+	baseStorageReference := dataStorageClient.GetBaseContainerFQN(ctx)
+	storagePrefix, err := dataStorageClient.ConstructReference(ctx, baseStorageReference, dataCatalogConfig.CacheStoragePrefix)
+	if err != nil {
+		logger.Errorf(ctx, "Failed to create prefix %v, err %v", dataCatalogConfig.CacheStoragePrefix, err)
+		panic(err)
+	}
+
+	dbConfigValues := configProvider.ApplicationConfiguration().GetDbConfig()
+	repos := repositories.GetRepository(ctx, repositories.POSTGRES, *dbConfigValues, cacheScope)
+	logger.Infof(ctx, "Created DB connection.")
+
+	return &CacheService{
+		CacheManager: impl.NewCacheManager(repos, dataStorageClient, storagePrefix, dataCatalogConfig.CacheMaxInlineSizeBytes,
+			time.Duration(dataCatalogConfig.HeartbeatGracePeriodMultiplier), dataCatalogConfig.MaxReservationHeartbeat.Duration, time.Now,
+			cacheScope.NewSubScope("cache")),
+	}
+}
+
+// Create and start the gRPC server
+func ServeInsecure(ctx context.Context, cfg *config.Config) error {
+	grpcServer := newGRPCServer(ctx, cfg)
+
+	grpcListener, err := net.Listen("tcp", cfg.GetGrpcHostAddress())
+	if err != nil {
+		return err
+	}
+
+	logger.Infof(ctx, "Serving CacheService Insecure on port %v", cfg.GetGrpcHostAddress())
+	return grpcServer.Serve(grpcListener)
+}
+
+// Creates a new GRPC Server with all the configuration
+func newGRPCServer(_ context.Context, cfg *config.Config) *grpc.Server {
+	tracerProvider := otelutils.GetTracerProvider(otelutils.DataCatalogServerTracer)
+	opts := []grpc.ServerOption{grpc.UnaryInterceptor(
+		otelgrpc.UnaryServerInterceptor(
+			otelgrpc.WithTracerProvider(tracerProvider),
+			otelgrpc.WithPropagators(propagation.TraceContext{}),
+		),
+	)}
+	if cfg.GrpcMaxRecvMsgSizeMBs > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(cfg.GrpcMaxRecvMsgSizeMBs*1024*1024))
+	}
+	grpcServer := grpc.NewServer(opts...)
+	cache.RegisterCacheServiceServer(grpcServer, NewCacheService())
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+
+	if cfg.GrpcServerReflection {
+		reflection.Register(grpcServer)
+	}
+	return grpcServer
+}
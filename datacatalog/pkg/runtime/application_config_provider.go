@@ -16,6 +16,28 @@ const datacatalog = "datacatalog"
 
 var datacatalogConfig = config.MustRegisterSection(datacatalog, &configs.DataCatalogConfig{})
 
+func init() {
+	config.AddTypedValidator(datacatalogConfig, validateDataCatalogConfig)
+}
+
+// validateDataCatalogConfig rejects values that would make reservations or cached outputs unusable.
+func validateDataCatalogConfig(cfg *configs.DataCatalogConfig) error {
+	errs := config.FieldErrors{}
+	if cfg.HeartbeatGracePeriodMultiplier < 0 {
+		errs.Addf("heartbeat-grace-period-multiplier", "must not be negative, got [%v]", cfg.HeartbeatGracePeriodMultiplier)
+	}
+
+	if cfg.MaxReservationHeartbeat.Duration < 0 {
+		errs.Addf("max-reservation-heartbeat", "must not be negative, got [%v]", cfg.MaxReservationHeartbeat.Duration)
+	}
+
+	if cfg.CacheMaxInlineSizeBytes < 0 {
+		errs.Addf("cache-max-inline-size-bytes", "must not be negative, got [%v]", cfg.CacheMaxInlineSizeBytes)
+	}
+
+	return errs.ErrorOrNil()
+}
+
 // Defines the interface to return top-level config structs necessary to start up a datacatalog application.
 type ApplicationConfiguration interface {
 	GetDbConfig() *database.DbConfig
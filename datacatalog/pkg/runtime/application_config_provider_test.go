@@ -0,0 +1,28 @@
+package runtime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/datacatalog/pkg/runtime/configs"
+	"github.com/flyteorg/flyte/flytestdlib/config"
+)
+
+func TestValidateDataCatalogConfig(t *testing.T) {
+	assert.NoError(t, validateDataCatalogConfig(&configs.DataCatalogConfig{
+		HeartbeatGracePeriodMultiplier: 3,
+		MaxReservationHeartbeat:        config.Duration{Duration: 10 * time.Second},
+	}))
+
+	err := validateDataCatalogConfig(&configs.DataCatalogConfig{
+		HeartbeatGracePeriodMultiplier: -1,
+		MaxReservationHeartbeat:        config.Duration{Duration: -time.Second},
+	})
+
+	var fieldErrs config.FieldErrors
+	if assert.ErrorAs(t, err, &fieldErrs) {
+		assert.Len(t, fieldErrs, 2)
+	}
+}
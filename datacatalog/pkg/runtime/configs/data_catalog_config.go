@@ -14,6 +14,8 @@ var defaultConfig = &DataCatalogConfig{
 	ProfilerPort:                   10254,
 	HeartbeatGracePeriodMultiplier: 3,
 	MaxReservationHeartbeat:        config.Duration{Duration: time.Second * 10},
+	CacheStoragePrefix:             "cache",
+	CacheMaxInlineSizeBytes:        1024 * 1024,
 }
 
 // DataCatalogConfig is the base configuration to start datacatalog
@@ -23,4 +25,6 @@ type DataCatalogConfig struct {
 	ProfilerPort                   int             `json:"profiler-port" pflag:",Port that the profiling service is listening on."`
 	HeartbeatGracePeriodMultiplier int             `json:"heartbeat-grace-period-multiplier" pflag:",Number of heartbeats before a reservation expires without an extension."`
 	MaxReservationHeartbeat        config.Duration `json:"max-reservation-heartbeat" pflag:",The maximum available reservation extension heartbeat interval."`
+	CacheStoragePrefix             string          `json:"cache-storage-prefix" pflag:",Prefix under which the cache service offloads cached outputs that are too large to be stored in the database."`
+	CacheMaxInlineSizeBytes        int64           `json:"cache-max-inline-size-bytes" pflag:",Cached outputs larger than this are offloaded to the blob store instead of being stored in the database."`
 }
@@ -55,5 +55,7 @@ func (cfg DataCatalogConfig) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "profiler-port"), defaultConfig.ProfilerPort, "Port that the profiling service is listening on.")
 	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "heartbeat-grace-period-multiplier"), defaultConfig.HeartbeatGracePeriodMultiplier, "Number of heartbeats before a reservation expires without an extension.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "max-reservation-heartbeat"), defaultConfig.MaxReservationHeartbeat.String(), "The maximum available reservation extension heartbeat interval.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "cache-storage-prefix"), defaultConfig.CacheStoragePrefix, "Prefix under which the cache service offloads cached outputs that are too large to be stored in the database.")
+	cmdFlags.Int64(fmt.Sprintf("%v%v", prefix, "cache-max-inline-size-bytes"), defaultConfig.CacheMaxInlineSizeBytes, "Cached outputs larger than this are offloaded to the blob store instead of being stored in the database.")
 	return cmdFlags
 }
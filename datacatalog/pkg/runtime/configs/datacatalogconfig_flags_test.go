@@ -169,4 +169,32 @@ func TestDataCatalogConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_cache-storage-prefix", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("cache-storage-prefix", testValue)
+			if vString, err := cmdFlags.GetString("cache-storage-prefix"); err == nil {
+				testDecodeJson_DataCatalogConfig(t, fmt.Sprintf("%v", vString), &actual.CacheStoragePrefix)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_cache-max-inline-size-bytes", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("cache-max-inline-size-bytes", testValue)
+			if vInt64, err := cmdFlags.GetInt64("cache-max-inline-size-bytes"); err == nil {
+				testDecodeJson_DataCatalogConfig(t, fmt.Sprintf("%v", vInt64), &actual.CacheMaxInlineSizeBytes)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 }
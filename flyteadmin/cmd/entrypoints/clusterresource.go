@@ -2,6 +2,9 @@ package entrypoints
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"strings"
 
 	errors2 "github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -47,6 +50,8 @@ var controllerRunCmd = &cobra.Command{
 	},
 }
 
+var syncDryRun bool
+
 var controllerSyncCmd = &cobra.Command{
 	Use:   "sync",
 	Short: "This command will sync cluster resources",
@@ -58,6 +63,14 @@ var controllerSyncCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
+		if syncDryRun {
+			changes, err := clusterResourceController.DryRun(ctx)
+			printResourceChanges(cmd.OutOrStdout(), changes)
+			if err != nil {
+				return errors2.Wrap(err, "Failed to compute cluster resource changes ")
+			}
+			return nil
+		}
 		err = clusterResourceController.Sync(ctx)
 		if err != nil {
 			return errors2.Wrap(err, "Failed to sync cluster resources ")
@@ -67,8 +80,31 @@ var controllerSyncCmd = &cobra.Command{
 	},
 }
 
+// printResourceChanges prints the changes a sync would make, grouped by namespace.
+func printResourceChanges(out io.Writer, changes []clusterresource.ResourceChange) {
+	if len(changes) == 0 {
+		fmt.Fprintln(out, "No changes")
+		return
+	}
+	namespace := ""
+	for _, change := range changes {
+		if change.Namespace != namespace {
+			namespace = change.Namespace
+			fmt.Fprintf(out, "namespace %s:\n", namespace)
+		}
+		fmt.Fprintf(out, "  %s %s/%s (template %s, cluster %s)\n", change.Type, change.Kind, change.Name,
+			change.Template, change.Cluster)
+		for _, line := range strings.Split(strings.TrimSpace(change.Diff), "\n") {
+			if len(line) > 0 {
+				fmt.Fprintf(out, "    %s\n", line)
+			}
+		}
+	}
+}
+
 func init() {
 	RootCmd.AddCommand(parentClusterResourceCmd)
 	parentClusterResourceCmd.AddCommand(controllerRunCmd)
 	parentClusterResourceCmd.AddCommand(controllerSyncCmd)
+	controllerSyncCmd.Flags().BoolVar(&syncDryRun, "dry-run", false, "Print the changes the sync would make per namespace without applying them")
 }
@@ -10,6 +10,7 @@ import (
 	"path"
 	"path/filepath"
 	"runtime/debug"
+	"sort"
 	"strings"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -59,14 +60,41 @@ const domainVariable = "domain"
 const templateVariableFormat = "{{ %s }}"
 const replaceAllInstancesOfString = -1
 const noChange = "{}"
+const driftEventReason = "DriftDetected"
+const eventSourceComponent = "flyteadmin-clusterresource"
 
 // The clusterresource Controller manages applying desired templatized kubernetes resource files as resources
 // in the execution kubernetes cluster.
 type Controller interface {
 	Sync(ctx context.Context) error
+	// DryRun computes the changes a sync would make without modifying any resources.
+	DryRun(ctx context.Context) ([]ResourceChange, error)
 	Run()
 }
 
+type ChangeType string
+
+const (
+	ChangeCreate ChangeType = "create"
+	ChangeUpdate ChangeType = "update"
+	// ChangeDrift reverts a resource which was modified outside of the controller.
+	ChangeDrift ChangeType = "drift"
+	// ChangePrune deletes a resource whose template was removed.
+	ChangePrune ChangeType = "prune"
+)
+
+// ResourceChange describes a change the controller made, or would make in a dry run, to a managed resource.
+type ResourceChange struct {
+	Cluster   string
+	Namespace NamespaceName
+	Template  FileName
+	Kind      string
+	Name      string
+	Type      ChangeType
+	// Diff holds the manifest of a created resource or the patch applied to an existing one.
+	Diff string
+}
+
 type controllerMetrics struct {
 	Scope                           promutils.Scope
 	SyncErrors                      prometheus.Counter
@@ -79,6 +107,9 @@ type controllerMetrics struct {
 	TemplateDecodeErrors            prometheus.Counter
 	AppliedTemplateExists           prometheus.Counter
 	TemplateUpdateErrors            prometheus.Counter
+	DriftDetected                   prometheus.Counter
+	KubernetesResourcesPruned       prometheus.Counter
+	PruneErrors                     prometheus.Counter
 	Panics                          prometheus.Counter
 }
 
@@ -98,6 +129,8 @@ type controller struct {
 	appliedTemplates  NamespaceCache
 	adminDataProvider interfaces.FlyteAdminDataProvider
 	listTargets       executionclusterIfaces.ListTargetsInterface
+	// Namespaced resources of templates which opted into pruning, searched for orphans on every sync.
+	pruneResources map[schema.GroupVersionResource]bool
 }
 
 // templateAlreadyApplied checks if there is an applied template with the same checksum
@@ -238,6 +271,16 @@ type dynamicResource struct {
 	mapping *meta.RESTMapping
 }
 
+// decodeManifest decodes a rendered template into an unstructured kubernetes object.
+func decodeManifest(config string) (*unstructured.Unstructured, error) {
+	decUnstructured := yaml.NewDecodingSerializer(unstructured.UnstructuredJSONScheme)
+	obj := &unstructured.Unstructured{}
+	if _, _, err := decUnstructured.Decode([]byte(config), nil, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
 // This function borrows heavily from the excellent example code here:
 // https://ymmt2005.hatenablog.com/entry/2020/04/14/An_example_of_using_dynamic_client_of_k8s.io/client-go#Background-Server-Side-Apply
 // to dynamically discover the GroupVersionResource for the templatized k8s object from the cluster resource config files
@@ -249,12 +292,11 @@ func prepareDynamicCreate(target executioncluster.ExecutionTarget, config string
 	}
 	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(dc))
 
-	decUnstructured := yaml.NewDecodingSerializer(unstructured.UnstructuredJSONScheme)
-	obj := &unstructured.Unstructured{}
-	_, gvk, err := decUnstructured.Decode([]byte(config), nil, obj)
+	obj, err := decodeManifest(config)
 	if err != nil {
 		return dynamicResource{}, err
 	}
+	gvk := obj.GroupVersionKind()
 
 	// Find GVR
 	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
@@ -273,9 +315,14 @@ func prepareDynamicCreate(target executioncluster.ExecutionTarget, config string
 //  1. create k8s object resource from template by performing:
 //     a) read template file
 //     b) substitute templatized variables with their resolved values
-//  2. create the resource on the kubernetes cluster and cache successful outcomes
+//  2. create or patch the resource on the kubernetes cluster and cache successful outcomes
+//  3. prune the managed resources which opted into pruning but are no longer rendered by any template
+//
+// In a dry run nothing is modified and the changes which would have been made are returned instead.
 func (c *controller) syncNamespace(ctx context.Context, project *admin.Project, domain *admin.Domain,
-	namespace NamespaceName, templateValues, customTemplateValues templateValuesType) (ResourceSyncStats, error) {
+	namespace NamespaceName, templateValues, customTemplateValues templateValuesType,
+	pruneResources []schema.GroupVersionResource, observedPruneResources map[schema.GroupVersionResource]bool,
+	dryRun bool) (ResourceSyncStats, []ResourceChange, error) {
 	templateDir := c.config.ClusterResourceConfiguration().GetTemplatePath()
 	if c.lastAppliedTemplateDir != templateDir {
 		// Invalidate all caches
@@ -284,13 +331,16 @@ func (c *controller) syncNamespace(ctx context.Context, project *admin.Project,
 	}
 	templateFiles, err := ioutil.ReadDir(templateDir)
 	if err != nil {
-		return ResourceSyncStats{}, errors.NewFlyteAdminErrorf(codes.Internal,
+		return ResourceSyncStats{}, nil, errors.NewFlyteAdminErrorf(codes.Internal,
 			"Failed to read config template dir [%s] for namespace [%s] with err: %v",
 			namespace, templateDir, err)
 	}
 
 	collectedErrs := make([]error, 0)
 	stats := ResourceSyncStats{}
+	changes := make([]ResourceChange, 0)
+	renderedResources := make(map[resourceKey]bool, len(templateFiles))
+	detectDrift := c.config.ClusterResourceConfiguration().IsDriftDetectionEnabled()
 	for _, templateFile := range templateFiles {
 		templateFileName := templateFile.Name()
 		if filepath.Ext(templateFileName) != ".yaml" {
@@ -307,115 +357,293 @@ func (c *controller) syncNamespace(ctx context.Context, project *admin.Project,
 			continue
 		}
 
+		obj, err := decodeManifest(k8sManifest)
+		if err != nil {
+			c.metrics.TemplateDecodeErrors.Inc()
+			collectedErrs = append(collectedErrs, errors.NewFlyteAdminErrorf(codes.Internal,
+				"failed to decode config template [%s] for namespace [%s] with err: %v", templateFileName, namespace, err))
+			continue
+		}
+		renderedResources[getResourceKey(obj)] = true
+
 		checksum := md5.Sum([]byte(k8sManifest)) // #nosec
-		if c.templateAlreadyApplied(namespace, templateFileName, checksum) {
+		if !dryRun && !detectDrift && c.templateAlreadyApplied(namespace, templateFileName, checksum) {
 			// nothing to do.
 			logger.Debugf(ctx, "syncing namespace [%s]: templateFile [%s] already applied, nothing to do.", namespace, templateFile.Name())
 			stats.AlreadyThere++
 			continue
 		}
 
-		// 2) create the resource on the kubernetes cluster and cache successful outcomes
+		// 2) create or patch the resource on the kubernetes cluster and cache successful outcomes
+		synced := true
 		for _, target := range c.listTargets.GetValidTargets() {
-			dynamicObj, err := prepareDynamicCreate(*target, k8sManifest)
+			change, err := c.syncResource(ctx, target, namespace, templateFileName, k8sManifest, checksum,
+				observedPruneResources, dryRun)
 			if err != nil {
-				logger.Warningf(ctx, "Failed to transform kubernetes manifest for namespace [%s] "+
-					"into a dynamic unstructured mapping with err: %v, manifest: %v", namespace, err, k8sManifest)
 				collectedErrs = append(collectedErrs, err)
-				c.metrics.KubernetesResourcesCreateErrors.Inc()
 				stats.Errored++
+				synced = false
 				continue
 			}
+			if change == nil {
+				stats.AlreadyThere++
+				continue
+			}
+			switch change.Type {
+			case ChangeCreate:
+				stats.Created++
+			case ChangeUpdate:
+				stats.Updated++
+			case ChangeDrift:
+				stats.Drifted++
+			}
+			changes = append(changes, *change)
+		}
+		if synced && !dryRun {
+			c.setTemplateChecksum(namespace, templateFileName, checksum)
+		}
+	}
+	if len(collectedErrs) > 0 {
+		// Pruning is skipped since resources of templates which failed to render would be mistaken for orphans.
+		return stats, changes, errors.NewCollectedFlyteAdminError(codes.Internal, collectedErrs)
+	}
 
-			logger.Debugf(ctx, "Attempting to create resource [%+v] in cluster [%v] for namespace [%s]",
-				dynamicObj.obj.GetKind(), target.ID, namespace)
+	// 3) prune the resources of removed templates
+	pruned, err := c.pruneNamespace(ctx, namespace, renderedResources, pruneResources, observedPruneResources, dryRun)
+	stats.Pruned += len(pruned)
+	changes = append(changes, pruned...)
+	if err != nil {
+		return stats, changes, err
+	}
 
-			dr := getDynamicResourceInterface(dynamicObj.mapping, target.DynamicClient, namespace)
-			_, err = dr.Create(ctx, dynamicObj.obj, metav1.CreateOptions{})
+	return stats, changes, nil
+}
 
-			if err != nil {
-				if k8serrors.IsAlreadyExists(err) {
-					logger.Debugf(ctx, "Type [%+v] in namespace [%s] already exists - attempting update instead",
-						dynamicObj.obj.GetKind(), namespace)
-					c.metrics.AppliedTemplateExists.Inc()
-
-					currentObj, err := dr.Get(ctx, dynamicObj.obj.GetName(), metav1.GetOptions{})
-					if err != nil {
-						c.metrics.TemplateUpdateErrors.Inc()
-						logger.Warningf(ctx, "Failed to get current resource from server [%+v] in namespace [%s] with err: %v",
-							dynamicObj.obj.GetKind(), namespace, err)
-						collectedErrs = append(collectedErrs, err)
-						stats.Errored++
-						continue
-					}
+// syncResource creates the resource rendered from a template in the target cluster, or patches it when it already
+// exists. Namespaced kinds that opted into pruning are recorded in observedPruneResources. It returns the change made,
+// or nil when the resource is already up to date.
+func (c *controller) syncResource(ctx context.Context, target *executioncluster.ExecutionTarget, namespace NamespaceName,
+	templateFileName FileName, k8sManifest string, checksum [16]byte,
+	observedPruneResources map[schema.GroupVersionResource]bool, dryRun bool) (*ResourceChange, error) {
+	dynamicObj, err := prepareDynamicCreate(*target, k8sManifest)
+	if err != nil {
+		logger.Warningf(ctx, "Failed to transform kubernetes manifest for namespace [%s] "+
+			"into a dynamic unstructured mapping with err: %v, manifest: %v", namespace, err, k8sManifest)
+		c.metrics.KubernetesResourcesCreateErrors.Inc()
+		return nil, err
+	}
+	setOwnership(dynamicObj.obj, templateFileName, checksum)
+	if isPruneEnabled(dynamicObj.obj) && dynamicObj.mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		observedPruneResources[dynamicObj.mapping.Resource] = true
+	}
+
+	change := &ResourceChange{
+		Cluster:   target.ID,
+		Namespace: namespace,
+		Template:  templateFileName,
+		Kind:      dynamicObj.obj.GetKind(),
+		Name:      dynamicObj.obj.GetName(),
+	}
+	dr := getDynamicResourceInterface(dynamicObj.mapping, target.DynamicClient, namespace)
+	currentObj, err := dr.Get(ctx, dynamicObj.obj.GetName(), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		change.Type = ChangeCreate
+		change.Diff = k8sManifest
+		if dryRun {
+			return change, nil
+		}
 
-					modified, err := json.Marshal(dynamicObj.obj)
-					if err != nil {
-						c.metrics.TemplateUpdateErrors.Inc()
-						logger.Warningf(ctx, "Failed to marshal resource [%+v] in namespace [%s] to json with err: %v",
-							dynamicObj.obj.GetKind(), namespace, err)
-						collectedErrs = append(collectedErrs, err)
-						stats.Errored++
-						continue
-					}
+		logger.Debugf(ctx, "Attempting to create resource [%+v] in cluster [%v] for namespace [%s]",
+			dynamicObj.obj.GetKind(), target.ID, namespace)
+		if _, err = dr.Create(ctx, dynamicObj.obj, metav1.CreateOptions{}); err != nil {
+			c.metrics.KubernetesResourcesCreateErrors.Inc()
+			logger.Warningf(ctx, "Failed to create kubernetes object from config template [%s] for namespace [%s] with err: %v",
+				templateFileName, namespace, err)
+			return nil, errors.NewFlyteAdminErrorf(codes.Internal,
+				"Failed to create kubernetes object from config template [%s] for namespace [%s] with err: %v",
+				templateFileName, namespace, err)
+		}
+		logger.Debugf(ctx, "Created resource [%+v] for namespace [%s] in kubernetes",
+			dynamicObj.obj.GetKind(), namespace)
+		c.metrics.KubernetesResourcesCreated.Inc()
+		return change, nil
+	}
+	if err != nil {
+		c.metrics.TemplateUpdateErrors.Inc()
+		logger.Warningf(ctx, "Failed to get current resource from server [%+v] in namespace [%s] with err: %v",
+			dynamicObj.obj.GetKind(), namespace, err)
+		return nil, err
+	}
 
-					patch, patchType, err := c.createPatch(dynamicObj.mapping.GroupVersionKind, currentObj, modified, namespace)
-					if err != nil {
-						c.metrics.TemplateUpdateErrors.Inc()
-						logger.Warningf(ctx, "Failed to create patch for resource [%+v] in namespace [%s] err: %v",
-							dynamicObj.obj.GetKind(), namespace, err)
-						collectedErrs = append(collectedErrs, err)
-						stats.Errored++
-						continue
-					}
+	logger.Debugf(ctx, "Type [%+v] in namespace [%s] already exists - attempting update instead",
+		dynamicObj.obj.GetKind(), namespace)
+	c.metrics.AppliedTemplateExists.Inc()
+	modified, err := json.Marshal(dynamicObj.obj)
+	if err != nil {
+		c.metrics.TemplateUpdateErrors.Inc()
+		logger.Warningf(ctx, "Failed to marshal resource [%+v] in namespace [%s] to json with err: %v",
+			dynamicObj.obj.GetKind(), namespace, err)
+		return nil, err
+	}
 
-					if string(patch) == noChange {
-						logger.Infof(ctx, "Resource [%+v] in namespace [%s] is not modified",
-							dynamicObj.obj.GetKind(), namespace)
-						stats.AlreadyThere++
-						continue
-					}
+	patch, patchType, err := c.createPatch(dynamicObj.mapping.GroupVersionKind, currentObj, modified, namespace)
+	if err != nil {
+		c.metrics.TemplateUpdateErrors.Inc()
+		logger.Warningf(ctx, "Failed to create patch for resource [%+v] in namespace [%s] err: %v",
+			dynamicObj.obj.GetKind(), namespace, err)
+		return nil, err
+	}
+
+	if string(patch) == noChange {
+		logger.Debugf(ctx, "Resource [%+v] in namespace [%s] is not modified",
+			dynamicObj.obj.GetKind(), namespace)
+		return nil, nil
+	}
+
+	change.Type = ChangeUpdate
+	change.Diff = string(patch)
+	if isDrifted(currentObj, checksum, patch) {
+		change.Type = ChangeDrift
+		logger.Infof(ctx, "Resource [%+v] [%s] in namespace [%s] drifted from config template [%s]",
+			dynamicObj.obj.GetKind(), dynamicObj.obj.GetName(), namespace, templateFileName)
+	}
+	if dryRun {
+		return change, nil
+	}
+	if change.Type == ChangeDrift {
+		c.metrics.DriftDetected.Inc()
+		c.recordDriftEvent(ctx, target, currentObj, templateFileName)
+	}
+
+	_, err = dr.Patch(ctx, dynamicObj.obj.GetName(),
+		patchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		c.metrics.TemplateUpdateErrors.Inc()
+		logger.Warningf(ctx, "Failed to patch resource [%+v] in namespace [%s] with err: %v",
+			dynamicObj.obj.GetKind(), namespace, err)
+		return nil, err
+	}
+
+	logger.Debugf(ctx, "Successfully updated resource [%+v] in namespace [%s]",
+		dynamicObj.obj.GetKind(), namespace)
+	return change, nil
+}
+
+// recordDriftEvent emits a kubernetes event on a managed resource which was modified outside of the controller.
+func (c *controller) recordDriftEvent(ctx context.Context, target *executioncluster.ExecutionTarget,
+	obj *unstructured.Unstructured, templateFileName FileName) {
+	if target.Client == nil {
+		return
+	}
+	eventNamespace := obj.GetNamespace()
+	if len(eventNamespace) == 0 {
+		eventNamespace = metav1.NamespaceDefault
+	}
+	now := metav1.Now()
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: obj.GetName() + ".",
+			Namespace:    eventNamespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion:      obj.GetAPIVersion(),
+			Kind:            obj.GetKind(),
+			Name:            obj.GetName(),
+			Namespace:       obj.GetNamespace(),
+			UID:             obj.GetUID(),
+			ResourceVersion: obj.GetResourceVersion(),
+		},
+		Reason: driftEventReason,
+		Message: fmt.Sprintf("Resource was modified outside of config template [%s] and is being reverted",
+			templateFileName),
+		Type:           corev1.EventTypeWarning,
+		Source:         corev1.EventSource{Component: eventSourceComponent},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	if err := target.Client.Create(ctx, event); err != nil {
+		logger.Warningf(ctx, "Failed to record drift event for resource [%+v] [%s] with err: %v",
+			obj.GetKind(), obj.GetName(), err)
+	}
+}
+
+// pruneNamespace deletes the managed resources in a namespace which opted into pruning but are no longer rendered by
+// any template. Both the configured kinds and the kinds observed to opt into pruning are checked.
+func (c *controller) pruneNamespace(ctx context.Context, namespace NamespaceName,
+	renderedResources map[resourceKey]bool, configuredResources []schema.GroupVersionResource,
+	observedResources map[schema.GroupVersionResource]bool, dryRun bool) ([]ResourceChange, error) {
+	// Resources are deduplicated across versions so objects are neither listed nor deleted twice.
+	resources := make(map[schema.GroupResource]schema.GroupVersionResource, len(observedResources)+len(configuredResources))
+	for _, gvr := range configuredResources {
+		resources[gvr.GroupResource()] = gvr
+	}
+	for gvr := range observedResources {
+		resources[gvr.GroupResource()] = gvr
+	}
+	if len(resources) == 0 {
+		return nil, nil
+	}
+	sortedResources := make([]schema.GroupVersionResource, 0, len(resources))
+	for _, gvr := range resources {
+		sortedResources = append(sortedResources, gvr)
+	}
+	sort.Slice(sortedResources, func(i, j int) bool {
+		return sortedResources[i].String() < sortedResources[j].String()
+	})
 
-					_, err = dr.Patch(ctx, dynamicObj.obj.GetName(),
-						patchType, patch, metav1.PatchOptions{})
-					if err != nil {
-						c.metrics.TemplateUpdateErrors.Inc()
-						logger.Warningf(ctx, "Failed to patch resource [%+v] in namespace [%s] with err: %v",
-							dynamicObj.obj.GetKind(), namespace, err)
+	collectedErrs := make([]error, 0)
+	changes := make([]ResourceChange, 0)
+	listOptions := metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", managedByLabel, managedByValue)}
+	for _, target := range c.listTargets.GetValidTargets() {
+		for _, gvr := range sortedResources {
+			dr := target.DynamicClient.Resource(gvr).Namespace(namespace)
+			list, err := dr.List(ctx, listOptions)
+			if err != nil {
+				if k8serrors.IsNotFound(err) {
+					logger.Debugf(ctx, "Resource [%v] is not served by cluster [%s], nothing to prune", gvr, target.ID)
+					continue
+				}
+				c.metrics.PruneErrors.Inc()
+				logger.Warningf(ctx, "Failed to list resources [%v] in namespace [%s] with err: %v", gvr, namespace, err)
+				collectedErrs = append(collectedErrs, err)
+				continue
+			}
+
+			for i := range list.Items {
+				obj := &list.Items[i]
+				if !isManaged(obj) || !isPruneEnabled(obj) || renderedResources[getResourceKey(obj)] {
+					continue
+				}
+				change := ResourceChange{
+					Cluster:   target.ID,
+					Namespace: namespace,
+					Template:  obj.GetAnnotations()[templateAnnotation],
+					Kind:      obj.GetKind(),
+					Name:      obj.GetName(),
+					Type:      ChangePrune,
+				}
+				if !dryRun {
+					err := dr.Delete(ctx, obj.GetName(), metav1.DeleteOptions{})
+					if err != nil && !k8serrors.IsNotFound(err) {
+						c.metrics.PruneErrors.Inc()
+						logger.Warningf(ctx, "Failed to prune resource [%+v] [%s] in namespace [%s] with err: %v",
+							obj.GetKind(), obj.GetName(), namespace, err)
 						collectedErrs = append(collectedErrs, err)
-						stats.Errored++
 						continue
 					}
-
-					stats.Updated++
-					logger.Debugf(ctx, "Successfully updated resource [%+v] in namespace [%s]",
-						dynamicObj.obj.GetKind(), namespace)
-					c.setTemplateChecksum(namespace, templateFileName, checksum)
-				} else {
-					// Some error other than AlreadyExists was raised when we tried to Create the k8s object.
-					c.metrics.KubernetesResourcesCreateErrors.Inc()
-					logger.Warningf(ctx, "Failed to create kubernetes object from config template [%s] for namespace [%s] with err: %v",
-						templateFileName, namespace, err)
-					err := errors.NewFlyteAdminErrorf(codes.Internal,
-						"Failed to create kubernetes object from config template [%s] for namespace [%s] with err: %v",
-						templateFileName, namespace, err)
-					stats.Errored++
-					collectedErrs = append(collectedErrs, err)
+					logger.Infof(ctx, "Pruned resource [%+v] [%s] in namespace [%s] of removed config template [%s]",
+						obj.GetKind(), obj.GetName(), namespace, change.Template)
+					c.metrics.KubernetesResourcesPruned.Inc()
 				}
-			} else {
-				stats.Created++
-				logger.Debugf(ctx, "Created resource [%+v] for namespace [%s] in kubernetes",
-					dynamicObj.obj.GetKind(), namespace)
-				c.metrics.KubernetesResourcesCreated.Inc()
-				c.setTemplateChecksum(namespace, templateFileName, checksum)
+				changes = append(changes, change)
 			}
 		}
 	}
 	if len(collectedErrs) > 0 {
-		return stats, errors.NewCollectedFlyteAdminError(codes.Internal, collectedErrs)
+		return changes, errors.NewCollectedFlyteAdminError(codes.Internal, collectedErrs)
 	}
-
-	return stats, nil
+	return changes, nil
 }
 
 var metadataAccessor = meta.NewAccessor()
@@ -560,6 +788,15 @@ func (c *controller) createPatch(gvk schema.GroupVersionKind, currentObj *unstru
 }
 
 func (c *controller) Sync(ctx context.Context) error {
+	_, err := c.sync(ctx, false)
+	return err
+}
+
+func (c *controller) DryRun(ctx context.Context) ([]ResourceChange, error) {
+	return c.sync(ctx, true)
+}
+
+func (c *controller) sync(ctx context.Context, dryRun bool) ([]ResourceChange, error) {
 	defer func() {
 		if err := recover(); err != nil {
 			c.metrics.Panics.Inc()
@@ -571,7 +808,7 @@ func (c *controller) Sync(ctx context.Context) error {
 
 	projects, err := c.adminDataProvider.GetProjects(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	var errs = make([]error, 0)
 	templateValues, err := populateTemplateValues(c.config.ClusterResourceConfiguration().GetTemplateData())
@@ -584,8 +821,30 @@ func (c *controller) Sync(ctx context.Context) error {
 		logger.Warningf(ctx, "Failed to get domain-specific templatized values specified in config: %v", err)
 		errs = append(errs, err)
 	}
+	pruneResources := make([]schema.GroupVersionResource, 0)
+	for _, resource := range c.config.ClusterResourceConfiguration().GetPruneResources() {
+		gvr, err := parseGroupVersionResource(resource)
+		if err != nil {
+			logger.Warningf(ctx, "Failed to parse prune resources specified in config: %v", err)
+			errs = append(errs, err)
+			continue
+		}
+		pruneResources = append(pruneResources, gvr)
+	}
+
+	// Kinds that opted into pruning are remembered across syncs, so their resources are still pruned once their templates
+	// are removed. A dry run records them into a copy instead. It then reports the same prunes as a real sync would make
+	// without changing what later syncs prune.
+	observedPruneResources := c.pruneResources
+	if dryRun {
+		observedPruneResources = make(map[schema.GroupVersionResource]bool, len(c.pruneResources))
+		for gvr := range c.pruneResources {
+			observedPruneResources[gvr] = true
+		}
+	}
 
 	stats := ResourceSyncStats{}
+	changes := make([]ResourceChange, 0)
 
 	for _, project := range projects.GetProjects() {
 		for _, domain := range project.GetDomains() {
@@ -597,7 +856,9 @@ func (c *controller) Sync(ctx context.Context) error {
 				errs = append(errs, err)
 			}
 
-			newStats, err := c.syncNamespace(ctx, project, domain, namespace, templateValues, customTemplateValues)
+			newStats, newChanges, err := c.syncNamespace(ctx, project, domain, namespace, templateValues, customTemplateValues,
+				pruneResources, observedPruneResources, dryRun)
+			changes = append(changes, newChanges...)
 			if err != nil {
 				logger.Warningf(ctx, "Failed to create cluster resources for namespace [%s] with err: %v", namespace, err)
 				c.metrics.ResourceAddErrors.Inc()
@@ -616,10 +877,10 @@ func (c *controller) Sync(ctx context.Context) error {
 
 	if len(errs) > 0 {
 		c.metrics.SyncErrors.Add(float64(len(errs)))
-		return errors.NewCollectedFlyteAdminError(codes.Internal, errs)
+		return changes, errors.NewCollectedFlyteAdminError(codes.Internal, errs)
 	}
 
-	return nil
+	return changes, nil
 }
 
 func (c *controller) Run() {
@@ -660,6 +921,12 @@ func newMetrics(scope promutils.Scope) controllerMetrics {
 		TemplateUpdateErrors: scope.MustNewCounter("template_update_errors",
 			"Number of times an attempt at updating an already existing kubernetes resource with a template"+
 				"file failed"),
+		DriftDetected: scope.MustNewCounter("drift_detected",
+			"Number of times a managed kubernetes resource was found modified outside of its template"),
+		KubernetesResourcesPruned: scope.MustNewCounter("k8s_resources_pruned",
+			"overall count of managed resources deleted after their template was removed"),
+		PruneErrors: scope.MustNewCounter("prune_errors",
+			"overall count of errors encountered pruning managed resources"),
 		Panics: scope.MustNewCounter("panics",
 			"overall count of panics encountered in primary ClusterResourceController loop"),
 	}
@@ -674,6 +941,7 @@ func NewClusterResourceController(adminDataProvider interfaces.FlyteAdminDataPro
 		poller:            make(chan struct{}),
 		metrics:           newMetrics(scope),
 		appliedTemplates:  make(map[string]TemplateChecksums),
+		pruneResources:    make(map[schema.GroupVersionResource]bool),
 	}
 }
 
@@ -4,20 +4,31 @@ import (
 	"context"
 	"crypto/md5" // #nosec
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/rest"
 
 	"github.com/flyteorg/flyte/flyteadmin/pkg/clusterresource/mocks"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/executioncluster"
 	execClusterMocks "github.com/flyteorg/flyte/flyteadmin/pkg/executioncluster/mocks"
 	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	runtimeMocks "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/mocks"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	mockScope "github.com/flyteorg/flyte/flytestdlib/promutils"
 )
@@ -645,3 +656,287 @@ func Test_controller_createPatch(t *testing.T) {
 		})
 	}
 }
+
+func newManagedConfigMap(name, template string, prune bool) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetName(name)
+	obj.SetNamespace("my-project-dev")
+	if prune {
+		obj.SetAnnotations(map[string]string{PruneAnnotation: "true"})
+	}
+	setOwnership(obj, template, md5.Sum([]byte(template))) // #nosec
+	return obj
+}
+
+func TestPruneNamespace(t *testing.T) {
+	configMaps := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	unmanaged := &unstructured.Unstructured{}
+	unmanaged.SetAPIVersion("v1")
+	unmanaged.SetKind("ConfigMap")
+	unmanaged.SetName("unmanaged")
+	unmanaged.SetNamespace("my-project-dev")
+
+	newController := func() (*controller, *dynamicfake.FakeDynamicClient) {
+		dynamicClient := dynamicfake.NewSimpleDynamicClient(k8sruntime.NewScheme(),
+			newManagedConfigMap("rendered", "rendered.yaml", true),
+			newManagedConfigMap("orphan", "removed.yaml", true),
+			newManagedConfigMap("kept", "removed-without-prune.yaml", false),
+			unmanaged)
+		listTargets := execClusterMocks.ListTargetsInterface{}
+		listTargets.EXPECT().GetValidTargets().Return(map[string]*executioncluster.ExecutionTarget{
+			"cluster": {ID: "cluster", DynamicClient: dynamicClient},
+		})
+		return &controller{
+			metrics:     newMetrics(mockScope.NewTestScope()),
+			listTargets: &listTargets,
+		}, dynamicClient
+	}
+	renderedResources := map[resourceKey]bool{
+		{Kind: "ConfigMap", Name: "rendered"}: true,
+	}
+	expectedChanges := []ResourceChange{
+		{
+			Cluster:   "cluster",
+			Namespace: "my-project-dev",
+			Template:  "removed.yaml",
+			Kind:      "ConfigMap",
+			Name:      "orphan",
+			Type:      ChangePrune,
+		},
+	}
+	getNames := func(t *testing.T, dynamicClient *dynamicfake.FakeDynamicClient) []string {
+		list, err := dynamicClient.Resource(configMaps).Namespace("my-project-dev").List(context.Background(), metav1.ListOptions{})
+		assert.NoError(t, err)
+		names := make([]string, 0, len(list.Items))
+		for _, item := range list.Items {
+			names = append(names, item.GetName())
+		}
+		return names
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		c, dynamicClient := newController()
+		changes, err := c.pruneNamespace(context.Background(), "my-project-dev", renderedResources, nil,
+			map[schema.GroupVersionResource]bool{configMaps: true}, true)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedChanges, changes)
+		assert.ElementsMatch(t, []string{"rendered", "orphan", "kept", "unmanaged"}, getNames(t, dynamicClient))
+	})
+	t.Run("prune", func(t *testing.T) {
+		c, dynamicClient := newController()
+		changes, err := c.pruneNamespace(context.Background(), "my-project-dev", renderedResources,
+			[]schema.GroupVersionResource{configMaps}, nil, false)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedChanges, changes)
+		assert.ElementsMatch(t, []string{"rendered", "kept", "unmanaged"}, getNames(t, dynamicClient))
+	})
+}
+
+// newDiscoveryServer serves the discovery documents of a widgets custom resource. Custom resources are patched with
+// json merge patches, which unlike strategic merge patches are supported by the fake dynamic client.
+func newDiscoveryServer(t *testing.T) *httptest.Server {
+	responses := map[string]string{
+		"/api": `{"kind":"APIVersions","versions":[]}`,
+		"/apis": `{"kind":"APIGroupList","apiVersion":"v1","groups":[{"name":"example.com",` +
+			`"versions":[{"groupVersion":"example.com/v1","version":"v1"}],` +
+			`"preferredVersion":{"groupVersion":"example.com/v1","version":"v1"}}]}`,
+		"/apis/example.com/v1": `{"kind":"APIResourceList","groupVersion":"example.com/v1","resources":[` +
+			`{"name":"widgets","namespaced":true,"kind":"Widget","verbs":["create","get","list","patch","delete"]}]}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(response))
+		assert.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSyncResource(t *testing.T) {
+	widgets := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	manifest := `apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: settings
+  namespace: my-project-dev
+  annotations:
+    clusterresource.flyte.org/prune: "true"
+spec:
+  key: value
+`
+	checksum := md5.Sum([]byte(manifest)) // #nosec
+	server := newDiscoveryServer(t)
+
+	// The resource was synced with the current template and edited by hand afterwards.
+	drifted, err := decodeManifest(manifest)
+	assert.NoError(t, err)
+	setOwnership(drifted, "settings.yaml", checksum)
+	assert.NoError(t, unstructured.SetNestedField(drifted.Object, "edited", "spec", "key"))
+
+	newController := func(objects ...k8sruntime.Object) (*controller, *executioncluster.ExecutionTarget,
+		*dynamicfake.FakeDynamicClient) {
+		dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(k8sruntime.NewScheme(),
+			map[schema.GroupVersionResource]string{widgets: "WidgetList"}, objects...)
+		target := &executioncluster.ExecutionTarget{
+			ID:            "cluster",
+			DynamicClient: dynamicClient,
+			Config:        rest.Config{Host: server.URL},
+		}
+		return &controller{
+			metrics:        newMetrics(mockScope.NewTestScope()),
+			pruneResources: make(map[schema.GroupVersionResource]bool),
+		}, target, dynamicClient
+	}
+	getValue := func(t *testing.T, dynamicClient *dynamicfake.FakeDynamicClient) string {
+		obj, err := dynamicClient.Resource(widgets).Namespace("my-project-dev").Get(context.Background(), "settings",
+			metav1.GetOptions{})
+		assert.NoError(t, err)
+		value, _, err := unstructured.NestedString(obj.Object, "spec", "key")
+		assert.NoError(t, err)
+		return value
+	}
+
+	t.Run("dry run create", func(t *testing.T) {
+		c, target, dynamicClient := newController()
+		change, err := c.syncResource(context.Background(), target, "my-project-dev", "settings.yaml", manifest,
+			checksum, c.pruneResources, true)
+		assert.NoError(t, err)
+		if assert.NotNil(t, change) {
+			assert.Equal(t, ChangeCreate, change.Type)
+			assert.Equal(t, "settings", change.Name)
+		}
+		assert.Equal(t, map[schema.GroupVersionResource]bool{widgets: true}, c.pruneResources)
+		_, err = dynamicClient.Resource(widgets).Namespace("my-project-dev").Get(context.Background(), "settings",
+			metav1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+	t.Run("dry run drift", func(t *testing.T) {
+		c, target, dynamicClient := newController(drifted.DeepCopy())
+		change, err := c.syncResource(context.Background(), target, "my-project-dev", "settings.yaml", manifest,
+			checksum, c.pruneResources, true)
+		assert.NoError(t, err)
+		if assert.NotNil(t, change) {
+			assert.Equal(t, ChangeDrift, change.Type)
+			assert.Contains(t, change.Diff, `"key":"value"`)
+		}
+		assert.Equal(t, map[schema.GroupVersionResource]bool{widgets: true}, c.pruneResources)
+		assert.Equal(t, "edited", getValue(t, dynamicClient))
+		assert.Equal(t, float64(0), testutil.ToFloat64(c.metrics.DriftDetected))
+	})
+	t.Run("drift", func(t *testing.T) {
+		c, target, dynamicClient := newController(drifted.DeepCopy())
+		change, err := c.syncResource(context.Background(), target, "my-project-dev", "settings.yaml", manifest,
+			checksum, c.pruneResources, false)
+		assert.NoError(t, err)
+		if assert.NotNil(t, change) {
+			assert.Equal(t, ChangeDrift, change.Type)
+		}
+		assert.Equal(t, map[schema.GroupVersionResource]bool{widgets: true}, c.pruneResources)
+		assert.Equal(t, "value", getValue(t, dynamicClient))
+		assert.Equal(t, float64(1), testutil.ToFloat64(c.metrics.DriftDetected))
+	})
+	t.Run("template changed", func(t *testing.T) {
+		// A resource synced with an older version of the template is updated, not reported as drifted.
+		outdated := drifted.DeepCopy()
+		setOwnership(outdated, "settings.yaml", md5.Sum([]byte("older template"))) // #nosec
+		c, target, dynamicClient := newController(outdated)
+		change, err := c.syncResource(context.Background(), target, "my-project-dev", "settings.yaml", manifest,
+			checksum, c.pruneResources, false)
+		assert.NoError(t, err)
+		if assert.NotNil(t, change) {
+			assert.Equal(t, ChangeUpdate, change.Type)
+		}
+		assert.Equal(t, "value", getValue(t, dynamicClient))
+		assert.Equal(t, float64(0), testutil.ToFloat64(c.metrics.DriftDetected))
+	})
+}
+
+func TestDryRunMatchesSync(t *testing.T) {
+	widgets := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	server := newDiscoveryServer(t)
+	templateDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(templateDir, "settings.yaml"), []byte(`apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: settings
+  namespace: {{ namespace }}
+  annotations:
+    clusterresource.flyte.org/prune: "true"
+spec:
+  key: value
+`), 0600))
+
+	// The widget of a template that has been removed, which only a sync that knows widgets opted into pruning finds.
+	orphan := &unstructured.Unstructured{}
+	orphan.SetAPIVersion("example.com/v1")
+	orphan.SetKind("Widget")
+	orphan.SetName("orphan")
+	orphan.SetNamespace("my-project-dev")
+	orphan.SetAnnotations(map[string]string{PruneAnnotation: "true"})
+	setOwnership(orphan, "removed.yaml", md5.Sum([]byte("removed"))) // #nosec
+
+	// Every controller starts like a fresh process, without any kinds recorded by earlier syncs.
+	newController := func() (*controller, *dynamicfake.FakeDynamicClient) {
+		dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(k8sruntime.NewScheme(),
+			map[schema.GroupVersionResource]string{widgets: "WidgetList"}, orphan.DeepCopy())
+		listTargets := execClusterMocks.ListTargetsInterface{}
+		listTargets.EXPECT().GetValidTargets().Return(map[string]*executioncluster.ExecutionTarget{
+			"cluster": {ID: "cluster", DynamicClient: dynamicClient, Config: rest.Config{Host: server.URL}},
+		})
+		adminDataProvider := mocks.FlyteAdminDataProvider{}
+		adminDataProvider.EXPECT().GetProjects(mock.Anything).Return(&admin.Projects{Projects: []*admin.Project{
+			{Id: "my-project", Name: "my-project", Domains: []*admin.Domain{{Id: "dev", Name: "dev"}}},
+		}}, nil)
+		adminDataProvider.EXPECT().GetClusterResourceAttributes(mock.Anything, mock.Anything, mock.Anything).Return(
+			nil, errors.NewFlyteAdminError(codes.NotFound, "foo"))
+		namespaceMapping := runtimeMocks.NamespaceMappingConfiguration{}
+		namespaceMapping.EXPECT().GetNamespaceTemplate().Return("{{ project }}-{{ domain }}")
+		config := runtimeMocks.NewMockConfigurationProvider(nil, nil, nil, nil, nil, &namespaceMapping)
+		config.(*runtimeMocks.MockConfigurationProvider).AddClusterResourceConfiguration(
+			&runtimeMocks.MockClusterResourceConfiguration{TemplatePath: templateDir})
+		return &controller{
+			config:            config,
+			metrics:           newMetrics(mockScope.NewTestScope()),
+			appliedTemplates:  make(NamespaceCache),
+			adminDataProvider: &adminDataProvider,
+			listTargets:       &listTargets,
+			pruneResources:    make(map[schema.GroupVersionResource]bool),
+		}, dynamicClient
+	}
+	getPrunes := func(changes []ResourceChange) []ResourceChange {
+		prunes := make([]ResourceChange, 0)
+		for _, change := range changes {
+			if change.Type == ChangePrune {
+				prunes = append(prunes, change)
+			}
+		}
+		return prunes
+	}
+
+	dryRunController, dryRunClient := newController()
+	dryRunChanges, err := dryRunController.DryRun(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, dryRunController.pruneResources)
+	_, err = dryRunClient.Resource(widgets).Namespace("my-project-dev").Get(context.Background(), "orphan",
+		metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	syncController, syncClient := newController()
+	syncChanges, err := syncController.sync(context.Background(), false)
+	assert.NoError(t, err)
+	_, err = syncClient.Resource(widgets).Namespace("my-project-dev").Get(context.Background(), "orphan",
+		metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	if assert.Len(t, getPrunes(syncChanges), 1) {
+		assert.Equal(t, "orphan", getPrunes(syncChanges)[0].Name)
+	}
+	assert.Equal(t, getPrunes(syncChanges), getPrunes(dryRunChanges))
+}
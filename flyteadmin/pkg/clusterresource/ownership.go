@@ -0,0 +1,85 @@
+package clusterresource
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// managedByLabel marks the resources created by the cluster resource controller.
+	managedByLabel = "clusterresource.flyte.org/managed-by"
+	managedByValue = "flyteadmin"
+	// templateAnnotation records the template file a managed resource was rendered from.
+	templateAnnotation = "clusterresource.flyte.org/template"
+	// checksumAnnotation records the checksum of the rendered template a managed resource was last synced with.
+	checksumAnnotation = "clusterresource.flyte.org/checksum"
+	// PruneAnnotation opts the resources of a template into pruning: once the template is removed, or stops rendering
+	// a resource, the controller deletes it.
+	PruneAnnotation = "clusterresource.flyte.org/prune"
+)
+
+// resourceKey identifies a resource within a namespace independent of its api version.
+type resourceKey struct {
+	Group string
+	Kind  string
+	Name  string
+}
+
+func getResourceKey(obj *unstructured.Unstructured) resourceKey {
+	return resourceKey{
+		Group: obj.GroupVersionKind().Group,
+		Kind:  obj.GetKind(),
+		Name:  obj.GetName(),
+	}
+}
+
+// setOwnership labels and annotates a rendered template so the controller can later recognize the resource as its own.
+func setOwnership(obj *unstructured.Unstructured, templateFileName FileName, checksum [16]byte) {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string, 1)
+	}
+	labels[managedByLabel] = managedByValue
+	obj.SetLabels(labels)
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string, 2)
+	}
+	annotations[templateAnnotation] = templateFileName
+	annotations[checksumAnnotation] = hex.EncodeToString(checksum[:])
+	obj.SetAnnotations(annotations)
+}
+
+func isManaged(obj *unstructured.Unstructured) bool {
+	return obj.GetLabels()[managedByLabel] == managedByValue
+}
+
+func isPruneEnabled(obj *unstructured.Unstructured) bool {
+	prune, err := strconv.ParseBool(obj.GetAnnotations()[PruneAnnotation])
+	return err == nil && prune
+}
+
+// isDrifted returns true when a resource last synced with the given template checksum no longer matches it, meaning it
+// was modified outside of the controller rather than by a template change.
+func isDrifted(current *unstructured.Unstructured, checksum [16]byte, patch []byte) bool {
+	return string(patch) != noChange && current.GetAnnotations()[checksumAnnotation] == hex.EncodeToString(checksum[:])
+}
+
+// parseGroupVersionResource parses resources formatted as [group/]version/resource, e.g. v1/configmaps or
+// rbac.authorization.k8s.io/v1/rolebindings.
+func parseGroupVersionResource(resource string) (schema.GroupVersionResource, error) {
+	parts := strings.Split(resource, "/")
+	switch {
+	case len(parts) == 2 && len(parts[0]) > 0 && len(parts[1]) > 0:
+		return schema.GroupVersionResource{Version: parts[0], Resource: parts[1]}, nil
+	case len(parts) == 3 && len(parts[0]) > 0 && len(parts[1]) > 0 && len(parts[2]) > 0:
+		return schema.GroupVersionResource{Group: parts[0], Version: parts[1], Resource: parts[2]}, nil
+	}
+	return schema.GroupVersionResource{}, fmt.Errorf("invalid prune resource [%s], expected [group/]version/resource", resource)
+}
@@ -0,0 +1,49 @@
+package clusterresource
+
+import (
+	"crypto/md5" // #nosec
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestSetOwnership(t *testing.T) {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetName("settings")
+	obj.SetAnnotations(map[string]string{PruneAnnotation: "true"})
+	checksum := md5.Sum([]byte("template")) // #nosec
+
+	assert.False(t, isManaged(obj))
+	setOwnership(obj, "settings.yaml", checksum)
+	assert.True(t, isManaged(obj))
+	assert.True(t, isPruneEnabled(obj))
+	assert.Equal(t, "settings.yaml", obj.GetAnnotations()[templateAnnotation])
+	assert.Equal(t, resourceKey{Kind: "ConfigMap", Name: "settings"}, getResourceKey(obj))
+
+	t.Run("drifted", func(t *testing.T) {
+		assert.False(t, isDrifted(obj, checksum, []byte(noChange)))
+		assert.True(t, isDrifted(obj, checksum, []byte(`{"data":{"foo":"bar"}}`)))
+	})
+	t.Run("template changed", func(t *testing.T) {
+		assert.False(t, isDrifted(obj, md5.Sum([]byte("new template")), []byte(`{"data":{"foo":"bar"}}`))) // #nosec
+	})
+}
+
+func TestParseGroupVersionResource(t *testing.T) {
+	gvr, err := parseGroupVersionResource("v1/configmaps")
+	assert.NoError(t, err)
+	assert.Equal(t, schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}, gvr)
+
+	gvr, err = parseGroupVersionResource("rbac.authorization.k8s.io/v1/rolebindings")
+	assert.NoError(t, err)
+	assert.Equal(t, schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"}, gvr)
+
+	for _, resource := range []string{"configmaps", "v1/", "a/b/c/d"} {
+		_, err = parseGroupVersionResource(resource)
+		assert.Error(t, err, resource)
+	}
+}
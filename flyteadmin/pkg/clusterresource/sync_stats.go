@@ -1,10 +1,13 @@
 package clusterresource
 
-// ResourceSyncStats is a simple struct to track the number of resources created, updated, already there, and errored
+// ResourceSyncStats is a simple struct to track the number of resources created, updated, already there, drifted,
+// pruned and errored
 type ResourceSyncStats struct {
 	Created      int
 	Updated      int
 	AlreadyThere int
+	Drifted      int
+	Pruned       int
 	Errored      int
 }
 
@@ -13,5 +16,7 @@ func (m *ResourceSyncStats) Add(other ResourceSyncStats) {
 	m.Created += other.Created
 	m.Updated += other.Updated
 	m.AlreadyThere += other.AlreadyThere
+	m.Drifted += other.Drifted
+	m.Pruned += other.Pruned
 	m.Errored += other.Errored
 }
@@ -0,0 +1,72 @@
+package common
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+// Launch plan annotations which define the concurrency policy of a launch plan. The policy of the launched version
+// applies to executions launched manually as well as by schedules, and executions of all versions of the launch plan
+// count towards the limit.
+const (
+	// MaxConcurrencyAnnotation is the maximum number of executions of the launch plan that may run at the same time.
+	MaxConcurrencyAnnotation = "concurrency.flyte.org/max-executions"
+	// ConcurrencyOverflowAnnotation is the behavior when an execution is created while the launch plan is at capacity.
+	ConcurrencyOverflowAnnotation = "concurrency.flyte.org/overflow"
+)
+
+type ConcurrencyOverflow string
+
+const (
+	// ConcurrencyOverflowQueue holds back new executions until a running execution of the launch plan terminates.
+	ConcurrencyOverflowQueue ConcurrencyOverflow = "queue"
+	// ConcurrencyOverflowSkip records new executions as aborted without launching them.
+	ConcurrencyOverflowSkip ConcurrencyOverflow = "skip"
+	// ConcurrencyOverflowReplace terminates the oldest running executions to make room for new executions.
+	ConcurrencyOverflowReplace ConcurrencyOverflow = "replace"
+)
+
+var concurrencyOverflows = sets.NewString(string(ConcurrencyOverflowQueue), string(ConcurrencyOverflowSkip),
+	string(ConcurrencyOverflowReplace))
+
+type ConcurrencyPolicy struct {
+	MaxExecutions int
+	Overflow      ConcurrencyOverflow
+}
+
+// GetConcurrencyPolicy returns the concurrency policy defined by the annotations of a launch plan spec, if any.
+// The overflow behavior defaults to queueing executions.
+func GetConcurrencyPolicy(spec *admin.LaunchPlanSpec) (*ConcurrencyPolicy, error) {
+	annotations := spec.GetAnnotations().GetValues()
+	maxExecutions, found := annotations[MaxConcurrencyAnnotation]
+	overflow := annotations[ConcurrencyOverflowAnnotation]
+	if !found {
+		if len(overflow) > 0 {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"annotation [%s] requires annotation [%s] to be set", ConcurrencyOverflowAnnotation, MaxConcurrencyAnnotation)
+		}
+		return nil, nil
+	}
+
+	policy := &ConcurrencyPolicy{Overflow: ConcurrencyOverflowQueue}
+	var err error
+	if policy.MaxExecutions, err = strconv.Atoi(maxExecutions); err != nil || policy.MaxExecutions < 1 {
+		return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+			"annotation [%s] must be a positive integer, got [%s]", MaxConcurrencyAnnotation, maxExecutions)
+	}
+	if len(overflow) > 0 {
+		if !concurrencyOverflows.Has(overflow) {
+			return nil, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
+				"annotation [%s] must be one of [%s], got [%s]", ConcurrencyOverflowAnnotation,
+				strings.Join(concurrencyOverflows.List(), ", "), overflow)
+		}
+		policy.Overflow = ConcurrencyOverflow(overflow)
+	}
+	return policy, nil
+}
@@ -0,0 +1,51 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+func launchPlanSpecWithAnnotations(annotations map[string]string) *admin.LaunchPlanSpec {
+	return &admin.LaunchPlanSpec{
+		Annotations: &admin.Annotations{
+			Values: annotations,
+		},
+	}
+}
+
+func TestGetConcurrencyPolicy(t *testing.T) {
+	t.Run("no policy", func(t *testing.T) {
+		policy, err := GetConcurrencyPolicy(&admin.LaunchPlanSpec{})
+		assert.NoError(t, err)
+		assert.Nil(t, policy)
+	})
+	t.Run("defaults to queueing", func(t *testing.T) {
+		policy, err := GetConcurrencyPolicy(launchPlanSpecWithAnnotations(map[string]string{
+			MaxConcurrencyAnnotation: "2",
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, &ConcurrencyPolicy{MaxExecutions: 2, Overflow: ConcurrencyOverflowQueue}, policy)
+	})
+	t.Run("overflow", func(t *testing.T) {
+		policy, err := GetConcurrencyPolicy(launchPlanSpecWithAnnotations(map[string]string{
+			MaxConcurrencyAnnotation:      "1",
+			ConcurrencyOverflowAnnotation: "replace",
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, &ConcurrencyPolicy{MaxExecutions: 1, Overflow: ConcurrencyOverflowReplace}, policy)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		for _, annotations := range []map[string]string{
+			{MaxConcurrencyAnnotation: "0"},
+			{MaxConcurrencyAnnotation: "many"},
+			{MaxConcurrencyAnnotation: "1", ConcurrencyOverflowAnnotation: "wait"},
+			{ConcurrencyOverflowAnnotation: "skip"},
+		} {
+			_, err := GetConcurrencyPolicy(launchPlanSpecWithAnnotations(annotations))
+			assert.Error(t, err, "%v", annotations)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package common
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/flyteorg/flyte/flytestdlib/contextutils"
+)
+
+// SchedulerLeaseName is the name of the lease that the replicas of the native scheduler compete for.
+const SchedulerLeaseName = "flyte-native-scheduler"
+
+// FencingTokenHeader is the gRPC metadata key carrying the fencing token of the scheduler that launches an execution.
+const FencingTokenHeader = "x-flyte-scheduler-fencing-token"
+
+const fencingTokenKey contextutils.Key = "scheduler_fencing_token"
+
+// WithFencingToken returns a context that carries the fencing token of the current leadership term.
+func WithFencingToken(ctx context.Context, token int64) context.Context {
+	return context.WithValue(ctx, fencingTokenKey, token)
+}
+
+// GetFencingToken returns the fencing token carried by the context, if any.
+func GetFencingToken(ctx context.Context) (int64, bool) {
+	token, ok := ctx.Value(fencingTokenKey).(int64)
+	return token, ok
+}
+
+// AppendFencingToken adds the fencing token carried by ctx, if any, to the outgoing gRPC metadata of callCtx.
+func AppendFencingToken(ctx, callCtx context.Context) context.Context {
+	token, ok := GetFencingToken(ctx)
+	if !ok {
+		return callCtx
+	}
+	return metadata.AppendToOutgoingContext(callCtx, FencingTokenHeader, strconv.FormatInt(token, 10))
+}
+
+// GetIncomingFencingToken returns the fencing token sent by the scheduler in the incoming gRPC metadata, if any.
+func GetIncomingFencingToken(ctx context.Context) (int64, bool, error) {
+	values := metadata.ValueFromIncomingContext(ctx, FencingTokenHeader)
+	if len(values) == 0 {
+		return 0, false, nil
+	}
+	token, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil {
+		return 0, false, err
+	}
+	return token, true, nil
+}
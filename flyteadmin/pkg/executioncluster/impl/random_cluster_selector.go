@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"hash/fnv"
 	"math/rand"
+	"sync/atomic"
 
 	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/executioncluster"
@@ -22,9 +23,15 @@ import (
 // Selects cluster based on weights and domains.
 type RandomClusterSelector struct {
 	interfaces.ListTargetsInterface
+	weights         atomic.Pointer[clusterWeights]
+	resourceManager managerInterfaces.ResourceInterface
+}
+
+// clusterWeights holds the weighted lists clusters are selected from. They're rebuilt when the cluster configuration
+// is updated at runtime.
+type clusterWeights struct {
 	equalWeightedAllClusters random.WeightedRandomList
 	labelWeightedRandomMap   map[string]random.WeightedRandomList
-	resourceManager          managerInterfaces.ResourceInterface
 	defaultExecutionLabel    string
 }
 
@@ -83,7 +90,7 @@ func getLabeledWeightedRandomForCluster(ctx context.Context,
 	return labeledWeightedRandomMap, nil
 }
 
-func (s RandomClusterSelector) GetTarget(ctx context.Context, spec *executioncluster.ExecutionTargetSpec) (*executioncluster.ExecutionTarget, error) {
+func (s *RandomClusterSelector) GetTarget(ctx context.Context, spec *executioncluster.ExecutionTargetSpec) (*executioncluster.ExecutionTarget, error) {
 	if spec == nil {
 		return nil, fmt.Errorf("empty executionTargetSpec")
 	}
@@ -95,6 +102,7 @@ func (s RandomClusterSelector) GetTarget(ctx context.Context, spec *executionclu
 	}
 
 	var weightedRandomList random.WeightedRandomList
+	weights := s.weights.Load()
 
 	var label string
 
@@ -118,8 +126,8 @@ func (s RandomClusterSelector) GetTarget(ctx context.Context, spec *executionclu
 	}
 
 	if label != "" {
-		if _, ok := s.labelWeightedRandomMap[label]; ok {
-			weightedRandomList = s.labelWeightedRandomMap[label]
+		if _, ok := weights.labelWeightedRandomMap[label]; ok {
+			weightedRandomList = weights.labelWeightedRandomMap[label]
 		} else {
 			logger.Debugf(ctx, "No cluster mapping found for the label %s", label)
 		}
@@ -128,17 +136,17 @@ func (s RandomClusterSelector) GetTarget(ctx context.Context, spec *executionclu
 	}
 
 	if weightedRandomList == nil {
-		if s.defaultExecutionLabel != "" {
-			if _, ok := s.labelWeightedRandomMap[s.defaultExecutionLabel]; ok {
-				weightedRandomList = s.labelWeightedRandomMap[s.defaultExecutionLabel]
+		if weights.defaultExecutionLabel != "" {
+			if _, ok := weights.labelWeightedRandomMap[weights.defaultExecutionLabel]; ok {
+				weightedRandomList = weights.labelWeightedRandomMap[weights.defaultExecutionLabel]
 			} else {
-				logger.Warnf(ctx, "No cluster mapping found for the default execution label %s", s.defaultExecutionLabel)
+				logger.Warnf(ctx, "No cluster mapping found for the default execution label %s", weights.defaultExecutionLabel)
 			}
 		}
 	}
 
 	if weightedRandomList == nil {
-		weightedRandomList = s.equalWeightedAllClusters
+		weightedRandomList = weights.equalWeightedAllClusters
 	}
 
 	executionName := spec.ExecutionID
@@ -158,24 +166,48 @@ func (s RandomClusterSelector) GetTarget(ctx context.Context, spec *executionclu
 	return &execTarget, nil
 }
 
-func NewRandomClusterSelector(listTargets interfaces.ListTargetsInterface, config runtime.Configuration,
-	db repositoryInterfaces.Repository) (interfaces.ClusterInterface, error) {
-
-	defaultExecutionLabel := config.ClusterConfiguration().GetDefaultExecutionLabel()
-
-	equalWeightedAllClusters, err := convertToRandomWeightedList(context.Background(), listTargets.GetValidTargets())
+func newClusterWeights(ctx context.Context, listTargets interfaces.ListTargetsInterface,
+	clusterConfig runtime.ClusterConfiguration) (*clusterWeights, error) {
+	equalWeightedAllClusters, err := convertToRandomWeightedList(ctx, listTargets.GetValidTargets())
 	if err != nil {
 		return nil, err
 	}
-	labelWeightedRandomMap, err := getLabeledWeightedRandomForCluster(context.Background(), config.ClusterConfiguration(), listTargets.GetValidTargets())
+	labelWeightedRandomMap, err := getLabeledWeightedRandomForCluster(ctx, clusterConfig, listTargets.GetValidTargets())
 	if err != nil {
 		return nil, err
 	}
-	return &RandomClusterSelector{
-		labelWeightedRandomMap:   labelWeightedRandomMap,
-		resourceManager:          resources.NewResourceManager(db, config.ApplicationConfiguration()),
+	return &clusterWeights{
 		equalWeightedAllClusters: equalWeightedAllClusters,
-		ListTargetsInterface:     listTargets,
-		defaultExecutionLabel:    defaultExecutionLabel,
+		labelWeightedRandomMap:   labelWeightedRandomMap,
+		defaultExecutionLabel:    clusterConfig.GetDefaultExecutionLabel(),
 	}, nil
 }
+
+// NewRandomClusterSelector creates a cluster selector. Label weights and the default execution label are updated
+// live when the cluster configuration supports it, while the set of clusters is fixed once created.
+func NewRandomClusterSelector(listTargets interfaces.ListTargetsInterface, config runtime.Configuration,
+	db repositoryInterfaces.Repository) (interfaces.ClusterInterface, error) {
+
+	weights, err := newClusterWeights(context.Background(), listTargets, config.ClusterConfiguration())
+	if err != nil {
+		return nil, err
+	}
+	selector := &RandomClusterSelector{
+		resourceManager:      resources.NewResourceManager(db, config.ApplicationConfiguration()),
+		ListTargetsInterface: listTargets,
+	}
+	selector.weights.Store(weights)
+
+	if subscriber, ok := config.ClusterConfiguration().(runtime.ClusterConfigurationSubscriber); ok {
+		subscriber.SubscribeToUpdates(func(ctx context.Context) {
+			weights, err := newClusterWeights(ctx, listTargets, config.ClusterConfiguration())
+			if err != nil {
+				logger.Errorf(ctx, "Failed to apply the updated cluster weights, keeping the current ones. Error: %v", err)
+				return
+			}
+			selector.weights.Store(weights)
+			logger.Infof(ctx, "Applied the updated cluster weights")
+		})
+	}
+	return selector, nil
+}
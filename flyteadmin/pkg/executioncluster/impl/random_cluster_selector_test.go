@@ -33,6 +33,7 @@ const (
 	clusterConfig1                 = "clusters_config.yaml"
 	clusterConfig2                 = "clusters_config2.yaml"
 	clusterConfig2WithDefaultLabel = "clusters_config2_default_label.yaml"
+	clusterConfig2WithLabelThree   = "clusters_config2_default_label_three.yaml"
 )
 
 func initTestConfig(fileName string) error {
@@ -339,3 +340,22 @@ func TestRandomClusterSelectorGetTargetWithExecutionClusterLabelClusterAssignmen
 	assert.Equal(t, "testcluster3", target.ID)
 	assert.True(t, target.Enabled)
 }
+
+func TestRandomClusterSelectorConfigUpdate(t *testing.T) {
+	cluster := getRandomClusterSelectorWithDefaultLabelForTest(t, clusterConfig2WithDefaultLabel)
+	spec := &executioncluster.ExecutionTargetSpec{
+		Project:     testProject,
+		Domain:      "different",
+		Workflow:    testWorkflow,
+		ExecutionID: "e3",
+	}
+	target, err := cluster.GetTarget(context.Background(), spec)
+	assert.Nil(t, err)
+	assert.Equal(t, testCluster1, target.ID)
+
+	// The default execution label changed by the update applies to the existing selector.
+	assert.NoError(t, initTestConfig(clusterConfig2WithLabelThree))
+	target, err = cluster.GetTarget(context.Background(), spec)
+	assert.Nil(t, err)
+	assert.Equal(t, testCluster3, target.ID)
+}
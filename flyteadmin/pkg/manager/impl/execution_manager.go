@@ -14,6 +14,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/flyteorg/flyte/flyteadmin/auth"
 	cloudeventInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/async/cloudevent/interfaces"
@@ -24,6 +25,7 @@ import (
 	dataInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/data/interfaces"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/executions"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/policy"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/resources"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/shared"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/util"
@@ -38,6 +40,7 @@ import (
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s"
+	compiler "github.com/flyteorg/flyte/flytepropeller/pkg/compiler/transformers/k8s"
 	"github.com/flyteorg/flyte/flytestdlib/contextutils"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 	"github.com/flyteorg/flyte/flytestdlib/promutils"
@@ -47,6 +50,9 @@ import (
 
 const childContainerQueueKey = "child_queue"
 
+// The number of queued executions of a project considered for launch whenever one of its executions terminates.
+const maxQueuedProjectExecutions = 100
+
 type executionSystemMetrics struct {
 	Scope                      promutils.Scope
 	ActiveExecutions           prometheus.Gauge
@@ -62,6 +68,12 @@ type executionSystemMetrics struct {
 	AcceptanceDelay            prometheus.Summary
 	PublishEventError          prometheus.Counter
 	TerminateExecutionFailures prometheus.Counter
+	ConcurrencyQueued          prometheus.Counter
+	ConcurrencySkipped         prometheus.Counter
+	ConcurrencyReplaced        prometheus.Counter
+	ConcurrencyDequeued        prometheus.Counter
+	QuotaQueued                prometheus.Counter
+	QuotaRejected              prometheus.Counter
 }
 
 type executionUserMetrics struct {
@@ -172,6 +184,19 @@ func (m *ExecutionManager) addPluginOverrides(ctx context.Context, executionID *
 	return nil, nil
 }
 
+// Applies the registration policies of the execution's project and domain to the tasks of the workflow, so that tasks
+// registered before a policy was configured are held to it as well.
+func (m *ExecutionManager) applyRegistrationPolicies(
+	ctx context.Context, executionID *core.WorkflowExecutionIdentifier, closure *core.CompiledWorkflowClosure) error {
+	policies := policy.ForProjectDomain(m.config.RegistrationPolicyConfiguration().GetRegistrationPolicies(),
+		executionID.GetProject(), executionID.GetDomain())
+	if err := policies.ApplyToWorkflow(closure); err != nil {
+		logger.Debugf(ctx, "Execution [%+v] failed registration policies with err: %v", executionID, err)
+		return err
+	}
+	return nil
+}
+
 // TODO: Delete this code usage after the flyte v0.17.0 release
 // Assumes input contains a compiled task with a valid container resource execConfig.
 //
@@ -554,6 +579,10 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 		return nil, nil, err
 	}
 
+	if err = m.applyRegistrationPolicies(ctx, workflowExecutionID, workflow.GetClosure().GetCompiledWorkflow()); err != nil {
+		return nil, nil, err
+	}
+
 	// Dynamically assign task resource defaults.
 	platformTaskResources := util.GetTaskResources(ctx, workflow.GetId(), m.resourceManager, m.config.TaskResourceConfiguration())
 	for _, t := range workflow.GetClosure().GetCompiledWorkflow().GetTasks() {
@@ -583,6 +612,16 @@ func (m *ExecutionManager) launchSingleTaskExecution(
 		annotations = executionConfig.GetAnnotations().GetValues()
 	}
 
+	// Single task executions cannot be queued, they are rejected if the project is at its execution limit.
+	quota, err := executions.GetProjectQuota(ctx, m.resourceManager, workflowExecutionID.GetProject(), workflowExecutionID.GetDomain())
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err = m.applyProjectQuota(ctx, quota, workflowExecutionID, false); err != nil {
+		return nil, nil, err
+	}
+	annotations = addQuotaAnnotations(quota, annotations)
+
 	var rawOutputDataConfig *admin.RawOutputDataConfig
 	if executionConfig.GetRawOutputDataConfig() != nil {
 		rawOutputDataConfig = executionConfig.GetRawOutputDataConfig()
@@ -885,11 +924,13 @@ func (m *ExecutionManager) launchExecutionAndPrepareModel(
 		ctx, model, err := m.launchSingleTaskExecution(ctx, request, requestedAt)
 		return ctx, model, nil, err
 	}
-	return m.launchExecution(ctx, request, requestedAt)
+	return m.launchExecution(ctx, request, requestedAt, false)
 }
 
+// launchExecution prepares and launches a launch plan execution. Executions that are dequeued were already admitted by
+// the concurrency policy of the launch plan and keep the principal of the request that queued them.
 func (m *ExecutionManager) launchExecution(
-	ctx context.Context, request *admin.ExecutionCreateRequest, requestedAt time.Time) (context.Context, *models.Execution, []*models.ExecutionTag, error) {
+	ctx context.Context, request *admin.ExecutionCreateRequest, requestedAt time.Time, dequeued bool) (context.Context, *models.Execution, []*models.ExecutionTag, error) {
 	launchPlanModel, err := util.GetLaunchPlanModel(ctx, m.db, request.GetSpec().GetLaunchPlan())
 	if err != nil {
 		logger.Debugf(ctx, "Failed to get launch plan model for ExecutionCreateRequest %+v with err %v", request, err)
@@ -978,7 +1019,9 @@ func (m *ExecutionManager) launchExecution(
 	if requestSpec.GetMetadata() == nil {
 		requestSpec.Metadata = &admin.ExecutionMetadata{}
 	}
-	requestSpec.Metadata.Principal = getUser(ctx)
+	if !dequeued {
+		requestSpec.Metadata.Principal = getUser(ctx)
+	}
 	requestSpec.Metadata.ArtifactIds = usedArtifactIDs
 
 	// Get the node and parent execution (if any) that launched this execution
@@ -989,6 +1032,10 @@ func (m *ExecutionManager) launchExecution(
 		return nil, nil, nil, err
 	}
 
+	if err = m.applyRegistrationPolicies(ctx, workflowExecutionID, workflow.GetClosure().GetCompiledWorkflow()); err != nil {
+		return nil, nil, nil, err
+	}
+
 	// Dynamically assign task resource defaults.
 	platformTaskResources := util.GetTaskResources(ctx, workflow.GetId(), m.resourceManager, m.config.TaskResourceConfiguration())
 	for _, task := range workflow.GetClosure().GetCompiledWorkflow().GetTasks() {
@@ -1018,6 +1065,11 @@ func (m *ExecutionManager) launchExecution(
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	quota, err := executions.GetProjectQuota(ctx, m.resourceManager, workflowExecutionID.GetProject(), workflowExecutionID.GetDomain())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	annotations = addQuotaAnnotations(quota, annotations)
 	var rawOutputDataConfig *admin.RawOutputDataConfig
 	if executionConfig.GetRawOutputDataConfig() != nil {
 		rawOutputDataConfig = executionConfig.GetRawOutputDataConfig()
@@ -1097,25 +1149,43 @@ func (m *ExecutionManager) launchExecution(
 		Namespace:             namespace,
 	}
 
-	workflowExecutor := plugins.Get[workflowengineInterfaces.WorkflowExecutor](m.pluginRegistry, plugins.PluginIDWorkflowExecutor)
-	execInfo, execErr := workflowExecutor.Execute(ctx, workflowengineInterfaces.ExecutionData{
-		Namespace:                namespace,
-		ExecutionID:              workflowExecutionID,
-		ReferenceWorkflowName:    workflow.GetId().GetName(),
-		ReferenceLaunchPlanName:  launchPlan.GetId().GetName(),
-		WorkflowClosure:          workflow.GetClosure().GetCompiledWorkflow(),
-		WorkflowClosureReference: storage.DataReference(workflowModel.RemoteClosureIdentifier),
-		ExecutionParameters:      executionParameters,
-		OffloadedInputsReference: inputsURI,
-	})
-	if execErr != nil {
-		createExecModelInput.Error = execErr
-		m.systemMetrics.PropellerFailures.Inc()
-		logger.Infof(ctx, "failed to execute workflow %+v with execution id %+v and inputs %+v with err %v",
-			request, workflowExecutionID, executionInputs, execErr)
-	} else {
-		m.systemMetrics.AcceptanceDelay.Observe(acceptanceDelay.Seconds())
-		createExecModelInput.Cluster = execInfo.Cluster
+	if !dequeued {
+		createExecModelInput.ConcurrencyState, createExecModelInput.ConcurrencyCause, err = m.applyConcurrencyPolicy(
+			ctx, launchPlan, workflowExecutionID)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if !dequeued && len(createExecModelInput.ConcurrencyState) == 0 {
+		createExecModelInput.ConcurrencyState, err = m.applyProjectQuota(ctx, quota, workflowExecutionID, true)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	// Executions held back by the concurrency policy of the launch plan or the quota of the project are recorded
+	// without being launched.
+	if len(createExecModelInput.ConcurrencyState) == 0 {
+		workflowExecutor := plugins.Get[workflowengineInterfaces.WorkflowExecutor](m.pluginRegistry, plugins.PluginIDWorkflowExecutor)
+		execInfo, execErr := workflowExecutor.Execute(ctx, workflowengineInterfaces.ExecutionData{
+			Namespace:                namespace,
+			ExecutionID:              workflowExecutionID,
+			ReferenceWorkflowName:    workflow.GetId().GetName(),
+			ReferenceLaunchPlanName:  launchPlan.GetId().GetName(),
+			WorkflowClosure:          workflow.GetClosure().GetCompiledWorkflow(),
+			WorkflowClosureReference: storage.DataReference(workflowModel.RemoteClosureIdentifier),
+			ExecutionParameters:      executionParameters,
+			OffloadedInputsReference: inputsURI,
+		})
+		if execErr != nil {
+			createExecModelInput.Error = execErr
+			m.systemMetrics.PropellerFailures.Inc()
+			logger.Infof(ctx, "failed to execute workflow %+v with execution id %+v and inputs %+v with err %v",
+				request, workflowExecutionID, executionInputs, execErr)
+		} else {
+			m.systemMetrics.AcceptanceDelay.Observe(acceptanceDelay.Seconds())
+			createExecModelInput.Cluster = execInfo.Cluster
+		}
 	}
 
 	executionModel, err := transformers.CreateExecutionModel(createExecModelInput)
@@ -1149,16 +1219,311 @@ func (m *ExecutionManager) createExecutionModel(
 			workflowExecutionIdentifier, workflowExecutionIdentifier, err)
 		return nil, err
 	}
-	m.systemMetrics.ActiveExecutions.Inc()
+	if executionModel.ConcurrencyState != models.ExecutionConcurrencySkipped {
+		m.systemMetrics.ActiveExecutions.Inc()
+	}
 	m.systemMetrics.ExecutionsCreated.Inc()
 	m.systemMetrics.SpecSizeBytes.Observe(float64(len(executionModel.Spec)))
 	m.systemMetrics.ClosureSizeBytes.Observe(float64(len(executionModel.Closure)))
+	if executionModel.ConcurrencyState == models.ExecutionConcurrencyQueued {
+		// The launch plan or project may have gained capacity since the execution was queued, e.g. if the policy only
+		// queued the execution behind executions that were already waiting.
+		m.launchQueuedExecutions(ctx, executionModel)
+	}
 	return workflowExecutionIdentifier, nil
 }
 
+// applyConcurrencyPolicy enforces the concurrency policy of a launch plan on a new execution and returns the
+// concurrency state the execution is to be created in, which is empty if it can be launched right away. Executions
+// count towards the limit from the time they are launched until they terminate, so concurrent launches and
+// terminations may briefly exceed or underuse the limit.
+func (m *ExecutionManager) applyConcurrencyPolicy(ctx context.Context, launchPlan *admin.LaunchPlan,
+	executionID *core.WorkflowExecutionIdentifier) (string, string, error) {
+	policy, err := common.GetConcurrencyPolicy(launchPlan.GetSpec())
+	if err != nil || policy == nil {
+		return "", "", err
+	}
+
+	activeExecutions, err := executions.CountActiveExecutions(ctx, m.db, launchPlan.GetId())
+	if err != nil {
+		return "", "", err
+	}
+	launchPlanName := fmt.Sprintf("%s/%s/%s", launchPlan.GetId().GetProject(), launchPlan.GetId().GetDomain(),
+		launchPlan.GetId().GetName())
+	switch policy.Overflow {
+	case common.ConcurrencyOverflowQueue:
+		// Queued executions are launched in order, new executions wait behind the ones already waiting.
+		queuedExecutions, err := executions.ListQueuedExecutions(ctx, m.db, launchPlan.GetId(), 1)
+		if err != nil {
+			return "", "", err
+		}
+		if activeExecutions < int64(policy.MaxExecutions) && len(queuedExecutions) == 0 {
+			return "", "", nil
+		}
+		logger.Infof(ctx, "Queueing execution [%s] as launch plan [%s] has [%d] active executions out of [%d]",
+			executionID.GetName(), launchPlanName, activeExecutions, policy.MaxExecutions)
+		m.systemMetrics.ConcurrencyQueued.Inc()
+		return models.ExecutionConcurrencyQueued, "", nil
+	case common.ConcurrencyOverflowSkip:
+		if activeExecutions < int64(policy.MaxExecutions) {
+			return "", "", nil
+		}
+		logger.Infof(ctx, "Skipping execution [%s] as launch plan [%s] has [%d] active executions out of [%d]",
+			executionID.GetName(), launchPlanName, activeExecutions, policy.MaxExecutions)
+		m.systemMetrics.ConcurrencySkipped.Inc()
+		return models.ExecutionConcurrencySkipped, fmt.Sprintf(
+			"Skipped as launch plan [%s] already had [%d] active executions, the maximum allowed by its concurrency policy",
+			launchPlanName, activeExecutions), nil
+	case common.ConcurrencyOverflowReplace:
+		if activeExecutions < int64(policy.MaxExecutions) {
+			return "", "", nil
+		}
+		oldestExecutions, err := executions.ListActiveExecutions(ctx, m.db, launchPlan.GetId(),
+			int(activeExecutions)-policy.MaxExecutions+1)
+		if err != nil {
+			return "", "", err
+		}
+		for _, oldestExecution := range oldestExecutions {
+			oldestExecutionID := transformers.GetExecutionIdentifier(&oldestExecution)
+			logger.Infof(ctx, "Replacing execution [%s] of launch plan [%s] with execution [%s]",
+				oldestExecutionID.GetName(), launchPlanName, executionID.GetName())
+			_, err := m.TerminateExecution(ctx, &admin.ExecutionTerminateRequest{
+				Id: &oldestExecutionID,
+				Cause: fmt.Sprintf("Replaced by execution [%s] per the concurrency policy of launch plan [%s]",
+					executionID.GetName(), launchPlanName),
+			})
+			// The execution may have terminated in the meantime.
+			if err != nil && status.Code(err) != codes.FailedPrecondition {
+				return "", "", err
+			}
+			m.systemMetrics.ConcurrencyReplaced.Inc()
+		}
+	}
+	return "", "", nil
+}
+
+// applyProjectQuota enforces the execution limit of the quota of a project on a new execution and returns the
+// concurrency state the execution is to be created in, which is empty if it can be launched right away. Executions
+// which cannot be queued are rejected regardless of the overflow behavior of the quota.
+// The limit is enforced on a best-effort basis: the active executions are counted before the execution is launched and
+// recorded, without holding a lock across the launch, so executions created concurrently may briefly exceed it.
+func (m *ExecutionManager) applyProjectQuota(ctx context.Context, quota *admin.ProjectQuotaAttributes,
+	executionID *core.WorkflowExecutionIdentifier, queueable bool) (string, error) {
+	hasCapacity, activeExecutions, err := m.hasProjectCapacity(ctx, quota, executionID.GetProject(),
+		executionID.GetDomain())
+	if err != nil || hasCapacity {
+		return "", err
+	}
+	if quota.GetOverflow() == admin.ProjectQuotaAttributes_QUEUE && queueable {
+		logger.Infof(ctx, "Queueing execution [%s] as project [%s] domain [%s] has [%d] active executions out of [%d]",
+			executionID.GetName(), executionID.GetProject(), executionID.GetDomain(), activeExecutions,
+			quota.GetMaxConcurrentExecutions())
+		m.systemMetrics.QuotaQueued.Inc()
+		return models.ExecutionConcurrencyQueued, nil
+	}
+	m.systemMetrics.QuotaRejected.Inc()
+	return "", errors.NewFlyteAdminErrorf(codes.ResourceExhausted,
+		"project [%s] domain [%s] already has [%d] active executions, the maximum allowed by its quota",
+		executionID.GetProject(), executionID.GetDomain(), activeExecutions)
+}
+
+// hasProjectCapacity reports whether a project and domain can launch another execution under its quota, along with
+// the number of its active executions.
+func (m *ExecutionManager) hasProjectCapacity(ctx context.Context, quota *admin.ProjectQuotaAttributes, project,
+	domain string) (bool, int64, error) {
+	if quota.GetMaxConcurrentExecutions() <= 0 {
+		return true, 0, nil
+	}
+	activeExecutions, err := executions.CountProjectExecutions(ctx, m.db, project, domain, "")
+	if err != nil {
+		return false, 0, err
+	}
+	return activeExecutions < int64(quota.GetMaxConcurrentExecutions()), activeExecutions, nil
+}
+
+// addQuotaAnnotations adds the task limits of the quota of a project to the annotations of an execution, which propeller
+// enforces when launching task pods.
+func addQuotaAnnotations(quota *admin.ProjectQuotaAttributes, annotations map[string]string) map[string]string {
+	if quota.GetMaxRunningTasks() <= 0 && len(quota.GetMaxCpu()) == 0 && len(quota.GetMaxGpu()) == 0 {
+		return annotations
+	}
+	quotaAnnotations := make(map[string]string, len(annotations)+3)
+	for key, value := range annotations {
+		quotaAnnotations[key] = value
+	}
+	if quota.GetMaxRunningTasks() > 0 {
+		quotaAnnotations[compiler.MaxRunningTasksAnnotation] = strconv.Itoa(int(quota.GetMaxRunningTasks()))
+	}
+	if len(quota.GetMaxCpu()) > 0 {
+		quotaAnnotations[compiler.MaxCPUAnnotation] = quota.GetMaxCpu()
+	}
+	if len(quota.GetMaxGpu()) > 0 {
+		quotaAnnotations[compiler.MaxGPUAnnotation] = quota.GetMaxGpu()
+	}
+	return quotaAnnotations
+}
+
+// launchQueuedExecutions launches executions queued behind an execution, first those queued by the concurrency policy
+// of its launch plan and then those queued by the quota of its project.
+func (m *ExecutionManager) launchQueuedExecutions(ctx context.Context, executionModel *models.Execution) {
+	m.launchQueuedLaunchPlanExecutions(ctx, executionModel)
+	m.launchQueuedProjectExecutions(ctx, executionModel.Project, executionModel.Domain)
+}
+
+// launchQueuedProjectExecutions launches the queued executions of a project and domain, oldest first, for as long as
+// the project has capacity. Executions held back by the concurrency policy of their launch plan are passed over.
+func (m *ExecutionManager) launchQueuedProjectExecutions(ctx context.Context, project, domain string) {
+	queuedExecutions, err := executions.ListProjectQueuedExecutions(ctx, m.db, project, domain,
+		maxQueuedProjectExecutions)
+	if err != nil {
+		logger.Errorf(ctx, "Failed to list queued executions of project [%s] domain [%s]: %v", project, domain, err)
+		return
+	}
+	if len(queuedExecutions) == 0 {
+		return
+	}
+	quota, err := executions.GetProjectQuota(ctx, m.resourceManager, project, domain)
+	if err != nil {
+		logger.Errorf(ctx, "Failed to get the quota of project [%s] domain [%s]: %v", project, domain, err)
+		return
+	}
+	for i := range queuedExecutions {
+		hasCapacity, _, err := m.hasProjectCapacity(ctx, quota, project, domain)
+		if err != nil {
+			logger.Errorf(ctx, "Failed to count active executions of project [%s] domain [%s]: %v", project, domain, err)
+			return
+		}
+		if !hasCapacity {
+			return
+		}
+		if _, err := m.launchQueuedExecution(ctx, &queuedExecutions[i]); err != nil {
+			logger.Errorf(ctx, "Failed to launch queued execution [%s] of project [%s] domain [%s]: %v",
+				queuedExecutions[i].Name, project, domain, err)
+		}
+	}
+}
+
+// launchQueuedLaunchPlanExecutions launches executions queued by the concurrency policy of the launch plan of an
+// execution, oldest first, for as long as the launch plan has capacity.
+func (m *ExecutionManager) launchQueuedLaunchPlanExecutions(ctx context.Context, executionModel *models.Execution) {
+	var spec admin.ExecutionSpec
+	if err := proto.Unmarshal(executionModel.Spec, &spec); err != nil {
+		logger.Errorf(ctx, "Failed to unmarshal spec of execution [%s] to launch queued executions: %v",
+			executionModel.Name, err)
+		return
+	}
+	launchPlanID := spec.GetLaunchPlan()
+	if launchPlanID.GetResourceType() != core.ResourceType_LAUNCH_PLAN {
+		return
+	}
+
+	for {
+		queuedExecutions, err := executions.ListQueuedExecutions(ctx, m.db, launchPlanID, 1)
+		if err != nil {
+			logger.Errorf(ctx, "Failed to list queued executions of launch plan [%+v]: %v", launchPlanID, err)
+			return
+		}
+		if len(queuedExecutions) == 0 {
+			return
+		}
+		launched, err := m.launchQueuedExecution(ctx, &queuedExecutions[0])
+		if err != nil {
+			logger.Errorf(ctx, "Failed to launch queued execution [%s] of launch plan [%+v]: %v",
+				queuedExecutions[0].Name, launchPlanID, err)
+			return
+		}
+		if !launched {
+			return
+		}
+	}
+}
+
+// launchQueuedExecution launches a queued execution if its launch plan and project have capacity and reports whether
+// it did.
+func (m *ExecutionManager) launchQueuedExecution(ctx context.Context, queuedModel *models.Execution) (bool, error) {
+	var spec admin.ExecutionSpec
+	if err := proto.Unmarshal(queuedModel.Spec, &spec); err != nil {
+		return false, errors.NewFlyteAdminErrorf(codes.Internal, "failed to unmarshal spec: %v", err)
+	}
+	launchPlanModel, err := util.GetLaunchPlanModel(ctx, m.db, spec.GetLaunchPlan())
+	if err != nil {
+		return false, err
+	}
+	launchPlan, err := transformers.FromLaunchPlanModel(launchPlanModel)
+	if err != nil {
+		return false, err
+	}
+	policy, err := common.GetConcurrencyPolicy(launchPlan.GetSpec())
+	if err != nil {
+		return false, err
+	}
+	if policy != nil {
+		activeExecutions, err := executions.CountActiveExecutions(ctx, m.db, launchPlan.GetId())
+		if err != nil {
+			return false, err
+		}
+		if activeExecutions >= int64(policy.MaxExecutions) {
+			return false, nil
+		}
+	}
+	quota, err := executions.GetProjectQuota(ctx, m.resourceManager, queuedModel.Project, queuedModel.Domain)
+	if err != nil {
+		return false, err
+	}
+	if hasCapacity, _, err := m.hasProjectCapacity(ctx, quota, queuedModel.Project, queuedModel.Domain); err != nil ||
+		!hasCapacity {
+		return false, err
+	}
+
+	// Claim the execution so that it is launched exactly once.
+	claimed, err := m.db.ExecutionRepo().UpdateConcurrencyState(ctx, queuedModel.ID, models.ExecutionConcurrencyQueued, "")
+	if err != nil || !claimed {
+		return claimed, err
+	}
+
+	inputs := &core.LiteralMap{}
+	if len(queuedModel.UserInputsURI) > 0 {
+		if err := m.storageClient.ReadProtobuf(ctx, queuedModel.UserInputsURI, inputs); err != nil {
+			return false, m.requeueExecution(ctx, queuedModel, err)
+		}
+	}
+	_, executionModel, _, err := m.launchExecution(ctx, &admin.ExecutionCreateRequest{
+		Project: queuedModel.Project,
+		Domain:  queuedModel.Domain,
+		Name:    queuedModel.Name,
+		Spec:    &spec,
+		Inputs:  inputs,
+	}, time.Now(), true)
+	if err != nil {
+		return false, m.requeueExecution(ctx, queuedModel, err)
+	}
+
+	executionModel.ID = queuedModel.ID
+	if err := m.db.ExecutionRepo().Update(ctx, *executionModel); err != nil {
+		return false, err
+	}
+	logger.Infof(ctx, "Launched queued execution [%s] of launch plan [%+v]", queuedModel.Name, spec.GetLaunchPlan())
+	m.systemMetrics.ConcurrencyDequeued.Inc()
+	return true, nil
+}
+
+// requeueExecution returns a claimed execution that failed to launch to the queue so that launching it is retried.
+func (m *ExecutionManager) requeueExecution(ctx context.Context, queuedModel *models.Execution, launchErr error) error {
+	if _, err := m.db.ExecutionRepo().UpdateConcurrencyState(ctx, queuedModel.ID, "",
+		models.ExecutionConcurrencyQueued); err != nil {
+		logger.Errorf(ctx, "Failed to requeue execution [%s]: %v", queuedModel.Name, err)
+	}
+	return launchErr
+}
+
 func (m *ExecutionManager) CreateExecution(
 	ctx context.Context, request *admin.ExecutionCreateRequest, requestedAt time.Time) (
 	*admin.ExecutionCreateResponse, error) {
+	ctx = repositoryInterfaces.WithPrimaryReads(ctx)
+
+	if err := m.validateSchedulerFencingToken(ctx); err != nil {
+		return nil, err
+	}
 
 	// Prior to flyteidl v0.15.0, Inputs was held in ExecutionSpec. Ensure older clients continue to work.
 	if request.GetInputs() == nil || len(request.GetInputs().GetLiterals()) == 0 {
@@ -1180,9 +1545,35 @@ func (m *ExecutionManager) CreateExecution(
 	}, nil
 }
 
+// validateSchedulerFencingToken rejects executions launched by a native scheduler replica that is no longer the leader.
+// The fencing token sent by the scheduler must match the token of the current scheduler lease, which is incremented
+// every time the lease changes hands.
+func (m *ExecutionManager) validateSchedulerFencingToken(ctx context.Context) error {
+	token, ok, err := common.GetIncomingFencingToken(ctx)
+	if err != nil {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid scheduler fencing token: %v", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	lease, err := m.db.SchedulerLeaseRepo().Get(ctx, common.SchedulerLeaseName)
+	if err != nil {
+		return err
+	}
+	if lease.Token != token {
+		logger.Warnf(ctx, "Rejecting scheduled execution with stale fencing token [%d], current token is [%d]",
+			token, lease.Token)
+		return errors.NewFlyteAdminErrorf(codes.Aborted,
+			"scheduler fencing token [%d] is stale, the current scheduler leader holds token [%d]", token, lease.Token)
+	}
+	return nil
+}
+
 func (m *ExecutionManager) RelaunchExecution(
 	ctx context.Context, request *admin.ExecutionRelaunchRequest, requestedAt time.Time) (
 	*admin.ExecutionCreateResponse, error) {
+	ctx = repositoryInterfaces.WithPrimaryReads(ctx)
 	existingExecutionModel, err := util.GetExecutionModel(ctx, m.db, request.GetId())
 	if err != nil {
 		logger.Debugf(ctx, "Failed to get execution model for request [%+v] with err %v", request, err)
@@ -1241,6 +1632,7 @@ func (m *ExecutionManager) RelaunchExecution(
 func (m *ExecutionManager) RecoverExecution(
 	ctx context.Context, request *admin.ExecutionRecoverRequest, requestedAt time.Time) (
 	*admin.ExecutionCreateResponse, error) {
+	ctx = repositoryInterfaces.WithPrimaryReads(ctx)
 	existingExecutionModel, err := util.GetExecutionModel(ctx, m.db, request.GetId())
 	if err != nil {
 		logger.Debugf(ctx, "Failed to get execution model for request [%+v] with err %v", request, err)
@@ -1430,6 +1822,7 @@ func (m *ExecutionManager) emitOverallWorkflowExecutionTime(
 
 func (m *ExecutionManager) CreateWorkflowEvent(ctx context.Context, request *admin.WorkflowExecutionEventRequest) (
 	*admin.WorkflowExecutionEventResponse, error) {
+	ctx = repositoryInterfaces.WithPrimaryReads(ctx)
 	err := validation.ValidateCreateWorkflowEventRequest(request, m.config.ApplicationConfiguration().GetRemoteDataConfig().MaxSizeInBytes)
 	if err != nil {
 		logger.Debugf(ctx, "received invalid CreateWorkflowEventRequest [%s]: %v", request.GetRequestId(), err)
@@ -1510,6 +1903,9 @@ func (m *ExecutionManager) CreateWorkflowEvent(ctx context.Context, request *adm
 		m.systemMetrics.ActiveExecutions.Dec()
 		m.systemMetrics.ExecutionsTerminated.Inc(contextutils.WithPhase(ctx, request.GetEvent().GetPhase().String()))
 		go m.emitOverallWorkflowExecutionTime(executionModel, request.GetEvent().GetOccurredAt())
+		// The terminated execution frees up a concurrency slot of its launch plan.
+		terminatedExecution := *executionModel
+		go m.launchQueuedExecutions(context.WithoutCancel(ctx), &terminatedExecution)
 		if request.GetEvent().GetOutputData() != nil {
 			m.userMetrics.WorkflowExecutionOutputBytes.Observe(float64(proto.Size(request.GetEvent().GetOutputData())))
 		}
@@ -1568,6 +1964,7 @@ func (m *ExecutionManager) GetExecution(
 
 func (m *ExecutionManager) UpdateExecution(ctx context.Context, request *admin.ExecutionUpdateRequest,
 	requestedAt time.Time) (*admin.ExecutionUpdateResponse, error) {
+	ctx = repositoryInterfaces.WithPrimaryReads(ctx)
 	if err := validation.ValidateWorkflowExecutionIdentifier(request.GetId()); err != nil {
 		logger.Debugf(ctx, "UpdateExecution request [%+v] failed validation with err: %v", request, err)
 		return nil, err
@@ -1800,6 +2197,7 @@ func (m *ExecutionManager) publishNotifications(ctx context.Context, request *ad
 
 func (m *ExecutionManager) TerminateExecution(
 	ctx context.Context, request *admin.ExecutionTerminateRequest) (*admin.ExecutionTerminateResponse, error) {
+	ctx = repositoryInterfaces.WithPrimaryReads(ctx)
 	if err := validation.ValidateWorkflowExecutionIdentifier(request.GetId()); err != nil {
 		logger.Debugf(ctx, "received terminate execution request: %v with invalid identifier: %v", request, err)
 		return nil, err
@@ -1819,6 +2217,9 @@ func (m *ExecutionManager) TerminateExecution(
 	if common.IsExecutionTerminal(core.WorkflowExecution_Phase(core.WorkflowExecution_Phase_value[executionModel.Phase])) {
 		return nil, errors.NewAlreadyInTerminalStateError(ctx, "Cannot abort an already terminated workflow execution", executionModel.Phase)
 	}
+	if executionModel.ConcurrencyState == models.ExecutionConcurrencyQueued {
+		return m.terminateQueuedExecution(ctx, executionModel, request.GetCause())
+	}
 
 	err = transformers.SetExecutionAborting(&executionModel, request.GetCause(), getUser(ctx))
 	if err != nil {
@@ -1847,6 +2248,33 @@ func (m *ExecutionManager) TerminateExecution(
 	return &admin.ExecutionTerminateResponse{}, nil
 }
 
+// terminateQueuedExecution aborts an execution held back by the concurrency policy of its launch plan. The execution
+// was never launched, so it is marked aborted right away.
+func (m *ExecutionManager) terminateQueuedExecution(ctx context.Context, executionModel models.Execution, cause string) (
+	*admin.ExecutionTerminateResponse, error) {
+	claimed, err := m.db.ExecutionRepo().UpdateConcurrencyState(ctx, executionModel.ID,
+		models.ExecutionConcurrencyQueued, models.ExecutionConcurrencySkipped)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, errors.NewFlyteAdminErrorf(codes.Aborted,
+			"execution [%s] is being launched from the concurrency queue, retry terminating it", executionModel.Name)
+	}
+
+	if err := transformers.SetExecutionAborted(&executionModel, cause, getUser(ctx)); err != nil {
+		logger.Debugf(ctx, "failed to add abort metadata for queued execution [%s] with err: %v", executionModel.Name, err)
+		return nil, err
+	}
+	executionModel.ConcurrencyState = models.ExecutionConcurrencySkipped
+	if err := m.db.ExecutionRepo().Update(ctx, executionModel); err != nil {
+		logger.Debugf(ctx, "failed to save abort cause for queued execution [%s] with err: %v", executionModel.Name, err)
+		return nil, err
+	}
+	m.systemMetrics.ActiveExecutions.Dec()
+	return &admin.ExecutionTerminateResponse{}, nil
+}
+
 func newExecutionSystemMetrics(scope promutils.Scope) executionSystemMetrics {
 	return executionSystemMetrics{
 		Scope: scope,
@@ -1874,6 +2302,18 @@ func newExecutionSystemMetrics(scope promutils.Scope) executionSystemMetrics {
 			"overall count of publish event errors when invoking publish()"),
 		TerminateExecutionFailures: scope.MustNewCounter("execution_termination_failure",
 			"count of failed workflow executions terminations"),
+		ConcurrencyQueued: scope.MustNewCounter("concurrency_queued",
+			"count of executions queued by the concurrency policy of their launch plan"),
+		ConcurrencySkipped: scope.MustNewCounter("concurrency_skipped",
+			"count of executions skipped by the concurrency policy of their launch plan"),
+		ConcurrencyReplaced: scope.MustNewCounter("concurrency_replaced",
+			"count of executions terminated to make room for newer executions of their launch plan"),
+		ConcurrencyDequeued: scope.MustNewCounter("concurrency_dequeued",
+			"count of queued executions launched once their launch plan had capacity"),
+		QuotaQueued: scope.MustNewCounter("quota_queued",
+			"count of executions queued by the quota of their project"),
+		QuotaRejected: scope.MustNewCounter("quota_rejected",
+			"count of executions rejected by the quota of their project"),
 	}
 }
 
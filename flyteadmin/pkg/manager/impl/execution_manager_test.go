@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -47,10 +48,13 @@ import (
 	workflowengineInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/workflowengine/interfaces"
 	workflowengineMocks "github.com/flyteorg/flyte/flyteadmin/pkg/workflowengine/mocks"
 	"github.com/flyteorg/flyte/flyteadmin/plugins"
+	schedulerMocks "github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/mocks"
+	schedulerModels "github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/models"
 	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/event"
+	compiler "github.com/flyteorg/flyte/flytepropeller/pkg/compiler/transformers/k8s"
 	mockScope "github.com/flyteorg/flyte/flytestdlib/promutils"
 	"github.com/flyteorg/flyte/flytestdlib/storage"
 )
@@ -6029,3 +6033,447 @@ func TestQueryTemplate(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestValidateSchedulerFencingToken(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	leaseRepo := repository.SchedulerLeaseRepo().(*schedulerMocks.SchedulerLeaseRepoInterface)
+	leaseRepo.EXPECT().Get(mock.Anything, common.SchedulerLeaseName).Return(schedulerModels.SchedulerLease{Token: 3}, nil)
+	execManager := ExecutionManager{db: repository}
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(common.FencingTokenHeader, token))
+	}
+
+	assert.NoError(t, execManager.validateSchedulerFencingToken(context.Background()))
+	assert.NoError(t, execManager.validateSchedulerFencingToken(withToken("3")))
+
+	err := execManager.validateSchedulerFencingToken(withToken("2"))
+	assert.Error(t, err)
+	assert.Equal(t, codes.Aborted, err.(flyteAdminErrors.FlyteAdminError).Code())
+
+	err = execManager.validateSchedulerFencingToken(withToken("not-a-token"))
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
+
+func setConcurrencyLpCallbackForExecTest(repository interfaces.Repository, overflow common.ConcurrencyOverflow) {
+	lpSpec := testutils.GetSampleLpSpecForTest()
+	lpSpec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			common.MaxConcurrencyAnnotation:      "1",
+			common.ConcurrencyOverflowAnnotation: string(overflow),
+		},
+	}
+	lpSpecBytes, _ := proto.Marshal(lpSpec)
+	lpClosureBytes, _ := proto.Marshal(&admin.LaunchPlanClosure{
+		ExpectedInputs: lpSpec.GetDefaultInputs(),
+	})
+	repository.LaunchPlanRepo().(*repositoryMocks.MockLaunchPlanRepo).SetGetCallback(
+		func(input interfaces.Identifier) (models.LaunchPlan, error) {
+			return models.LaunchPlan{
+				LaunchPlanKey: models.LaunchPlanKey{
+					Project: input.Project,
+					Domain:  input.Domain,
+					Name:    input.Name,
+					Version: input.Version,
+				},
+				BaseModel: models.BaseModel{
+					ID: uint(100),
+				},
+				Spec:    lpSpecBytes,
+				Closure: lpClosureBytes,
+			}, nil
+		})
+}
+
+func TestCreateExecution_ConcurrencyPolicy(t *testing.T) {
+	newExecManager := func(repository interfaces.Repository, executor *workflowengineMocks.WorkflowExecutor) *ExecutionManager {
+		executor.EXPECT().ID().Return("customMockExecutor")
+		r := plugins.NewRegistry()
+		r.RegisterDefault(plugins.PluginIDWorkflowExecutor, executor)
+		return NewExecutionManager(repository, r, getMockExecutionsConfigProvider(),
+			getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(),
+			&mockPublisher, mockExecutionRemoteURL, nil, nil, nil, nil,
+			&eventWriterMocks.WorkflowExecutionEventWriter{}).(*ExecutionManager)
+	}
+	withActiveExecutions := func(repository interfaces.Repository, count int64) {
+		repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCountCallback(
+			func(ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
+				assert.True(t, input.JoinTableEntities[common.LaunchPlan])
+				return count, nil
+			})
+	}
+
+	t.Run("launches below the limit", func(t *testing.T) {
+		repository := getMockRepositoryForExecTest()
+		setConcurrencyLpCallbackForExecTest(repository, common.ConcurrencyOverflowSkip)
+		withActiveExecutions(repository, 0)
+		created := false
+		repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+			func(ctx context.Context, input models.Execution) error {
+				created = true
+				assert.Empty(t, input.ConcurrencyState)
+				assert.Equal(t, core.WorkflowExecution_UNDEFINED.String(), input.Phase)
+				return nil
+			})
+		executor := &workflowengineMocks.WorkflowExecutor{}
+		executor.EXPECT().Execute(mock.Anything, mock.Anything).Return(workflowengineInterfaces.ExecutionResponse{
+			Cluster: testCluster,
+		}, nil)
+
+		_, err := newExecManager(repository, executor).CreateExecution(context.Background(),
+			testutils.GetExecutionRequest(), requestedAt)
+		assert.NoError(t, err)
+		assert.True(t, created)
+	})
+
+	t.Run("queue", func(t *testing.T) {
+		repository := getMockRepositoryForExecTest()
+		setConcurrencyLpCallbackForExecTest(repository, common.ConcurrencyOverflowQueue)
+		withActiveExecutions(repository, 1)
+		created := false
+		repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+			func(ctx context.Context, input models.Execution) error {
+				created = true
+				assert.Equal(t, models.ExecutionConcurrencyQueued, input.ConcurrencyState)
+				assert.Equal(t, core.WorkflowExecution_QUEUED.String(), input.Phase)
+				assert.Empty(t, input.Cluster)
+				return nil
+			})
+
+		// The execution must not be launched.
+		_, err := newExecManager(repository, &workflowengineMocks.WorkflowExecutor{}).CreateExecution(
+			context.Background(), testutils.GetExecutionRequest(), requestedAt)
+		assert.NoError(t, err)
+		assert.True(t, created)
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		repository := getMockRepositoryForExecTest()
+		setConcurrencyLpCallbackForExecTest(repository, common.ConcurrencyOverflowSkip)
+		withActiveExecutions(repository, 1)
+		created := false
+		repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+			func(ctx context.Context, input models.Execution) error {
+				created = true
+				assert.Equal(t, models.ExecutionConcurrencySkipped, input.ConcurrencyState)
+				assert.Equal(t, core.WorkflowExecution_ABORTED.String(), input.Phase)
+				assert.Contains(t, input.AbortCause, "project/domain/name")
+				return nil
+			})
+
+		_, err := newExecManager(repository, &workflowengineMocks.WorkflowExecutor{}).CreateExecution(
+			context.Background(), testutils.GetExecutionRequest(), requestedAt)
+		assert.NoError(t, err)
+		assert.True(t, created)
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		repository := getMockRepositoryForExecTest()
+		setConcurrencyLpCallbackForExecTest(repository, common.ConcurrencyOverflowReplace)
+		withActiveExecutions(repository, 1)
+		oldest := models.Execution{
+			BaseModel:    models.BaseModel{ID: 7},
+			ExecutionKey: models.ExecutionKey{Project: "project", Domain: "domain", Name: "oldest"},
+			Phase:        core.WorkflowExecution_RUNNING.String(),
+			Cluster:      testCluster,
+		}
+		repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetListCallback(
+			func(ctx context.Context, input interfaces.ListResourceInput) (interfaces.ExecutionCollectionOutput, error) {
+				assert.Equal(t, 1, input.Limit)
+				return interfaces.ExecutionCollectionOutput{Executions: []models.Execution{oldest}}, nil
+			})
+		repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetGetCallback(
+			func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+				assert.Equal(t, "oldest", input.Name)
+				return oldest, nil
+			})
+		aborting := false
+		repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetUpdateCallback(
+			func(ctx context.Context, execution models.Execution) error {
+				aborting = true
+				assert.Equal(t, "oldest", execution.Name)
+				assert.Equal(t, core.WorkflowExecution_ABORTING.String(), execution.Phase)
+				assert.Contains(t, execution.AbortCause, "Replaced by execution [name]")
+				return nil
+			})
+		repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+			func(ctx context.Context, input models.Execution) error {
+				assert.Empty(t, input.ConcurrencyState)
+				return nil
+			})
+		executor := &workflowengineMocks.WorkflowExecutor{}
+		executor.EXPECT().Abort(mock.Anything, mock.MatchedBy(func(data workflowengineInterfaces.AbortData) bool {
+			return data.ExecutionID.GetName() == "oldest"
+		})).Return(nil)
+		executor.EXPECT().Execute(mock.Anything, mock.Anything).Return(workflowengineInterfaces.ExecutionResponse{
+			Cluster: testCluster,
+		}, nil)
+
+		_, err := newExecManager(repository, executor).CreateExecution(context.Background(),
+			testutils.GetExecutionRequest(), requestedAt)
+		assert.NoError(t, err)
+		assert.True(t, aborting)
+	})
+}
+
+func TestLaunchQueuedExecutions(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setConcurrencyLpCallbackForExecTest(repository, common.ConcurrencyOverflowQueue)
+	request := testutils.GetExecutionRequest()
+	request.Spec.Metadata = &admin.ExecutionMetadata{
+		Mode:      admin.ExecutionMetadata_SCHEDULED,
+		Principal: principal,
+	}
+	specBytes, _ := proto.Marshal(request.GetSpec())
+	queued := models.Execution{
+		BaseModel:        models.BaseModel{ID: 8},
+		ExecutionKey:     models.ExecutionKey{Project: "project", Domain: "domain", Name: "queued"},
+		Phase:            core.WorkflowExecution_QUEUED.String(),
+		Spec:             specBytes,
+		ConcurrencyState: models.ExecutionConcurrencyQueued,
+	}
+
+	executionRepo := repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo)
+	executionRepo.SetCountCallback(func(ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
+		return 0, nil
+	})
+	executionRepo.SetListCallback(func(ctx context.Context, input interfaces.ListResourceInput) (
+		interfaces.ExecutionCollectionOutput, error) {
+		if queued.ConcurrencyState == models.ExecutionConcurrencyQueued {
+			return interfaces.ExecutionCollectionOutput{Executions: []models.Execution{queued}}, nil
+		}
+		return interfaces.ExecutionCollectionOutput{}, nil
+	})
+	executionRepo.SetUpdateConcurrencyStateCallback(func(ctx context.Context, id uint, expected, state string) (bool, error) {
+		assert.Equal(t, queued.ID, id)
+		assert.Equal(t, models.ExecutionConcurrencyQueued, expected)
+		assert.Empty(t, state)
+		queued.ConcurrencyState = state
+		return true, nil
+	})
+	updated := false
+	executionRepo.SetUpdateCallback(func(ctx context.Context, execution models.Execution) error {
+		updated = true
+		assert.Equal(t, queued.ID, execution.ID)
+		assert.Equal(t, "queued", execution.Name)
+		assert.Equal(t, core.WorkflowExecution_UNDEFINED.String(), execution.Phase)
+		assert.Equal(t, testCluster, execution.Cluster)
+		assert.Equal(t, principal, execution.User)
+		return nil
+	})
+
+	executor := &workflowengineMocks.WorkflowExecutor{}
+	executor.EXPECT().ID().Return("customMockExecutor")
+	executor.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(data workflowengineInterfaces.ExecutionData) bool {
+		return data.ExecutionID.GetName() == "queued"
+	})).Return(workflowengineInterfaces.ExecutionResponse{
+		Cluster: testCluster,
+	}, nil).Once()
+	r := plugins.NewRegistry()
+	r.RegisterDefault(plugins.PluginIDWorkflowExecutor, executor)
+	execManager := NewExecutionManager(repository, r, getMockExecutionsConfigProvider(),
+		getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(),
+		&mockPublisher, mockExecutionRemoteURL, nil, nil, nil, nil,
+		&eventWriterMocks.WorkflowExecutionEventWriter{}).(*ExecutionManager)
+
+	execManager.launchQueuedExecutions(context.Background(), &models.Execution{Spec: specBytes})
+	assert.True(t, updated)
+	assert.Empty(t, queued.ConcurrencyState)
+}
+
+// setProjectQuotaForExecTest defines a quota as the PROJECT_QUOTA matchable attribute of every project and domain.
+func setProjectQuotaForExecTest(repository interfaces.Repository, quota *admin.ProjectQuotaAttributes) {
+	repository.ResourceRepo().(*repositoryMocks.MockResourceRepo).GetFunction = func(ctx context.Context,
+		ID interfaces.ResourceID) (models.Resource, error) {
+		if ID.ResourceType != admin.MatchableResource_PROJECT_QUOTA.String() {
+			return models.Resource{}, flyteAdminErrors.NewFlyteAdminErrorf(codes.NotFound, "not found")
+		}
+		attributes, err := proto.Marshal(&admin.MatchingAttributes{
+			Target: &admin.MatchingAttributes_ProjectQuotaAttributes{ProjectQuotaAttributes: quota},
+		})
+		if err != nil {
+			return models.Resource{}, err
+		}
+		return models.Resource{Project: ID.Project, ResourceType: ID.ResourceType, Attributes: attributes}, nil
+	}
+}
+
+func TestCreateExecution_ProjectQuota(t *testing.T) {
+	newExecManager := func(repository interfaces.Repository, executor *workflowengineMocks.WorkflowExecutor) *ExecutionManager {
+		executor.EXPECT().ID().Return("customMockExecutor")
+		r := plugins.NewRegistry()
+		r.RegisterDefault(plugins.PluginIDWorkflowExecutor, executor)
+		return NewExecutionManager(repository, r, getMockExecutionsConfigProvider(),
+			getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(),
+			&mockPublisher, mockExecutionRemoteURL, nil, nil, nil, nil,
+			&eventWriterMocks.WorkflowExecutionEventWriter{}).(*ExecutionManager)
+	}
+	withQuota := func(repository interfaces.Repository, overflow admin.ProjectQuotaAttributes_OverflowBehavior,
+		activeExecutions int64) {
+		setProjectQuotaForExecTest(repository, &admin.ProjectQuotaAttributes{
+			MaxConcurrentExecutions: 2,
+			MaxRunningTasks:         10,
+			MaxGpu:                  "4",
+			Overflow:                overflow,
+		})
+		repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCountCallback(
+			func(ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
+				return activeExecutions, nil
+			})
+	}
+
+	t.Run("launches below the limit with the task limits", func(t *testing.T) {
+		repository := getMockRepositoryForExecTest()
+		setDefaultLpCallbackForExecTest(repository)
+		withQuota(repository, admin.ProjectQuotaAttributes_REJECT, 1)
+		executor := &workflowengineMocks.WorkflowExecutor{}
+		executor.EXPECT().Execute(mock.Anything, mock.MatchedBy(func(data workflowengineInterfaces.ExecutionData) bool {
+			annotations := data.ExecutionParameters.Annotations
+			return annotations[compiler.MaxRunningTasksAnnotation] == "10" &&
+				annotations[compiler.MaxGPUAnnotation] == "4" && len(annotations[compiler.MaxCPUAnnotation]) == 0
+		})).Return(workflowengineInterfaces.ExecutionResponse{Cluster: testCluster}, nil)
+
+		_, err := newExecManager(repository, executor).CreateExecution(context.Background(),
+			testutils.GetExecutionRequest(), requestedAt)
+		assert.NoError(t, err)
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		repository := getMockRepositoryForExecTest()
+		setDefaultLpCallbackForExecTest(repository)
+		withQuota(repository, admin.ProjectQuotaAttributes_REJECT, 2)
+
+		_, err := newExecManager(repository, &workflowengineMocks.WorkflowExecutor{}).CreateExecution(
+			context.Background(), testutils.GetExecutionRequest(), requestedAt)
+		assert.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, err.(flyteAdminErrors.FlyteAdminError).Code())
+	})
+
+	t.Run("queue", func(t *testing.T) {
+		repository := getMockRepositoryForExecTest()
+		setDefaultLpCallbackForExecTest(repository)
+		withQuota(repository, admin.ProjectQuotaAttributes_QUEUE, 2)
+		created := false
+		repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCreateCallback(
+			func(ctx context.Context, input models.Execution) error {
+				created = true
+				assert.Equal(t, models.ExecutionConcurrencyQueued, input.ConcurrencyState)
+				assert.Equal(t, core.WorkflowExecution_QUEUED.String(), input.Phase)
+				return nil
+			})
+
+		// The execution must not be launched.
+		_, err := newExecManager(repository, &workflowengineMocks.WorkflowExecutor{}).CreateExecution(
+			context.Background(), testutils.GetExecutionRequest(), requestedAt)
+		assert.NoError(t, err)
+		assert.True(t, created)
+	})
+}
+
+func TestLaunchQueuedProjectExecutions(t *testing.T) {
+	repository := getMockRepositoryForExecTest()
+	setDefaultLpCallbackForExecTest(repository)
+	specBytes, _ := proto.Marshal(testutils.GetExecutionRequest().GetSpec())
+	queued := []models.Execution{
+		{
+			BaseModel:        models.BaseModel{ID: 8},
+			ExecutionKey:     models.ExecutionKey{Project: "project", Domain: "domain", Name: "first"},
+			Phase:            core.WorkflowExecution_QUEUED.String(),
+			Spec:             specBytes,
+			ConcurrencyState: models.ExecutionConcurrencyQueued,
+		},
+		{
+			BaseModel:        models.BaseModel{ID: 9},
+			ExecutionKey:     models.ExecutionKey{Project: "project", Domain: "domain", Name: "second"},
+			Phase:            core.WorkflowExecution_QUEUED.String(),
+			Spec:             specBytes,
+			ConcurrencyState: models.ExecutionConcurrencyQueued,
+		},
+	}
+	setProjectQuotaForExecTest(repository, &admin.ProjectQuotaAttributes{
+		MaxConcurrentExecutions: 2,
+		Overflow:                admin.ProjectQuotaAttributes_QUEUE,
+	})
+	activeExecutions := int64(1)
+	executionRepo := repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo)
+	executionRepo.SetCountCallback(func(ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
+		return activeExecutions, nil
+	})
+	executionRepo.SetListCallback(func(ctx context.Context, input interfaces.ListResourceInput) (
+		interfaces.ExecutionCollectionOutput, error) {
+		assert.Equal(t, maxQueuedProjectExecutions, input.Limit)
+		return interfaces.ExecutionCollectionOutput{Executions: queued}, nil
+	})
+	executionRepo.SetUpdateConcurrencyStateCallback(func(ctx context.Context, id uint, expected, state string) (bool, error) {
+		return true, nil
+	})
+	var launched []string
+	executionRepo.SetUpdateCallback(func(ctx context.Context, execution models.Execution) error {
+		launched = append(launched, execution.Name)
+		activeExecutions++
+		return nil
+	})
+
+	executor := &workflowengineMocks.WorkflowExecutor{}
+	executor.EXPECT().ID().Return("customMockExecutor")
+	executor.EXPECT().Execute(mock.Anything, mock.Anything).Return(workflowengineInterfaces.ExecutionResponse{
+		Cluster: testCluster,
+	}, nil)
+	r := plugins.NewRegistry()
+	r.RegisterDefault(plugins.PluginIDWorkflowExecutor, executor)
+	execManager := NewExecutionManager(repository, r, getMockExecutionsConfigProvider(),
+		getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(),
+		&mockPublisher, mockExecutionRemoteURL, nil, nil, nil, nil,
+		&eventWriterMocks.WorkflowExecutionEventWriter{}).(*ExecutionManager)
+
+	// Only the oldest execution fits in the quota.
+	execManager.launchQueuedProjectExecutions(context.Background(), "project", "domain")
+	assert.Equal(t, []string{"first"}, launched)
+}
+
+func TestTerminateExecution_Queued(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	executionRepo := repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo)
+	closure, _ := proto.Marshal(&admin.ExecutionClosure{Phase: core.WorkflowExecution_QUEUED})
+	executionRepo.SetGetCallback(func(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
+		return models.Execution{
+			BaseModel:        models.BaseModel{ID: 9},
+			ExecutionKey:     models.ExecutionKey{Project: "project", Domain: "domain", Name: "name"},
+			Phase:            core.WorkflowExecution_QUEUED.String(),
+			Closure:          closure,
+			ConcurrencyState: models.ExecutionConcurrencyQueued,
+		}, nil
+	})
+	claimed := true
+	executionRepo.SetUpdateConcurrencyStateCallback(func(ctx context.Context, id uint, expected, state string) (bool, error) {
+		assert.Equal(t, models.ExecutionConcurrencyQueued, expected)
+		assert.Equal(t, models.ExecutionConcurrencySkipped, state)
+		return claimed, nil
+	})
+	updated := false
+	executionRepo.SetUpdateCallback(func(ctx context.Context, execution models.Execution) error {
+		updated = true
+		assert.Equal(t, core.WorkflowExecution_ABORTED.String(), execution.Phase)
+		assert.Equal(t, "abort cause", execution.AbortCause)
+		return nil
+	})
+
+	// Queued executions were never launched so there is nothing to abort in the cluster.
+	r := plugins.NewRegistry()
+	r.RegisterDefault(plugins.PluginIDWorkflowExecutor, &defaultTestExecutor)
+	execManager := NewExecutionManager(repository, r, getMockExecutionsConfigProvider(),
+		getMockStorageForExecTest(context.Background()), mockScope.NewTestScope(), mockScope.NewTestScope(),
+		&mockPublisher, mockExecutionRemoteURL, nil, nil, nil, nil, &eventWriterMocks.WorkflowExecutionEventWriter{})
+	request := &admin.ExecutionTerminateRequest{
+		Id:    &core.WorkflowExecutionIdentifier{Project: "project", Domain: "domain", Name: "name"},
+		Cause: "abort cause",
+	}
+	_, err := execManager.TerminateExecution(context.Background(), request)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+
+	claimed = false
+	_, err = execManager.TerminateExecution(context.Background(), request)
+	assert.Error(t, err)
+	assert.Equal(t, codes.Aborted, err.(flyteAdminErrors.FlyteAdminError).Code())
+}
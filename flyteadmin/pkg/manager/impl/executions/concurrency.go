@@ -0,0 +1,103 @@
+package executions
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
+	repositoryInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+var terminalExecutionPhases = []string{
+	core.WorkflowExecution_SUCCEEDED.String(),
+	core.WorkflowExecution_FAILED.String(),
+	core.WorkflowExecution_ABORTED.String(),
+	core.WorkflowExecution_TIMED_OUT.String(),
+}
+
+// getLaunchPlanExecutionFilters returns the filters for non-terminal executions of any version of a launch plan with
+// the given concurrency state.
+func getLaunchPlanExecutionFilters(launchPlanID *core.Identifier, concurrencyState string) ([]common.InlineFilter, error) {
+	filters := make([]common.InlineFilter, 0, 5)
+	for _, field := range []struct {
+		entity common.Entity
+		name   string
+		value  string
+	}{
+		{common.LaunchPlan, "project", launchPlanID.GetProject()},
+		{common.LaunchPlan, "domain", launchPlanID.GetDomain()},
+		{common.LaunchPlan, "name", launchPlanID.GetName()},
+		{common.Execution, "concurrency_state", concurrencyState},
+	} {
+		filter, err := common.NewSingleValueFilter(field.entity, common.Equal, field.name, field.value)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+
+	phaseFilter, err := common.NewRepeatedValueFilter(common.Execution, common.ValueNotIn, "phase",
+		terminalExecutionPhases)
+	if err != nil {
+		return nil, err
+	}
+	return append(filters, phaseFilter), nil
+}
+
+// CountActiveExecutions returns the number of launched executions of any version of a launch plan which have not
+// terminated yet.
+func CountActiveExecutions(ctx context.Context, db repositoryInterfaces.Repository, launchPlanID *core.Identifier) (
+	int64, error) {
+	// Limits must not be enforced on counts read from a lagging replica.
+	ctx = repositoryInterfaces.WithPrimaryReads(ctx)
+	filters, err := getLaunchPlanExecutionFilters(launchPlanID, "")
+	if err != nil {
+		return 0, err
+	}
+	return db.ExecutionRepo().Count(ctx, repositoryInterfaces.CountResourceInput{
+		InlineFilters:     filters,
+		JoinTableEntities: map[common.Entity]bool{common.LaunchPlan: true},
+	})
+}
+
+// ListActiveExecutions returns up to limit launched executions of a launch plan which have not terminated yet, oldest
+// first.
+func ListActiveExecutions(ctx context.Context, db repositoryInterfaces.Repository, launchPlanID *core.Identifier,
+	limit int) ([]models.Execution, error) {
+	return listLaunchPlanExecutions(ctx, db, launchPlanID, "", limit)
+}
+
+// ListQueuedExecutions returns up to limit executions of a launch plan held back by its concurrency policy, oldest
+// first.
+func ListQueuedExecutions(ctx context.Context, db repositoryInterfaces.Repository, launchPlanID *core.Identifier,
+	limit int) ([]models.Execution, error) {
+	return listLaunchPlanExecutions(ctx, db, launchPlanID, models.ExecutionConcurrencyQueued, limit)
+}
+
+func listLaunchPlanExecutions(ctx context.Context, db repositoryInterfaces.Repository, launchPlanID *core.Identifier,
+	concurrencyState string, limit int) ([]models.Execution, error) {
+	ctx = repositoryInterfaces.WithPrimaryReads(ctx)
+	filters, err := getLaunchPlanExecutionFilters(launchPlanID, concurrencyState)
+	if err != nil {
+		return nil, err
+	}
+	sortParameter, err := common.NewSortParameter(&admin.Sort{
+		Key:       "execution_created_at",
+		Direction: admin.Sort_ASCENDING,
+	}, models.ExecutionColumns)
+	if err != nil {
+		return nil, err
+	}
+	output, err := db.ExecutionRepo().List(ctx, repositoryInterfaces.ListResourceInput{
+		Limit:             limit,
+		InlineFilters:     filters,
+		SortParameter:     sortParameter,
+		JoinTableEntities: map[common.Entity]bool{common.LaunchPlan: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.Executions, nil
+}
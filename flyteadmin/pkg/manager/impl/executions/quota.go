@@ -0,0 +1,132 @@
+package executions
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/util"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/interfaces"
+	repositoryInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+var terminalTaskExecutionPhases = []string{
+	core.TaskExecution_SUCCEEDED.String(),
+	core.TaskExecution_FAILED.String(),
+	core.TaskExecution_ABORTED.String(),
+}
+
+// GetProjectQuota returns the quota applying to the executions of a project and domain, or nil if there is none. As
+// for any matchable attribute, a quota defined for the domain takes precedence over the one defined for the project.
+func GetProjectQuota(ctx context.Context, resourceManager interfaces.ResourceInterface, project, domain string) (
+	*admin.ProjectQuotaAttributes, error) {
+	resource, err := util.GetMatchableResource(ctx, resourceManager, admin.MatchableResource_PROJECT_QUOTA, project,
+		domain, "")
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil || resource.Attributes.GetProjectQuotaAttributes() == nil {
+		return nil, nil
+	}
+	return resource.Attributes.GetProjectQuotaAttributes(), nil
+}
+
+// getProjectExecutionFilters returns the filters for non-terminal executions of a project and domain with the given
+// concurrency state.
+func getProjectExecutionFilters(project, domain, concurrencyState string) ([]common.InlineFilter, error) {
+	filters := make([]common.InlineFilter, 0, 4)
+	for _, field := range []struct {
+		name  string
+		value string
+	}{
+		{"project", project},
+		{"domain", domain},
+		{"concurrency_state", concurrencyState},
+	} {
+		filter, err := common.NewSingleValueFilter(common.Execution, common.Equal, field.name, field.value)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+
+	phaseFilter, err := common.NewRepeatedValueFilter(common.Execution, common.ValueNotIn, "phase",
+		terminalExecutionPhases)
+	if err != nil {
+		return nil, err
+	}
+	return append(filters, phaseFilter), nil
+}
+
+// CountProjectExecutions returns the number of non-terminal executions of a project and domain with the given
+// concurrency state. An empty state counts the launched executions. The count is not taken in the transaction which
+// creates the execution, so quotas checked against it are best-effort.
+func CountProjectExecutions(ctx context.Context, db repositoryInterfaces.Repository, project, domain,
+	concurrencyState string) (int64, error) {
+	// Quotas must not be enforced on counts read from a lagging replica.
+	ctx = repositoryInterfaces.WithPrimaryReads(ctx)
+	filters, err := getProjectExecutionFilters(project, domain, concurrencyState)
+	if err != nil {
+		return 0, err
+	}
+	return db.ExecutionRepo().Count(ctx, repositoryInterfaces.CountResourceInput{
+		InlineFilters: filters,
+	})
+}
+
+// ListProjectQueuedExecutions returns up to limit queued executions of a project and domain, oldest first.
+func ListProjectQueuedExecutions(ctx context.Context, db repositoryInterfaces.Repository, project, domain string,
+	limit int) ([]models.Execution, error) {
+	ctx = repositoryInterfaces.WithPrimaryReads(ctx)
+	filters, err := getProjectExecutionFilters(project, domain, models.ExecutionConcurrencyQueued)
+	if err != nil {
+		return nil, err
+	}
+	sortParameter, err := common.NewSortParameter(&admin.Sort{
+		Key:       "execution_created_at",
+		Direction: admin.Sort_ASCENDING,
+	}, models.ExecutionColumns)
+	if err != nil {
+		return nil, err
+	}
+	output, err := db.ExecutionRepo().List(ctx, repositoryInterfaces.ListResourceInput{
+		Limit:         limit,
+		InlineFilters: filters,
+		SortParameter: sortParameter,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.Executions, nil
+}
+
+// CountProjectRunningTasks returns the number of task executions of a project and domain which have not terminated.
+func CountProjectRunningTasks(ctx context.Context, db repositoryInterfaces.Repository, project, domain string) (
+	int64, error) {
+	ctx = repositoryInterfaces.WithPrimaryReads(ctx)
+	filters := make([]common.InlineFilter, 0, 3)
+	for _, field := range []struct {
+		name  string
+		value string
+	}{
+		{"project", project},
+		{"domain", domain},
+	} {
+		filter, err := common.NewSingleValueFilter(common.Execution, common.Equal, field.name, field.value)
+		if err != nil {
+			return 0, err
+		}
+		filters = append(filters, filter)
+	}
+	phaseFilter, err := common.NewRepeatedValueFilter(common.TaskExecution, common.ValueNotIn, "phase",
+		terminalTaskExecutionPhases)
+	if err != nil {
+		return 0, err
+	}
+	return db.TaskExecutionRepo().Count(ctx, repositoryInterfaces.CountResourceInput{
+		InlineFilters:     append(filters, phaseFilter),
+		JoinTableEntities: map[common.Entity]bool{common.Execution: true},
+	})
+}
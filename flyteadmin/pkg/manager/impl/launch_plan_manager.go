@@ -53,6 +53,7 @@ func (m *LaunchPlanManager) getNamedEntityContext(ctx context.Context, identifie
 func (m *LaunchPlanManager) CreateLaunchPlan(
 	ctx context.Context,
 	request *admin.LaunchPlanCreateRequest) (*admin.LaunchPlanCreateResponse, error) {
+	ctx = repoInterfaces.WithPrimaryReads(ctx)
 	if err := validation.ValidateIdentifier(request.GetSpec().GetWorkflowId(), common.Workflow); err != nil {
 		logger.Debugf(ctx, "Failed to validate provided workflow ID for CreateLaunchPlan with err: %v", err)
 		return nil, err
@@ -330,6 +331,7 @@ func (m *LaunchPlanManager) enableLaunchPlan(ctx context.Context, request *admin
 
 func (m *LaunchPlanManager) UpdateLaunchPlan(ctx context.Context, request *admin.LaunchPlanUpdateRequest) (
 	*admin.LaunchPlanUpdateResponse, error) {
+	ctx = repoInterfaces.WithPrimaryReads(ctx)
 	if err := validation.ValidateIdentifier(request.GetId(), common.LaunchPlan); err != nil {
 		logger.Debugf(ctx, "can't update launch plan [%+v] state, invalid identifier: %v", request.GetId(), err)
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/duration"
@@ -12,6 +13,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/spananalysis"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/apis/flyteworkflow/v1alpha1"
@@ -22,19 +24,40 @@ import (
 const (
 	RequestLimit uint32 = 50
 
-	nodeIdle         = "NODE_IDLE"
-	nodeReset        = "NODE_RESET"
-	nodeSetup        = "NODE_SETUP"
-	nodeTeardown     = "NODE_TEARDOWN"
-	nodeTransition   = "NODE_TRANSITION"
-	taskRuntime      = "TASK_RUNTIME"
-	taskSetup        = "TASK_SETUP"
-	taskTeardown     = "TASK_TEARDOWN"
-	workflowSetup    = "WORKFLOW_SETUP"
-	workflowTeardown = "WORKFLOW_TEARDOWN"
+	nodeIdle           = "NODE_IDLE"
+	nodeReset          = "NODE_RESET"
+	nodeSetup          = "NODE_SETUP"
+	nodeTeardown       = "NODE_TEARDOWN"
+	nodeTransition     = "NODE_TRANSITION"
+	taskImagePull      = "TASK_IMAGE_PULL"
+	taskInitialization = "TASK_INITIALIZATION"
+	taskQueueing       = "TASK_QUEUEING"
+	taskRuntime        = "TASK_RUNTIME"
+	taskScheduling     = "TASK_SCHEDULING"
+	taskSetup          = "TASK_SETUP"
+	taskTeardown       = "TASK_TEARDOWN"
+	workflowSetup      = "WORKFLOW_SETUP"
+	workflowTeardown   = "WORKFLOW_TEARDOWN"
 )
 
 var (
+	// taskSetupReasons maps fragments of the reasons plugins report while a task is pending to the operation the time
+	// following the reason is attributed to. Reasons matching none of them are attributed to queueing.
+	taskSetupReasons = []struct {
+		fragment  string
+		operation string
+	}{
+		{"ContainerCreating", taskImagePull},
+		{"ErrImagePull", taskImagePull},
+		{"ImagePullBackOff", taskImagePull},
+		// The init containers of the pod are running, its images have already been pulled.
+		{"PodInitializing", taskInitialization},
+		{"task submitted to K8s", taskScheduling},
+		{"Unschedulable", taskScheduling},
+		{"Scheduling", taskScheduling},
+		{"Demistify Pending", taskScheduling},
+	}
+
 	emptyDuration *duration.Duration = &duration.Duration{
 		Seconds: 0,
 		Nanos:   0,
@@ -56,6 +79,10 @@ type MetricsManager struct {
 	nodeExecutionManager interfaces.NodeExecutionInterface
 	taskExecutionManager interfaces.TaskExecutionInterface
 	metrics              metrics
+
+	// detailedTaskSetup breaks TASK_SETUP Spans down further using the reasons reported by pending tasks. It is only
+	// set when analyzing an execution so GetExecutionMetrics keeps returning the same Spans.
+	detailedTaskSetup bool
 }
 
 // createOperationSpan returns a Span defined by the provided arguments.
@@ -235,7 +262,7 @@ func (m *MetricsManager) parseDynamicNodeExecution(ctx context.Context, nodeExec
 		*spans = append(*spans, createOperationSpan(nodeExecution.GetClosure().GetCreatedAt(), taskExecutions[0].GetClosure().GetCreatedAt(), nodeSetup))
 
 		// task execution(s)
-		parseTaskExecutions(taskExecutions, spans, depth)
+		m.parseTaskExecutions(taskExecutions, spans, depth)
 
 		nodeExecutions, err := m.getNodeExecutions(ctx, &admin.NodeExecutionListRequest{
 			WorkflowExecutionId: nodeExecution.GetId().GetExecutionId(),
@@ -549,9 +576,59 @@ func (m *MetricsManager) parseSubworkflowNodeExecution(ctx context.Context,
 	return nil
 }
 
+// getTaskSetupOperation returns the operation the time following a reason reported by a pending task is attributed to.
+func getTaskSetupOperation(reason string) string {
+	for _, r := range taskSetupReasons {
+		if strings.Contains(reason, r.fragment) {
+			return r.operation
+		}
+	}
+
+	return taskQueueing
+}
+
+// parseTaskSetup returns the Span of the time between the creation of the task execution and the plugin starting it.
+// If the task reported why it was pending, the Span is further broken down into queueing, pod scheduling, image pull
+// and init container Spans.
+func parseTaskSetup(taskExecution *admin.TaskExecution) *core.Span {
+	createdAt := taskExecution.GetClosure().GetCreatedAt()
+	startedAt := taskExecution.GetClosure().GetStartedAt()
+	span := createOperationSpan(createdAt, startedAt, taskSetup)
+
+	reasons := make([]*admin.Reason, 0, len(taskExecution.GetClosure().GetReasons()))
+	for _, reason := range taskExecution.GetClosure().GetReasons() {
+		occurredAt := reason.GetOccurredAt().AsTime()
+		if !occurredAt.Before(createdAt.AsTime()) && occurredAt.Before(startedAt.AsTime()) {
+			reasons = append(reasons, reason)
+		}
+	}
+	if len(reasons) == 0 {
+		return span
+	}
+	sort.SliceStable(reasons, func(i, j int) bool {
+		return reasons[i].GetOccurredAt().AsTime().Before(reasons[j].GetOccurredAt().AsTime())
+	})
+
+	// time before the first reason is queueing
+	operation, operationStart := taskQueueing, createdAt
+	for _, reason := range reasons {
+		next := getTaskSetupOperation(reason.GetMessage())
+		if next == operation {
+			continue
+		}
+		if reason.GetOccurredAt().AsTime().After(operationStart.AsTime()) {
+			span.Spans = append(span.Spans, createOperationSpan(operationStart, reason.GetOccurredAt(), operation))
+		}
+		operation, operationStart = next, reason.GetOccurredAt()
+	}
+	span.Spans = append(span.Spans, createOperationSpan(operationStart, startedAt, operation))
+
+	return span
+}
+
 // parseTaskExecution partitions the task execution into a collection of Categorical and Reference Spans which are
 // returned as a hierarchical breakdown of the task execution.
-func parseTaskExecution(taskExecution *admin.TaskExecution) *core.Span {
+func (m *MetricsManager) parseTaskExecution(taskExecution *admin.TaskExecution) *core.Span {
 	spans := make([]*core.Span, 0)
 
 	// check if plugin has started yet
@@ -559,7 +636,11 @@ func parseTaskExecution(taskExecution *admin.TaskExecution) *core.Span {
 		spans = append(spans, createOperationSpan(taskExecution.GetClosure().GetCreatedAt(), taskExecution.GetClosure().GetUpdatedAt(), taskSetup))
 	} else {
 		// frontend overhead
-		spans = append(spans, createOperationSpan(taskExecution.GetClosure().GetCreatedAt(), taskExecution.GetClosure().GetStartedAt(), taskSetup))
+		if m.detailedTaskSetup {
+			spans = append(spans, parseTaskSetup(taskExecution))
+		} else {
+			spans = append(spans, createOperationSpan(taskExecution.GetClosure().GetCreatedAt(), taskExecution.GetClosure().GetStartedAt(), taskSetup))
+		}
 
 		// check if plugin has completed yet
 		if taskExecution.GetClosure().GetDuration() == nil || reflect.DeepEqual(taskExecution.GetClosure().GetDuration(), emptyDuration) {
@@ -588,7 +669,7 @@ func parseTaskExecution(taskExecution *admin.TaskExecution) *core.Span {
 
 // parseTaskExecutions partitions the task executions into a collection of Categorical and Reference Spans which are
 // appended to the provided spans argument.
-func parseTaskExecutions(taskExecutions []*admin.TaskExecution, spans *[]*core.Span, depth int) {
+func (m *MetricsManager) parseTaskExecutions(taskExecutions []*admin.TaskExecution, spans *[]*core.Span, depth int) {
 	// sort task executions
 	sort.Slice(taskExecutions, func(i, j int) bool {
 		x := taskExecutions[i].GetClosure().GetCreatedAt().AsTime()
@@ -603,7 +684,7 @@ func parseTaskExecutions(taskExecutions []*admin.TaskExecution, spans *[]*core.S
 		}
 
 		if depth != 0 {
-			*spans = append(*spans, parseTaskExecution(taskExecution))
+			*spans = append(*spans, m.parseTaskExecution(taskExecution))
 		}
 	}
 }
@@ -628,7 +709,7 @@ func (m *MetricsManager) parseTaskNodeExecution(ctx context.Context, nodeExecuti
 		*spans = append(*spans, createOperationSpan(nodeExecution.GetClosure().GetCreatedAt(), taskExecutions[0].GetClosure().GetCreatedAt(), nodeSetup))
 
 		// parse task executions
-		parseTaskExecutions(taskExecutions, spans, depth)
+		m.parseTaskExecutions(taskExecutions, spans, depth)
 
 		// backend overhead
 		lastTask := taskExecutions[len(taskExecutions)-1]
@@ -661,6 +742,28 @@ func (m *MetricsManager) GetExecutionMetrics(ctx context.Context,
 	return &admin.WorkflowExecutionGetMetricsResponse{Span: span}, nil
 }
 
+// GetExecutionAnalysis returns the critical path analysis of the workflow execution. Unlike GetExecutionMetrics the
+// setup of each task is broken down into queueing, pod scheduling, image pull and initialization.
+func (m *MetricsManager) GetExecutionAnalysis(ctx context.Context,
+	request *admin.WorkflowExecutionGetAnalysisRequest) (*admin.WorkflowExecutionGetAnalysisResponse, error) {
+
+	// retrieve workflow execution
+	executionRequest := &admin.WorkflowExecutionGetRequest{Id: request.GetId()}
+	execution, err := m.executionManager.GetExecution(ctx, executionRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	detailed := *m
+	detailed.detailedTaskSetup = true
+	span, err := detailed.parseExecution(ctx, execution, int(request.GetDepth()))
+	if err != nil {
+		return nil, err
+	}
+
+	return spananalysis.Analyze(span), nil
+}
+
 // NewMetricsManager returns a new MetricsManager constructed with the provided arguments.
 func NewMetricsManager(
 	workflowManager interfaces.WorkflowInterface,
@@ -907,7 +907,7 @@ func TestParseTaskExecution(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			// parse task execution
-			span := parseTaskExecution(test.taskExecution)
+			span := (&MetricsManager{}).parseTaskExecution(test.taskExecution)
 			_, ok := span.GetId().(*core.Span_TaskId)
 			assert.True(t, ok)
 
@@ -919,6 +919,101 @@ func TestParseTaskExecution(t *testing.T) {
 	}
 }
 
+func TestParseTaskSetup(t *testing.T) {
+	tests := []struct {
+		name               string
+		reasons            []*admin.Reason
+		operationDurations map[string][]int64
+	}{
+		{
+			"NoReasons",
+			nil,
+			map[string][]int64{},
+		},
+		{
+			"Pending",
+			[]*admin.Reason{
+				&admin.Reason{OccurredAt: addTimestamp(baseTimestamp, 0), Message: "Exceeded resourcequota: pods"},
+				&admin.Reason{OccurredAt: addTimestamp(baseTimestamp, 30), Message: "task submitted to K8s"},
+				&admin.Reason{OccurredAt: addTimestamp(baseTimestamp, 35), Message: "Unschedulable:0/1 nodes are available"},
+				&admin.Reason{OccurredAt: addTimestamp(baseTimestamp, 40), Message: "[ContainersNotReady|ContainerCreating]: containers with unready status"},
+				&admin.Reason{OccurredAt: addTimestamp(baseTimestamp, 60), Message: "task is running"},
+			},
+			map[string][]int64{
+				taskQueueing:   []int64{30},
+				taskScheduling: []int64{10},
+				taskImagePull:  []int64{20},
+			},
+		},
+		{
+			"InitContainers",
+			[]*admin.Reason{
+				&admin.Reason{OccurredAt: addTimestamp(baseTimestamp, 10), Message: "[ContainersNotReady|ContainerCreating]: containers with unready status"},
+				&admin.Reason{OccurredAt: addTimestamp(baseTimestamp, 25), Message: "[ContainersNotReady|PodInitializing]: containers with unready status"},
+			},
+			map[string][]int64{
+				taskQueueing:       []int64{10},
+				taskImagePull:      []int64{15},
+				taskInitialization: []int64{35},
+			},
+		},
+		{
+			"FirstReasonAfterCreation",
+			[]*admin.Reason{
+				&admin.Reason{OccurredAt: addTimestamp(baseTimestamp, 20), Message: "Scheduling"},
+			},
+			map[string][]int64{
+				taskQueueing:   []int64{20},
+				taskScheduling: []int64{40},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			span := parseTaskSetup(&admin.TaskExecution{
+				Closure: &admin.TaskExecutionClosure{
+					CreatedAt: baseTimestamp,
+					StartedAt: addTimestamp(baseTimestamp, 60),
+					Reasons:   test.reasons,
+				},
+			})
+			assert.Equal(t, taskSetup, span.GetOperationId())
+
+			// validate spans
+			operationDurations, referenceCount := parseSpans(span.GetSpans())
+			assert.True(t, reflect.DeepEqual(test.operationDurations, operationDurations))
+			assert.Equal(t, 0, referenceCount)
+		})
+	}
+}
+
+func TestParseTaskExecution_DetailedTaskSetup(t *testing.T) {
+	taskExecution := &admin.TaskExecution{
+		Closure: &admin.TaskExecutionClosure{
+			CreatedAt: baseTimestamp,
+			StartedAt: addTimestamp(baseTimestamp, 60),
+			Duration:  baseDuration,
+			UpdatedAt: addTimestamp(baseTimestamp, 470),
+			Reasons: []*admin.Reason{
+				&admin.Reason{OccurredAt: addTimestamp(baseTimestamp, 20), Message: "Unschedulable:0/1 nodes are available"},
+			},
+		},
+	}
+
+	// GetExecutionMetrics does not break the task setup down
+	span := (&MetricsManager{}).parseTaskExecution(taskExecution)
+	assert.Equal(t, taskSetup, span.GetSpans()[0].GetOperationId())
+	assert.Empty(t, span.GetSpans()[0].GetSpans())
+
+	span = (&MetricsManager{detailedTaskSetup: true}).parseTaskExecution(taskExecution)
+	operationDurations, _ := parseSpans(span.GetSpans()[0].GetSpans())
+	assert.Equal(t, map[string][]int64{
+		taskQueueing:   []int64{20},
+		taskScheduling: []int64{40},
+	}, operationDurations)
+}
+
 func TestParseTaskExecutions(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -972,7 +1067,7 @@ func TestParseTaskExecutions(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			// parse task executions
 			spans := make([]*core.Span, 0)
-			parseTaskExecutions(test.taskExecutions, &spans, -1)
+			(&MetricsManager{}).parseTaskExecutions(test.taskExecutions, &spans, -1)
 
 			// validate spans
 			operationDurations, referenceCount := parseSpans(spans)
@@ -1079,3 +1174,30 @@ func TestParseTaskNodeExecution(t *testing.T) {
 		})
 	}
 }
+
+func TestGetExecutionAnalysis(t *testing.T) {
+	execution := &admin.Execution{
+		Id: &core.WorkflowExecutionIdentifier{Project: "project", Domain: "domain", Name: "name"},
+		Closure: &admin.ExecutionClosure{
+			CreatedAt: baseTimestamp,
+			UpdatedAt: addTimestamp(baseTimestamp, 30),
+		},
+	}
+	metricsManager := MetricsManager{
+		workflowManager:      getMockWorkflowManager(&admin.Workflow{}),
+		executionManager:     getMockExecutionManager(execution),
+		nodeExecutionManager: getMockNodeExecutionManager(nil, nil),
+	}
+
+	analysis, err := metricsManager.GetExecutionAnalysis(context.TODO(), &admin.WorkflowExecutionGetAnalysisRequest{
+		Id:    execution.GetId(),
+		Depth: 1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(30), analysis.GetDuration().GetSeconds())
+	assert.Len(t, analysis.GetBreakdown(), 1)
+	assert.Equal(t, admin.ActivityDuration_PROPELLER_OVERHEAD, analysis.GetBreakdown()[0].GetActivity())
+	assert.Len(t, analysis.GetCriticalPath(), 1)
+	assert.Equal(t, workflowSetup, analysis.GetCriticalPath()[0].GetOperationId())
+	assert.False(t, metricsManager.detailedTaskSetup)
+}
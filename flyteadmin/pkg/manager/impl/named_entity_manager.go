@@ -37,6 +37,7 @@ type NamedEntityManager struct {
 
 func (m *NamedEntityManager) UpdateNamedEntity(ctx context.Context, request *admin.NamedEntityUpdateRequest) (
 	*admin.NamedEntityUpdateResponse, error) {
+	ctx = repoInterfaces.WithPrimaryReads(ctx)
 	if err := validation.ValidateNamedEntityUpdateRequest(request); err != nil {
 		logger.Debugf(ctx, "invalid request [%+v]: %v", request, err)
 		return nil, err
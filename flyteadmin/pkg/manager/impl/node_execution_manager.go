@@ -203,6 +203,7 @@ func (m *NodeExecutionManager) uploadDynamicWorkflowClosure(
 
 func (m *NodeExecutionManager) CreateNodeEvent(ctx context.Context, request *admin.NodeExecutionEventRequest) (
 	*admin.NodeExecutionEventResponse, error) {
+	ctx = repoInterfaces.WithPrimaryReads(ctx)
 	if err := validation.ValidateNodeExecutionEventRequest(request, m.config.ApplicationConfiguration().GetRemoteDataConfig().MaxSizeInBytes); err != nil {
 		logger.Debugf(ctx, "CreateNodeEvent called with invalid identifier [%+v]: %v", request.GetEvent().GetId(), err)
 	}
@@ -0,0 +1,223 @@
+// Package policy enforces the organization-wide registration policies platform teams configure on the tasks users
+// register and execute.
+package policy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/utils"
+)
+
+const latestTag = "latest"
+
+// Policies are the registration policies which apply to a single project and domain, in the order they are configured.
+type Policies []runtimeInterfaces.RegistrationPolicy
+
+// ForProjectDomain returns the policies which apply to the project and domain.
+func ForProjectDomain(policies []runtimeInterfaces.RegistrationPolicy, project, domain string) Policies {
+	var matching Policies
+	for _, p := range policies {
+		if (len(p.Project) == 0 || p.Project == project) && (len(p.Domain) == 0 || p.Domain == domain) {
+			matching = append(matching, p)
+		}
+	}
+	return matching
+}
+
+// ApplyToTask mutates the task template to satisfy the policies and returns an InvalidArgument error listing every
+// violation that cannot be fixed by mutating the task.
+func (p Policies) ApplyToTask(template *core.TaskTemplate) error {
+	violations := p.applyToTask(template)
+	if len(violations) == 0 {
+		return nil
+	}
+	return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "task [%s] violates registration policies: %s",
+		formatIdentifier(template.GetId()), strings.Join(violations, "; "))
+}
+
+// ApplyToWorkflow applies the policies to every task of the compiled workflow and returns an InvalidArgument error
+// listing the violations of all of its tasks.
+func (p Policies) ApplyToWorkflow(closure *core.CompiledWorkflowClosure) error {
+	var violations []string
+	for _, task := range closure.GetTasks() {
+		template := task.GetTemplate()
+		for _, violation := range p.applyToTask(template) {
+			violations = append(violations, fmt.Sprintf("task [%s]: %s", formatIdentifier(template.GetId()), violation))
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "workflow [%s] violates registration policies: %s",
+		formatIdentifier(closure.GetPrimary().GetTemplate().GetId()), strings.Join(violations, "; "))
+}
+
+func (p Policies) applyToTask(template *core.TaskTemplate) []string {
+	if template == nil {
+		return nil
+	}
+	var violations []string
+	var podSpec *corev1.PodSpec
+	if template.GetK8SPod().GetPodSpec() != nil {
+		podSpec = &corev1.PodSpec{}
+		if err := utils.UnmarshalStructToObj(template.GetK8SPod().GetPodSpec(), podSpec); err != nil {
+			return []string{fmt.Sprintf("pod spec cannot be parsed: %v", err)}
+		}
+	}
+	podSpecModified := false
+	for _, policy := range p {
+		if policy.MaxRetries > 0 && template.GetMetadata().GetRetries().GetRetries() > policy.MaxRetries {
+			template.Metadata.Retries.Retries = policy.MaxRetries
+		}
+
+		if container := template.GetContainer(); container != nil {
+			injectDefaultEnv(container, policy.DefaultEnv)
+			if policy.RequireResourceLimits {
+				violations = append(violations, checkResourceLimits(container)...)
+			}
+			violations = append(violations, checkImage(container.GetImage(), policy)...)
+		}
+		if podSpec == nil {
+			continue
+		}
+		for i := range podSpec.Containers {
+			container := &podSpec.Containers[i]
+			podSpecModified = injectPodDefaultEnv(container, policy.DefaultEnv) || podSpecModified
+			if policy.RequireResourceLimits {
+				violations = append(violations, checkPodResourceLimits(container)...)
+			}
+			violations = append(violations, checkImage(container.Image, policy)...)
+		}
+		// Init containers run to completion before the task starts, only the images they pull are restricted.
+		for _, container := range podSpec.InitContainers {
+			violations = append(violations, checkImage(container.Image, policy)...)
+		}
+	}
+	if podSpecModified {
+		podSpecStruct, err := utils.MarshalObjToStruct(podSpec)
+		if err != nil {
+			return append(violations, fmt.Sprintf("pod spec cannot be updated: %v", err))
+		}
+		template.GetK8SPod().PodSpec = podSpecStruct
+	}
+	return dedupe(violations)
+}
+
+func checkImage(image string, policy runtimeInterfaces.RegistrationPolicy) []string {
+	var violations []string
+	if len(policy.AllowedRegistries) > 0 && !isAllowedRegistry(image, policy.AllowedRegistries) {
+		violations = append(violations, fmt.Sprintf("image [%s] is not pulled from one of the allowed registries [%s]",
+			image, strings.Join(policy.AllowedRegistries, ", ")))
+	}
+	if policy.RejectLatestTag && isLatest(image) {
+		violations = append(violations, fmt.Sprintf("image [%s] is tagged %s, pin it to a version or digest",
+			image, latestTag))
+	}
+	return violations
+}
+
+// injectDefaultEnv adds the variables the container does not set itself, in a stable order so that registering the
+// same task twice yields the same digest.
+func injectDefaultEnv(container *core.Container, defaultEnv map[string]string) {
+	set := make(map[string]bool, len(container.GetEnv()))
+	for _, env := range container.GetEnv() {
+		set[env.GetKey()] = true
+	}
+	for _, key := range missingEnv(set, defaultEnv) {
+		container.Env = append(container.Env, &core.KeyValuePair{Key: key, Value: defaultEnv[key]})
+	}
+}
+
+// injectPodDefaultEnv is injectDefaultEnv for the containers of a pod spec. It returns whether any variable was added.
+func injectPodDefaultEnv(container *corev1.Container, defaultEnv map[string]string) bool {
+	set := make(map[string]bool, len(container.Env))
+	for _, env := range container.Env {
+		set[env.Name] = true
+	}
+	missing := missingEnv(set, defaultEnv)
+	for _, key := range missing {
+		container.Env = append(container.Env, corev1.EnvVar{Name: key, Value: defaultEnv[key]})
+	}
+	return len(missing) > 0
+}
+
+func missingEnv(set map[string]bool, defaultEnv map[string]string) []string {
+	keys := make([]string, 0, len(defaultEnv))
+	for key := range defaultEnv {
+		if !set[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func checkResourceLimits(container *core.Container) []string {
+	limits := make(map[core.Resources_ResourceName]bool)
+	for _, limit := range container.GetResources().GetLimits() {
+		if len(limit.GetValue()) > 0 {
+			limits[limit.GetName()] = true
+		}
+	}
+	var violations []string
+	for _, name := range []core.Resources_ResourceName{core.Resources_CPU, core.Resources_MEMORY} {
+		if !limits[name] {
+			violations = append(violations, fmt.Sprintf("container sets no %s limit", strings.ToLower(name.String())))
+		}
+	}
+	return violations
+}
+
+func checkPodResourceLimits(container *corev1.Container) []string {
+	var violations []string
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		if limit, ok := container.Resources.Limits[name]; !ok || limit.IsZero() {
+			violations = append(violations, fmt.Sprintf("container [%s] sets no %s limit", container.Name, name))
+		}
+	}
+	return violations
+}
+
+func isAllowedRegistry(image string, registries []string) bool {
+	for _, registry := range registries {
+		if strings.HasPrefix(image, strings.TrimSuffix(registry, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// isLatest returns whether the image is tagged latest, which is also the tag of images referenced without a tag.
+// Images pinned to a digest are never considered latest.
+func isLatest(image string) bool {
+	if strings.Contains(image, "@") {
+		return false
+	}
+	name := image[strings.LastIndex(image, "/")+1:]
+	idx := strings.LastIndex(name, ":")
+	return idx < 0 || name[idx+1:] == latestTag
+}
+
+func dedupe(violations []string) []string {
+	seen := make(map[string]bool, len(violations))
+	deduped := violations[:0]
+	for _, violation := range violations {
+		if !seen[violation] {
+			seen[violation] = true
+			deduped = append(deduped, violation)
+		}
+	}
+	return deduped
+}
+
+func formatIdentifier(id *core.Identifier) string {
+	return fmt.Sprintf("%s/%s/%s:%s", id.GetProject(), id.GetDomain(), id.GetName(), id.GetVersion())
+}
@@ -0,0 +1,171 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/utils"
+)
+
+func getTaskTemplate(image string) *core.TaskTemplate {
+	return &core.TaskTemplate{
+		Id: &core.Identifier{Project: "project", Domain: "domain", Name: "task", Version: "v1"},
+		Metadata: &core.TaskMetadata{
+			Retries: &core.RetryStrategy{Retries: 10},
+		},
+		Target: &core.TaskTemplate_Container{
+			Container: &core.Container{
+				Image: image,
+				Env:   []*core.KeyValuePair{{Key: "LOG_LEVEL", Value: "debug"}},
+				Resources: &core.Resources{
+					Limits: []*core.Resources_ResourceEntry{{Name: core.Resources_CPU, Value: "1"}},
+				},
+			},
+		},
+	}
+}
+
+func TestForProjectDomain(t *testing.T) {
+	policies := []runtimeInterfaces.RegistrationPolicy{
+		{MaxRetries: 1},
+		{Project: "project", MaxRetries: 2},
+		{Project: "project", Domain: "production", MaxRetries: 3},
+		{Project: "other", MaxRetries: 4},
+	}
+	assert.Equal(t, Policies{policies[0], policies[1]}, ForProjectDomain(policies, "project", "development"))
+	assert.Equal(t, Policies{policies[0], policies[1], policies[2]}, ForProjectDomain(policies, "project", "production"))
+	assert.Equal(t, Policies{policies[0]}, ForProjectDomain(policies, "unknown", "production"))
+}
+
+func TestApplyToTask_Mutations(t *testing.T) {
+	template := getTaskTemplate("ghcr.io/flyteorg/flytekit:v1.2.3")
+	policies := Policies{{
+		MaxRetries: 3,
+		DefaultEnv: map[string]string{"LOG_LEVEL": "info", "REGION": "us-east-2", "ENV": "prod"},
+	}}
+	assert.NoError(t, policies.ApplyToTask(template))
+	assert.Equal(t, uint32(3), template.GetMetadata().GetRetries().GetRetries())
+	assert.Equal(t, []*core.KeyValuePair{
+		{Key: "LOG_LEVEL", Value: "debug"},
+		{Key: "ENV", Value: "prod"},
+		{Key: "REGION", Value: "us-east-2"},
+	}, template.GetContainer().GetEnv())
+
+	// Applying the policies again leaves the task unchanged.
+	assert.NoError(t, policies.ApplyToTask(template))
+	assert.Len(t, template.GetContainer().GetEnv(), 3)
+}
+
+func TestApplyToTask_Violations(t *testing.T) {
+	policies := Policies{{
+		RequireResourceLimits: true,
+		AllowedRegistries:     []string{"ghcr.io/flyteorg", "123456789.dkr.ecr.us-east-2.amazonaws.com/"},
+		RejectLatestTag:       true,
+	}}
+
+	for _, image := range []string{
+		"ghcr.io/flyteorg/flytekit:v1.2.3",
+		"123456789.dkr.ecr.us-east-2.amazonaws.com/team/image@sha256:5d7c2b0e",
+		"ghcr.io/flyteorg/flytekit:latest-fix",
+	} {
+		template := getTaskTemplate(image)
+		template.GetContainer().GetResources().Limits = append(template.GetContainer().GetResources().GetLimits(),
+			&core.Resources_ResourceEntry{Name: core.Resources_MEMORY, Value: "1Gi"})
+		assert.NoError(t, policies.ApplyToTask(template), image)
+	}
+
+	err := policies.ApplyToTask(getTaskTemplate("docker.io/library/python"))
+	assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	assert.EqualError(t, err, "task [project/domain/task:v1] violates registration policies: "+
+		"container sets no memory limit; "+
+		"image [docker.io/library/python] is not pulled from one of the allowed registries "+
+		"[ghcr.io/flyteorg, 123456789.dkr.ecr.us-east-2.amazonaws.com/]; "+
+		"image [docker.io/library/python] is tagged latest, pin it to a version or digest")
+
+	err = policies.ApplyToTask(getTaskTemplate("localhost:5000/flyteorg/flytekit:latest"))
+	assert.Contains(t, err.Error(), "image [localhost:5000/flyteorg/flytekit:latest] is tagged latest")
+}
+
+func getPodTaskTemplate(t *testing.T, podSpec *corev1.PodSpec) *core.TaskTemplate {
+	podSpecStruct, err := utils.MarshalObjToStruct(podSpec)
+	assert.NoError(t, err)
+	return &core.TaskTemplate{
+		Id:     &core.Identifier{Project: "project", Domain: "domain", Name: "task", Version: "v1"},
+		Target: &core.TaskTemplate_K8SPod{K8SPod: &core.K8SPod{PodSpec: podSpecStruct}},
+	}
+}
+
+func TestApplyToTask_PodSpec(t *testing.T) {
+	limits := corev1.ResourceRequirements{Limits: corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("1"),
+		corev1.ResourceMemory: resource.MustParse("1Gi"),
+	}}
+
+	t.Run("mutations", func(t *testing.T) {
+		template := getPodTaskTemplate(t, &corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "primary", Image: "ghcr.io/flyteorg/flytekit:v1.2.3", Env: []corev1.EnvVar{{Name: "REGION", Value: "eu"}}},
+			{Name: "sidecar", Image: "ghcr.io/flyteorg/sidecar:v1"},
+		}})
+		assert.NoError(t, Policies{{DefaultEnv: map[string]string{"REGION": "us-east-2", "ENV": "prod"}}}.ApplyToTask(template))
+
+		var podSpec corev1.PodSpec
+		assert.NoError(t, utils.UnmarshalStructToObj(template.GetK8SPod().GetPodSpec(), &podSpec))
+		assert.Equal(t, []corev1.EnvVar{{Name: "REGION", Value: "eu"}, {Name: "ENV", Value: "prod"}}, podSpec.Containers[0].Env)
+		assert.Equal(t, []corev1.EnvVar{{Name: "ENV", Value: "prod"}, {Name: "REGION", Value: "us-east-2"}}, podSpec.Containers[1].Env)
+	})
+
+	t.Run("violations", func(t *testing.T) {
+		policies := Policies{{
+			RequireResourceLimits: true,
+			AllowedRegistries:     []string{"ghcr.io/flyteorg"},
+			RejectLatestTag:       true,
+		}}
+		assert.NoError(t, policies.ApplyToTask(getPodTaskTemplate(t, &corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "init", Image: "ghcr.io/flyteorg/init:v1"}},
+			Containers:     []corev1.Container{{Name: "primary", Image: "ghcr.io/flyteorg/flytekit:v1.2.3", Resources: limits}},
+		})))
+
+		err := policies.ApplyToTask(getPodTaskTemplate(t, &corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "init", Image: "ghcr.io/flyteorg/init"}},
+			Containers: []corev1.Container{
+				{Name: "primary", Image: "docker.io/library/python:3.12", Resources: limits},
+				{Name: "sidecar", Image: "ghcr.io/flyteorg/sidecar:v1"},
+			},
+		}))
+		assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+		assert.EqualError(t, err, "task [project/domain/task:v1] violates registration policies: "+
+			"image [docker.io/library/python:3.12] is not pulled from one of the allowed registries [ghcr.io/flyteorg]; "+
+			"container [sidecar] sets no cpu limit; "+
+			"container [sidecar] sets no memory limit; "+
+			"image [ghcr.io/flyteorg/init] is tagged latest, pin it to a version or digest")
+	})
+}
+
+func TestApplyToWorkflow(t *testing.T) {
+	closure := &core.CompiledWorkflowClosure{
+		Primary: &core.CompiledWorkflow{
+			Template: &core.WorkflowTemplate{
+				Id: &core.Identifier{Project: "project", Domain: "domain", Name: "workflow", Version: "v1"},
+			},
+		},
+		Tasks: []*core.CompiledTask{
+			{Template: getTaskTemplate("ghcr.io/flyteorg/flytekit:v1.2.3")},
+			{Template: getTaskTemplate("ghcr.io/flyteorg/flytekit")},
+		},
+	}
+	assert.NoError(t, Policies{{MaxRetries: 1}}.ApplyToWorkflow(closure))
+	for _, task := range closure.GetTasks() {
+		assert.Equal(t, uint32(1), task.GetTemplate().GetMetadata().GetRetries().GetRetries())
+	}
+
+	err := Policies{{RejectLatestTag: true}}.ApplyToWorkflow(closure)
+	assert.EqualError(t, err, "workflow [project/domain/workflow:v1] violates registration policies: "+
+		"task [project/domain/task:v1]: image [ghcr.io/flyteorg/flytekit] is tagged latest, pin it to a version or digest")
+}
@@ -30,6 +30,7 @@ var alphabeticalSortParam, _ = common.NewSortParameter(&admin.Sort{
 
 func (m *ProjectManager) CreateProject(ctx context.Context, request *admin.ProjectRegisterRequest) (
 	*admin.ProjectRegisterResponse, error) {
+	ctx = repoInterfaces.WithPrimaryReads(ctx)
 	if err := validation.ValidateProjectRegisterRequest(request); err != nil {
 		return nil, err
 	}
@@ -89,6 +90,7 @@ func (m *ProjectManager) ListProjects(ctx context.Context, request *admin.Projec
 }
 
 func (m *ProjectManager) UpdateProject(ctx context.Context, projectUpdate *admin.Project) (*admin.ProjectUpdateResponse, error) {
+	ctx = repoInterfaces.WithPrimaryReads(ctx)
 	var response admin.ProjectUpdateResponse
 	projectRepo := m.db.ProjectRepo()
 
@@ -0,0 +1,55 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/executions"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/validation"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/interfaces"
+	repoInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+type ProjectQuotaManager struct {
+	db              repoInterfaces.Repository
+	config          runtimeInterfaces.Configuration
+	resourceManager interfaces.ResourceInterface
+}
+
+func (m *ProjectQuotaManager) GetProjectDomainQuotaUsage(ctx context.Context,
+	request *admin.ProjectDomainQuotaUsageGetRequest) (*admin.ProjectDomainQuotaUsageGetResponse, error) {
+	project, domain := request.GetProject(), request.GetDomain()
+	if err := validation.ValidateProjectAndDomain(ctx, m.db, m.config.ApplicationConfiguration(), project,
+		domain); err != nil {
+		return nil, err
+	}
+	quota, err := executions.GetProjectQuota(ctx, m.resourceManager, project, domain)
+	if err != nil {
+		return nil, err
+	}
+	response := &admin.ProjectDomainQuotaUsageGetResponse{
+		Quota: quota,
+	}
+	if response.ActiveExecutions, err = executions.CountProjectExecutions(ctx, m.db, project, domain, ""); err != nil {
+		return nil, err
+	}
+	if response.QueuedExecutions, err = executions.CountProjectExecutions(ctx, m.db, project, domain,
+		models.ExecutionConcurrencyQueued); err != nil {
+		return nil, err
+	}
+	if response.RunningTasks, err = executions.CountProjectRunningTasks(ctx, m.db, project, domain); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func NewProjectQuotaManager(db repoInterfaces.Repository, config runtimeInterfaces.Configuration,
+	resourceManager interfaces.ResourceInterface) interfaces.ProjectQuotaInterface {
+	return &ProjectQuotaManager{
+		db:              db,
+		config:          config,
+		resourceManager: resourceManager,
+	}
+}
@@ -0,0 +1,83 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/interfaces"
+	managerMocks "github.com/flyteorg/flyte/flyteadmin/pkg/manager/mocks"
+	repoInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	repositoryMocks "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/mocks"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+func TestGetProjectDomainQuotaUsage(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	quota := &admin.ProjectQuotaAttributes{
+		MaxConcurrentExecutions: 5,
+		Overflow:                admin.ProjectQuotaAttributes_QUEUE,
+	}
+	resourceManager := &managerMocks.ResourceInterface{}
+	resourceManager.EXPECT().GetResource(context.Background(), interfaces.ResourceRequest{
+		Project:      "project",
+		Domain:       "development",
+		ResourceType: admin.MatchableResource_PROJECT_QUOTA,
+	}).Return(&interfaces.ResourceResponse{
+		Attributes: &admin.MatchingAttributes{
+			Target: &admin.MatchingAttributes_ProjectQuotaAttributes{ProjectQuotaAttributes: quota},
+		},
+	}, nil)
+	repository.ExecutionRepo().(*repositoryMocks.MockExecutionRepo).SetCountCallback(
+		func(ctx context.Context, input repoInterfaces.CountResourceInput) (int64, error) {
+			for _, filter := range input.InlineFilters {
+				if filter.GetField() == "concurrency_state" {
+					queryExpr, _ := filter.GetGormQueryExpr()
+					if queryExpr.Args == models.ExecutionConcurrencyQueued {
+						return 2, nil
+					}
+				}
+			}
+			return 5, nil
+		})
+	repository.TaskExecutionRepo().(*repositoryMocks.MockTaskExecutionRepo).SetCountCallback(
+		func(ctx context.Context, input repoInterfaces.CountResourceInput) (int64, error) {
+			assert.True(t, input.JoinTableEntities[common.Execution])
+			return 12, nil
+		})
+	manager := NewProjectQuotaManager(repository, mockProjectConfigProvider, resourceManager)
+
+	usage, err := manager.GetProjectDomainQuotaUsage(context.Background(), &admin.ProjectDomainQuotaUsageGetRequest{
+		Project: "project",
+		Domain:  "development",
+	})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(&admin.ProjectDomainQuotaUsageGetResponse{
+		Quota:            quota,
+		ActiveExecutions: 5,
+		QueuedExecutions: 2,
+		RunningTasks:     12,
+	}, usage))
+}
+
+func TestGetProjectDomainQuotaUsage_NoQuota(t *testing.T) {
+	repository := repositoryMocks.NewMockRepository()
+	resourceManager := &managerMocks.ResourceInterface{}
+	resourceManager.EXPECT().GetResource(context.Background(), interfaces.ResourceRequest{
+		Project:      "project",
+		Domain:       "development",
+		ResourceType: admin.MatchableResource_PROJECT_QUOTA,
+	}).Return(nil, nil)
+	manager := NewProjectQuotaManager(repository, mockProjectConfigProvider, resourceManager)
+
+	usage, err := manager.GetProjectDomainQuotaUsage(context.Background(), &admin.ProjectDomainQuotaUsageGetRequest{
+		Project: "project",
+		Domain:  "development",
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, usage.GetQuota())
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/policy"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/resources"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/util"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/validation"
@@ -61,6 +62,7 @@ func setDefaults(request *admin.TaskCreateRequest) (*admin.TaskCreateRequest, er
 func (t *TaskManager) CreateTask(
 	ctx context.Context,
 	request *admin.TaskCreateRequest) (*admin.TaskCreateResponse, error) {
+	ctx = repoInterfaces.WithPrimaryReads(ctx)
 	platformTaskResources := util.GetTaskResources(ctx, request.GetId(), t.resourceManager, t.config.TaskResourceConfiguration())
 	if err := validation.ValidateTask(ctx, request, t.db, platformTaskResources,
 		t.config.WhitelistConfiguration(), t.config.ApplicationConfiguration()); err != nil {
@@ -72,6 +74,12 @@ func (t *TaskManager) CreateTask(
 	if err != nil {
 		return nil, err
 	}
+	policies := policy.ForProjectDomain(t.config.RegistrationPolicyConfiguration().GetRegistrationPolicies(),
+		request.GetId().GetProject(), request.GetId().GetDomain())
+	if err := policies.ApplyToTask(finalizedRequest.GetSpec().GetTemplate()); err != nil {
+		logger.Debugf(ctx, "Task [%+v] failed registration policies with err: %v", request.GetId(), err)
+		return nil, err
+	}
 	// Compile task and store the compiled version in the database.
 	compiledTask, err := t.compiler.CompileTask(finalizedRequest.GetSpec().GetTemplate())
 	if err != nil {
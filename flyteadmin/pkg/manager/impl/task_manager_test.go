@@ -17,6 +17,7 @@ import (
 	repositoryMocks "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/mocks"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
 	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	runtimeIFaceMocks "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces/mocks"
 	runtimeMocks "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/mocks"
 	workflowengine "github.com/flyteorg/flyte/flyteadmin/pkg/workflowengine/interfaces"
 	workflowMocks "github.com/flyteorg/flyte/flyteadmin/pkg/workflowengine/mocks"
@@ -171,6 +172,44 @@ func TestCreateTask_ValidationError(t *testing.T) {
 	assert.Nil(t, response)
 }
 
+func TestCreateTask_RegistrationPolicies(t *testing.T) {
+	mockRepository := getMockTaskRepository()
+	mockRepository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetGetCallback(
+		func(input interfaces.Identifier) (models.Task, error) {
+			return models.Task{}, errors.New("foo")
+		})
+	var createCalled bool
+	mockRepository.TaskRepo().(*repositoryMocks.MockTaskRepo).SetCreateCallback(func(input models.Task, descriptionEntity *models.DescriptionEntity) error {
+		createCalled = true
+		return nil
+	})
+	mockConfig := getMockConfigForTaskTest()
+	mockPolicies := &runtimeIFaceMocks.RegistrationPolicyConfiguration{}
+	mockPolicies.EXPECT().GetRegistrationPolicies().Return([]runtimeInterfaces.RegistrationPolicy{
+		{DefaultEnv: map[string]string{"REGION": "us-east-2"}},
+		{Project: "other", RejectLatestTag: true},
+	})
+	mockConfig.(*runtimeMocks.MockConfigurationProvider).AddRegistrationPolicyConfiguration(mockPolicies)
+	taskManager := NewTaskManager(mockRepository, mockConfig, getMockTaskCompiler(), mockScope.NewTestScope())
+
+	request := testutils.GetValidTaskRequest()
+	response, err := taskManager.CreateTask(context.Background(), request)
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.True(t, createCalled)
+	assert.True(t, proto.Equal(&core.KeyValuePair{Key: "REGION", Value: "us-east-2"},
+		request.GetSpec().GetTemplate().GetContainer().GetEnv()[0]))
+
+	createCalled = false
+	request = testutils.GetValidTaskRequest()
+	request.Id.Project = "other"
+	response, err = taskManager.CreateTask(context.Background(), request)
+	assert.Equal(t, codes.InvalidArgument, err.(adminErrors.FlyteAdminError).Code())
+	assert.Contains(t, err.Error(), "image [image] is tagged latest")
+	assert.Nil(t, response)
+	assert.False(t, createCalled)
+}
+
 func TestCreateTask_CompilerError(t *testing.T) {
 	mockCompiler := workflowMocks.NewMockCompiler()
 	expectedErr := errors.New("expected error")
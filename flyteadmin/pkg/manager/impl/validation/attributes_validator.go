@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/shared"
@@ -33,11 +34,36 @@ func validateMatchingAttributes(attributes *admin.MatchingAttributes, identifier
 		return admin.MatchableResource_WORKFLOW_EXECUTION_CONFIG, nil
 	} else if attributes.GetClusterAssignment() != nil {
 		return admin.MatchableResource_CLUSTER_ASSIGNMENT, nil
+	} else if attributes.GetProjectQuotaAttributes() != nil {
+		if err := validateProjectQuotaAttributes(attributes.GetProjectQuotaAttributes(), identifier); err != nil {
+			return defaultMatchableResource, err
+		}
+		return admin.MatchableResource_PROJECT_QUOTA, nil
 	}
 	return defaultMatchableResource, errors.NewFlyteAdminErrorf(codes.InvalidArgument,
 		"Unrecognized matching attributes type for request %s", identifier)
 }
 
+func validateProjectQuotaAttributes(quota *admin.ProjectQuotaAttributes, identifier string) error {
+	if quota.GetMaxConcurrentExecutions() < 0 || quota.GetMaxRunningTasks() < 0 {
+		return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "quota limits of %s must not be negative", identifier)
+	}
+	for name, value := range map[string]string{"max_cpu": quota.GetMaxCpu(), "max_gpu": quota.GetMaxGpu()} {
+		if len(value) == 0 {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "invalid %s [%s] for %s: %v", name, value,
+				identifier, err)
+		}
+		if quantity.Sign() < 0 {
+			return errors.NewFlyteAdminErrorf(codes.InvalidArgument, "%s of %s must not be negative", name, identifier)
+		}
+	}
+	return nil
+}
+
 func ValidateProjectDomainAttributesUpdateRequest(ctx context.Context,
 	db repositoryInterfaces.Repository, config runtimeInterfaces.ApplicationConfiguration,
 	request *admin.ProjectDomainAttributesUpdateRequest) (
@@ -101,6 +101,30 @@ func TestValidateMatchingAttributes(t *testing.T) {
 			admin.MatchableResource_CLUSTER_ASSIGNMENT,
 			nil,
 		},
+		{
+			&admin.MatchingAttributes{
+				Target: &admin.MatchingAttributes_ProjectQuotaAttributes{
+					ProjectQuotaAttributes: &admin.ProjectQuotaAttributes{
+						MaxConcurrentExecutions: 10,
+						MaxGpu:                  "4",
+						Overflow:                admin.ProjectQuotaAttributes_QUEUE,
+					},
+				},
+			},
+			admin.MatchableResource_PROJECT_QUOTA,
+			nil,
+		},
+		{
+			&admin.MatchingAttributes{
+				Target: &admin.MatchingAttributes_ProjectQuotaAttributes{
+					ProjectQuotaAttributes: &admin.ProjectQuotaAttributes{
+						MaxRunningTasks: -1,
+					},
+				},
+			},
+			defaultMatchableResource,
+			errors.NewFlyteAdminErrorf(codes.InvalidArgument, "quota limits of foo must not be negative"),
+		},
 	}
 	for _, tc := range testCases {
 		matchableResource, err := validateMatchingAttributes(tc.attributes, "foo")
@@ -109,6 +133,14 @@ func TestValidateMatchingAttributes(t *testing.T) {
 	}
 }
 
+func TestValidateProjectQuotaAttributes(t *testing.T) {
+	assert.NoError(t, validateProjectQuotaAttributes(&admin.ProjectQuotaAttributes{MaxCpu: "500m"}, "foo"))
+	err := validateProjectQuotaAttributes(&admin.ProjectQuotaAttributes{MaxCpu: "lots"}, "foo")
+	assert.Equal(t, codes.InvalidArgument, err.(errors.FlyteAdminError).Code())
+	err = validateProjectQuotaAttributes(&admin.ProjectQuotaAttributes{MaxGpu: "-2"}, "foo")
+	assert.EqualError(t, err, "max_gpu of foo must not be negative")
+}
+
 func TestValidateProjectDomainAttributesUpdateRequest(t *testing.T) {
 	_, err := ValidateProjectDomainAttributesUpdateRequest(context.Background(),
 		testutils.GetRepoWithDefaultProject(), attributesApplicationConfigProvider,
@@ -35,6 +35,9 @@ func ValidateLaunchPlan(ctx context.Context,
 	if err := validateLabels(request.GetSpec().GetLabels()); err != nil {
 		return err
 	}
+	if _, err := common.GetConcurrencyPolicy(request.GetSpec()); err != nil {
+		return err
+	}
 
 	if err := validateLiteralMap(request.GetSpec().GetFixedInputs(), shared.FixedInputs); err != nil {
 		return err
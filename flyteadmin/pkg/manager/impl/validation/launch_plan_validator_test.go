@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/testutils"
 	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
@@ -477,3 +478,13 @@ func TestValidateSchedule_InvalidCronExpression(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 }
+
+func TestValidateLpConcurrencyPolicy(t *testing.T) {
+	request := testutils.GetLaunchPlanRequest()
+	request.Spec.Annotations = &admin.Annotations{
+		Values: map[string]string{
+			common.MaxConcurrencyAnnotation: "0",
+		}}
+	err := ValidateLaunchPlan(context.Background(), request, testutils.GetRepoWithDefaultProject(), lpApplicationConfig, getWorkflowInterface())
+	assert.Error(t, err)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/policy"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/util"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/impl/validation"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/manager/interfaces"
@@ -24,6 +25,7 @@ import (
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	compiler "github.com/flyteorg/flyte/flytepropeller/pkg/compiler/common"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/lint"
 	"github.com/flyteorg/flyte/flytestdlib/contextutils"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 	"github.com/flyteorg/flyte/flytestdlib/promutils"
@@ -36,6 +38,7 @@ type workflowMetrics struct {
 	Scope                   promutils.Scope
 	CompilationFailures     prometheus.Counter
 	TypedInterfaceSizeBytes prometheus.Summary
+	LintWarnings            *prometheus.CounterVec
 }
 
 type WorkflowManager struct {
@@ -118,6 +121,19 @@ func (w *WorkflowManager) getCompiledWorkflow(
 	}, nil
 }
 
+// Runs the advisory lint pass on a compiled workflow when enabled. Warnings are logged and counted but never fail the
+// registration.
+func (w *WorkflowManager) lintWorkflow(ctx context.Context, identifier *core.Identifier, closure *admin.WorkflowClosure) {
+	if w.config.RegistrationValidationConfiguration().GetWorkflowLintMode() != runtimeInterfaces.WorkflowLintModeAdvisory {
+		return
+	}
+
+	for _, warning := range lint.Lint(closure.GetCompiledWorkflow()) {
+		w.metrics.LintWarnings.WithLabelValues(string(warning.Code)).Inc()
+		logger.Warnf(ctx, "Lint warning for workflow [%+v]: %v", identifier, warning)
+	}
+}
+
 func (w *WorkflowManager) createDataReference(
 	ctx context.Context, identifier *core.Identifier) (storage.DataReference, error) {
 	nestedSubKeys := []string{
@@ -133,6 +149,7 @@ func (w *WorkflowManager) createDataReference(
 func (w *WorkflowManager) CreateWorkflow(
 	ctx context.Context,
 	request *admin.WorkflowCreateRequest) (*admin.WorkflowCreateResponse, error) {
+	ctx = repoInterfaces.WithPrimaryReads(ctx)
 	if err := validation.ValidateWorkflow(ctx, request, w.db, w.config.ApplicationConfiguration()); err != nil {
 		return nil, err
 	}
@@ -154,6 +171,13 @@ func (w *WorkflowManager) CreateWorkflow(
 	if err != nil {
 		return nil, err
 	}
+	policies := policy.ForProjectDomain(w.config.RegistrationPolicyConfiguration().GetRegistrationPolicies(),
+		request.GetId().GetProject(), request.GetId().GetDomain())
+	if err := policies.ApplyToWorkflow(workflowClosure.GetCompiledWorkflow()); err != nil {
+		logger.Debugf(ctx, "Workflow [%+v] failed registration policies with err: %v", request.GetId(), err)
+		return nil, err
+	}
+	w.lintWorkflow(ctx, request.GetId(), workflowClosure)
 	workflowDigest, err := util.GetWorkflowDigest(ctx, workflowClosure.GetCompiledWorkflow())
 	if err != nil {
 		logger.Errorf(ctx, "failed to compute workflow digest with err %v", err)
@@ -361,6 +385,8 @@ func NewWorkflowManager(
 			"compilation_failures", "any observed failures when compiling a workflow"),
 		TypedInterfaceSizeBytes: scope.MustNewSummary("typed_interface_size_bytes",
 			"size in bytes of serialized workflow TypedInterface"),
+		LintWarnings: scope.MustNewCounterVec("lint_warnings",
+			"lint warnings observed on registered workflows", "code"),
 	}
 	return &WorkflowManager{
 		db:            db,
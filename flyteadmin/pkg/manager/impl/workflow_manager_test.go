@@ -161,6 +161,28 @@ func TestCreateWorkflow(t *testing.T) {
 	assert.Nil(t, response)
 }
 
+func TestCreateWorkflow_LintAdvisory(t *testing.T) {
+	repository := getMockRepository(!returnWorkflowOnGet)
+	var createCalled bool
+	repository.WorkflowRepo().(*repositoryMocks.MockWorkflowRepo).SetCreateCallback(func(input models.Workflow, descriptionEntity *models.DescriptionEntity) error {
+		createCalled = true
+		return nil
+	})
+
+	configProvider := runtimeMocks.NewMockConfigurationProvider(
+		testutils.GetApplicationConfigWithDefaultDomains(), nil, nil, nil, nil, nil)
+	configProvider.(*runtimeMocks.MockConfigurationProvider).AddRegistrationValidationConfiguration(
+		&runtimeMocks.MockRegistrationValidationProvider{
+			WorkflowLintMode: runtimeInterfaces.WorkflowLintModeAdvisory,
+		})
+	workflowManager := NewWorkflowManager(
+		repository, configProvider, getMockWorkflowCompiler(), getMockStorage(), storagePrefix, mockScope.NewTestScope())
+	response, err := workflowManager.CreateWorkflow(context.Background(), testutils.GetWorkflowRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, &admin.WorkflowCreateResponse{}, response)
+	assert.True(t, createCalled)
+}
+
 func TestCreateWorkflow_ValidationError(t *testing.T) {
 	workflowManager := NewWorkflowManager(
 		repositoryMocks.NewMockRepository(),
@@ -12,4 +12,6 @@ import (
 type MetricsInterface interface {
 	GetExecutionMetrics(ctx context.Context, request *admin.WorkflowExecutionGetMetricsRequest) (
 		*admin.WorkflowExecutionGetMetricsResponse, error)
+	GetExecutionAnalysis(ctx context.Context, request *admin.WorkflowExecutionGetAnalysisRequest) (
+		*admin.WorkflowExecutionGetAnalysisResponse, error)
 }
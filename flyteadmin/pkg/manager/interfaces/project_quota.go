@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+//go:generate mockery --name=ProjectQuotaInterface --output=../mocks --case=underscore --with-expecter
+
+// Interface for reporting the usage of project quotas. Quotas themselves are managed as PROJECT_QUOTA matchable
+// attributes. They are enforced on a best-effort basis, executions and tasks created concurrently may briefly exceed
+// them.
+type ProjectQuotaInterface interface {
+	// GetProjectDomainQuotaUsage returns the usage of a domain of a project and the quota in effect for it.
+	GetProjectDomainQuotaUsage(ctx context.Context, request *admin.ProjectDomainQuotaUsageGetRequest) (
+		*admin.ProjectDomainQuotaUsageGetResponse, error)
+}
@@ -23,6 +23,65 @@ func (_m *MetricsInterface) EXPECT() *MetricsInterface_Expecter {
 	return &MetricsInterface_Expecter{mock: &_m.Mock}
 }
 
+// GetExecutionAnalysis provides a mock function with given fields: ctx, request
+func (_m *MetricsInterface) GetExecutionAnalysis(ctx context.Context, request *admin.WorkflowExecutionGetAnalysisRequest) (*admin.WorkflowExecutionGetAnalysisResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExecutionAnalysis")
+	}
+
+	var r0 *admin.WorkflowExecutionGetAnalysisResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.WorkflowExecutionGetAnalysisRequest) (*admin.WorkflowExecutionGetAnalysisResponse, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.WorkflowExecutionGetAnalysisRequest) *admin.WorkflowExecutionGetAnalysisResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.WorkflowExecutionGetAnalysisResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.WorkflowExecutionGetAnalysisRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetricsInterface_GetExecutionAnalysis_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExecutionAnalysis'
+type MetricsInterface_GetExecutionAnalysis_Call struct {
+	*mock.Call
+}
+
+// GetExecutionAnalysis is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *admin.WorkflowExecutionGetAnalysisRequest
+func (_e *MetricsInterface_Expecter) GetExecutionAnalysis(ctx interface{}, request interface{}) *MetricsInterface_GetExecutionAnalysis_Call {
+	return &MetricsInterface_GetExecutionAnalysis_Call{Call: _e.mock.On("GetExecutionAnalysis", ctx, request)}
+}
+
+func (_c *MetricsInterface_GetExecutionAnalysis_Call) Run(run func(ctx context.Context, request *admin.WorkflowExecutionGetAnalysisRequest)) *MetricsInterface_GetExecutionAnalysis_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.WorkflowExecutionGetAnalysisRequest))
+	})
+	return _c
+}
+
+func (_c *MetricsInterface_GetExecutionAnalysis_Call) Return(_a0 *admin.WorkflowExecutionGetAnalysisResponse, _a1 error) *MetricsInterface_GetExecutionAnalysis_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetricsInterface_GetExecutionAnalysis_Call) RunAndReturn(run func(context.Context, *admin.WorkflowExecutionGetAnalysisRequest) (*admin.WorkflowExecutionGetAnalysisResponse, error)) *MetricsInterface_GetExecutionAnalysis_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetExecutionMetrics provides a mock function with given fields: ctx, request
 func (_m *MetricsInterface) GetExecutionMetrics(ctx context.Context, request *admin.WorkflowExecutionGetMetricsRequest) (*admin.WorkflowExecutionGetMetricsResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -1285,6 +1285,80 @@ var ContinuedMigrations = []*gormigrate.Migration{
 			return nil
 		},
 	},
+	{
+		ID: "2026-10-18-task-execution-error-category",
+		Migrate: func(tx *gorm.DB) error {
+			type TaskExecution struct {
+				ErrorCategory string `gorm:"index"`
+			}
+
+			return tx.Table("task_executions").AutoMigrate(&TaskExecution{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Table("task_executions").Migrator().DropColumn(&models.TaskExecution{}, "error_category")
+		},
+	},
+	{
+		ID: "2026-10-18-schedulable-entities-time-zone",
+		Migrate: func(tx *gorm.DB) error {
+			type SchedulableEntity struct {
+				TimeZone string
+			}
+
+			return tx.Table("schedulable_entities").AutoMigrate(&SchedulableEntity{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Table("schedulable_entities").Migrator().DropColumn(&schedulerModels.SchedulableEntity{}, "time_zone")
+		},
+	},
+	{
+		ID: "2026-10-18-scheduler-leases",
+		Migrate: func(tx *gorm.DB) error {
+			type SchedulerLease struct {
+				Name       string `gorm:"primary_key"`
+				Holder     string
+				Token      int64
+				AcquiredAt time.Time
+				ExpiresAt  time.Time
+			}
+
+			return tx.AutoMigrate(&SchedulerLease{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("scheduler_leases")
+		},
+	},
+	{
+		ID: "2026-10-18-executions-concurrency-state",
+		Migrate: func(tx *gorm.DB) error {
+			type Execution struct {
+				ConcurrencyState string `gorm:"index" valid:"length(0|255)"`
+			}
+
+			return tx.Table("executions").AutoMigrate(&Execution{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Table("executions").Migrator().DropColumn(&models.Execution{}, "concurrency_state")
+		},
+	},
+	{
+		ID: "2026-10-18-executions-concurrency-state-default",
+		Migrate: func(tx *gorm.DB) error {
+			type Execution struct {
+				ConcurrencyState string `gorm:"index;default:''" valid:"length(0|255)"`
+			}
+
+			if err := tx.Table("executions").AutoMigrate(&Execution{}); err != nil {
+				return err
+			}
+
+			// Executions created before the column was added were launched, which is an empty concurrency state.
+			return tx.Exec("UPDATE executions SET concurrency_state = '' WHERE concurrency_state IS NULL").Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return nil
+		},
+	},
 }
 
 var m = append(LegacyMigrations, NoopMigrations...)
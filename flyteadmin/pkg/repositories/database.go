@@ -12,9 +12,12 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/plugin/opentelemetry/tracing"
 
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/gormimpl"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyte/flytestdlib/database"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 	"github.com/flyteorg/flyte/flytestdlib/otelutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
 )
 
 const defaultDB = "postgres"
@@ -109,6 +112,42 @@ func GetDB(ctx context.Context, dbConfig *database.DbConfig, logConfig *logger.C
 	return gormDb, setupDbConnectionPool(ctx, gormDb, dbConfig)
 }
 
+// GetReadReplicaDB opens a connection pool to the read replica of the postgres database in dbConfig.
+func GetReadReplicaDB(ctx context.Context, dbConfig *database.DbConfig, logConfig *logger.Config) (*gorm.DB, error) {
+	gormDb, err := database.GetReadOnlyDB(ctx, dbConfig, logConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	tracerProvider := otelutils.GetTracerProvider(otelutils.AdminGormTracer)
+	if err := gormDb.Use(tracing.NewPlugin(tracing.WithTracerProvider(tracerProvider), tracing.WithoutMetrics())); err != nil {
+		return nil, fmt.Errorf("failed to enable tracing for gorm read replica db, %w", err)
+	}
+
+	return gormDb, setupDbConnectionPool(ctx, gormDb, dbConfig)
+}
+
+// GetReadRouter returns a ReadRouter over db which serves reads from the read replica when enabled in replicaConfig.
+// The replication lag of the replica is monitored until ctx is cancelled.
+func GetReadRouter(ctx context.Context, db *gorm.DB, dbConfig *database.DbConfig, logConfig *logger.Config,
+	replicaConfig runtimeInterfaces.ReadReplicaConfig, scope promutils.Scope) (*gormimpl.ReadRouter, error) {
+	if !replicaConfig.Enabled {
+		return gormimpl.NewPrimaryReadRouter(db), nil
+	}
+	replica, err := GetReadReplicaDB(ctx, dbConfig, logConfig)
+	if err != nil {
+		return nil, err
+	}
+	readRouter := gormimpl.NewReadRouter(ctx, db, replica, gormimpl.ReadRouterConfig{
+		ReadYourWritesWindow: replicaConfig.ReadYourWritesWindow.Duration,
+		MaxReplicationLag:    replicaConfig.MaxReplicationLag.Duration,
+		LagCheckInterval:     replicaConfig.LagCheckInterval.Duration,
+	}, scope)
+	readRouter.Start(ctx)
+	logger.Infof(ctx, "Routing reads to read replica [%s]", dbConfig.Postgres.ReadReplicaHost)
+	return readRouter, nil
+}
+
 // Creates DB if it doesn't exist for the passed in config
 func createPostgresDbIfNotExists(ctx context.Context, gormConfig *gorm.Config, pgConfig database.PostgresConfig) (*gorm.DB, error) {
 
@@ -27,6 +27,7 @@ type GormRepo struct {
 	descriptionEntityRepo        interfaces.DescriptionEntityRepoInterface
 	schedulableEntityRepo        schedulerInterfaces.SchedulableEntityRepoInterface
 	scheduleEntitiesSnapshotRepo schedulerInterfaces.ScheduleEntitiesSnapShotRepoInterface
+	schedulerLeaseRepo           schedulerInterfaces.SchedulerLeaseRepoInterface
 	signalRepo                   interfaces.SignalRepoInterface
 }
 
@@ -86,6 +87,10 @@ func (r *GormRepo) ScheduleEntitiesSnapshotRepo() schedulerInterfaces.ScheduleEn
 	return r.scheduleEntitiesSnapshotRepo
 }
 
+func (r *GormRepo) SchedulerLeaseRepo() schedulerInterfaces.SchedulerLeaseRepoInterface {
+	return r.schedulerLeaseRepo
+}
+
 func (r *GormRepo) SignalRepo() interfaces.SignalRepoInterface {
 	return r.signalRepo
 }
@@ -95,22 +100,31 @@ func (r *GormRepo) GetGormDB() *gorm.DB {
 }
 
 func NewGormRepo(db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.Repository {
+	return NewGormRepoWithReadRouter(gormimpl.NewPrimaryReadRouter(db), errorTransformer, scope)
+}
+
+// NewGormRepoWithReadRouter returns a Repository whose execution and entity reads go through the readRouter. All
+// other queries use the primary.
+func NewGormRepoWithReadRouter(readRouter *gormimpl.ReadRouter, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.Repository {
+	db := readRouter.Primary()
 	return &GormRepo{
 		db:                           db,
-		executionRepo:                gormimpl.NewExecutionRepo(db, errorTransformer, scope.NewSubScope("executions")),
+		executionRepo:                gormimpl.NewExecutionRepoWithReadRouter(readRouter, errorTransformer, scope.NewSubScope("executions")),
 		executionEventRepo:           gormimpl.NewExecutionEventRepo(db, errorTransformer, scope.NewSubScope("execution_events")),
-		launchPlanRepo:               gormimpl.NewLaunchPlanRepo(db, errorTransformer, scope.NewSubScope("launch_plans")),
-		projectRepo:                  gormimpl.NewProjectRepo(db, errorTransformer, scope.NewSubScope("project")),
-		namedEntityRepo:              gormimpl.NewNamedEntityRepo(db, errorTransformer, scope.NewSubScope("named_entity")),
-		nodeExecutionRepo:            gormimpl.NewNodeExecutionRepo(db, errorTransformer, scope.NewSubScope("node_executions")),
+		launchPlanRepo:               gormimpl.NewLaunchPlanRepoWithReadRouter(readRouter, errorTransformer, scope.NewSubScope("launch_plans")),
+		projectRepo:                  gormimpl.NewProjectRepoWithReadRouter(readRouter, errorTransformer, scope.NewSubScope("project")),
+		namedEntityRepo:              gormimpl.NewNamedEntityRepoWithReadRouter(readRouter, errorTransformer, scope.NewSubScope("named_entity")),
+		nodeExecutionRepo:            gormimpl.NewNodeExecutionRepoWithReadRouter(readRouter, errorTransformer, scope.NewSubScope("node_executions")),
 		nodeExecutionEventRepo:       gormimpl.NewNodeExecutionEventRepo(db, errorTransformer, scope.NewSubScope("node_execution_events")),
-		taskRepo:                     gormimpl.NewTaskRepo(db, errorTransformer, scope.NewSubScope("tasks")),
-		taskExecutionRepo:            gormimpl.NewTaskExecutionRepo(db, errorTransformer, scope.NewSubScope("task_executions")),
-		workflowRepo:                 gormimpl.NewWorkflowRepo(db, errorTransformer, scope.NewSubScope("workflows")),
+		taskRepo:                     gormimpl.NewTaskRepoWithReadRouter(readRouter, errorTransformer, scope.NewSubScope("tasks")),
+		taskExecutionRepo:            gormimpl.NewTaskExecutionRepoWithReadRouter(readRouter, errorTransformer, scope.NewSubScope("task_executions")),
+		workflowRepo:                 gormimpl.NewWorkflowRepoWithReadRouter(readRouter, errorTransformer, scope.NewSubScope("workflows")),
 		resourceRepo:                 gormimpl.NewResourceRepo(db, errorTransformer, scope.NewSubScope("resources")),
-		descriptionEntityRepo:        gormimpl.NewDescriptionEntityRepo(db, errorTransformer, scope.NewSubScope("description_entities")),
+		descriptionEntityRepo:        gormimpl.NewDescriptionEntityRepoWithReadRouter(readRouter, errorTransformer, scope.NewSubScope("description_entities")),
 		schedulableEntityRepo:        schedulerGormImpl.NewSchedulableEntityRepo(db, errorTransformer, scope.NewSubScope("schedulable_entity")),
 		scheduleEntitiesSnapshotRepo: schedulerGormImpl.NewScheduleEntitiesSnapshotRepo(db, errorTransformer, scope.NewSubScope("schedule_entities_snapshot")),
+		schedulerLeaseRepo:           schedulerGormImpl.NewSchedulerLeaseRepo(db, errorTransformer, scope.NewSubScope("scheduler_lease")),
 		signalRepo:                   gormimpl.NewSignalRepo(db, errorTransformer, scope.NewSubScope("signals")),
 	}
 }
@@ -16,6 +16,7 @@ import (
 // DescriptionEntityRepo Implementation of DescriptionEntityRepoInterface.
 type DescriptionEntityRepo struct {
 	db               *gorm.DB
+	readRouter       *ReadRouter
 	errorTransformer flyteAdminDbErrors.ErrorTransformer
 	metrics          gormMetrics
 }
@@ -28,7 +29,7 @@ func (r *DescriptionEntityRepo) Get(ctx context.Context, input interfaces.GetDes
 		return models.DescriptionEntity{}, err
 	}
 
-	tx := r.db.WithContext(ctx).Table(descriptionEntityTableName)
+	tx := r.readRouter.Reader(ctx, "description_entities.get", "").Table(descriptionEntityTableName)
 	// Apply filters
 	tx, err = applyFilters(tx, filters, nil)
 	if err != nil {
@@ -53,7 +54,7 @@ func (r *DescriptionEntityRepo) List(
 		return interfaces.DescriptionEntityCollectionOutput{}, err
 	}
 	var descriptionEntities []models.DescriptionEntity
-	tx := r.db.WithContext(ctx).Limit(input.Limit).Offset(input.Offset)
+	tx := r.readRouter.Reader(ctx, "description_entities.list", "").Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -106,9 +107,16 @@ func getDescriptionEntityFilters(resourceType core.ResourceType, project string,
 // NewDescriptionEntityRepo Returns an instance of DescriptionRepoInterface
 func NewDescriptionEntityRepo(
 	db *gorm.DB, errorTransformer flyteAdminDbErrors.ErrorTransformer, scope promutils.Scope) interfaces.DescriptionEntityRepoInterface {
+	return NewDescriptionEntityRepoWithReadRouter(NewPrimaryReadRouter(db), errorTransformer, scope)
+}
+
+// Returns an instance of DescriptionEntityRepoInterface which reads through the given ReadRouter
+func NewDescriptionEntityRepoWithReadRouter(readRouter *ReadRouter, errorTransformer flyteAdminDbErrors.ErrorTransformer,
+	scope promutils.Scope) interfaces.DescriptionEntityRepoInterface {
 	metrics := newMetrics(scope)
 	return &DescriptionEntityRepo{
-		db:               db,
+		db:               readRouter.Primary(),
+		readRouter:       readRouter,
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
 	}
@@ -18,6 +18,7 @@ import (
 // Implementation of ExecutionInterface.
 type ExecutionRepo struct {
 	db               *gorm.DB
+	readRouter       *ReadRouter
 	errorTransformer adminErrors.ErrorTransformer
 	metrics          gormMetrics
 }
@@ -39,13 +40,17 @@ func (r *ExecutionRepo) Create(ctx context.Context, input models.Execution, exec
 		return nil
 	})
 	timer.Stop()
+	if err == nil {
+		r.readRouter.RecordWrite(ctx, newExecutionKey(input.Project, input.Domain, input.Name))
+	}
 	return err
 }
 
 func (r *ExecutionRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
 	var execution models.Execution
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.WithContext(ctx).Where(&models.Execution{
+	db := r.readRouter.Reader(ctx, "executions.get", newExecutionKey(input.Project, input.Domain, input.Name))
+	tx := db.Where(&models.Execution{
 		ExecutionKey: models.ExecutionKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -73,9 +78,21 @@ func (r *ExecutionRepo) Update(ctx context.Context, execution models.Execution)
 	if err := tx.Error; err != nil {
 		return r.errorTransformer.ToFlyteAdminError(err)
 	}
+	r.readRouter.RecordWrite(ctx, newExecutionKey(execution.Project, execution.Domain, execution.Name))
 	return nil
 }
 
+func (r *ExecutionRepo) UpdateConcurrencyState(ctx context.Context, id uint, expected, state string) (bool, error) {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.WithContext(ctx).Model(&models.Execution{}).Where(getIDFilter(id)).
+		Where("concurrency_state = ?", expected).Update("concurrency_state", state)
+	timer.Stop()
+	if err := tx.Error; err != nil {
+		return false, r.errorTransformer.ToFlyteAdminError(err)
+	}
+	return tx.RowsAffected > 0, nil
+}
+
 func (r *ExecutionRepo) List(ctx context.Context, input interfaces.ListResourceInput) (
 	interfaces.ExecutionCollectionOutput, error) {
 	var err error
@@ -84,7 +101,7 @@ func (r *ExecutionRepo) List(ctx context.Context, input interfaces.ListResourceI
 		return interfaces.ExecutionCollectionOutput{}, err
 	}
 	var executions []models.Execution
-	tx := r.db.WithContext(ctx).Limit(input.Limit).Offset(input.Offset)
+	tx := r.readRouter.Reader(ctx, "executions.list", "").Limit(input.Limit).Offset(input.Offset)
 	// And add join condition as required by user-specified filters (which can potentially include join table attrs).
 	if ok := input.JoinTableEntities[common.LaunchPlan]; ok {
 		tx = tx.Joins(fmt.Sprintf("INNER JOIN %s ON %s.launch_plan_id = %s.id",
@@ -127,7 +144,7 @@ func (r *ExecutionRepo) List(ctx context.Context, input interfaces.ListResourceI
 
 func (r *ExecutionRepo) Count(ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
 	var err error
-	tx := r.db.WithContext(ctx).Model(&models.Execution{})
+	tx := r.readRouter.Reader(ctx, "executions.count", "").Model(&models.Execution{})
 
 	// Add join condition as required by user-specified filters (which can potentially include join table attrs).
 	if ok := input.JoinTableEntities[common.LaunchPlan]; ok {
@@ -163,9 +180,16 @@ func (r *ExecutionRepo) Count(ctx context.Context, input interfaces.CountResourc
 // Returns an instance of ExecutionRepoInterface
 func NewExecutionRepo(
 	db *gorm.DB, errorTransformer adminErrors.ErrorTransformer, scope promutils.Scope) interfaces.ExecutionRepoInterface {
+	return NewExecutionRepoWithReadRouter(NewPrimaryReadRouter(db), errorTransformer, scope)
+}
+
+// Returns an instance of ExecutionRepoInterface which reads through the given ReadRouter
+func NewExecutionRepoWithReadRouter(readRouter *ReadRouter, errorTransformer adminErrors.ErrorTransformer,
+	scope promutils.Scope) interfaces.ExecutionRepoInterface {
 	metrics := newMetrics(scope)
 	return &ExecutionRepo{
-		db:               db,
+		db:               readRouter.Primary(),
+		readRouter:       readRouter,
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
 	}
@@ -24,6 +24,7 @@ type launchPlanMetrics struct {
 // Implementation of LaunchPlanRepoInterface.
 type LaunchPlanRepo struct {
 	db                *gorm.DB
+	readRouter        *ReadRouter
 	errorTransformer  adminErrors.ErrorTransformer
 	metrics           gormMetrics
 	launchPlanMetrics launchPlanMetrics
@@ -52,7 +53,7 @@ func (r *LaunchPlanRepo) Update(ctx context.Context, input models.LaunchPlan) er
 func (r *LaunchPlanRepo) Get(ctx context.Context, input interfaces.Identifier) (models.LaunchPlan, error) {
 	var launchPlan models.LaunchPlan
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.WithContext(ctx).Where(&models.LaunchPlan{
+	tx := r.readRouter.Reader(ctx, "launch_plans.get", "").Where(&models.LaunchPlan{
 		LaunchPlanKey: models.LaunchPlanKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -114,7 +115,7 @@ func (r *LaunchPlanRepo) List(ctx context.Context, input interfaces.ListResource
 		return interfaces.LaunchPlanCollectionOutput{}, err
 	}
 	var launchPlans []models.LaunchPlan
-	tx := r.db.WithContext(ctx).Limit(input.Limit).Offset(input.Offset)
+	tx := r.readRouter.Reader(ctx, "launch_plans.list", "").Limit(input.Limit).Offset(input.Offset)
 
 	// Add join conditions
 	tx = tx.Joins("inner join workflows on launch_plans.workflow_id = workflows.id")
@@ -151,7 +152,8 @@ func (r *LaunchPlanRepo) ListLaunchPlanIdentifiers(ctx context.Context, input in
 		return interfaces.LaunchPlanCollectionOutput{}, err
 	}
 
-	tx := r.db.WithContext(ctx).Model(models.LaunchPlan{}).Limit(input.Limit).Offset(input.Offset)
+	tx := r.readRouter.Reader(ctx, "launch_plans.list_identifiers", "").
+		Model(models.LaunchPlan{}).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -181,6 +183,12 @@ func (r *LaunchPlanRepo) ListLaunchPlanIdentifiers(ctx context.Context, input in
 // Returns an instance of LaunchPlanRepoInterface
 func NewLaunchPlanRepo(
 	db *gorm.DB, errorTransformer adminErrors.ErrorTransformer, scope promutils.Scope) interfaces.LaunchPlanRepoInterface {
+	return NewLaunchPlanRepoWithReadRouter(NewPrimaryReadRouter(db), errorTransformer, scope)
+}
+
+// Returns an instance of LaunchPlanRepoInterface which reads through the given ReadRouter
+func NewLaunchPlanRepoWithReadRouter(readRouter *ReadRouter, errorTransformer adminErrors.ErrorTransformer,
+	scope promutils.Scope) interfaces.LaunchPlanRepoInterface {
 	metrics := newMetrics(scope)
 	launchPlanMetrics := launchPlanMetrics{
 		SetActiveDuration: scope.MustNewStopWatch(
@@ -189,7 +197,8 @@ func NewLaunchPlanRepo(
 	}
 
 	return &LaunchPlanRepo{
-		db:                db,
+		db:                readRouter.Primary(),
+		readRouter:        readRouter,
 		errorTransformer:  errorTransformer,
 		metrics:           metrics,
 		launchPlanMetrics: launchPlanMetrics,
@@ -107,6 +107,7 @@ func getNamedEntityFilters(resourceType core.ResourceType, project string, domai
 // Implementation of NamedEntityRepoInterface.
 type NamedEntityRepo struct {
 	db               *gorm.DB
+	readRouter       *ReadRouter
 	errorTransformer errors.ErrorTransformer
 	metrics          gormMetrics
 }
@@ -143,7 +144,7 @@ func (r *NamedEntityRepo) Get(ctx context.Context, input interfaces.GetNamedEnti
 		return models.NamedEntity{}, adminErrors.NewFlyteAdminErrorf(codes.InvalidArgument, "Cannot get NamedEntityMetadata for resource type: %v", input.ResourceType)
 	}
 
-	tx := r.db.WithContext(ctx).Table(tableName).Joins(joinString)
+	tx := r.readRouter.Reader(ctx, "named_entities.get", "").Table(tableName).Joins(joinString)
 
 	// Apply filters
 	tx, err = applyScopedFilters(tx, filters, nil)
@@ -182,7 +183,7 @@ func (r *NamedEntityRepo) List(ctx context.Context, input interfaces.ListNamedEn
 			"Cannot list entity names for resource type: %v", input.ResourceType)
 	}
 
-	tx := getSubQueryJoin(r.db.WithContext(ctx), tableName, input)
+	tx := getSubQueryJoin(r.readRouter.Reader(ctx, "named_entities.list", ""), tableName, input)
 
 	// Apply filters
 	tx, err := applyScopedFilters(tx, input.InlineFilters, input.MapFilters)
@@ -214,10 +215,17 @@ func (r *NamedEntityRepo) List(ctx context.Context, input interfaces.ListNamedEn
 // Returns an instance of NamedEntityRepoInterface
 func NewNamedEntityRepo(
 	db *gorm.DB, errorTransformer errors.ErrorTransformer, scope promutils.Scope) interfaces.NamedEntityRepoInterface {
+	return NewNamedEntityRepoWithReadRouter(NewPrimaryReadRouter(db), errorTransformer, scope)
+}
+
+// Returns an instance of NamedEntityRepoInterface which reads through the given ReadRouter
+func NewNamedEntityRepoWithReadRouter(readRouter *ReadRouter, errorTransformer errors.ErrorTransformer,
+	scope promutils.Scope) interfaces.NamedEntityRepoInterface {
 	metrics := newMetrics(scope)
 
 	return &NamedEntityRepo{
-		db:               db,
+		db:               readRouter.Primary(),
+		readRouter:       readRouter,
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
 	}
@@ -17,6 +17,7 @@ import (
 // Implementation of NodeExecutionInterface.
 type NodeExecutionRepo struct {
 	db               *gorm.DB
+	readRouter       *ReadRouter
 	errorTransformer adminErrors.ErrorTransformer
 	metrics          gormMetrics
 }
@@ -28,13 +29,16 @@ func (r *NodeExecutionRepo) Create(ctx context.Context, execution *models.NodeEx
 	if tx.Error != nil {
 		return r.errorTransformer.ToFlyteAdminError(tx.Error)
 	}
+	r.readRouter.RecordWrite(ctx, newExecutionKey(execution.ExecutionKey.Project, execution.ExecutionKey.Domain,
+		execution.ExecutionKey.Name))
 	return nil
 }
 
 func (r *NodeExecutionRepo) Get(ctx context.Context, input interfaces.NodeExecutionResource) (models.NodeExecution, error) {
 	var nodeExecution models.NodeExecution
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.WithContext(ctx).Where(&models.NodeExecution{
+	db := r.readRouter.Reader(ctx, "node_executions.get", nodeExecutionResourceKey(input))
+	tx := db.Where(&models.NodeExecution{
 		NodeExecutionKey: models.NodeExecutionKey{
 			NodeID: input.NodeExecutionIdentifier.GetNodeId(),
 			ExecutionKey: models.ExecutionKey{
@@ -66,7 +70,8 @@ func (r *NodeExecutionRepo) Get(ctx context.Context, input interfaces.NodeExecut
 func (r *NodeExecutionRepo) GetWithChildren(ctx context.Context, input interfaces.NodeExecutionResource) (models.NodeExecution, error) {
 	var nodeExecution models.NodeExecution
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.WithContext(ctx).Where(&models.NodeExecution{
+	db := r.readRouter.Reader(ctx, "node_executions.get", nodeExecutionResourceKey(input))
+	tx := db.Where(&models.NodeExecution{
 		NodeExecutionKey: models.NodeExecutionKey{
 			NodeID: input.NodeExecutionIdentifier.GetNodeId(),
 			ExecutionKey: models.ExecutionKey{
@@ -102,6 +107,8 @@ func (r *NodeExecutionRepo) Update(ctx context.Context, nodeExecution *models.No
 	if err := tx.Error; err != nil {
 		return r.errorTransformer.ToFlyteAdminError(err)
 	}
+	r.readRouter.RecordWrite(ctx, newExecutionKey(nodeExecution.ExecutionKey.Project, nodeExecution.ExecutionKey.Domain,
+		nodeExecution.ExecutionKey.Name))
 	return nil
 }
 
@@ -112,7 +119,8 @@ func (r *NodeExecutionRepo) List(ctx context.Context, input interfaces.ListResou
 		return interfaces.NodeExecutionCollectionOutput{}, err
 	}
 	var nodeExecutions []models.NodeExecution
-	tx := r.db.WithContext(ctx).Limit(input.Limit).Offset(input.Offset).Preload("ChildNodeExecutions")
+	tx := r.readRouter.Reader(ctx, "node_executions.list", executionKeyFromFilters(input.InlineFilters)).
+		Limit(input.Limit).Offset(input.Offset).Preload("ChildNodeExecutions")
 	// And add join condition, if any
 	if input.JoinTableEntities[common.Execution] {
 		tx = tx.Joins(innerJoinExecToNodeExec)
@@ -161,7 +169,8 @@ func (r *NodeExecutionRepo) Exists(ctx context.Context, input interfaces.NodeExe
 
 func (r *NodeExecutionRepo) Count(ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
 	var err error
-	tx := r.db.WithContext(ctx).Model(&models.NodeExecution{}).Preload("ChildNodeExecutions")
+	tx := r.readRouter.Reader(ctx, "node_executions.count", executionKeyFromFilters(input.InlineFilters)).
+		Model(&models.NodeExecution{}).Preload("ChildNodeExecutions")
 
 	// And add join condition, if any
 	if input.JoinTableEntities[common.Execution] {
@@ -185,13 +194,25 @@ func (r *NodeExecutionRepo) Count(ctx context.Context, input interfaces.CountRes
 	return count, nil
 }
 
+func nodeExecutionResourceKey(input interfaces.NodeExecutionResource) string {
+	executionID := input.NodeExecutionIdentifier.GetExecutionId()
+	return newExecutionKey(executionID.GetProject(), executionID.GetDomain(), executionID.GetName())
+}
+
 // Returns an instance of NodeExecutionRepoInterface
 func NewNodeExecutionRepo(
 	db *gorm.DB, errorTransformer adminErrors.ErrorTransformer,
+	scope promutils.Scope) interfaces.NodeExecutionRepoInterface {
+	return NewNodeExecutionRepoWithReadRouter(NewPrimaryReadRouter(db), errorTransformer, scope)
+}
+
+// Returns an instance of NodeExecutionRepoInterface which reads through the given ReadRouter
+func NewNodeExecutionRepoWithReadRouter(readRouter *ReadRouter, errorTransformer adminErrors.ErrorTransformer,
 	scope promutils.Scope) interfaces.NodeExecutionRepoInterface {
 	metrics := newMetrics(scope)
 	return &NodeExecutionRepo{
-		db:               db,
+		db:               readRouter.Primary(),
+		readRouter:       readRouter,
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
 	}
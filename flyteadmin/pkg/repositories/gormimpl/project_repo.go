@@ -17,6 +17,7 @@ import (
 
 type ProjectRepo struct {
 	db               *gorm.DB
+	readRouter       *ReadRouter
 	errorTransformer flyteAdminDbErrors.ErrorTransformer
 	metrics          gormMetrics
 }
@@ -34,7 +35,7 @@ func (r *ProjectRepo) Create(ctx context.Context, project models.Project) error
 func (r *ProjectRepo) Get(ctx context.Context, projectID string) (models.Project, error) {
 	var project models.Project
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.WithContext(ctx).Where(&models.Project{
+	tx := r.readRouter.Reader(ctx, "projects.get", "").Where(&models.Project{
 		Identifier: projectID,
 	}).Take(&project)
 	timer.Stop()
@@ -52,7 +53,7 @@ func (r *ProjectRepo) Get(ctx context.Context, projectID string) (models.Project
 func (r *ProjectRepo) List(ctx context.Context, input interfaces.ListResourceInput) ([]models.Project, error) {
 	var projects []models.Project
 
-	tx := r.db.WithContext(ctx).Offset(input.Offset)
+	tx := r.readRouter.Reader(ctx, "projects.list", "").Offset(input.Offset)
 	if input.Limit != 0 {
 		tx = tx.Limit(input.Limit)
 	}
@@ -85,10 +86,17 @@ func (r *ProjectRepo) List(ctx context.Context, input interfaces.ListResourceInp
 }
 
 func NewProjectRepo(db *gorm.DB, errorTransformer flyteAdminDbErrors.ErrorTransformer,
+	scope promutils.Scope) interfaces.ProjectRepoInterface {
+	return NewProjectRepoWithReadRouter(NewPrimaryReadRouter(db), errorTransformer, scope)
+}
+
+// Returns an instance of ProjectRepoInterface which reads through the given ReadRouter
+func NewProjectRepoWithReadRouter(readRouter *ReadRouter, errorTransformer flyteAdminDbErrors.ErrorTransformer,
 	scope promutils.Scope) interfaces.ProjectRepoInterface {
 	metrics := newMetrics(scope)
 	return &ProjectRepo{
-		db:               db,
+		db:               readRouter.Primary(),
+		readRouter:       readRouter,
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
 	}
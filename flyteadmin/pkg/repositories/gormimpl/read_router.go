@@ -0,0 +1,251 @@
+package gormimpl
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/utils/clock"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+const (
+	readTargetPrimary = "primary"
+	readTargetReplica = "replica"
+
+	readReasonDefault        = "default"
+	readReasonRecentWrite    = "recent_write"
+	readReasonClientWrite    = "client_write"
+	readReasonReplicationLag = "replication_lag"
+	readReasonRequested      = "requested"
+
+	// Upper bound on the number of executions tracked for read-your-writes at any time.
+	maxRecentWrites = 100000
+
+	// Reports the replay delay of a postgres standby. A standby which has replayed everything it received is not
+	// lagging, no matter how long ago the last transaction was.
+	replicationLagQuery = `SELECT CASE WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0) END`
+)
+
+// PrimaryReadsUntilHeader is set on the response to a request which wrote an execution, to the unix time in milliseconds
+// until which the reads of the client must be served by the primary. Clients which send it back on their following
+// requests, as gRPC metadata or as the Grpc-Metadata-Flyte-Primary-Reads-Until HTTP header, read their own writes from
+// any flyteadmin instance. Otherwise only the instance which handled the write knows of it, and read-your-writes holds
+// only if the clients are routed to the same instance, e.g. with session affinity on the load balancer.
+const PrimaryReadsUntilHeader = "flyte-primary-reads-until"
+
+// ReadRouterConfig controls when reads are served by the read replica.
+type ReadRouterConfig struct {
+	// Reads of an execution go to the primary for this long after the execution, or one of its node or task
+	// executions, was written.
+	ReadYourWritesWindow time.Duration
+	// Reads fall back to the primary while the replica lags behind by more than this.
+	MaxReplicationLag time.Duration
+	// How often the replication lag is measured.
+	LagCheckInterval time.Duration
+}
+
+type readRouterMetrics struct {
+	Reads          *prometheus.CounterVec
+	ReplicationLag prometheus.Gauge
+	LagCheckErrors prometheus.Counter
+}
+
+// ReadRouter picks the database connection that serves a read query. Without a replica, every read goes to the
+// primary.
+type ReadRouter struct {
+	primary        *gorm.DB
+	replica        *gorm.DB
+	config         ReadRouterConfig
+	recentWrites   *cache.LRUExpireCache
+	replicaHealthy atomic.Bool
+	lagFunc        func(ctx context.Context) (time.Duration, error)
+	clock          clock.PassiveClock
+	metrics        *readRouterMetrics
+}
+
+// Primary returns the connection to the primary database, which serves all writes.
+func (r *ReadRouter) Primary() *gorm.DB {
+	return r.primary
+}
+
+// Reader returns the connection to use for a read query. route names the query in metrics and executionKey, if not
+// empty, identifies the execution whose data is read so that it can be read from the primary after a recent write.
+func (r *ReadRouter) Reader(ctx context.Context, route, executionKey string) *gorm.DB {
+	if r.replica == nil {
+		return r.primary.WithContext(ctx)
+	}
+	target, reason := r.route(ctx, executionKey)
+	r.metrics.Reads.WithLabelValues(route, target, reason).Inc()
+	if target == readTargetReplica {
+		return r.replica.WithContext(ctx)
+	}
+	return r.primary.WithContext(ctx)
+}
+
+func (r *ReadRouter) route(ctx context.Context, executionKey string) (target, reason string) {
+	if interfaces.PrimaryReadsRequired(ctx) {
+		return readTargetPrimary, readReasonRequested
+	}
+	if len(executionKey) > 0 {
+		if _, ok := r.recentWrites.Get(executionKey); ok {
+			return readTargetPrimary, readReasonRecentWrite
+		}
+	}
+	if !r.clock.Now().After(primaryReadsUntil(ctx)) {
+		return readTargetPrimary, readReasonClientWrite
+	}
+	if !r.replicaHealthy.Load() {
+		return readTargetPrimary, readReasonReplicationLag
+	}
+	return readTargetReplica, readReasonDefault
+}
+
+// RecordWrite marks an execution as written so that its reads are served by the primary for the read-your-writes
+// window. The end of the window is also returned to the client in the PrimaryReadsUntilHeader.
+func (r *ReadRouter) RecordWrite(ctx context.Context, executionKey string) {
+	if r.replica == nil || len(executionKey) == 0 {
+		return
+	}
+	r.recentWrites.Add(executionKey, struct{}{}, r.config.ReadYourWritesWindow)
+	until := r.clock.Now().Add(r.config.ReadYourWritesWindow)
+	// Fails outside of gRPC requests, e.g. for writes made by the scheduler, which have no client to tell.
+	_ = grpc.SetHeader(ctx, metadata.Pairs(PrimaryReadsUntilHeader, strconv.FormatInt(until.UnixMilli(), 10)))
+}
+
+// primaryReadsUntil returns the latest time until which the client of the request asked for its reads to be served by
+// the primary, or the zero time if it did not.
+func primaryReadsUntil(ctx context.Context) time.Time {
+	var until time.Time
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return until
+	}
+	for _, value := range md.Get(PrimaryReadsUntilHeader) {
+		millis, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			logger.Debugf(ctx, "Ignoring invalid [%s] header [%s]", PrimaryReadsUntilHeader, value)
+			continue
+		}
+		if t := time.UnixMilli(millis); t.After(until) {
+			until = t
+		}
+	}
+	return until
+}
+
+// Start measures the replication lag of the replica until the context is cancelled. Reads go to the primary until
+// the first measurement succeeds.
+func (r *ReadRouter) Start(ctx context.Context) {
+	if r.replica == nil {
+		return
+	}
+	r.checkReplicationLag(ctx)
+	go func() {
+		ticker := time.NewTicker(r.config.LagCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.checkReplicationLag(ctx)
+			}
+		}
+	}()
+}
+
+func (r *ReadRouter) checkReplicationLag(ctx context.Context) {
+	lag, err := r.lagFunc(ctx)
+	if err != nil {
+		logger.Warningf(ctx, "Failed to measure read replica lag, reading from the primary: %v", err)
+		r.metrics.LagCheckErrors.Inc()
+		r.replicaHealthy.Store(false)
+		return
+	}
+	r.metrics.ReplicationLag.Set(lag.Seconds())
+	healthy := lag <= r.config.MaxReplicationLag
+	if r.replicaHealthy.Swap(healthy) != healthy {
+		logger.Infof(ctx, "Read replica lag is %v, reading from the replica: %v", lag, healthy)
+	}
+}
+
+func (r *ReadRouter) queryReplicationLag(ctx context.Context) (time.Duration, error) {
+	var lagSeconds float64
+	if err := r.replica.WithContext(ctx).Raw(replicationLagQuery).Scan(&lagSeconds).Error; err != nil {
+		return 0, err
+	}
+	return time.Duration(lagSeconds * float64(time.Second)), nil
+}
+
+// NewPrimaryReadRouter returns a ReadRouter which serves all reads from the primary.
+func NewPrimaryReadRouter(db *gorm.DB) *ReadRouter {
+	return &ReadRouter{primary: db}
+}
+
+// NewReadRouter returns a ReadRouter which serves reads from the replica when they are safe to serve from it. Call
+// Start to begin monitoring the replication lag.
+func NewReadRouter(ctx context.Context, primary, replica *gorm.DB, config ReadRouterConfig,
+	scope promutils.Scope) *ReadRouter {
+	if config.ReadYourWritesWindow < config.MaxReplicationLag+config.LagCheckInterval {
+		logger.Warningf(ctx, "Read-your-writes window [%v] is shorter than the max replication lag [%v] plus the "+
+			"lag check interval [%v], reads after a write may be stale", config.ReadYourWritesWindow,
+			config.MaxReplicationLag, config.LagCheckInterval)
+	}
+	router := &ReadRouter{
+		primary:      primary,
+		replica:      replica,
+		config:       config,
+		recentWrites: cache.NewLRUExpireCacheWithClock(maxRecentWrites, clock.RealClock{}),
+		clock:        clock.RealClock{},
+		metrics: &readRouterMetrics{
+			Reads: scope.MustNewCounterVec("reads",
+				"number of read queries by route and the database which served them", "route", "target", "reason"),
+			ReplicationLag: scope.MustNewGauge("replication_lag_seconds",
+				"last measured replication lag of the read replica"),
+			LagCheckErrors: scope.MustNewCounter("lag_check_errors",
+				"number of failures to measure the replication lag of the read replica"),
+		},
+	}
+	router.lagFunc = router.queryReplicationLag
+	return router
+}
+
+func newExecutionKey(project, domain, name string) string {
+	if len(project) == 0 || len(domain) == 0 || len(name) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s/%s", project, domain, name)
+}
+
+var executionKeyFields = []string{"execution_project", "execution_domain", "execution_name"}
+
+// executionKeyFromFilters returns the key of the execution the filters select the children of, e.g. when listing the
+// node executions of a workflow execution, and an empty string otherwise.
+func executionKeyFromFilters(filters []common.InlineFilter) string {
+	values := make(map[string]string, len(executionKeyFields))
+	for _, filter := range filters {
+		expr, err := filter.GetGormQueryExpr()
+		if err != nil {
+			continue
+		}
+		for _, field := range executionKeyFields {
+			if expr.Query == fmt.Sprintf("%s = ?", field) {
+				values[field] = fmt.Sprintf("%v", expr.Args)
+			}
+		}
+	}
+	return newExecutionKey(values["execution_project"], values["execution_domain"], values["execution_name"])
+}
@@ -0,0 +1,211 @@
+package gormimpl
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	mocket "github.com/Selvatico/go-mocket"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/util/cache"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
+	adminErrors "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
+	mockScope "github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+func assertReadsFrom(t *testing.T, expected, actual *gorm.DB) {
+	assert.Same(t, expected.Dialector, actual.Dialector)
+}
+
+func newTestReadRouter(t *testing.T, lag time.Duration, lagErr error) *ReadRouter {
+	router := NewReadRouter(context.Background(), GetDbForTest(t), GetDbForTest(t), ReadRouterConfig{
+		ReadYourWritesWindow: time.Minute,
+		MaxReplicationLag:    5 * time.Second,
+		LagCheckInterval:     5 * time.Second,
+	}, mockScope.NewTestScope())
+	router.lagFunc = func(ctx context.Context) (time.Duration, error) {
+		return lag, lagErr
+	}
+	router.checkReplicationLag(context.Background())
+	return router
+}
+
+func useFakeClock(router *ReadRouter) *clocktesting.FakeClock {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	router.clock = fakeClock
+	router.recentWrites = cache.NewLRUExpireCacheWithClock(maxRecentWrites, fakeClock)
+	return fakeClock
+}
+
+// headerStream records the headers set by a gRPC handler.
+type headerStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestReadRouter_PrimaryOnly(t *testing.T) {
+	primary := GetDbForTest(t)
+	router := NewPrimaryReadRouter(primary)
+	router.RecordWrite(context.Background(), "project/domain/name")
+	router.Start(context.Background())
+
+	assertReadsFrom(t, primary, router.Reader(context.Background(), "executions.get", ""))
+}
+
+func TestReadRouter_Reader(t *testing.T) {
+	ctx := context.Background()
+	router := newTestReadRouter(t, time.Second, nil)
+	executionKey := newExecutionKey(project, domain, name)
+
+	assertReadsFrom(t, router.replica, router.Reader(ctx, "executions.get", executionKey))
+
+	router.RecordWrite(ctx, executionKey)
+	assertReadsFrom(t, router.primary, router.Reader(ctx, "executions.get", executionKey))
+	assertReadsFrom(t, router.replica,
+		router.Reader(ctx, "executions.get", newExecutionKey(project, domain, "other")))
+	assertReadsFrom(t, router.replica, router.Reader(ctx, "executions.list", ""))
+
+	assertReadsFrom(t, router.primary,
+		router.Reader(interfaces.WithPrimaryReads(ctx), "executions.list", ""))
+}
+
+func TestReadRouter_ReplicationLag(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("lagging", func(t *testing.T) {
+		router := newTestReadRouter(t, time.Minute, nil)
+		assertReadsFrom(t, router.primary, router.Reader(ctx, "executions.list", ""))
+
+		router.lagFunc = func(ctx context.Context) (time.Duration, error) {
+			return 0, nil
+		}
+		router.checkReplicationLag(ctx)
+		assertReadsFrom(t, router.replica, router.Reader(ctx, "executions.list", ""))
+	})
+	t.Run("lag check failure", func(t *testing.T) {
+		router := newTestReadRouter(t, 0, errors.New("replica unavailable"))
+		assertReadsFrom(t, router.primary, router.Reader(ctx, "executions.list", ""))
+	})
+}
+
+func TestReadRouter_LagBound(t *testing.T) {
+	ctx := context.Background()
+	executionKey := newExecutionKey(project, domain, name)
+
+	t.Run("max replication lag", func(t *testing.T) {
+		router := newTestReadRouter(t, 5*time.Second, nil)
+		assertReadsFrom(t, router.replica, router.Reader(ctx, "executions.list", ""))
+
+		router.lagFunc = func(ctx context.Context) (time.Duration, error) {
+			return 5*time.Second + time.Millisecond, nil
+		}
+		router.checkReplicationLag(ctx)
+		target, reason := router.route(ctx, "")
+		assert.Equal(t, readTargetPrimary, target)
+		assert.Equal(t, readReasonReplicationLag, reason)
+	})
+	t.Run("read-your-writes window", func(t *testing.T) {
+		router := newTestReadRouter(t, 5*time.Second, nil)
+		fakeClock := useFakeClock(router)
+		router.RecordWrite(ctx, executionKey)
+
+		// A replica within the max lag may not have replayed the write until the window has passed.
+		fakeClock.Step(time.Minute)
+		assertReadsFrom(t, router.primary, router.Reader(ctx, "executions.get", executionKey))
+		fakeClock.Step(time.Millisecond)
+		assertReadsFrom(t, router.replica, router.Reader(ctx, "executions.get", executionKey))
+	})
+}
+
+func TestReadRouter_PrimaryReadsUntilHeader(t *testing.T) {
+	router := newTestReadRouter(t, 0, nil)
+	fakeClock := useFakeClock(router)
+	executionKey := newExecutionKey(project, domain, name)
+
+	stream := &headerStream{}
+	router.RecordWrite(grpc.NewContextWithServerTransportStream(context.Background(), stream), executionKey)
+	until := stream.header.Get(PrimaryReadsUntilHeader)
+	assert.Equal(t, []string{strconv.FormatInt(fakeClock.Now().Add(time.Minute).UnixMilli(), 10)}, until)
+
+	// Another instance, which did not see the write, reads from the primary for clients which send the header back.
+	other := newTestReadRouter(t, 0, nil)
+	otherClock := useFakeClock(other)
+	otherClock.SetTime(fakeClock.Now())
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(PrimaryReadsUntilHeader, "invalid",
+		PrimaryReadsUntilHeader, until[0]))
+	target, reason := other.route(ctx, "")
+	assert.Equal(t, readTargetPrimary, target)
+	assert.Equal(t, readReasonClientWrite, reason)
+
+	otherClock.Step(time.Minute + time.Millisecond)
+	assertReadsFrom(t, other.replica, other.Reader(ctx, "executions.list", ""))
+}
+
+func TestReadRouter_RecordsExecutionWrites(t *testing.T) {
+	ctx := context.Background()
+	router := newTestReadRouter(t, 0, nil)
+	executionRepo := NewExecutionRepoWithReadRouter(router, adminErrors.NewTestErrorTransformer(),
+		mockScope.NewTestScope())
+	nodeExecutionRepo := NewNodeExecutionRepoWithReadRouter(router, adminErrors.NewTestErrorTransformer(),
+		mockScope.NewTestScope())
+	GlobalMock := mocket.Catcher.Reset()
+	GlobalMock.Logging = true
+
+	err := executionRepo.Update(ctx, models.Execution{
+		BaseModel: models.BaseModel{ID: 1},
+		ExecutionKey: models.ExecutionKey{
+			Project: project,
+			Domain:  domain,
+			Name:    name,
+		},
+	})
+	assert.NoError(t, err)
+	target, reason := router.route(ctx, newExecutionKey(project, domain, name))
+	assert.Equal(t, readTargetPrimary, target)
+	assert.Equal(t, readReasonRecentWrite, reason)
+
+	err = nodeExecutionRepo.Create(ctx, &models.NodeExecution{
+		NodeExecutionKey: models.NodeExecutionKey{
+			NodeID: "node",
+			ExecutionKey: models.ExecutionKey{
+				Project: project,
+				Domain:  domain,
+				Name:    "other",
+			},
+		},
+	})
+	assert.NoError(t, err)
+	target, _ = router.route(ctx, executionKeyFromFilters([]common.InlineFilter{
+		getEqualityFilter(common.NodeExecution, "project", project),
+		getEqualityFilter(common.NodeExecution, "domain", domain),
+		getEqualityFilter(common.NodeExecution, "name", "other"),
+	}))
+	assert.Equal(t, readTargetPrimary, target)
+}
+
+func TestExecutionKeyFromFilters(t *testing.T) {
+	assert.Equal(t, "project/domain/name", executionKeyFromFilters([]common.InlineFilter{
+		getEqualityFilter(common.TaskExecution, "project", project),
+		getEqualityFilter(common.TaskExecution, "domain", domain),
+		getEqualityFilter(common.TaskExecution, "name", name),
+		getEqualityFilter(common.TaskExecution, "node_id", "node"),
+	}))
+	assert.Empty(t, executionKeyFromFilters([]common.InlineFilter{
+		getEqualityFilter(common.Execution, "project", project),
+		getEqualityFilter(common.Execution, "domain", domain),
+	}))
+}
@@ -17,6 +17,7 @@ import (
 // Implementation of TaskExecutionInterface.
 type TaskExecutionRepo struct {
 	db               *gorm.DB
+	readRouter       *ReadRouter
 	errorTransformer flyteAdminDbErrors.ErrorTransformer
 	metrics          gormMetrics
 }
@@ -28,13 +29,17 @@ func (r *TaskExecutionRepo) Create(ctx context.Context, input models.TaskExecuti
 	if tx.Error != nil {
 		return r.errorTransformer.ToFlyteAdminError(tx.Error)
 	}
+	r.readRouter.RecordWrite(ctx, taskExecutionModelKey(input))
 	return nil
 }
 
 func (r *TaskExecutionRepo) Get(ctx context.Context, input interfaces.GetTaskExecutionInput) (models.TaskExecution, error) {
 	var taskExecution models.TaskExecution
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.WithContext(ctx).Where(&models.TaskExecution{
+	executionID := input.TaskExecutionID.GetNodeExecutionId().GetExecutionId()
+	db := r.readRouter.Reader(ctx, "task_executions.get",
+		newExecutionKey(executionID.GetProject(), executionID.GetDomain(), executionID.GetName()))
+	tx := db.Where(&models.TaskExecution{
 		TaskExecutionKey: models.TaskExecutionKey{
 			TaskKey: models.TaskKey{
 				Project: input.TaskExecutionID.GetTaskId().GetProject(),
@@ -88,6 +93,7 @@ func (r *TaskExecutionRepo) Update(ctx context.Context, execution models.TaskExe
 	if err := tx.Error; err != nil {
 		return r.errorTransformer.ToFlyteAdminError(err)
 	}
+	r.readRouter.RecordWrite(ctx, taskExecutionModelKey(execution))
 	return nil
 }
 
@@ -97,7 +103,8 @@ func (r *TaskExecutionRepo) List(ctx context.Context, input interfaces.ListResou
 	}
 
 	var taskExecutions []models.TaskExecution
-	tx := r.db.WithContext(ctx).Limit(input.Limit).Offset(input.Offset).Preload("ChildNodeExecution")
+	tx := r.readRouter.Reader(ctx, "task_executions.list", executionKeyFromFilters(input.InlineFilters)).
+		Limit(input.Limit).Offset(input.Offset).Preload("ChildNodeExecution")
 
 	// And add three join conditions
 	// We enable joining on
@@ -139,7 +146,8 @@ func (r *TaskExecutionRepo) List(ctx context.Context, input interfaces.ListResou
 
 func (r *TaskExecutionRepo) Count(ctx context.Context, input interfaces.CountResourceInput) (int64, error) {
 	var err error
-	tx := r.db.WithContext(ctx).Model(&models.TaskExecution{})
+	tx := r.readRouter.Reader(ctx, "task_executions.count", executionKeyFromFilters(input.InlineFilters)).
+		Model(&models.TaskExecution{})
 
 	// And add three join conditions
 	// We enable joining on
@@ -173,12 +181,24 @@ func (r *TaskExecutionRepo) Count(ctx context.Context, input interfaces.CountRes
 	return count, nil
 }
 
+func taskExecutionModelKey(taskExecution models.TaskExecution) string {
+	executionKey := taskExecution.TaskExecutionKey.NodeExecutionKey.ExecutionKey
+	return newExecutionKey(executionKey.Project, executionKey.Domain, executionKey.Name)
+}
+
 // Returns an instance of TaskExecutionRepoInterface
 func NewTaskExecutionRepo(
 	db *gorm.DB, errorTransformer flyteAdminDbErrors.ErrorTransformer, scope promutils.Scope) interfaces.TaskExecutionRepoInterface {
+	return NewTaskExecutionRepoWithReadRouter(NewPrimaryReadRouter(db), errorTransformer, scope)
+}
+
+// Returns an instance of TaskExecutionRepoInterface which reads through the given ReadRouter
+func NewTaskExecutionRepoWithReadRouter(readRouter *ReadRouter, errorTransformer flyteAdminDbErrors.ErrorTransformer,
+	scope promutils.Scope) interfaces.TaskExecutionRepoInterface {
 	metrics := newMetrics(scope)
 	return &TaskExecutionRepo{
-		db:               db,
+		db:               readRouter.Primary(),
+		readRouter:       readRouter,
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
 	}
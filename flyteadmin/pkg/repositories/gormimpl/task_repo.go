@@ -16,6 +16,7 @@ import (
 // Implementation of TaskRepoInterface.
 type TaskRepo struct {
 	db               *gorm.DB
+	readRouter       *ReadRouter
 	errorTransformer flyteAdminDbErrors.ErrorTransformer
 	metrics          gormMetrics
 }
@@ -49,7 +50,7 @@ func (r *TaskRepo) Create(ctx context.Context, input models.Task, descriptionEnt
 func (r *TaskRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Task, error) {
 	var task models.Task
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.WithContext(ctx).Where(&models.Task{
+	tx := r.readRouter.Reader(ctx, "tasks.get", "").Where(&models.Task{
 		TaskKey: models.TaskKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -80,7 +81,7 @@ func (r *TaskRepo) List(
 		return interfaces.TaskCollectionOutput{}, err
 	}
 	var tasks []models.Task
-	tx := r.db.WithContext(ctx).Limit(input.Limit).Offset(input.Offset)
+	tx := r.readRouter.Reader(ctx, "tasks.list", "").Limit(input.Limit).Offset(input.Offset)
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
 	if err != nil {
@@ -110,7 +111,8 @@ func (r *TaskRepo) ListTaskIdentifiers(ctx context.Context, input interfaces.Lis
 		return interfaces.TaskCollectionOutput{}, err
 	}
 
-	tx := r.db.WithContext(ctx).Model(models.Task{}).Limit(input.Limit).Offset(input.Offset)
+	tx := r.readRouter.Reader(ctx, "tasks.list_identifiers", "").
+		Model(models.Task{}).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -142,9 +144,16 @@ func (r *TaskRepo) ListTaskIdentifiers(ctx context.Context, input interfaces.Lis
 // Returns an instance of TaskRepoInterface
 func NewTaskRepo(
 	db *gorm.DB, errorTransformer flyteAdminDbErrors.ErrorTransformer, scope promutils.Scope) interfaces.TaskRepoInterface {
+	return NewTaskRepoWithReadRouter(NewPrimaryReadRouter(db), errorTransformer, scope)
+}
+
+// Returns an instance of TaskRepoInterface which reads through the given ReadRouter
+func NewTaskRepoWithReadRouter(readRouter *ReadRouter, errorTransformer flyteAdminDbErrors.ErrorTransformer,
+	scope promutils.Scope) interfaces.TaskRepoInterface {
 	metrics := newMetrics(scope)
 	return &TaskRepo{
-		db:               db,
+		db:               readRouter.Primary(),
+		readRouter:       readRouter,
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
 	}
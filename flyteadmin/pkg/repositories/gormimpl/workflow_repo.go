@@ -16,6 +16,7 @@ import (
 // Implementation of WorkflowRepoInterface.
 type WorkflowRepo struct {
 	db               *gorm.DB
+	readRouter       *ReadRouter
 	errorTransformer flyteAdminDbErrors.ErrorTransformer
 	metrics          gormMetrics
 }
@@ -43,7 +44,7 @@ func (r *WorkflowRepo) Create(ctx context.Context, input models.Workflow, descri
 func (r *WorkflowRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Workflow, error) {
 	var workflow models.Workflow
 	timer := r.metrics.GetDuration.Start()
-	tx := r.db.WithContext(ctx).Where(&models.Workflow{
+	tx := r.readRouter.Reader(ctx, "workflows.get", "").Where(&models.Workflow{
 		WorkflowKey: models.WorkflowKey{
 			Project: input.Project,
 			Domain:  input.Domain,
@@ -73,7 +74,7 @@ func (r *WorkflowRepo) List(
 		return interfaces.WorkflowCollectionOutput{}, err
 	}
 	var workflows []models.Workflow
-	tx := r.db.WithContext(ctx).Limit(input.Limit).Offset(input.Offset)
+	tx := r.readRouter.Reader(ctx, "workflows.list", "").Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -103,7 +104,8 @@ func (r *WorkflowRepo) ListIdentifiers(ctx context.Context, input interfaces.Lis
 		return interfaces.WorkflowCollectionOutput{}, err
 	}
 
-	tx := r.db.WithContext(ctx).Model(models.Workflow{}).Limit(input.Limit).Offset(input.Offset)
+	tx := r.readRouter.Reader(ctx, "workflows.list_identifiers", "").
+		Model(models.Workflow{}).Limit(input.Limit).Offset(input.Offset)
 
 	// Apply filters
 	tx, err := applyFilters(tx, input.InlineFilters, input.MapFilters)
@@ -133,9 +135,16 @@ func (r *WorkflowRepo) ListIdentifiers(ctx context.Context, input interfaces.Lis
 // Returns an instance of WorkflowRepoInterface
 func NewWorkflowRepo(
 	db *gorm.DB, errorTransformer flyteAdminDbErrors.ErrorTransformer, scope promutils.Scope) interfaces.WorkflowRepoInterface {
+	return NewWorkflowRepoWithReadRouter(NewPrimaryReadRouter(db), errorTransformer, scope)
+}
+
+// Returns an instance of WorkflowRepoInterface which reads through the given ReadRouter
+func NewWorkflowRepoWithReadRouter(readRouter *ReadRouter, errorTransformer flyteAdminDbErrors.ErrorTransformer,
+	scope promutils.Scope) interfaces.WorkflowRepoInterface {
 	metrics := newMetrics(scope)
 	return &WorkflowRepo{
-		db:               db,
+		db:               readRouter.Primary(),
+		readRouter:       readRouter,
 		errorTransformer: errorTransformer,
 		metrics:          metrics,
 	}
@@ -12,6 +12,9 @@ type ExecutionRepoInterface interface {
 	Create(ctx context.Context, input models.Execution, executionTagModel []*models.ExecutionTag) error
 	// This updates only an existing execution model with all non-empty fields in the input.
 	Update(ctx context.Context, execution models.Execution) error
+	// Sets the concurrency state of an execution if it currently is the expected state and reports whether it was
+	// updated. This allows a single caller to claim a queued execution.
+	UpdateConcurrencyState(ctx context.Context, id uint, expected, state string) (bool, error)
 	// Returns a matching execution if it exists.
 	Get(ctx context.Context, input Identifier) (models.Execution, error)
 	// Returns executions matching query parameters. A limit must be provided for the results page size.
@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+)
+
+type primaryReadsKey struct{}
+
+// WithPrimaryReads returns a context whose repository reads bypass the read replica, if one is configured. Use it for
+// reads that feed a decision which must not act on stale data, e.g. enforcing concurrency limits or quotas.
+func WithPrimaryReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryReadsKey{}, true)
+}
+
+// PrimaryReadsRequired returns whether the context was marked by WithPrimaryReads.
+func PrimaryReadsRequired(ctx context.Context) bool {
+	required, _ := ctx.Value(primaryReadsKey{}).(bool)
+	return required
+}
@@ -24,6 +24,7 @@ type Repository interface {
 	DescriptionEntityRepo() DescriptionEntityRepoInterface
 	SchedulableEntityRepo() schedulerInterfaces.SchedulableEntityRepoInterface
 	ScheduleEntitiesSnapshotRepo() schedulerInterfaces.ScheduleEntitiesSnapShotRepoInterface
+	SchedulerLeaseRepo() schedulerInterfaces.SchedulerLeaseRepoInterface
 	SignalRepo() SignalRepoInterface
 
 	GetGormDB() *gorm.DB
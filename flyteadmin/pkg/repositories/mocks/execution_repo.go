@@ -9,17 +9,19 @@ import (
 
 type CreateExecutionFunc func(ctx context.Context, input models.Execution) error
 type UpdateExecutionFunc func(ctx context.Context, execution models.Execution) error
+type UpdateExecutionConcurrencyStateFunc func(ctx context.Context, id uint, expected, state string) (bool, error)
 type GetExecutionFunc func(ctx context.Context, input interfaces.Identifier) (models.Execution, error)
 type ListExecutionFunc func(ctx context.Context, input interfaces.ListResourceInput) (
 	interfaces.ExecutionCollectionOutput, error)
 type CountExecutionFunc func(ctx context.Context, input interfaces.CountResourceInput) (int64, error)
 
 type MockExecutionRepo struct {
-	createFunction CreateExecutionFunc
-	updateFunction UpdateExecutionFunc
-	getFunction    GetExecutionFunc
-	listFunction   ListExecutionFunc
-	countFunction  CountExecutionFunc
+	createFunction                 CreateExecutionFunc
+	updateFunction                 UpdateExecutionFunc
+	updateConcurrencyStateFunction UpdateExecutionConcurrencyStateFunc
+	getFunction                    GetExecutionFunc
+	listFunction                   ListExecutionFunc
+	countFunction                  CountExecutionFunc
 }
 
 func (r *MockExecutionRepo) Create(ctx context.Context, input models.Execution, executionTagModel []*models.ExecutionTag) error {
@@ -44,6 +46,18 @@ func (r *MockExecutionRepo) SetUpdateCallback(updateFunction UpdateExecutionFunc
 	r.updateFunction = updateFunction
 }
 
+func (r *MockExecutionRepo) UpdateConcurrencyState(ctx context.Context, id uint, expected, state string) (bool, error) {
+	if r.updateConcurrencyStateFunction != nil {
+		return r.updateConcurrencyStateFunction(ctx, id, expected, state)
+	}
+	return true, nil
+}
+
+func (r *MockExecutionRepo) SetUpdateConcurrencyStateCallback(
+	updateConcurrencyStateFunction UpdateExecutionConcurrencyStateFunc) {
+	r.updateConcurrencyStateFunction = updateConcurrencyStateFunction
+}
+
 func (r *MockExecutionRepo) Get(ctx context.Context, input interfaces.Identifier) (models.Execution, error) {
 	if r.getFunction != nil {
 		return r.getFunction(ctx, input)
@@ -23,6 +23,7 @@ type MockRepository struct {
 	descriptionEntityRepo         interfaces.DescriptionEntityRepoInterface
 	schedulableEntityRepo         sIface.SchedulableEntityRepoInterface
 	schedulableEntitySnapshotRepo sIface.ScheduleEntitiesSnapShotRepoInterface
+	schedulerLeaseRepo            sIface.SchedulerLeaseRepoInterface
 	signalRepo                    interfaces.SignalRepoInterface
 }
 
@@ -38,6 +39,10 @@ func (r *MockRepository) ScheduleEntitiesSnapshotRepo() sIface.ScheduleEntitiesS
 	return r.schedulableEntitySnapshotRepo
 }
 
+func (r *MockRepository) SchedulerLeaseRepo() sIface.SchedulerLeaseRepoInterface {
+	return r.schedulerLeaseRepo
+}
+
 func (r *MockRepository) TaskRepo() interfaces.TaskRepoInterface {
 	return r.taskRepo
 }
@@ -106,6 +111,7 @@ func NewMockRepository() interfaces.Repository {
 		NodeExecutionEventRepoIface:   &NodeExecutionEventRepoInterface{},
 		schedulableEntityRepo:         &sMocks.SchedulableEntityRepoInterface{},
 		schedulableEntitySnapshotRepo: &sMocks.ScheduleEntitiesSnapShotRepoInterface{},
+		schedulerLeaseRepo:            &sMocks.SchedulerLeaseRepoInterface{},
 		signalRepo:                    &SignalRepoInterface{},
 	}
 }
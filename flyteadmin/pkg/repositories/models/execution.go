@@ -19,6 +19,13 @@ type ExecutionKey struct {
 	Name    string `gorm:"primary_key;column:execution_name" valid:"length(0|255)"`
 }
 
+const (
+	// ExecutionConcurrencyQueued marks an execution waiting for a running execution of its launch plan to terminate.
+	ExecutionConcurrencyQueued = "QUEUED"
+	// ExecutionConcurrencySkipped marks an execution that was never launched because its launch plan was at capacity.
+	ExecutionConcurrencySkipped = "SKIPPED"
+)
+
 // Database model to encapsulate a (workflow) execution.
 type Execution struct {
 	BaseModel
@@ -63,6 +70,9 @@ type Execution struct {
 	State *int32 `gorm:"index;default:0"`
 	// The resource type of the entity used to launch the execution, one of 'launch_plan' or 'task'
 	LaunchEntity string
+	// Set when the concurrency policy of the launch plan held back the execution, one of
+	// ExecutionConcurrencyQueued or ExecutionConcurrencySkipped. Empty for executions that were launched.
+	ConcurrencyState string `gorm:"index;default:''" valid:"length(0|255)"`
 	// Tags associated with the execution
 	Tags []AdminTag `gorm:"many2many:execution_admin_tags;"`
 }
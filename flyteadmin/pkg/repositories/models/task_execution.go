@@ -34,6 +34,8 @@ type TaskExecution struct {
 	// the execution was UpdatedAt, not to be confused with gorm.Model.UpdatedAt
 	TaskExecutionUpdatedAt *time.Time
 	Duration               time.Duration
+	// The failure category reported by the plugin for failed task executions, e.g. oom or preemption.
+	ErrorCategory string `gorm:"index" valid:"length(0|255)"`
 	// The child node executions (if any) launched by this task execution.
 	ChildNodeExecution []NodeExecution `gorm:"foreignkey:ParentTaskExecutionID;references:ID"`
 }
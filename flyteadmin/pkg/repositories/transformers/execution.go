@@ -46,6 +46,10 @@ type CreateExecutionModelInput struct {
 	LaunchEntity          core.ResourceType
 	Namespace             string
 	Error                 error
+	// Set when the concurrency policy of the launch plan held back the execution instead of launching it.
+	ConcurrencyState string
+	// The cause recorded for executions skipped by the concurrency policy of the launch plan.
+	ConcurrencyCause string
 }
 
 type ExecutionTransformerOptions struct {
@@ -103,6 +107,17 @@ func CreateExecutionModel(input CreateExecutionModelInput) (*models.Execution, e
 		}
 		closure.OutputResult = &admin.ExecutionClosure_Error{Error: execErr}
 	}
+	switch input.ConcurrencyState {
+	case models.ExecutionConcurrencyQueued:
+		closure.Phase = core.WorkflowExecution_QUEUED
+	case models.ExecutionConcurrencySkipped:
+		closure.Phase = core.WorkflowExecution_ABORTED
+		closure.OutputResult = &admin.ExecutionClosure_AbortMetadata{
+			AbortMetadata: &admin.AbortMetadata{
+				Cause: input.ConcurrencyCause,
+			},
+		}
+	}
 
 	closureBytes, err := proto.Marshal(&closure)
 
@@ -132,6 +147,10 @@ func CreateExecutionModel(input CreateExecutionModelInput) (*models.Execution, e
 		User:                  requestSpec.GetMetadata().GetPrincipal(),
 		State:                 &activeExecution,
 		LaunchEntity:          strings.ToLower(input.LaunchEntity.String()),
+		ConcurrencyState:      input.ConcurrencyState,
+	}
+	if input.ConcurrencyState == models.ExecutionConcurrencySkipped {
+		executionModel.AbortCause = input.ConcurrencyCause
 	}
 	// A reference launch entity can be one of either or a task OR launch plan. Traditionally, workflows are executed
 	// with a reference launch plan which is why this behavior is the default below.
@@ -335,6 +354,16 @@ func UpdateExecutionModelStateChangeDetails(executionModel *models.Execution, st
 // The execution abort metadata is recorded but the phase is not actually updated *until* the abort event is propagated
 // by flytepropeller. The metadata is preemptively saved at the time of the abort.
 func SetExecutionAborting(execution *models.Execution, cause, principal string) error {
+	return setExecutionAbortMetadata(execution, cause, principal, core.WorkflowExecution_ABORTING)
+}
+
+// SetExecutionAborted marks an execution which was never launched, and so has nothing to abort, as aborted.
+func SetExecutionAborted(execution *models.Execution, cause, principal string) error {
+	return setExecutionAbortMetadata(execution, cause, principal, core.WorkflowExecution_ABORTED)
+}
+
+func setExecutionAbortMetadata(execution *models.Execution, cause, principal string,
+	phase core.WorkflowExecution_Phase) error {
 	var closure admin.ExecutionClosure
 	err := proto.Unmarshal(execution.Closure, &closure)
 	if err != nil {
@@ -346,14 +375,14 @@ func SetExecutionAborting(execution *models.Execution, cause, principal string)
 			Principal: principal,
 		},
 	}
-	closure.Phase = core.WorkflowExecution_ABORTING
+	closure.Phase = phase
 	marshaledClosure, err := proto.Marshal(&closure)
 	if err != nil {
 		return flyteErrs.NewFlyteAdminErrorf(codes.Internal, "Failed to marshal execution closure: %v", err)
 	}
 	execution.Closure = marshaledClosure
 	execution.AbortCause = cause
-	execution.Phase = core.WorkflowExecution_ABORTING.String()
+	execution.Phase = phase.String()
 	return nil
 }
 
@@ -326,6 +326,52 @@ func TestCreateExecutionModel(t *testing.T) {
 		})
 		assert.Equal(t, expectedClosure, execution.Closure)
 	})
+	t.Run("queued by concurrency policy", func(t *testing.T) {
+		execution, err := CreateExecutionModel(CreateExecutionModelInput{
+			WorkflowExecutionID: &core.WorkflowExecutionIdentifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+			},
+			RequestSpec:        execRequest.GetSpec(),
+			LaunchPlanID:       lpID,
+			WorkflowID:         wfID,
+			CreatedAt:          createdAt,
+			WorkflowIdentifier: workflowIdentifier,
+			LaunchEntity:       core.ResourceType_LAUNCH_PLAN,
+			ConcurrencyState:   models.ExecutionConcurrencyQueued,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, core.WorkflowExecution_QUEUED.String(), execution.Phase)
+		assert.Equal(t, models.ExecutionConcurrencyQueued, execution.ConcurrencyState)
+		assert.Empty(t, execution.AbortCause)
+	})
+	t.Run("skipped by concurrency policy", func(t *testing.T) {
+		cause := "launch plan at capacity"
+		execution, err := CreateExecutionModel(CreateExecutionModelInput{
+			WorkflowExecutionID: &core.WorkflowExecutionIdentifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "name",
+			},
+			RequestSpec:        execRequest.GetSpec(),
+			LaunchPlanID:       lpID,
+			WorkflowID:         wfID,
+			CreatedAt:          createdAt,
+			WorkflowIdentifier: workflowIdentifier,
+			LaunchEntity:       core.ResourceType_LAUNCH_PLAN,
+			ConcurrencyState:   models.ExecutionConcurrencySkipped,
+			ConcurrencyCause:   cause,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, core.WorkflowExecution_ABORTED.String(), execution.Phase)
+		assert.Equal(t, models.ExecutionConcurrencySkipped, execution.ConcurrencyState)
+		assert.Equal(t, cause, execution.AbortCause)
+
+		var closure admin.ExecutionClosure
+		assert.NoError(t, proto.Unmarshal(execution.Closure, &closure))
+		assert.Equal(t, cause, closure.GetAbortMetadata().GetCause())
+	})
 }
 
 func TestUpdateModelState_UnknownToRunning(t *testing.T) {
@@ -20,6 +20,7 @@ import (
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/event"
+	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 	"github.com/flyteorg/flyte/flytestdlib/storage"
 )
@@ -99,6 +100,7 @@ func addTaskTerminalState(
 		closure.OutputResult = &admin.TaskExecutionClosure_Error{
 			Error: request.GetEvent().GetError(),
 		}
+		taskExecutionModel.ErrorCategory = string(pluginsCore.GetFailureCategory(request.GetEvent().GetCustomInfo()))
 	}
 	return nil
 }
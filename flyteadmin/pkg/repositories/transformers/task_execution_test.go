@@ -128,6 +128,11 @@ func TestAddTaskTerminalState_Error(t *testing.T) {
 				Error: expectedErr,
 			},
 			OccurredAt: occurredAtProto,
+			CustomInfo: &ptypesStruct.Struct{
+				Fields: map[string]*ptypesStruct.Value{
+					"failureCategory": {Kind: &ptypesStruct.Value_StringValue{StringValue: "oom"}},
+				},
+			},
 		},
 	}
 	startedAt := occurredAt.Add(-time.Minute)
@@ -144,6 +149,7 @@ func TestAddTaskTerminalState_Error(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, proto.Equal(expectedErr, closure.GetError()))
 	assert.Equal(t, time.Minute, taskExecutionModel.Duration)
+	assert.Equal(t, "oom", taskExecutionModel.ErrorCategory)
 }
 
 func TestAddTaskTerminalState_OutputURI(t *testing.T) {
@@ -91,6 +91,20 @@ func (m *AdminService) DeleteProjectDomainAttributes(ctx context.Context, reques
 	return response, nil
 }
 
+func (m *AdminService) GetProjectDomainQuotaUsage(ctx context.Context, request *admin.ProjectDomainQuotaUsageGetRequest) (
+	*admin.ProjectDomainQuotaUsageGetResponse, error) {
+	var response *admin.ProjectDomainQuotaUsageGetResponse
+	var err error
+	m.Metrics.projectDomainAttributesEndpointMetrics.getQuotaUsage.Time(func() {
+		response, err = m.ProjectQuotaManager.GetProjectDomainQuotaUsage(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.projectDomainAttributesEndpointMetrics.getQuotaUsage)
+	}
+
+	return response, nil
+}
+
 func (m *AdminService) UpdateProjectAttributes(ctx context.Context, request *admin.ProjectAttributesUpdateRequest) (
 	*admin.ProjectAttributesUpdateResponse, error) {
 	var response *admin.ProjectAttributesUpdateResponse
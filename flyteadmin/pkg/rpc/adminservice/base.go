@@ -40,6 +40,7 @@ type AdminService struct {
 	VersionManager           interfaces.VersionInterface
 	DescriptionEntityManager interfaces.DescriptionEntityInterface
 	MetricsManager           interfaces.MetricsInterface
+	ProjectQuotaManager      interfaces.ProjectQuotaInterface
 	Metrics                  AdminMetrics
 }
 
@@ -67,8 +68,13 @@ func NewAdminServer(ctx context.Context, pluginRegistry *plugins.Registry, confi
 		logger.Fatal(ctx, err)
 	}
 	dbScope := adminScope.NewSubScope("database")
-	repo := repositories.NewGormRepo(
-		db, errors.NewPostgresErrorTransformer(adminScope.NewSubScope("errors")), dbScope)
+	readRouter, err := repositories.GetReadRouter(ctx, db, databaseConfig, logConfig,
+		configuration.ApplicationConfiguration().GetTopLevelConfig().ReadReplica, dbScope.NewSubScope("read_router"))
+	if err != nil {
+		logger.Fatal(ctx, err)
+	}
+	repo := repositories.NewGormRepoWithReadRouter(
+		readRouter, errors.NewPostgresErrorTransformer(adminScope.NewSubScope("errors")), dbScope)
 	execCluster := executionCluster.GetExecutionCluster(
 		adminScope.NewSubScope("executor").NewSubScope("cluster"),
 		kubeConfig,
@@ -147,6 +153,7 @@ func NewAdminServer(ctx context.Context, pluginRegistry *plugins.Registry, confi
 		adminScope.NewSubScope("node_execution_manager"), urlData, eventPublisher, cloudEventPublisher, nodeExecutionEventWriter)
 	taskExecutionManager := manager.NewTaskExecutionManager(repo, configuration, dataStorageClient,
 		adminScope.NewSubScope("task_execution_manager"), urlData, eventPublisher, cloudEventPublisher)
+	resourceManager := resources.NewResourceManager(repo, configuration.ApplicationConfiguration())
 
 	logger.Info(ctx, "Initializing a new AdminService")
 	return &AdminService{
@@ -161,9 +168,10 @@ func NewAdminServer(ctx context.Context, pluginRegistry *plugins.Registry, confi
 		NodeExecutionManager:     nodeExecutionManager,
 		TaskExecutionManager:     taskExecutionManager,
 		ProjectManager:           manager.NewProjectManager(repo, configuration),
-		ResourceManager:          resources.NewResourceManager(repo, configuration.ApplicationConfiguration()),
+		ResourceManager:          resourceManager,
 		MetricsManager: manager.NewMetricsManager(workflowManager, executionManager, nodeExecutionManager,
 			taskExecutionManager, adminScope.NewSubScope("metrics_manager")),
-		Metrics: InitMetrics(adminScope),
+		ProjectQuotaManager: manager.NewProjectQuotaManager(repo, configuration, resourceManager),
+		Metrics:             InitMetrics(adminScope),
 	}
 }
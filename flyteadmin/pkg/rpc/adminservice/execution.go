@@ -125,6 +125,20 @@ func (m *AdminService) GetExecutionMetrics(
 	return response, nil
 }
 
+func (m *AdminService) GetExecutionAnalysis(
+	ctx context.Context, request *admin.WorkflowExecutionGetAnalysisRequest) (*admin.WorkflowExecutionGetAnalysisResponse, error) {
+	var response *admin.WorkflowExecutionGetAnalysisResponse
+	var err error
+	m.Metrics.executionEndpointMetrics.getAnalysis.Time(func() {
+		response, err = m.MetricsManager.GetExecutionAnalysis(ctx, request)
+	})
+	if err != nil {
+		return nil, util.TransformAndRecordError(err, &m.Metrics.executionEndpointMetrics.getAnalysis)
+	}
+	m.Metrics.executionEndpointMetrics.getAnalysis.Success()
+	return response, nil
+}
+
 func (m *AdminService) ListExecutions(
 	ctx context.Context, request *admin.ResourceListRequest) (*admin.ExecutionList, error) {
 	var response *admin.ExecutionList
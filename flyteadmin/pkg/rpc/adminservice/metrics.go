@@ -17,6 +17,7 @@ type executionEndpointMetrics struct {
 	update      util.RequestMetrics
 	getData     util.RequestMetrics
 	getMetrics  util.RequestMetrics
+	getAnalysis util.RequestMetrics
 	list        util.RequestMetrics
 	terminate   util.RequestMetrics
 }
@@ -71,10 +72,11 @@ type domainEndpointMetrics struct {
 type attributeEndpointMetrics struct {
 	scope promutils.Scope
 
-	update util.RequestMetrics
-	get    util.RequestMetrics
-	delete util.RequestMetrics
-	list   util.RequestMetrics
+	update        util.RequestMetrics
+	get           util.RequestMetrics
+	delete        util.RequestMetrics
+	list          util.RequestMetrics
+	getQuotaUsage util.RequestMetrics
 }
 
 type taskEndpointMetrics struct {
@@ -145,6 +147,7 @@ func InitMetrics(adminScope promutils.Scope) AdminMetrics {
 			update:      util.NewRequestMetrics(adminScope, "update_execution"),
 			getData:     util.NewRequestMetrics(adminScope, "get_execution_data"),
 			getMetrics:  util.NewRequestMetrics(adminScope, "get_execution_metrics"),
+			getAnalysis: util.NewRequestMetrics(adminScope, "get_execution_analysis"),
 			list:        util.NewRequestMetrics(adminScope, "list_execution"),
 			terminate:   util.NewRequestMetrics(adminScope, "terminate_execution"),
 		},
@@ -192,10 +195,11 @@ func InitMetrics(adminScope promutils.Scope) AdminMetrics {
 			delete: util.NewRequestMetrics(adminScope, "delete_project_attrs"),
 		},
 		projectDomainAttributesEndpointMetrics: attributeEndpointMetrics{
-			scope:  adminScope,
-			update: util.NewRequestMetrics(adminScope, "update_project_domain_attrs"),
-			get:    util.NewRequestMetrics(adminScope, "get_project_domain_attrs"),
-			delete: util.NewRequestMetrics(adminScope, "delete_project_domain_attrs"),
+			scope:         adminScope,
+			update:        util.NewRequestMetrics(adminScope, "update_project_domain_attrs"),
+			get:           util.NewRequestMetrics(adminScope, "get_project_domain_attrs"),
+			delete:        util.NewRequestMetrics(adminScope, "delete_project_domain_attrs"),
+			getQuotaUsage: util.NewRequestMetrics(adminScope, "get_project_domain_quota_usage"),
 		},
 		workflowAttributesEndpointMetrics: attributeEndpointMetrics{
 			scope:  adminScope,
@@ -110,3 +110,21 @@ func TestGetProjectAttr(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, getCalled)
 }
+
+func TestGetProjectDomainQuotaUsage(t *testing.T) {
+	ctx := context.Background()
+
+	mockProjectQuotaManager := mocks.ProjectQuotaInterface{}
+	mockProjectQuotaManager.EXPECT().GetProjectDomainQuotaUsage(mock.Anything, mock.Anything).Return(
+		&admin.ProjectDomainQuotaUsageGetResponse{ActiveExecutions: 3}, nil)
+	mockServer := NewMockAdminServer(NewMockAdminServerInput{
+		projectQuotaManager: &mockProjectQuotaManager,
+	})
+
+	resp, err := mockServer.GetProjectDomainQuotaUsage(ctx, &admin.ProjectDomainQuotaUsageGetRequest{
+		Project: "project",
+		Domain:  "domain",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), resp.GetActiveExecutions())
+}
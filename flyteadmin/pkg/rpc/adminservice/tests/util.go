@@ -15,6 +15,7 @@ type NewMockAdminServerInput struct {
 	taskManager          *mocks.TaskInterface
 	workflowManager      *mocks.WorkflowInterface
 	taskExecutionManager *mocks.TaskExecutionInterface
+	projectQuotaManager  *mocks.ProjectQuotaInterface
 }
 
 func NewMockAdminServer(input NewMockAdminServerInput) *adminservice.AdminService {
@@ -28,6 +29,7 @@ func NewMockAdminServer(input NewMockAdminServerInput) *adminservice.AdminServic
 		ResourceManager:      input.resourceManager,
 		WorkflowManager:      input.workflowManager,
 		TaskExecutionManager: input.taskExecutionManager,
+		ProjectQuotaManager:  input.projectQuotaManager,
 		Metrics:              adminservice.InitMetrics(testScope),
 	}
 }
@@ -1,6 +1,8 @@
 package runtime
 
 import (
+	"time"
+
 	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyte/flytestdlib/config"
@@ -34,6 +36,11 @@ var flyteAdminConfig = config.MustRegisterSection(flyteAdmin, &interfaces.Applic
 	K8SServiceAccount:           "",
 	UseOffloadedWorkflowClosure: false,
 	ConsoleURL:                  "",
+	ReadReplica: interfaces.ReadReplicaConfig{
+		ReadYourWritesWindow: config.Duration{Duration: 15 * time.Second},
+		MaxReplicationLag:    config.Duration{Duration: 5 * time.Second},
+		LagCheckInterval:     config.Duration{Duration: 5 * time.Second},
+	},
 })
 
 var schedulerConfig = config.MustRegisterSection(scheduler, &interfaces.SchedulerConfig{
@@ -49,6 +56,11 @@ var schedulerConfig = config.MustRegisterSection(scheduler, &interfaces.Schedule
 				Tps:   100,
 				Burst: 10,
 			},
+			LeaderElection: interfaces.SchedulerLeaderElectionConfig{
+				LeaseDuration: config.Duration{Duration: 15 * time.Second},
+				RenewDeadline: config.Duration{Duration: 10 * time.Second},
+				RetryPeriod:   config.Duration{Duration: 2 * time.Second},
+			},
 		},
 	},
 })
@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyte/flytestdlib/config"
@@ -12,6 +13,51 @@ const clustersKey = "clusters"
 
 var clusterConfig = config.MustRegisterSection(clustersKey, &interfaces.Clusters{})
 
+func init() {
+	config.AddTypedValidator(clusterConfig, validateClusters)
+}
+
+// validateClusters rejects cluster configs that can't be used to select clusters, e.g. labels that route executions to
+// clusters that aren't configured or enabled clusters that can't be reached.
+func validateClusters(clusters *interfaces.Clusters) error {
+	errs := config.FieldErrors{}
+	names := make(map[string]bool, len(clusters.ClusterConfigs))
+	for i, cluster := range clusters.ClusterConfigs {
+		field := fmt.Sprintf("clusterConfigs[%v]", i)
+		if len(cluster.Name) == 0 {
+			errs.Addf(field+".name", "cluster name is required")
+		} else if names[cluster.Name] {
+			errs.Addf(field+".name", "duplicate cluster name [%v]", cluster.Name)
+		}
+		names[cluster.Name] = true
+
+		if cluster.Enabled && !cluster.InCluster && len(cluster.Endpoint) == 0 {
+			errs.Addf(field+".endpoint", "cluster [%v] is enabled but has no endpoint and isn't in-cluster", cluster.Name)
+		}
+	}
+
+	for label, entities := range clusters.LabelClusterMap {
+		for i, entity := range entities {
+			field := fmt.Sprintf("labelClusterMap[%v][%v]", label, i)
+			// Entries of clusters that aren't enabled are skipped when selecting clusters, unknown ones are likely typos.
+			if !names[entity.ID] {
+				errs.Addf(field+".id", "label [%v] refers to cluster [%v] which isn't in clusterConfigs", label, entity.ID)
+			}
+
+			if entity.Weight < 0 || entity.Weight > 1 {
+				errs.Addf(field+".weight", "weight [%v] of cluster [%v] for label [%v] must be between 0 and 1",
+					entity.Weight, entity.ID, label)
+			}
+		}
+	}
+
+	if _, found := clusters.LabelClusterMap[clusters.DefaultExecutionLabel]; len(clusters.DefaultExecutionLabel) > 0 && !found {
+		errs.Addf("defaultExecutionLabel", "label [%v] isn't in labelClusterMap", clusters.DefaultExecutionLabel)
+	}
+
+	return errs.ErrorOrNil()
+}
+
 // Implementation of an interfaces.ClusterConfiguration
 type ClusterConfigurationProvider struct{}
 
@@ -42,6 +88,12 @@ func (p *ClusterConfigurationProvider) GetDefaultExecutionLabel() string {
 	return ""
 }
 
+func (p *ClusterConfigurationProvider) SubscribeToUpdates(handler func(ctx context.Context)) (unsubscribe func()) {
+	return clusterConfig.Subscribe(func(ctx context.Context, _ config.Config) {
+		handler(ctx)
+	})
+}
+
 func NewClusterConfigurationProvider() interfaces.ClusterConfiguration {
 	clusterConfigProvider := ClusterConfigurationProvider{}
 	clusterNameMap := make(map[string]bool)
@@ -41,6 +41,14 @@ func (p *ClusterResourceConfigurationProvider) IsStandaloneDeployment() bool {
 	return clusterResourceConfig.GetConfig().(*interfaces.ClusterResourceConfig).StandaloneDeployment
 }
 
+func (p *ClusterResourceConfigurationProvider) IsDriftDetectionEnabled() bool {
+	return clusterResourceConfig.GetConfig().(*interfaces.ClusterResourceConfig).DetectDrift
+}
+
+func (p *ClusterResourceConfigurationProvider) GetPruneResources() []string {
+	return clusterResourceConfig.GetConfig().(*interfaces.ClusterResourceConfig).PruneResources
+}
+
 func NewClusterResourceConfigurationProvider() interfaces.ClusterResourceConfiguration {
 	return &ClusterResourceConfigurationProvider{}
 }
@@ -2,12 +2,14 @@ package runtime
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyte/flytestdlib/config"
 	"github.com/flyteorg/flyte/flytestdlib/config/viper"
 )
@@ -59,6 +61,41 @@ func TestClusterConfig(t *testing.T) {
 	assert.Equal(t, true, clusters[2].InCluster)
 }
 
+func TestValidateClusters(t *testing.T) {
+	assert.NoError(t, validateClusters(&interfaces.Clusters{
+		ClusterConfigs:  []interfaces.ClusterConfig{{Name: "a"}, {Name: "b"}},
+		LabelClusterMap: map[string][]interfaces.ClusterEntity{"all": {{ID: "a", Weight: 0.5}, {ID: "b", Weight: 0.5}}},
+	}))
+
+	assert.Error(t, validateClusters(&interfaces.Clusters{
+		ClusterConfigs: []interfaces.ClusterConfig{{Name: "a"}, {Name: "a"}},
+	}))
+
+	assert.Error(t, validateClusters(&interfaces.Clusters{
+		ClusterConfigs:  []interfaces.ClusterConfig{{Name: "a"}},
+		LabelClusterMap: map[string][]interfaces.ClusterEntity{"all": {{ID: "a", Weight: 2}}},
+	}))
+
+	t.Run("Located problems", func(t *testing.T) {
+		err := initConfig("testdata/invalid_clusters_config.yaml")
+		assert.ErrorIs(t, err, config.ErrSectionValidation)
+
+		var validationErr *config.ValidationError
+		if assert.ErrorAs(t, err, &validationErr) {
+			var problems []string
+			for _, problem := range validationErr.Problems {
+				problems = append(problems, fmt.Sprintf("%v:%v %v", problem.Line, problem.Column, problem.Key))
+			}
+
+			assert.Equal(t, []string{
+				"2:3 clusters.defaultExecutionLabel",
+				"8:9 clusters.labelClusterMap[team2][0].id",
+				"14:5 clusters.clusterConfigs[1].endpoint",
+			}, problems)
+		}
+	})
+}
+
 func TestGetCloudEventsConfig(t *testing.T) {
 	err := initConfig("testdata/event.yaml")
 	assert.NoError(t, err)
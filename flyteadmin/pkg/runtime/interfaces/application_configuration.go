@@ -116,6 +116,24 @@ type ApplicationConfig struct {
 
 	// Enabling this will instruct operator to use storage (s3/gcs/etc) to offload workflow execution inputs instead of storing them inline in the CRD.
 	UseOffloadedInputs bool `json:"useOffloadedInputs" pflag:",Use offloaded inputs for workflows."`
+
+	// Routes read queries to the read replica configured in the database section.
+	ReadReplica ReadReplicaConfig `json:"readReplica" pflag:",Routes read queries to the database read replica."`
+}
+
+// ReadReplicaConfig controls which read queries are served by the read replica at database.postgres.readReplicaHost.
+// Reads which feed writes, e.g. while handling execution events or enforcing quotas, always go to the primary.
+type ReadReplicaConfig struct {
+	Enabled bool `json:"enabled" pflag:",Serve get, list and count queries from the read replica."`
+	// Reads of an execution go to the primary for this long after this instance wrote the execution or one of its
+	// node or task executions. Should exceed maxReplicationLag plus lagCheckInterval. Other instances only learn of the
+	// write from the flyte-primary-reads-until header, for clients which send it back, so deployments with several
+	// instances and clients which do not should route each client to the same instance.
+	ReadYourWritesWindow config.Duration `json:"readYourWritesWindow" pflag:",Time after a write to an execution during which its reads go to the primary."`
+	// Reads go to the primary while the replica lags behind by more than this.
+	MaxReplicationLag config.Duration `json:"maxReplicationLag" pflag:",Replication lag above which reads go to the primary."`
+	// How often the replication lag of the replica is measured.
+	LagCheckInterval config.Duration `json:"lagCheckInterval" pflag:",How often to measure the replication lag of the replica."`
 }
 
 func (a *ApplicationConfig) GetRoleNameKey() string {
@@ -469,7 +487,24 @@ type FlyteWorkflowExecutorConfig struct {
 	// Burst specifies burst traffic count
 	AdminRateLimit *AdminRateLimit `json:"adminRateLimit"`
 	// Defaults to using user local timezone where the scheduler is deployed.
+	// Only applies to cron schedules that don't specify their own time zone through a CRON_TZ prefix.
 	UseUTCTz bool `json:"useUTCTz"`
+	// Allows running multiple replicas of the scheduler of which only the elected leader runs the schedules.
+	LeaderElection SchedulerLeaderElectionConfig `json:"leaderElection"`
+}
+
+// SchedulerLeaderElectionConfig configures the election of the leader among the replicas of the native scheduler.
+// The lease is stored in the scheduler DB so no additional coordination service is needed.
+type SchedulerLeaderElectionConfig struct {
+	// Enables leader election. Without it every replica runs all the schedules.
+	Enabled bool `json:"enabled"`
+	// Duration that standby replicas wait after the last renewal before taking over the lease.
+	LeaseDuration config.Duration `json:"leaseDuration"`
+	// Duration that the leader keeps retrying to renew the lease before it steps down. Must be shorter than the lease
+	// duration by more than the maximum clock skew between replicas.
+	RenewDeadline config.Duration `json:"renewDeadline"`
+	// Duration between attempts to acquire or renew the lease.
+	RetryPeriod config.Duration `json:"retryPeriod"`
 }
 
 func (f *FlyteWorkflowExecutorConfig) GetAdminRateLimit() *AdminRateLimit {
@@ -480,6 +515,10 @@ func (f *FlyteWorkflowExecutorConfig) GetUseUTCTz() bool {
 	return f.UseUTCTz
 }
 
+func (f *FlyteWorkflowExecutorConfig) GetLeaderElection() SchedulerLeaderElectionConfig {
+	return f.LeaderElection
+}
+
 type AdminRateLimit struct {
 	Tps   rate.Limit `json:"tps"`
 	Burst int        `json:"burst"`
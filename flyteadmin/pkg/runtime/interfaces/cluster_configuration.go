@@ -1,6 +1,7 @@
 package interfaces
 
 import (
+	"context"
 	"io/ioutil"
 
 	"github.com/pkg/errors"
@@ -53,6 +54,12 @@ type Clusters struct {
 
 //go:generate mockery --name ClusterConfiguration --case=underscore --output=../mocks --case=underscore --with-expecter
 
+// ClusterConfigurationSubscriber is implemented by cluster configurations which can be updated at runtime.
+type ClusterConfigurationSubscriber interface {
+	// Subscribes to updates of the cluster configuration. The returned function removes the subscription.
+	SubscribeToUpdates(handler func(ctx context.Context)) (unsubscribe func())
+}
+
 // Provides values set in runtime configuration files.
 // These files can be changed without requiring a full server restart.
 type ClusterConfiguration interface {
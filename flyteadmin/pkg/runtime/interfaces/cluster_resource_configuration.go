@@ -44,6 +44,15 @@ type ClusterResourceConfig struct {
 	*/
 	CustomData           map[DomainName]TemplateData `json:"customData"`
 	StandaloneDeployment bool                        `json:"standaloneDeployment" pflag:", Whether the cluster resource sync is running in a standalone deployment and should call flyteadmin service endpoints"`
+	// DetectDrift makes every sync compare managed resources against their rendered templates, even when the template
+	// is unchanged since it was last applied, so that manual edits are reported and reverted. This bypasses the cache of
+	// applied templates, so every sync gets every rendered resource of every namespace from every cluster. Consider a
+	// longer refreshInterval when there are many projects, domains or templates.
+	DetectDrift bool `json:"detectDrift"`
+	// PruneResources lists additional resources, formatted as [group/]version/resource (e.g. v1/configmaps), which are
+	// searched for orphaned objects of templates which opted into pruning. Resources of templates which are still
+	// present are always searched.
+	PruneResources []string `json:"pruneResources"`
 }
 
 type ClusterResourceConfiguration interface {
@@ -52,4 +61,6 @@ type ClusterResourceConfiguration interface {
 	GetRefreshInterval() time.Duration
 	GetCustomTemplateData() map[DomainName]TemplateData
 	IsStandaloneDeployment() bool
+	IsDriftDetectionEnabled() bool
+	GetPruneResources() []string
 }
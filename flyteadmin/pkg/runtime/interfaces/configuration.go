@@ -12,4 +12,5 @@ type Configuration interface {
 	NamespaceMappingConfiguration() NamespaceMappingConfiguration
 	QualityOfServiceConfiguration() QualityOfServiceConfiguration
 	ClusterPoolAssignmentConfiguration() ClusterPoolAssignmentConfiguration
+	RegistrationPolicyConfiguration() RegistrationPolicyConfiguration
 }
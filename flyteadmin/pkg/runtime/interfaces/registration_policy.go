@@ -0,0 +1,34 @@
+package interfaces
+
+//go:generate mockery --name RegistrationPolicyConfiguration --output=mocks --case=underscore --with-expecter
+
+// RegistrationPolicy is a set of rules tasks must satisfy to be registered and executed. Rules either reject a task
+// which violates them or mutate the task so it satisfies them.
+type RegistrationPolicy struct {
+	// Project the policy applies to; applies to all projects if unset
+	Project string `json:"project"`
+	// Domain the policy applies to; applies to all domains if unset
+	Domain string `json:"domain"`
+	// RequireResourceLimits rejects container and pod tasks with a container which sets no cpu or memory limit
+	RequireResourceLimits bool `json:"requireResourceLimits"`
+	// AllowedRegistries rejects container and pod tasks with an image, including the images of init containers, not
+	// pulled from one of the registries, e.g. ghcr.io/flyteorg
+	AllowedRegistries []string `json:"allowedRegistries"`
+	// RejectLatestTag rejects container and pod tasks with an image, including the images of init containers, tagged
+	// latest, explicitly or by omitting the tag
+	RejectLatestTag bool `json:"rejectLatestTag"`
+	// DefaultEnv is added to the environment of the containers of container and pod tasks which do not set the
+	// variables themselves
+	DefaultEnv map[string]string `json:"defaultEnv"`
+	// MaxRetries caps the retries of tasks; no cap is enforced if unset
+	MaxRetries uint32 `json:"maxRetries"`
+}
+
+type RegistrationPolicyConfig struct {
+	Policies []RegistrationPolicy `json:"policies"`
+}
+
+// RegistrationPolicyConfiguration provides the policies enforced when tasks and workflows are registered and executed
+type RegistrationPolicyConfiguration interface {
+	GetRegistrationPolicies() []RegistrationPolicy
+}
@@ -1,10 +1,21 @@
 package interfaces
 
+// WorkflowLintMode determines whether the advisory lint pass runs on workflows at registration.
+type WorkflowLintMode = string
+
+const (
+	// WorkflowLintModeDisabled skips the lint pass entirely.
+	WorkflowLintModeDisabled WorkflowLintMode = "disabled"
+	// WorkflowLintModeAdvisory runs the lint pass and logs and counts warnings without affecting registration.
+	WorkflowLintModeAdvisory WorkflowLintMode = "advisory"
+)
+
 type RegistrationValidationConfig struct {
-	MaxWorkflowNodes     int    `json:"maxWorkflowNodes"`
-	MaxLabelEntries      int    `json:"maxLabelEntries"`
-	MaxAnnotationEntries int    `json:"maxAnnotationEntries"`
-	WorkflowSizeLimit    string `json:"workflowSizeLimit"`
+	MaxWorkflowNodes     int              `json:"maxWorkflowNodes"`
+	MaxLabelEntries      int              `json:"maxLabelEntries"`
+	MaxAnnotationEntries int              `json:"maxAnnotationEntries"`
+	WorkflowSizeLimit    string           `json:"workflowSizeLimit"`
+	WorkflowLintMode     WorkflowLintMode `json:"workflowLintMode"`
 }
 
 // Provides validation limits used at entity registration
@@ -13,4 +24,5 @@ type RegistrationValidationConfiguration interface {
 	GetMaxLabelEntries() int
 	GetMaxAnnotationEntries() int
 	GetWorkflowSizeLimit() string
+	GetWorkflowLintMode() WorkflowLintMode
 }
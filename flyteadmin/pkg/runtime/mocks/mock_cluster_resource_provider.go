@@ -12,6 +12,8 @@ type MockClusterResourceConfiguration struct {
 	RefreshInterval      time.Duration
 	CustomTemplateData   map[interfaces.DomainName]interfaces.TemplateData
 	StandaloneDeployment bool
+	DetectDrift          bool
+	PruneResources       []string
 }
 
 func (c MockClusterResourceConfiguration) GetTemplatePath() string {
@@ -33,6 +35,14 @@ func (c MockClusterResourceConfiguration) IsStandaloneDeployment() bool {
 	return c.StandaloneDeployment
 }
 
+func (c MockClusterResourceConfiguration) IsDriftDetectionEnabled() bool {
+	return c.DetectDrift
+}
+
+func (c MockClusterResourceConfiguration) GetPruneResources() []string {
+	return c.PruneResources
+}
+
 func NewMockClusterResourceConfiguration() interfaces.ClusterResourceConfiguration {
 	return &MockClusterResourceConfiguration{}
 }
@@ -17,6 +17,7 @@ type MockConfigurationProvider struct {
 	namespaceMappingConfiguration       interfaces.NamespaceMappingConfiguration
 	qualityOfServiceConfiguration       interfaces.QualityOfServiceConfiguration
 	clusterPoolAssignmentConfiguration  interfaces.ClusterPoolAssignmentConfiguration
+	registrationPolicyConfiguration     interfaces.RegistrationPolicyConfiguration
 }
 
 func (p *MockConfigurationProvider) ApplicationConfiguration() interfaces.ApplicationConfiguration {
@@ -79,6 +80,14 @@ func (p *MockConfigurationProvider) AddClusterPoolAssignmentConfiguration(cfg in
 	p.clusterPoolAssignmentConfiguration = cfg
 }
 
+func (p *MockConfigurationProvider) RegistrationPolicyConfiguration() interfaces.RegistrationPolicyConfiguration {
+	return p.registrationPolicyConfiguration
+}
+
+func (p *MockConfigurationProvider) AddRegistrationPolicyConfiguration(cfg interfaces.RegistrationPolicyConfiguration) {
+	p.registrationPolicyConfiguration = cfg
+}
+
 func NewMockConfigurationProvider(
 	applicationConfiguration interfaces.ApplicationConfiguration,
 	queueConfiguration interfaces.QueueConfiguration,
@@ -94,6 +103,9 @@ func NewMockConfigurationProvider(
 	mockClusterPoolAssignmentConfiguration := &ifaceMocks.ClusterPoolAssignmentConfiguration{}
 	mockClusterPoolAssignmentConfiguration.EXPECT().GetClusterPoolAssignments().Return(make(map[string]interfaces.ClusterPoolAssignment))
 
+	mockRegistrationPolicyConfiguration := &ifaceMocks.RegistrationPolicyConfiguration{}
+	mockRegistrationPolicyConfiguration.EXPECT().GetRegistrationPolicies().Return(nil)
+
 	return &MockConfigurationProvider{
 		applicationConfiguration:           applicationConfiguration,
 		queueConfiguration:                 queueConfiguration,
@@ -103,5 +115,6 @@ func NewMockConfigurationProvider(
 		namespaceMappingConfiguration:      namespaceMappingConfiguration,
 		qualityOfServiceConfiguration:      mockQualityOfServiceConfiguration,
 		clusterPoolAssignmentConfiguration: mockClusterPoolAssignmentConfiguration,
+		registrationPolicyConfiguration:    mockRegistrationPolicyConfiguration,
 	}
 }
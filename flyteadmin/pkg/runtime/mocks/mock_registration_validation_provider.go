@@ -7,6 +7,7 @@ type MockRegistrationValidationProvider struct {
 	MaxLabelEntries      int
 	MaxAnnotationEntries int
 	WorkflowSizeLimit    string
+	WorkflowLintMode     interfaces.WorkflowLintMode
 }
 
 func (c *MockRegistrationValidationProvider) GetWorkflowNodeLimit() int {
@@ -25,6 +26,10 @@ func (c *MockRegistrationValidationProvider) GetWorkflowSizeLimit() string {
 	return c.WorkflowSizeLimit
 }
 
+func (c *MockRegistrationValidationProvider) GetWorkflowLintMode() interfaces.WorkflowLintMode {
+	return c.WorkflowLintMode
+}
+
 func NewMockRegistrationValidationProvider() interfaces.RegistrationValidationConfiguration {
 	return &MockRegistrationValidationProvider{}
 }
@@ -0,0 +1,21 @@
+package runtime
+
+import (
+	"github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flytestdlib/config"
+)
+
+const registrationPoliciesKey = "registrationPolicies"
+
+var registrationPolicyConfig = config.MustRegisterSection(registrationPoliciesKey, &interfaces.RegistrationPolicyConfig{})
+
+// Implementation of an interfaces.RegistrationPolicyConfiguration
+type RegistrationPolicyProvider struct{}
+
+func (p *RegistrationPolicyProvider) GetRegistrationPolicies() []interfaces.RegistrationPolicy {
+	return registrationPolicyConfig.GetConfig().(*interfaces.RegistrationPolicyConfig).Policies
+}
+
+func NewRegistrationPolicyProvider() interfaces.RegistrationPolicyConfiguration {
+	return &RegistrationPolicyProvider{}
+}
@@ -10,6 +10,7 @@ const registration = "registration"
 
 var registrationValidationConfig = config.MustRegisterSection(registration, &interfaces.RegistrationValidationConfig{
 	MaxWorkflowNodes: 100,
+	WorkflowLintMode: interfaces.WorkflowLintModeDisabled,
 })
 
 // Implementation of an interfaces.TaskResourceConfiguration
@@ -31,6 +32,10 @@ func (p *RegistrationValidationProvider) GetWorkflowSizeLimit() string {
 	return registrationValidationConfig.GetConfig().(*interfaces.RegistrationValidationConfig).WorkflowSizeLimit
 }
 
+func (p *RegistrationValidationProvider) GetWorkflowLintMode() interfaces.WorkflowLintMode {
+	return registrationValidationConfig.GetConfig().(*interfaces.RegistrationValidationConfig).WorkflowLintMode
+}
+
 func NewRegistrationValidationProvider() interfaces.RegistrationValidationConfiguration {
 	return &RegistrationValidationProvider{}
 }
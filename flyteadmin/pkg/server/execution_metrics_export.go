@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/flyteorg/flyte/flyteidl/clients/go/spanexport"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	grpcService "github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/service"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+const (
+	executionMetricsExportPath = "/api/v1/metrics/executions/{project}/{domain}/{name}/export"
+	getExecutionMetricsMethod  = "/flyteidl.service.AdminService/GetExecutionMetrics"
+	defaultExportDepth         = 10
+)
+
+// registerExecutionMetricsHandlers registers the endpoint which downloads the span tree of an execution in a trace
+// format, e.g. GET /api/v1/metrics/executions/flytesnacks/development/f8a8c3e2b1/export?format=otlp&depth=5.
+// Requests are forwarded to the admin service the same way as the requests of the other gateway endpoints, so they are
+// subject to the same authentication.
+func registerExecutionMetricsHandlers(ctx context.Context, gwmux *runtime.ServeMux, grpcAddress string,
+	grpcConnectionOpts []grpc.DialOption) error {
+	conn, err := grpc.DialContext(ctx, grpcAddress, grpcConnectionOpts...)
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		if err := conn.Close(); err != nil {
+			logger.Errorf(ctx, "Failed to close conn to %s: %v", grpcAddress, err)
+		}
+	}()
+
+	client := grpcService.NewAdminServiceClient(conn)
+	return gwmux.HandlePath(http.MethodGet, executionMetricsExportPath, GetExecutionMetricsExportHandler(gwmux, client))
+}
+
+// GetExecutionMetricsExportHandler returns the handler which exports the span tree of an execution in the format given
+// by the format query parameter; chrome-trace by default.
+func GetExecutionMetricsExportHandler(gwmux *runtime.ServeMux, client grpcService.AdminServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(gwmux, r)
+		ctx, err := runtime.AnnotateContext(r.Context(), gwmux, r, getExecutionMetricsMethod,
+			runtime.WithHTTPPathPattern(executionMetricsExportPath))
+		if err != nil {
+			runtime.HTTPError(r.Context(), gwmux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		format := spanexport.FormatChromeTrace
+		if name := r.URL.Query().Get("format"); len(name) > 0 {
+			if format, err = spanexport.ParseFormat(name); err != nil {
+				runtime.HTTPError(ctx, gwmux, outboundMarshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+				return
+			}
+		}
+		resp, err := getExecutionMetrics(ctx, client, r, pathParams)
+		if err != nil {
+			runtime.HTTPError(ctx, gwmux, outboundMarshaler, w, r, err)
+			return
+		}
+		raw, err := spanexport.Export(resp.GetSpan(), format)
+		if err != nil {
+			runtime.HTTPError(ctx, gwmux, outboundMarshaler, w, r, status.Error(codes.Internal, err.Error()))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition",
+			fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s-%s.json", pathParams["name"], format)))
+		if _, err := w.Write(raw); err != nil {
+			logger.Errorf(ctx, "failed to write exported execution metrics, error: %s", err.Error())
+		}
+	}
+}
+
+// getExecutionMetrics retrieves the span tree of the execution identified by the path parameters, broken down to the
+// depth given by the depth query parameter.
+func getExecutionMetrics(ctx context.Context, client grpcService.AdminServiceClient, r *http.Request,
+	pathParams map[string]string) (*admin.WorkflowExecutionGetMetricsResponse, error) {
+	depth := int64(defaultExportDepth)
+	if value := r.URL.Query().Get("depth"); len(value) > 0 {
+		var err error
+		if depth, err = strconv.ParseInt(value, 10, 32); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid depth [%v]", value)
+		}
+	}
+
+	return client.GetExecutionMetrics(ctx, &admin.WorkflowExecutionGetMetricsRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: pathParams["project"],
+			Domain:  pathParams["domain"],
+			Name:    pathParams["name"],
+		},
+		Depth: int32(depth),
+	})
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/flyteorg/flyte/flyteidl/clients/go/admin/mocks"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+func newExecutionMetricsMux(t *testing.T) *runtime.ServeMux {
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	span := &core.Span{
+		StartTime: timestamppb.New(startTime),
+		EndTime:   timestamppb.New(startTime.Add(time.Minute)),
+		Id: &core.Span_WorkflowId{WorkflowId: &core.WorkflowExecutionIdentifier{
+			Project: "flytesnacks", Domain: "development", Name: "f8a8c3e2b1"}},
+	}
+
+	client := &mocks.AdminServiceClient{}
+	client.EXPECT().GetExecutionMetrics(mock.Anything, mock.MatchedBy(func(req *admin.WorkflowExecutionGetMetricsRequest) bool {
+		return req.GetId().GetName() == "f8a8c3e2b1"
+	})).RunAndReturn(func(_ context.Context, req *admin.WorkflowExecutionGetMetricsRequest, _ ...grpc.CallOption) (
+		*admin.WorkflowExecutionGetMetricsResponse, error) {
+		assert.Equal(t, "flytesnacks", req.GetId().GetProject())
+		assert.Equal(t, "development", req.GetId().GetDomain())
+		return &admin.WorkflowExecutionGetMetricsResponse{Span: span}, nil
+	})
+	client.EXPECT().GetExecutionMetrics(mock.Anything, mock.MatchedBy(func(req *admin.WorkflowExecutionGetMetricsRequest) bool {
+		return req.GetId().GetName() == "missing"
+	})).Return(nil, status.Error(codes.NotFound, "execution not found"))
+
+	gwmux := runtime.NewServeMux()
+	assert.NoError(t, gwmux.HandlePath(http.MethodGet, executionMetricsExportPath,
+		GetExecutionMetricsExportHandler(gwmux, client)))
+	return gwmux
+}
+
+func serveExecutionMetrics(gwmux *runtime.ServeMux, target string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	gwmux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+	return recorder
+}
+
+func TestGetExecutionMetricsExportHandler(t *testing.T) {
+	gwmux := newExecutionMetricsMux(t)
+
+	t.Run("chrome trace", func(t *testing.T) {
+		resp := serveExecutionMetrics(gwmux, "/api/v1/metrics/executions/flytesnacks/development/f8a8c3e2b1/export")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, `attachment; filename="f8a8c3e2b1-chrome-trace.json"`, resp.Header().Get("Content-Disposition"))
+		assert.True(t, json.Valid(resp.Body.Bytes()))
+	})
+	t.Run("otlp", func(t *testing.T) {
+		resp := serveExecutionMetrics(gwmux,
+			"/api/v1/metrics/executions/flytesnacks/development/f8a8c3e2b1/export?format=otlp&depth=5")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, `attachment; filename="f8a8c3e2b1-otlp.json"`, resp.Header().Get("Content-Disposition"))
+	})
+	t.Run("not found", func(t *testing.T) {
+		resp := serveExecutionMetrics(gwmux, "/api/v1/metrics/executions/flytesnacks/development/missing/export")
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+	t.Run("invalid format", func(t *testing.T) {
+		resp := serveExecutionMetrics(gwmux,
+			"/api/v1/metrics/executions/flytesnacks/development/f8a8c3e2b1/export?format=zipkin")
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("invalid depth", func(t *testing.T) {
+		resp := serveExecutionMetrics(gwmux,
+			"/api/v1/metrics/executions/flytesnacks/development/f8a8c3e2b1/export?depth=deep")
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, resp.Body.String(), "invalid depth [deep]")
+	})
+}
@@ -287,6 +287,11 @@ func newHTTPServer(ctx context.Context, pluginRegistry *plugins.Registry, cfg *c
 		return nil, errors.Wrap(err, "error registering signal service")
 	}
 
+	err = registerExecutionMetricsHandlers(ctx, gwmux, grpcAddress, grpcConnectionOpts)
+	if err != nil {
+		return nil, errors.Wrap(err, "error registering execution metrics handlers")
+	}
+
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		ctx := GetOrGenerateRequestIDForRequest(r)
 		gwmux.ServeHTTP(w, r.WithContext(ctx))
@@ -19,8 +19,8 @@ import (
 	"github.com/flyteorg/flyte/flytestdlib/promutils"
 )
 
-// goCronMetrics mertrics recorded for go cron.
-type goCronMetrics struct {
+// GoCronMetrics mertrics recorded for go cron.
+type GoCronMetrics struct {
 	Scope                     promutils.Scope
 	JobFuncPanicCounter       prometheus.Counter
 	JobScheduledFailedCounter prometheus.Counter
@@ -31,7 +31,7 @@ type goCronMetrics struct {
 type GoCronScheduler struct {
 	cron        *cron.Cron
 	jobStore    sync.Map
-	metrics     goCronMetrics
+	metrics     GoCronMetrics
 	rateLimiter *rate.Limiter
 	executor    executor.Executor
 	snapshot    snapshoter.Snapshot
@@ -203,7 +203,8 @@ func (g *GoCronScheduler) CatchupAll(ctx context.Context, until time.Time) bool
 func (g *GoCronScheduler) CatchUpSingleSchedule(ctx context.Context, s models.SchedulableEntity, fromTime time.Time, toTime time.Time) error {
 	var catchUpTimes []time.Time
 	var err error
-	catchUpTimes, err = GetCatchUpTimes(s, fromTime, toTime)
+	// Evaluate schedules without a time zone of their own in the time zone of the scheduler as the cron runner does.
+	catchUpTimes, err = GetCatchUpTimes(s, fromTime.In(g.cron.Location()), toTime)
 	if err != nil {
 		return err
 	}
@@ -220,6 +221,11 @@ func (g *GoCronScheduler) CatchUpSingleSchedule(ctx context.Context, s models.Sc
 	return nil
 }
 
+// Stop stops firing the schedules and waits for the jobs that are running to finish
+func (g *GoCronScheduler) Stop() {
+	<-g.cron.Stop().Done()
+}
+
 // GetCatchUpTimes find list of timestamps to be caught up on for schedule s from fromTime to toTime
 func GetCatchUpTimes(s models.SchedulableEntity, from time.Time, to time.Time) ([]time.Time, error) {
 	var scheduledTimes []time.Time
@@ -238,16 +244,17 @@ func GetCatchUpTimes(s models.SchedulableEntity, from time.Time, to time.Time) (
 	return scheduledTimes, nil
 }
 
-// GetScheduledTime find next schedule time for both cron and fixed rate scheduled entity given the fromTime
+// GetScheduledTime find next schedule time for both cron and fixed rate scheduled entity given the fromTime.
+// Cron schedules are evaluated in the time zone of the schedule, or the location of fromTime if it has none.
 func GetScheduledTime(s models.SchedulableEntity, fromTime time.Time) (time.Time, error) {
 	if len(s.CronExpression) > 0 {
-		return getCronScheduledTime(s.CronExpression, fromTime)
+		return getCronScheduledTime(s.CronExpression, s.TimeZone, fromTime)
 	}
 	return getFixedIntervalScheduledTime(s.Unit, s.FixedRateValue, fromTime)
 }
 
-func getCronScheduledTime(cronString string, fromTime time.Time) (time.Time, error) {
-	sched, err := cron.ParseStandard(cronString)
+func getCronScheduledTime(cronString, timeZone string, fromTime time.Time) (time.Time, error) {
+	sched, err := parseCronSchedule(cronString, timeZone, fromTime.Location())
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -300,14 +307,16 @@ func (g *GoCronScheduler) AddCronJob(ctx context.Context, job *GoCronJob) error
 	var jobFunc cron.TimedFuncJob
 	jobFunc = job.Run
 
-	entryID, err := g.cron.AddTimedJob(job.schedule.CronExpression, jobFunc)
+	sched, err := parseCronSchedule(job.schedule.CronExpression, job.schedule.TimeZone, g.cron.Location())
+	if err != nil {
+		return err
+	}
+	entryID := g.cron.ScheduleTimedJob(sched, jobFunc, time.Time{})
 	// Update the entry id in the job which is handle to be used for removal
 	job.entryID = entryID
-	if err == nil {
-		logger.Infof(ctx, "successfully added the schedule %s to the scheduler for schedule %+v",
-			job.nameOfSchedule, job.schedule)
-	}
-	return err
+	logger.Infof(ctx, "successfully added the schedule %s to the scheduler for schedule %+v",
+		job.nameOfSchedule, job.schedule)
+	return nil
 }
 
 // RemoveCronJob removes the job from the cron store
@@ -333,7 +342,17 @@ func getFixedRateDurationFromSchedule(unit admin.FixedRateUnit, fixedRateValue u
 	return d, nil
 }
 
+// NewGoCronScheduler creates a scheduler bootstrapped with the schedules and snapshot. Cron schedules run in their own
+// time zone, or in UTC or the local time zone of the scheduler depending on useUtcTz if they have none.
 func NewGoCronScheduler(ctx context.Context, schedules []models.SchedulableEntity, scope promutils.Scope,
+	snapshot snapshoter.Snapshot, rateLimiter *rate.Limiter, executor executor.Executor, useUtcTz bool) Scheduler {
+	return NewGoCronSchedulerWithMetrics(ctx, schedules, NewGoCronMetrics(scope), snapshot, rateLimiter, executor,
+		useUtcTz)
+}
+
+// NewGoCronSchedulerWithMetrics creates a scheduler like NewGoCronScheduler that records into already registered
+// metrics, so that a new scheduler can be created for every leadership term of the process.
+func NewGoCronSchedulerWithMetrics(ctx context.Context, schedules []models.SchedulableEntity, metrics GoCronMetrics,
 	snapshot snapshoter.Snapshot, rateLimiter *rate.Limiter, executor executor.Executor, useUtcTz bool) Scheduler {
 	// Create the new cron scheduler and start it off
 	var opts []cron.Option
@@ -345,7 +364,7 @@ func NewGoCronScheduler(ctx context.Context, schedules []models.SchedulableEntit
 	scheduler := &GoCronScheduler{
 		cron:        c,
 		jobStore:    sync.Map{},
-		metrics:     getCronMetrics(scope),
+		metrics:     metrics,
 		rateLimiter: rateLimiter,
 		executor:    executor,
 		snapshot:    snapshot,
@@ -354,8 +373,9 @@ func NewGoCronScheduler(ctx context.Context, schedules []models.SchedulableEntit
 	return scheduler
 }
 
-func getCronMetrics(scope promutils.Scope) goCronMetrics {
-	return goCronMetrics{
+// NewGoCronMetrics registers the go cron metrics in the scope. It must only be called once per scope.
+func NewGoCronMetrics(scope promutils.Scope) GoCronMetrics {
+	return GoCronMetrics{
 		Scope: scope,
 		JobFuncPanicCounter: scope.MustNewCounter("job_func_panic_counter",
 			"count of crashes for the job functions executed by the scheduler"),
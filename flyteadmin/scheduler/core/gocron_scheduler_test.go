@@ -16,6 +16,7 @@ import (
 	adminModels "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/models"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/runtime"
 	"github.com/flyteorg/flyte/flyteadmin/scheduler/executor/mocks"
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/identifier"
 	"github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/models"
 	"github.com/flyteorg/flyte/flyteadmin/scheduler/snapshoter"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
@@ -198,12 +199,52 @@ func TestGetTimedFuncWithSchedule(t *testing.T) {
 
 func TestGetCronScheduledTime(t *testing.T) {
 	fromTime := time.Date(2022, time.January, 27, 19, 0, 0, 0, time.UTC)
-	nextTime, err := getCronScheduledTime("0 19 * * *", fromTime)
+	nextTime, err := getCronScheduledTime("0 19 * * *", "", fromTime)
 	assert.Nil(t, err)
 	expectedNextTime := time.Date(2022, time.January, 28, 19, 0, 0, 0, time.UTC)
 	assert.Equal(t, expectedNextTime, nextTime)
 }
 
+func TestScheduleJobWithTimeZone(t *testing.T) {
+	ctx := context.Background()
+	g := setupWithSchedules(t, "schedule_job_with_time_zone", nil, true)
+	True := true
+	s := models.SchedulableEntity{
+		SchedulableEntityKey: models.SchedulableEntityKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "cron_tz",
+			Version: "version1",
+		},
+		CronExpression: "0 6 * * *",
+		TimeZone:       "America/New_York",
+		Active:         &True,
+	}
+
+	t.Run("valid time zone", func(t *testing.T) {
+		assert.NoError(t, g.ScheduleJob(ctx, s, g.GetTimedFuncWithSchedule(), nil))
+		defer g.DeScheduleJob(ctx, s)
+
+		val, ok := g.jobStore.Load(identifier.GetScheduleName(ctx, s))
+		assert.True(t, ok)
+		entry := g.cron.Entry(val.(*GoCronJob).entryID)
+		expected, err := GetScheduledTime(s, time.Now())
+		assert.NoError(t, err)
+		assert.True(t, expected.Equal(entry.Schedule.Next(time.Now())))
+		newYork, err := time.LoadLocation("America/New_York")
+		assert.NoError(t, err)
+		assert.Equal(t, 6, expected.In(newYork).Hour())
+	})
+
+	t.Run("invalid time zone", func(t *testing.T) {
+		invalid := s
+		invalid.TimeZone = "Mars/Olympus_Mons"
+		assert.Error(t, g.ScheduleJob(ctx, invalid, g.GetTimedFuncWithSchedule(), nil))
+		_, ok := g.jobStore.Load(identifier.GetScheduleName(ctx, invalid))
+		assert.False(t, ok)
+	})
+}
+
 func TestGetCatchUpTimes(t *testing.T) {
 	t.Run("to time before scheduled time", func(t *testing.T) {
 		s := models.SchedulableEntity{
@@ -25,4 +25,6 @@ type Scheduler interface {
 	CalculateSnapshot(ctx context.Context) snapshoter.Snapshot
 	// CatchupAll catches up all the schedules in the schedulers job store to the until time
 	CatchupAll(ctx context.Context, until time.Time) bool
+	// Stop stops firing the schedules and waits for the jobs that are running to finish
+	Stop()
 }
@@ -0,0 +1,128 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	// Embed the IANA time zone database so that per schedule time zones resolve on images without tzdata installed.
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	cronTimeZonePrefix       = "CRON_TZ="
+	legacyCronTimeZonePrefix = "TZ="
+	// allHours has a bit set for every hour of the day in the hour field of a cron spec.
+	allHours = 1<<24 - 1
+	// transitionProbeWindow is how far around a wall clock time offsets are sampled to find the offsets in effect
+	// before and after a time zone transition. Transitions are assumed to be further apart than this.
+	transitionProbeWindow = 24 * time.Hour
+)
+
+// SplitCronTimeZone splits the optional CRON_TZ=<zone> (or TZ=<zone>) prefix from a cron expression and returns the
+// IANA time zone and the remaining expression. The time zone is empty if the expression has no prefix.
+func SplitCronTimeZone(cronExpression string) (timeZone string, expression string) {
+	for _, prefix := range []string{cronTimeZonePrefix, legacyCronTimeZonePrefix} {
+		if strings.HasPrefix(cronExpression, prefix) {
+			fields := strings.SplitN(strings.TrimPrefix(cronExpression, prefix), " ", 2)
+			if len(fields) < 2 {
+				return fields[0], ""
+			}
+			return fields[0], strings.TrimSpace(fields[1])
+		}
+	}
+	return "", cronExpression
+}
+
+// parseCronSchedule parses the cron expression of a schedule and evaluates it in the given time zone. If no time zone
+// is given the one from a CRON_TZ prefix in the expression is used, and defaultLocation otherwise.
+func parseCronSchedule(cronString, timeZone string, defaultLocation *time.Location) (cron.Schedule, error) {
+	sched, err := cron.ParseStandard(cronString)
+	if err != nil {
+		return nil, err
+	}
+	spec, ok := sched.(*cron.SpecSchedule)
+	if !ok {
+		// Descriptors such as @every 1h run at a constant delay and do not depend on the time zone.
+		return sched, nil
+	}
+
+	location := defaultLocation
+	if spec.Location != time.Local {
+		location = spec.Location
+	}
+	if len(timeZone) > 0 {
+		if location, err = time.LoadLocation(timeZone); err != nil {
+			return nil, fmt.Errorf("invalid time zone %s for cron schedule %s: %w", timeZone, cronString, err)
+		}
+	}
+	return newZonedSchedule(spec, location), nil
+}
+
+// zonedSchedule evaluates a cron spec against the wall clock of a time zone and defines the behavior across daylight
+// saving time transitions the same way classic cron implementations do:
+//   - Schedules that fire every hour (e.g. "*/15 * * * *") follow elapsed time. They fire in both occurrences of a
+//     repeated hour and have nothing to fire in a skipped hour.
+//   - Schedules that fire at specific hours (e.g. "0 6 * * *") fire exactly once per matching wall clock time. A wall
+//     clock time that occurs twice when clocks are set back fires on its first occurrence only. A wall clock time that
+//     is skipped when clocks are set forward fires shifted forward by the length of the gap, e.g. 02:30 fires at 03:30
+//     on the day New York switches to daylight saving time.
+type zonedSchedule struct {
+	location *time.Location
+	// elapsed is the spec evaluated in location and is used for schedules that fire every hour.
+	elapsed *cron.SpecSchedule
+	// wallClock is the spec evaluated in UTC against wall clock times of location that are expressed as UTC.
+	wallClock *cron.SpecSchedule
+}
+
+func newZonedSchedule(spec *cron.SpecSchedule, location *time.Location) cron.Schedule {
+	elapsed, wallClock := *spec, *spec
+	elapsed.Location = location
+	wallClock.Location = time.UTC
+	return &zonedSchedule{location: location, elapsed: &elapsed, wallClock: &wallClock}
+}
+
+// Next returns the next activation time strictly after t, in the location of t.
+func (z *zonedSchedule) Next(t time.Time) time.Time {
+	if z.elapsed.Hour&allHours == allHours {
+		return z.elapsed.Next(t)
+	}
+
+	wall := toWallClock(t.In(z.location))
+	for {
+		wall = z.wallClock.Next(wall)
+		if wall.IsZero() {
+			return time.Time{}
+		}
+		// Skip the later occurrence of a repeated wall clock time, which resolves to an instant at or before t.
+		if next := fromWallClock(wall, z.location); next.After(t) {
+			return next.In(t.Location())
+		}
+	}
+}
+
+// toWallClock returns the wall clock time shown by t expressed as a UTC time.
+func toWallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// fromWallClock returns the earliest instant at which clocks in location show the wall clock time, which is expressed
+// as a UTC time. Wall clock times skipped by a transition are resolved using the offset in effect before it, which
+// shifts them forward by the length of the gap.
+func fromWallClock(wall time.Time, location *time.Location) time.Time {
+	var first time.Time
+	for _, probe := range []time.Time{wall.Add(-transitionProbeWindow), wall, wall.Add(transitionProbeWindow)} {
+		_, offset := probe.In(location).Zone()
+		candidate := wall.Add(-time.Duration(offset) * time.Second)
+		if toWallClock(candidate.In(location)).Equal(wall) && (first.IsZero() || candidate.Before(first)) {
+			first = candidate
+		}
+	}
+	if !first.IsZero() {
+		return first
+	}
+
+	_, offsetBefore := wall.Add(-transitionProbeWindow).In(location).Zone()
+	return wall.Add(-time.Duration(offsetBefore) * time.Second)
+}
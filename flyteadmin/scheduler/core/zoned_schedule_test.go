@@ -0,0 +1,127 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/models"
+)
+
+func utc(year int, month time.Month, day, hour, min int) time.Time {
+	return time.Date(year, month, day, hour, min, 0, 0, time.UTC)
+}
+
+func TestSplitCronTimeZone(t *testing.T) {
+	tests := []struct {
+		input              string
+		expectedTimeZone   string
+		expectedExpression string
+	}{
+		{"0 6 * * *", "", "0 6 * * *"},
+		{"CRON_TZ=America/New_York 0 6 * * *", "America/New_York", "0 6 * * *"},
+		{"TZ=Europe/London @daily", "Europe/London", "@daily"},
+		{"CRON_TZ=UTC", "UTC", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			timeZone, expression := SplitCronTimeZone(tt.input)
+			assert.Equal(t, tt.expectedTimeZone, timeZone)
+			assert.Equal(t, tt.expectedExpression, expression)
+		})
+	}
+}
+
+func TestGetCatchUpTimes_TimeZones(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule models.SchedulableEntity
+		from     time.Time
+		to       time.Time
+		expected []time.Time
+	}{
+		{
+			name:     "fixed hour across spring forward",
+			schedule: models.SchedulableEntity{CronExpression: "0 6 * * *", TimeZone: "America/New_York"},
+			from:     utc(2026, time.March, 7, 0, 0),
+			to:       utc(2026, time.March, 10, 0, 0),
+			// 06:00 EST and then 06:00 EDT.
+			expected: []time.Time{utc(2026, time.March, 7, 11, 0), utc(2026, time.March, 8, 10, 0), utc(2026, time.March, 9, 10, 0)},
+		},
+		{
+			name:     "fixed hour across fall back",
+			schedule: models.SchedulableEntity{CronExpression: "0 6 * * *", TimeZone: "Europe/London"},
+			from:     utc(2026, time.October, 24, 12, 0),
+			to:       utc(2026, time.October, 26, 12, 0),
+			// 06:00 BST and then 06:00 GMT.
+			expected: []time.Time{utc(2026, time.October, 25, 6, 0), utc(2026, time.October, 26, 6, 0)},
+		},
+		{
+			name:     "skipped wall clock time is shifted forward",
+			schedule: models.SchedulableEntity{CronExpression: "30 2 * * *", TimeZone: "America/New_York"},
+			from:     utc(2026, time.March, 7, 0, 0),
+			to:       utc(2026, time.March, 10, 0, 0),
+			// 02:30 EST, 03:30 EDT on the day 02:30 does not exist and then 02:30 EDT.
+			expected: []time.Time{utc(2026, time.March, 7, 7, 30), utc(2026, time.March, 8, 7, 30), utc(2026, time.March, 9, 6, 30)},
+		},
+		{
+			name:     "repeated wall clock time fires once",
+			schedule: models.SchedulableEntity{CronExpression: "30 1 * * *", TimeZone: "America/New_York"},
+			from:     utc(2026, time.October, 31, 12, 0),
+			to:       utc(2026, time.November, 2, 12, 0),
+			// The first 01:30 EDT of November 1st fires, the following 01:30 EST does not.
+			expected: []time.Time{utc(2026, time.November, 1, 5, 30), utc(2026, time.November, 2, 6, 30)},
+		},
+		{
+			name:     "hourly schedule follows elapsed time in repeated hour",
+			schedule: models.SchedulableEntity{CronExpression: "0 * * * *", TimeZone: "America/New_York"},
+			from:     utc(2026, time.November, 1, 4, 30),
+			to:       utc(2026, time.November, 1, 7, 30),
+			// 01:00 EDT, 01:00 EST and 02:00 EST.
+			expected: []time.Time{utc(2026, time.November, 1, 5, 0), utc(2026, time.November, 1, 6, 0), utc(2026, time.November, 1, 7, 0)},
+		},
+		{
+			name:     "hourly schedule follows elapsed time in skipped hour",
+			schedule: models.SchedulableEntity{CronExpression: "0 * * * *", TimeZone: "America/New_York"},
+			from:     utc(2026, time.March, 8, 5, 30),
+			to:       utc(2026, time.March, 8, 7, 30),
+			// 01:00 EST and 03:00 EDT.
+			expected: []time.Time{utc(2026, time.March, 8, 6, 0), utc(2026, time.March, 8, 7, 0)},
+		},
+		{
+			name:     "time zone prefix in the expression",
+			schedule: models.SchedulableEntity{CronExpression: "CRON_TZ=Asia/Kolkata 0 6 * * *"},
+			from:     utc(2026, time.January, 1, 0, 0),
+			to:       utc(2026, time.January, 2, 0, 0),
+			expected: []time.Time{utc(2026, time.January, 1, 0, 30)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catchUpTimes, err := GetCatchUpTimes(tt.schedule, tt.from, tt.to)
+			assert.NoError(t, err)
+			assert.Equal(t, len(tt.expected), len(catchUpTimes))
+			for i := range tt.expected {
+				assert.True(t, tt.expected[i].Equal(catchUpTimes[i]), "expected %v got %v", tt.expected[i], catchUpTimes[i])
+			}
+		})
+	}
+
+	t.Run("invalid time zone", func(t *testing.T) {
+		_, err := GetCatchUpTimes(models.SchedulableEntity{CronExpression: "0 6 * * *", TimeZone: "Mars/Olympus_Mons"},
+			utc(2026, time.January, 1, 0, 0), utc(2026, time.January, 2, 0, 0))
+		assert.Error(t, err)
+	})
+}
+
+func TestZonedSchedule_NextIsInLocationOfInput(t *testing.T) {
+	sched, err := parseCronSchedule("0 6 * * *", "America/New_York", time.UTC)
+	assert.NoError(t, err)
+
+	local := time.FixedZone("scheduler", 2*60*60)
+	next := sched.Next(time.Date(2026, time.June, 1, 0, 0, 0, 0, local))
+	assert.Equal(t, local, next.Location())
+	assert.True(t, utc(2026, time.June, 1, 10, 0).Equal(next))
+}
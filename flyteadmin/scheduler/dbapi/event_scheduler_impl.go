@@ -8,6 +8,7 @@ import (
 	scheduleInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/async/schedule/interfaces"
 	repositoryInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
 	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	schedulerCore "github.com/flyteorg/flyte/flyteadmin/scheduler/core"
 	"github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/models"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
@@ -31,7 +32,7 @@ func (s *eventScheduler) CreateScheduleInput(ctx context.Context, appConfig *run
 
 func (s *eventScheduler) AddSchedule(ctx context.Context, input interfaces.AddScheduleInput) error {
 	logger.Infof(ctx, "Received call to add schedule [%+v]", input)
-	var cronString string
+	var cronString, timeZone string
 	var fixedRateValue uint32
 	var fixedRateUnit admin.FixedRateUnit
 	switch v := input.ScheduleExpression.GetScheduleExpression().(type) {
@@ -39,13 +40,15 @@ func (s *eventScheduler) AddSchedule(ctx context.Context, input interfaces.AddSc
 		fixedRateValue = v.Rate.GetValue()
 		fixedRateUnit = v.Rate.GetUnit()
 	case *admin.Schedule_CronSchedule:
-		cronString = v.CronSchedule.GetSchedule()
+		// The time zone of the schedule is given as a CRON_TZ=<zone> prefix of the cron expression.
+		timeZone, cronString = schedulerCore.SplitCronTimeZone(v.CronSchedule.GetSchedule())
 	default:
 		return fmt.Errorf("failed adding schedule for unknown schedule expression type %v", v)
 	}
 	active := true
 	modelInput := models.SchedulableEntity{
 		CronExpression:      cronString,
+		TimeZone:            timeZone,
 		FixedRateValue:      fixedRateValue,
 		Unit:                fixedRateUnit,
 		KickoffTimeInputArg: input.ScheduleExpression.GetKickoffTimeInputArg(),
@@ -11,6 +11,7 @@ import (
 	repositoryInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/interfaces"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/mocks"
 	schedMocks "github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/mocks"
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/models"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 )
@@ -115,6 +116,34 @@ func TestAddSchedule(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("cron_schedule_with_time_zone", func(t *testing.T) {
+		eventScheduler := setupEventScheduler()
+		schedule := &admin.Schedule{
+			ScheduleExpression: &admin.Schedule_CronSchedule{
+				CronSchedule: &admin.CronSchedule{
+					Schedule: "CRON_TZ=America/New_York 0 6 * * *",
+				},
+			},
+			KickoffTimeInputArg: "kickoff_time",
+		}
+
+		scheduleEntitiesRepo := db.SchedulableEntityRepo().(*schedMocks.SchedulableEntityRepoInterface)
+		scheduleEntitiesRepo.EXPECT().Activate(mock.Anything, mock.MatchedBy(func(s models.SchedulableEntity) bool {
+			return s.CronExpression == "0 6 * * *" && s.TimeZone == "America/New_York"
+		})).Return(nil)
+
+		err := eventScheduler.AddSchedule(context.Background(), interfaces.AddScheduleInput{
+			Identifier: &core.Identifier{
+				Project: "project",
+				Domain:  "domain",
+				Name:    "scheduled_wroflow",
+				Version: "v1",
+			},
+			ScheduleExpression: schedule,
+		})
+		assert.Nil(t, err)
+	})
+
 	t.Run("cron_expression_unsupported", func(t *testing.T) {
 		eventScheduler := setupEventScheduler()
 		schedule := &admin.Schedule{
@@ -53,6 +53,13 @@
 //          It accepts
 //   			- Standard crontab specs, e.g. "* * * * ?"
 //   			- Descriptors, e.g. "@midnight", "@every 1h30m"
+//
+//			Each cron schedule can be evaluated in its own IANA time zone by prefixing the expression with CRON_TZ=<zone>,
+//			e.g. "CRON_TZ=America/New_York 0 6 * * *". The zone is stored with the schedule and used for live runs,
+//			catchup and the scheduled time passed to the execution. Schedules without one use UTC or the local zone
+//			of the scheduler based on useUTCTz. Schedules at specific hours fire once per wall clock time across
+//			daylight saving transitions, with skipped times shifted forward by the gap, while hourly schedules follow
+//			elapsed time.
 //		d) Job function :
 //			The job function accepts the scheduleTime and the schedule which is used for creating an execution request
 //			to the admin. Each job function is tied to schedule which gets executed in separate go routine by the gogf
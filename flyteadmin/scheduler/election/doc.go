@@ -0,0 +1,6 @@
+// Package election
+// This package elects a single leader among the replicas of the native scheduler using a lease stored in the scheduler
+// DB. Only the leader runs schedules. Every time the lease changes hands its fencing token is incremented and the
+// leader attaches its token to the executions it launches, so that admin can reject launches from a deposed leader
+// that has not noticed yet that it lost the lease.
+package election
@@ -0,0 +1,160 @@
+package election
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+// ErrLeadershipLost is the cause of the cancellation of the leader context when the lease could not be renewed.
+var ErrLeadershipLost = errors.New("scheduler leadership lost")
+
+type electorMetrics struct {
+	Scope                 promutils.Scope
+	IsLeader              prometheus.Gauge
+	LeadershipAcquired    prometheus.Counter
+	LeadershipLost        prometheus.Counter
+	LeaseOperationFailure prometheus.Counter
+}
+
+// LeaderElector campaigns for the scheduler lease and runs the leader function for as long as it holds it.
+type LeaderElector struct {
+	repo          interfaces.SchedulerLeaseRepoInterface
+	holder        string
+	leaseDuration time.Duration
+	renewDeadline time.Duration
+	retryPeriod   time.Duration
+	metrics       electorMetrics
+}
+
+// Run campaigns for the lease until ctx is done. Whenever this replica becomes the leader, lead is invoked with a context
+// that carries the fencing token of the leadership term and that is cancelled with ErrLeadershipLost as soon as the
+// lease can no longer be renewed, after which the replica campaigns again. Run returns the error of lead if it fails
+// while leading.
+func (e *LeaderElector) Run(ctx context.Context, lead func(ctx context.Context) error) error {
+	logger.Infof(ctx, "Scheduler replica [%s] is campaigning for the lease [%s]", e.holder, common.SchedulerLeaseName)
+	for {
+		lease, ok := e.acquire(ctx)
+		if !ok {
+			return nil
+		}
+
+		if err := e.lead(ctx, lease, lead); err != nil || ctx.Err() != nil {
+			return err
+		}
+	}
+}
+
+// acquire blocks until the lease is acquired or ctx is done.
+func (e *LeaderElector) acquire(ctx context.Context) (models.SchedulerLease, bool) {
+	for {
+		lease, err := e.repo.TryAcquire(ctx, common.SchedulerLeaseName, e.holder, time.Now(), e.leaseDuration)
+		if err != nil {
+			e.metrics.LeaseOperationFailure.Inc()
+			logger.Warnf(ctx, "Failed to acquire the scheduler lease due to %v", err)
+		} else if lease.Holder == e.holder {
+			return lease, true
+		}
+
+		select {
+		case <-ctx.Done():
+			return models.SchedulerLease{}, false
+		case <-time.After(e.retryPeriod):
+		}
+	}
+}
+
+// lead runs the leader function and renews the lease until either the leader function returns or the lease is lost.
+func (e *LeaderElector) lead(ctx context.Context, lease models.SchedulerLease,
+	lead func(ctx context.Context) error) error {
+	logger.Infof(ctx, "Scheduler replica [%s] became the leader with fencing token [%d]", e.holder, lease.Token)
+	e.metrics.LeadershipAcquired.Inc()
+	e.metrics.IsLeader.Set(1)
+	defer e.metrics.IsLeader.Set(0)
+
+	leaderCtx, cancel := context.WithCancelCause(common.WithFencingToken(ctx, lease.Token))
+	defer cancel(nil)
+	done := make(chan error, 1)
+	go func() {
+		done <- lead(leaderCtx)
+	}()
+
+	lastRenewal := time.Now()
+	ticker := time.NewTicker(e.retryPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-done:
+			// The leader function only returns on its own on shutdown or failure, hand over the lease right away.
+			if releaseErr := e.repo.Release(context.Background(), common.SchedulerLeaseName, e.holder, time.Now()); releaseErr != nil {
+				e.metrics.LeaseOperationFailure.Inc()
+				logger.Warnf(ctx, "Failed to release the scheduler lease due to %v", releaseErr)
+			}
+			return err
+		case <-ticker.C:
+			renewed, err := e.repo.TryAcquire(leaderCtx, common.SchedulerLeaseName, e.holder, time.Now(), e.leaseDuration)
+			if err == nil && renewed.Holder == e.holder && renewed.Token == lease.Token {
+				lastRenewal = time.Now()
+				continue
+			}
+
+			if err != nil {
+				e.metrics.LeaseOperationFailure.Inc()
+				logger.Warnf(ctx, "Failed to renew the scheduler lease due to %v", err)
+				if time.Since(lastRenewal) < e.renewDeadline {
+					continue
+				}
+			}
+
+			logger.Warnf(ctx, "Scheduler replica [%s] lost the leadership with fencing token [%d]", e.holder, lease.Token)
+			e.metrics.LeadershipLost.Inc()
+			cancel(ErrLeadershipLost)
+			<-done
+			return nil
+		}
+	}
+}
+
+// NewLeaderElector creates a LeaderElector for this replica of the scheduler.
+func NewLeaderElector(repo interfaces.SchedulerLeaseRepoInterface, cfg runtimeInterfaces.SchedulerLeaderElectionConfig,
+	scope promutils.Scope) (*LeaderElector, error) {
+	if cfg.RetryPeriod.Duration <= 0 || cfg.RenewDeadline.Duration <= cfg.RetryPeriod.Duration ||
+		cfg.LeaseDuration.Duration <= cfg.RenewDeadline.Duration {
+		return nil, fmt.Errorf("invalid scheduler leader election config, expected 0 < retryPeriod [%v] < "+
+			"renewDeadline [%v] < leaseDuration [%v]", cfg.RetryPeriod.Duration, cfg.RenewDeadline.Duration,
+			cfg.LeaseDuration.Duration)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	return &LeaderElector{
+		repo:          repo,
+		holder:        fmt.Sprintf("%s_%s", hostname, uuid.New().String()),
+		leaseDuration: cfg.LeaseDuration.Duration,
+		renewDeadline: cfg.RenewDeadline.Duration,
+		retryPeriod:   cfg.RetryPeriod.Duration,
+		metrics: electorMetrics{
+			Scope:              scope,
+			IsLeader:           scope.MustNewGauge("is_leader", "whether this replica is the leader of the scheduler"),
+			LeadershipAcquired: scope.MustNewCounter("leadership_acquired", "count of times this replica became the leader"),
+			LeadershipLost:     scope.MustNewCounter("leadership_lost", "count of times this replica lost the leadership"),
+			LeaseOperationFailure: scope.MustNewCounter("lease_operation_failure",
+				"count of failures to acquire, renew or release the scheduler lease"),
+		},
+	}, nil
+}
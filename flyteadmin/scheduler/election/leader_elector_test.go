@@ -0,0 +1,182 @@
+package election
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
+	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyte/flytestdlib/config"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+// inMemoryLeaseRepo implements the lease semantics of the gorm repo in memory.
+type inMemoryLeaseRepo struct {
+	mu       sync.Mutex
+	leases   map[string]models.SchedulerLease
+	failures int
+}
+
+func (r *inMemoryLeaseRepo) TryAcquire(_ context.Context, name, holder string, now time.Time,
+	leaseDuration time.Duration) (models.SchedulerLease, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failures > 0 {
+		r.failures--
+		return models.SchedulerLease{}, errors.New("db unavailable")
+	}
+
+	lease, found := r.leases[name]
+	switch {
+	case found && lease.Holder == holder:
+		lease.ExpiresAt = now.Add(leaseDuration)
+	case !found || !lease.ExpiresAt.After(now):
+		lease = models.SchedulerLease{Name: name, Holder: holder, Token: lease.Token + 1, AcquiredAt: now,
+			ExpiresAt: now.Add(leaseDuration)}
+	}
+	r.leases[name] = lease
+	return lease, nil
+}
+
+func (r *inMemoryLeaseRepo) Release(_ context.Context, name, holder string, now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if lease, found := r.leases[name]; found && lease.Holder == holder {
+		lease.ExpiresAt = now
+		r.leases[name] = lease
+	}
+	return nil
+}
+
+func (r *inMemoryLeaseRepo) Get(_ context.Context, name string) (models.SchedulerLease, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.leases[name], nil
+}
+
+func (r *inMemoryLeaseRepo) steal(holder string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lease := r.leases[common.SchedulerLeaseName]
+	lease.Holder = holder
+	lease.Token++
+	r.leases[common.SchedulerLeaseName] = lease
+}
+
+var testElectionConfig = runtimeInterfaces.SchedulerLeaderElectionConfig{
+	Enabled:       true,
+	LeaseDuration: config.Duration{Duration: 300 * time.Millisecond},
+	RenewDeadline: config.Duration{Duration: 100 * time.Millisecond},
+	RetryPeriod:   config.Duration{Duration: 10 * time.Millisecond},
+}
+
+func TestNewLeaderElector_InvalidConfig(t *testing.T) {
+	cfg := testElectionConfig
+	cfg.RenewDeadline = cfg.LeaseDuration
+	_, err := NewLeaderElector(&inMemoryLeaseRepo{}, cfg, promutils.NewTestScope())
+	assert.Error(t, err)
+}
+
+func TestLeaderElector_Run(t *testing.T) {
+	t.Run("single leader", func(t *testing.T) {
+		repo := &inMemoryLeaseRepo{leases: map[string]models.SchedulerLease{}}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var mu sync.Mutex
+		leading := 0
+		tokens := make(chan int64, 2)
+		var wg sync.WaitGroup
+		for i := 0; i < 2; i++ {
+			elector, err := NewLeaderElector(repo, testElectionConfig, promutils.NewTestScope())
+			assert.NoError(t, err)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, elector.Run(ctx, func(ctx context.Context) error {
+					mu.Lock()
+					leading++
+					mu.Unlock()
+					token, _ := common.GetFencingToken(ctx)
+					tokens <- token
+					<-ctx.Done()
+					return nil
+				}))
+			}()
+		}
+
+		assert.Equal(t, int64(1), <-tokens)
+		time.Sleep(5 * testElectionConfig.RetryPeriod.Duration)
+		mu.Lock()
+		assert.Equal(t, 1, leading)
+		mu.Unlock()
+
+		cancel()
+		wg.Wait()
+		lease, _ := repo.Get(context.Background(), common.SchedulerLeaseName)
+		assert.False(t, lease.ExpiresAt.After(time.Now()), "lease is released on shutdown")
+	})
+
+	t.Run("steps down when the lease is taken over", func(t *testing.T) {
+		repo := &inMemoryLeaseRepo{leases: map[string]models.SchedulerLease{}}
+		elector, err := NewLeaderElector(repo, testElectionConfig, promutils.NewTestScope())
+		assert.NoError(t, err)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		causes := make(chan error, 1)
+		go func() {
+			_ = elector.Run(ctx, func(ctx context.Context) error {
+				repo.steal("other")
+				<-ctx.Done()
+				causes <- context.Cause(ctx)
+				cancel()
+				return nil
+			})
+		}()
+
+		select {
+		case cause := <-causes:
+			assert.ErrorIs(t, cause, ErrLeadershipLost)
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "leader did not step down")
+		}
+		lease, _ := repo.Get(context.Background(), common.SchedulerLeaseName)
+		assert.Equal(t, "other", lease.Holder)
+	})
+
+	t.Run("tolerates renewal failures within the renew deadline", func(t *testing.T) {
+		repo := &inMemoryLeaseRepo{leases: map[string]models.SchedulerLease{}}
+		elector, err := NewLeaderElector(repo, testElectionConfig, promutils.NewTestScope())
+		assert.NoError(t, err)
+		ctx, cancel := context.WithTimeout(context.Background(), 20*testElectionConfig.RetryPeriod.Duration)
+		defer cancel()
+
+		terms := 0
+		assert.NoError(t, elector.Run(ctx, func(ctx context.Context) error {
+			terms++
+			repo.mu.Lock()
+			repo.failures = 2
+			repo.mu.Unlock()
+			<-ctx.Done()
+			return nil
+		}))
+		assert.Equal(t, 1, terms)
+	})
+
+	t.Run("leader failure", func(t *testing.T) {
+		repo := &inMemoryLeaseRepo{leases: map[string]models.SchedulerLease{}}
+		elector, err := NewLeaderElector(repo, testElectionConfig, promutils.NewTestScope())
+		assert.NoError(t, err)
+		err = elector.Run(context.Background(), func(ctx context.Context) error {
+			return errors.New("failed to read snapshot")
+		})
+		assert.EqualError(t, err, "failed to read snapshot")
+	})
+}
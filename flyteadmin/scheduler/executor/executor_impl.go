@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/retry"
 
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyte/flyteadmin/scheduler/identifier"
 	"github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/models"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
@@ -106,6 +107,12 @@ func (w *executor) Execute(ctx context.Context, scheduledTime time.Time, s model
 				logger.Debugf(ctx, "duplicate schedule %+v already exists for schedule", s)
 				return false
 			}
+			// Stop retrying once the scheduler is shutting down or admin rejected the launch because this scheduler
+			// is no longer the leader.
+			if ctx.Err() != nil || status.Code(err) == codes.Aborted {
+				logger.Warnf(ctx, "abandoning execution create request %+v due to %v", executionRequest, err)
+				return false
+			}
 			w.metrics.FailedExecutionCounter.Inc()
 			logger.Errorf(ctx, "failed to create execution create request %+v due to %v", executionRequest, err)
 			// TODO: Handle the case when admin launch plan state is archived but the schedule is active.
@@ -113,7 +120,9 @@ func (w *executor) Execute(ctx context.Context, scheduledTime time.Time, s model
 			return true
 		},
 		func() error {
-			_, execErr := w.adminServiceClient.CreateExecution(context.Background(), executionRequest)
+			// Admin applies the concurrency policy of the launch plan, which may queue or skip the execution.
+			callCtx := common.AppendFencingToken(ctx, context.Background())
+			_, execErr := w.adminServiceClient.CreateExecution(callCtx, executionRequest)
 			if isInactiveProjectError(execErr) {
 				logger.Debugf(ctx, "project %+v is inactive, ignoring schedule create failure for %+v", s.Project, s)
 				return nil
@@ -8,8 +8,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/flyteorg/flyte/flyteadmin/pkg/common"
 	"github.com/flyteorg/flyte/flyteadmin/pkg/errors"
 	"github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/models"
 	adminMocks "github.com/flyteorg/flyte/flyteidl/clients/go/admin/mocks"
@@ -110,3 +112,33 @@ func TestIsInactiveProjectError(t *testing.T) {
 
 	assert.True(t, isInactiveProjectError(statusErr.Err()))
 }
+
+func TestExecutorFencing(t *testing.T) {
+	executor := setupExecutor("testExecutor4")
+	active := true
+	schedule := models.SchedulableEntity{
+		SchedulableEntityKey: models.SchedulableEntityKey{
+			Project: "project",
+			Domain:  "domain",
+			Name:    "cron_schedule",
+			Version: "v1",
+		},
+		CronExpression: "*/1 * * * *",
+		Active:         &active,
+	}
+	ctx := common.WithFencingToken(context.Background(), 7)
+
+	t.Run("token is sent", func(t *testing.T) {
+		mockAdminClient.EXPECT().CreateExecution(mock.MatchedBy(func(ctx context.Context) bool {
+			md, ok := metadata.FromOutgoingContext(ctx)
+			return ok && len(md.Get(common.FencingTokenHeader)) == 1 && md.Get(common.FencingTokenHeader)[0] == "7"
+		}), mock.Anything).Return(&admin.ExecutionCreateResponse{}, nil).Once()
+		assert.Nil(t, executor.Execute(ctx, time.Now(), schedule))
+	})
+
+	t.Run("stale token is not retried", func(t *testing.T) {
+		mockAdminClient.EXPECT().CreateExecution(mock.Anything, mock.Anything).Return(nil,
+			errors.NewFlyteAdminErrorf(codes.Aborted, "stale fencing token")).Once()
+		assert.NotNil(t, executor.Execute(ctx, time.Now(), schedule))
+	})
+}
@@ -0,0 +1,93 @@
+package gormimpl
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	flyteSchedulerDbErrors "github.com/flyteorg/flyte/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/interfaces"
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+// SchedulerLeaseRepo Implementation of SchedulerLeaseRepoInterface.
+// Every state change is a single conditional statement so that concurrent replicas can never both acquire the lease.
+type SchedulerLeaseRepo struct {
+	db               *gorm.DB
+	errorTransformer flyteSchedulerDbErrors.ErrorTransformer
+	metrics          gormMetrics
+}
+
+func (r *SchedulerLeaseRepo) TryAcquire(ctx context.Context, name, holder string, now time.Time,
+	leaseDuration time.Duration) (models.SchedulerLease, error) {
+	timer := r.metrics.UpdateDuration.Start()
+	// Renew the lease if this holder already has it.
+	tx := r.db.Model(&models.SchedulerLease{}).Where("name = ? AND holder = ?", name, holder).
+		Updates(map[string]interface{}{"expires_at": now.Add(leaseDuration)})
+	if tx.Error == nil && tx.RowsAffected == 0 {
+		// Take over the lease if it expired, which fences off the previous holder by bumping the token.
+		tx = r.db.Model(&models.SchedulerLease{}).Where("name = ? AND expires_at <= ?", name, now).
+			Updates(map[string]interface{}{
+				"holder":      holder,
+				"token":       gorm.Expr("token + 1"),
+				"acquired_at": now,
+				"expires_at":  now.Add(leaseDuration),
+			})
+	}
+	if tx.Error == nil && tx.RowsAffected == 0 {
+		// Create the lease if no replica ever held it.
+		tx = r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.SchedulerLease{
+			Name:       name,
+			Holder:     holder,
+			Token:      1,
+			AcquiredAt: now,
+			ExpiresAt:  now.Add(leaseDuration),
+		})
+	}
+	timer.Stop()
+	if tx.Error != nil {
+		return models.SchedulerLease{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+
+	return r.Get(ctx, name)
+}
+
+func (r *SchedulerLeaseRepo) Release(ctx context.Context, name, holder string, now time.Time) error {
+	timer := r.metrics.UpdateDuration.Start()
+	tx := r.db.Model(&models.SchedulerLease{}).Where("name = ? AND holder = ?", name, holder).
+		Updates(map[string]interface{}{"expires_at": now})
+	timer.Stop()
+	if tx.Error != nil {
+		return r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return nil
+}
+
+func (r *SchedulerLeaseRepo) Get(ctx context.Context, name string) (models.SchedulerLease, error) {
+	var lease models.SchedulerLease
+	timer := r.metrics.GetDuration.Start()
+	tx := r.db.Where(&models.SchedulerLease{Name: name}).Take(&lease)
+	timer.Stop()
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return models.SchedulerLease{}, flyteSchedulerDbErrors.GetSingletonMissingEntityError("scheduler_leases")
+		}
+		return models.SchedulerLease{}, r.errorTransformer.ToFlyteAdminError(tx.Error)
+	}
+	return lease, nil
+}
+
+// NewSchedulerLeaseRepo Returns an instance of SchedulerLeaseRepoInterface
+func NewSchedulerLeaseRepo(
+	db *gorm.DB, errorTransformer flyteSchedulerDbErrors.ErrorTransformer, scope promutils.Scope) interfaces.SchedulerLeaseRepoInterface {
+	metrics := newMetrics(scope)
+	return &SchedulerLeaseRepo{
+		db:               db,
+		errorTransformer: errorTransformer,
+		metrics:          metrics,
+	}
+}
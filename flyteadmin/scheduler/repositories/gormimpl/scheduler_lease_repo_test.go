@@ -0,0 +1,63 @@
+package gormimpl
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/flyteorg/flyte/flyteadmin/pkg/repositories/errors"
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/models"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+func TestSchedulerLeaseRepo(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "scheduler.db")), &gorm.Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, db.AutoMigrate(&models.SchedulerLease{}))
+	scope := promutils.NewTestScope()
+	repo := NewSchedulerLeaseRepo(db, errors.NewTestErrorTransformer(), scope)
+
+	_, err = repo.Get(ctx, "lease")
+	assert.Error(t, err)
+
+	now := time.Now()
+	lease, err := repo.TryAcquire(ctx, "lease", "a", now, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", lease.Holder)
+	assert.Equal(t, int64(1), lease.Token)
+
+	// Another replica can't take over a lease that didn't expire.
+	lease, err = repo.TryAcquire(ctx, "lease", "b", now.Add(time.Second), time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", lease.Holder)
+
+	// The holder renews the lease without changing the token.
+	lease, err = repo.TryAcquire(ctx, "lease", "a", now.Add(30*time.Second), time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), lease.Token)
+	assert.True(t, lease.ExpiresAt.After(now.Add(time.Minute)))
+
+	// Once expired another replica takes over and the token is incremented.
+	lease, err = repo.TryAcquire(ctx, "lease", "b", now.Add(2*time.Minute), time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "b", lease.Holder)
+	assert.Equal(t, int64(2), lease.Token)
+
+	// Releasing is a no-op for a replica that doesn't hold the lease.
+	assert.NoError(t, repo.Release(ctx, "lease", "a", now.Add(2*time.Minute)))
+	lease, err = repo.TryAcquire(ctx, "lease", "a", now.Add(2*time.Minute), time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "b", lease.Holder)
+
+	assert.NoError(t, repo.Release(ctx, "lease", "b", now.Add(2*time.Minute)))
+	lease, err = repo.TryAcquire(ctx, "lease", "a", now.Add(2*time.Minute), time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", lease.Holder)
+	assert.Equal(t, int64(3), lease.Token)
+}
@@ -0,0 +1,24 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/models"
+)
+
+//go:generate mockery --name=SchedulerLeaseRepoInterface --output=../mocks --case=underscore --with-expecter
+
+// SchedulerLeaseRepoInterface : An Interface for interacting with the scheduler leases in the database
+type SchedulerLeaseRepoInterface interface {
+
+	// TryAcquire renews the lease if it is held by holder, or takes it over if it is missing or expired. It returns the
+	// lease as stored after the attempt, which names holder only if the attempt succeeded.
+	TryAcquire(ctx context.Context, name, holder string, now time.Time, leaseDuration time.Duration) (models.SchedulerLease, error)
+
+	// Release expires the lease if it is still held by holder so that another replica can take it over right away.
+	Release(ctx context.Context, name, holder string, now time.Time) error
+
+	// Get the lease from the database store.
+	Get(ctx context.Context, name string) (models.SchedulerLease, error)
+}
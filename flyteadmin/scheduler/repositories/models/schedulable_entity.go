@@ -14,6 +14,8 @@ type SchedulableEntity struct {
 	Unit                admin.FixedRateUnit
 	KickoffTimeInputArg string
 	Active              *bool
+	// IANA time zone the cron expression is evaluated in, e.g. America/New_York. Empty uses the scheduler default.
+	TimeZone string
 }
 
 // Schedulable entity primary key
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// SchedulerLease is the database model of the lease that elects the leader among the replicas of the native scheduler.
+type SchedulerLease struct {
+	Name string `gorm:"primary_key"`
+	// Holder identifies the scheduler replica holding the lease.
+	Holder string
+	// Token is incremented every time the lease changes hands and is used to fence the launches of deposed leaders.
+	Token      int64
+	AcquiredAt time.Time
+	ExpiresAt  time.Time
+}
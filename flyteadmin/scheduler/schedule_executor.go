@@ -2,6 +2,7 @@ package scheduler
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"golang.org/x/time/rate"
@@ -9,6 +10,7 @@ import (
 
 	runtimeInterfaces "github.com/flyteorg/flyte/flyteadmin/pkg/runtime/interfaces"
 	"github.com/flyteorg/flyte/flyteadmin/scheduler/core"
+	"github.com/flyteorg/flyte/flyteadmin/scheduler/election"
 	"github.com/flyteorg/flyte/flyteadmin/scheduler/executor"
 	repositoryInterfaces "github.com/flyteorg/flyte/flyteadmin/scheduler/repositories/interfaces"
 	"github.com/flyteorg/flyte/flyteadmin/scheduler/snapshoter"
@@ -31,9 +33,29 @@ type ScheduledExecutor struct {
 	scope                  promutils.Scope
 	adminServiceClient     service.AdminServiceClient
 	workflowExecutorConfig *runtimeInterfaces.FlyteWorkflowExecutorConfig
+	// The executor and the cron metrics outlive the leadership terms, each term only gets its own cron scheduler.
+	executor    executor.Executor
+	cronMetrics core.GoCronMetrics
 }
 
+// Run runs the scheduler until ctx is done. With leader election enabled the schedules are only run while this replica
+// is the leader.
 func (w *ScheduledExecutor) Run(ctx context.Context) error {
+	leaderElectionConfig := w.workflowExecutorConfig.GetLeaderElection()
+	if !leaderElectionConfig.Enabled {
+		return w.run(ctx)
+	}
+
+	elector, err := election.NewLeaderElector(w.db.SchedulerLeaseRepo(), leaderElectionConfig,
+		w.scope.NewSubScope("leader_election"))
+	if err != nil {
+		logger.Errorf(ctx, "unable to create the scheduler leader elector due to %v. Aborting", err)
+		return err
+	}
+	return elector.Run(ctx, w.run)
+}
+
+func (w *ScheduledExecutor) run(ctx context.Context) error {
 	logger.Infof(ctx, "Flyte native scheduler started successfully")
 
 	defer logger.Infof(ctx, "Flyte native scheduler shutdown")
@@ -60,15 +82,13 @@ func (w *ScheduledExecutor) Run(ctx context.Context) error {
 	// Set the rate limit on the admin
 	rateLimiter := rate.NewLimiter(adminRateLimit.GetTps(), adminRateLimit.GetBurst())
 
-	// Set the executor to send executions to admin
-	executor := executor.New(w.scope, w.adminServiceClient)
-
 	// Create the scheduler using GoCronScheduler implementation
 	// Also Bootstrap the schedules from the snapshot
 	bootStrapCtx, bootStrapCancel := context.WithCancel(ctx)
 	defer bootStrapCancel()
 	useUtcTz := w.workflowExecutorConfig.UseUTCTz
-	gcronScheduler := core.NewGoCronScheduler(bootStrapCtx, schedules, w.scope, snapshot, rateLimiter, executor, useUtcTz)
+	gcronScheduler := core.NewGoCronSchedulerWithMetrics(bootStrapCtx, schedules, w.cronMetrics, snapshot, rateLimiter,
+		w.executor, useUtcTz)
 	w.scheduler = gcronScheduler
 
 	// Start the go routine to write the update schedules periodically
@@ -100,6 +120,12 @@ func (w *ScheduledExecutor) Run(ctx context.Context) error {
 	wait.UntilWithContext(snapshoterCtx, snapshotRunner.Run, snapshotWriterDuration)
 	<-ctx.Done()
 
+	gcronScheduler.Stop()
+	// Record where the schedules are at so that the next leader resumes from there, unless another replica already
+	// took over the lease.
+	if !errors.Is(context.Cause(ctx), election.ErrLeadershipLost) {
+		snapshotRunner.Run(context.Background())
+	}
 	return nil
 }
 
@@ -112,5 +138,7 @@ func NewScheduledExecutor(db repositoryInterfaces.SchedulerRepoInterface,
 		adminServiceClient:     adminServiceClient,
 		workflowExecutorConfig: workflowExecutorConfig.GetFlyteWorkflowExecutorConfig(),
 		snapshoter:             snapshoter.New(scope, db),
+		executor:               executor.New(scope, adminServiceClient),
+		cronMetrics:            core.NewGoCronMetrics(scope),
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/flyteorg/flyte/flyteadmin/scheduler/snapshoter"
 	adminMocks "github.com/flyteorg/flyte/flyteidl/clients/go/admin/mocks"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flytestdlib/config"
 	"github.com/flyteorg/flyte/flytestdlib/promutils"
 )
 
@@ -181,3 +183,48 @@ func TestSuccessfulSchedulerExec(t *testing.T) {
 		}()
 	})
 }
+
+func TestSchedulerExecRegainsLeadership(t *testing.T) {
+	scheduleExecutor := setupScheduleExecutor(t, "regains_leadership")
+	scheduleExecutor.workflowExecutorConfig.LeaderElection = runtimeInterfaces.SchedulerLeaderElectionConfig{
+		Enabled:       true,
+		LeaseDuration: config.Duration{Duration: time.Second},
+		RenewDeadline: config.Duration{Duration: 500 * time.Millisecond},
+		RetryPeriod:   config.Duration{Duration: 50 * time.Millisecond},
+	}
+
+	scheduleEntitiesRepo := db.SchedulableEntityRepo().(*schedMocks.SchedulableEntityRepoInterface)
+	scheduleEntitiesRepo.EXPECT().GetAll(mock.Anything).Return(nil, nil)
+
+	// Lead for a few renewals, lose the lease to another replica and then take it over again.
+	var calls atomic.Int32
+	leaseRepo := db.SchedulerLeaseRepo().(*schedMocks.SchedulerLeaseRepoInterface)
+	leaseRepo.EXPECT().TryAcquire(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, name, holder string, now time.Time, leaseDuration time.Duration) (models.SchedulerLease, error) {
+			switch n := calls.Add(1); {
+			case n <= 3:
+				return models.SchedulerLease{Name: name, Holder: holder, Token: 1}, nil
+			case n == 4:
+				return models.SchedulerLease{Name: name, Holder: "other", Token: 2}, nil
+			default:
+				return models.SchedulerLease{Name: name, Holder: holder, Token: 3}, nil
+			}
+		})
+	leaseRepo.EXPECT().Release(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- scheduleExecutor.Run(ctx)
+	}()
+
+	// Wait for the lease to be renewed in the second term, which happens once the scheduler is started again.
+	assert.Eventually(t, func() bool { return calls.Load() >= 7 }, 10*time.Second, 10*time.Millisecond)
+	cancel()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "scheduler did not stop")
+	}
+}
@@ -0,0 +1,153 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	config "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/apply"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/cmd/update"
+	cmdUtil "github.com/flyteorg/flyte/flytectl/pkg/commandutils"
+	"github.com/spf13/pflag"
+)
+
+const (
+	applyShort = `Applies a declarative manifest of projects, matchable attributes and launch plan states.`
+	applyLong  = `
+Reads the resources declared in one or more manifest files, computes the changes needed to bring Flyte in line with
+them, shows these changes and applies them. Applying the same manifest again makes no changes.
+
+A manifest is a yaml file holding one or more resources separated by "---". Each resource has a kind and a spec.
+Matchable attribute specs use the same format as the attribute files of the corresponding update command, e.g.
+'flytectl update task-resource-attribute'. Omit the domain to declare project-level attributes.
+
+.. code-block:: yaml
+
+    kind: Project
+    spec:
+      id: flytesnacks
+      name: flytesnacks
+      description: Flyte examples
+      labels:
+        team: ml
+    ---
+    kind: TaskResourceAttribute
+    spec:
+      project: flytesnacks
+      domain: development
+      defaults:
+        cpu: "1"
+        memory: 150Mi
+      limits:
+        cpu: "2"
+        memory: 450Mi
+    ---
+    kind: LaunchPlan
+    spec:
+      project: flytesnacks
+      domain: development
+      name: core.basic.lp.go_greet
+      version: v1
+      active: true
+
+The supported kinds are Project, LaunchPlan, TaskResourceAttribute, ClusterResourceAttribute, ExecutionQueueAttribute,
+ExecutionClusterLabel, PluginOverride, WorkflowExecutionConfig and ProjectQuotaAttribute.
+
+Apply all manifests in a directory:
+::
+
+ flytectl apply -f manifests/
+
+Print the changes without applying them:
+::
+
+ flytectl apply -f manifests/ --dryRun
+
+With prune, the matchable attributes of the declared projects which are not declared are deleted and their active launch
+plans which are not declared are deactivated. Projects are never deleted.
+::
+
+ flytectl apply -f manifests/ --prune --force
+
+Usage
+`
+)
+
+// applyFlags adds the -f shorthand to the files flag.
+type applyFlags struct{}
+
+func (applyFlags) GetPFlagSet(prefix string) *pflag.FlagSet {
+	flags := config.DefaultConfig.GetPFlagSet(prefix)
+	flags.Lookup("files").Shorthand = "f"
+	return flags
+}
+
+func applyFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	paths := append(append([]string{}, config.DefaultConfig.Files...), args...)
+	if len(paths) == 0 {
+		return fmt.Errorf("at least one manifest file or directory is required")
+	}
+	m, err := loadManifest(paths)
+	if err != nil {
+		return fmt.Errorf("failed to load manifest: %w", err)
+	}
+
+	changes, err := plan(ctx, cmdCtx, m, config.DefaultConfig.Prune)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		fmt.Printf("No changes detected. Skipping the apply.\n")
+		return nil
+	}
+	if err := printPlan(changes); err != nil {
+		return err
+	}
+
+	if config.DefaultConfig.DryRun {
+		fmt.Printf("skipping apply (DryRun)\n")
+		return nil
+	}
+	if !config.DefaultConfig.Force && !cmdUtil.AskForConfirmation("Continue?", os.Stdin) {
+		return fmt.Errorf("apply aborted by user")
+	}
+
+	for i, c := range changes {
+		if err := c.apply(ctx, cmdCtx); err != nil {
+			return fmt.Errorf("failed to %s, %d of %d changes applied: %w", c, i, len(changes), err)
+		}
+		fmt.Printf("applied %s\n", c)
+	}
+	fmt.Printf("Applied %d changes.\n", len(changes))
+	return nil
+}
+
+func printPlan(changes []change) error {
+	counts := map[action]int{}
+	for _, c := range changes {
+		counts[c.action]++
+		patch, err := update.DiffAsYaml(fmt.Sprintf("%s %s", c.kind, c.id), fmt.Sprintf("%s %s", c.kind, c.id),
+			c.before, c.after)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s %s %s:\n%s\n", c.action, c.kind, c.id, patch)
+	}
+	fmt.Printf("Plan: %d to create, %d to update, %d to delete.\n", counts[actionCreate], counts[actionUpdate],
+		counts[actionDelete])
+	return nil
+}
+
+// CreateApplyCommand will return the apply command
+func CreateApplyCommand() map[string]cmdCore.CommandEntry {
+	return map[string]cmdCore.CommandEntry{
+		"apply": {
+			Short:                    applyShort,
+			Long:                     applyLong,
+			CmdFunc:                  applyFunc,
+			PFlagProvider:            applyFlags{},
+			ProjectDomainNotRequired: true,
+		},
+	}
+}
@@ -0,0 +1,164 @@
+package apply
+
+import (
+	"testing"
+
+	config "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/apply"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flytectl/pkg/ext"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testProject = "flytesnacks"
+	testDomain  = "development"
+	testLPName  = "core.basic.lp.go_greet"
+)
+
+var (
+	liveProject = &admin.Project{
+		Id:          testProject,
+		Name:        testProject,
+		Description: "Flyte examples",
+		Labels:      &admin.Labels{Values: map[string]string{"team": "ml"}},
+		Domains:     []*admin.Domain{{Id: testDomain, Name: testDomain}},
+	}
+	liveTaskResources = &admin.MatchingAttributes{
+		Target: &admin.MatchingAttributes_TaskResourceAttributes{
+			TaskResourceAttributes: &admin.TaskResourceAttributes{
+				Defaults: &admin.TaskResourceSpec{Cpu: "1", Memory: "150Mi"},
+				Limits:   &admin.TaskResourceSpec{Cpu: "2", Memory: "450Mi"},
+			},
+		},
+	}
+	liveExecutionQueue = &admin.MatchingAttributes{
+		Target: &admin.MatchingAttributes_ExecutionQueueAttributes{
+			ExecutionQueueAttributes: &admin.ExecutionQueueAttributes{Tags: []string{"foo"}},
+		},
+	}
+)
+
+func setupApply(t *testing.T, cfg config.Config) testutils.TestStruct {
+	s := testutils.Setup(t)
+	cfg.Files = []string{"testdata/manifests"}
+	*config.DefaultConfig = cfg
+	t.Cleanup(func() {
+		*config.DefaultConfig = config.Config{}
+	})
+	return s
+}
+
+func mockLiveState(s testutils.TestStruct, project *admin.Project, taskResources, executionQueue *admin.MatchingAttributes,
+	lpState admin.LaunchPlanState) {
+	if project != nil {
+		s.FetcherExt.EXPECT().GetProjectByID(s.Ctx, testProject).Return(project, nil)
+	} else {
+		s.FetcherExt.EXPECT().GetProjectByID(s.Ctx, testProject).Return(nil, ext.NewNotFoundError("project %s", testProject))
+	}
+	if taskResources != nil {
+		s.FetcherExt.EXPECT().FetchProjectDomainAttributes(s.Ctx, testProject, testDomain, admin.MatchableResource_TASK_RESOURCE).
+			Return(&admin.ProjectDomainAttributesGetResponse{Attributes: &admin.ProjectDomainAttributes{
+				MatchingAttributes: taskResources,
+			}}, nil)
+	} else {
+		s.FetcherExt.EXPECT().FetchProjectDomainAttributes(s.Ctx, testProject, testDomain, admin.MatchableResource_TASK_RESOURCE).
+			Return(nil, ext.NewNotFoundError("attribute"))
+	}
+	s.FetcherExt.EXPECT().FetchProjectAttributes(s.Ctx, testProject, admin.MatchableResource_EXECUTION_QUEUE).
+		Return(&admin.ProjectAttributesGetResponse{Attributes: &admin.ProjectAttributes{
+			MatchingAttributes: executionQueue,
+		}}, nil)
+	s.FetcherExt.EXPECT().FetchLPVersion(s.Ctx, testLPName, "v1", testProject, testDomain).
+		Return(&admin.LaunchPlan{Closure: &admin.LaunchPlanClosure{State: lpState}}, nil)
+}
+
+func TestApplyNoChanges(t *testing.T) {
+	s := setupApply(t, config.Config{})
+	mockLiveState(s, liveProject, liveTaskResources, liveExecutionQueue, admin.LaunchPlanState_ACTIVE)
+
+	err := applyFunc(s.Ctx, nil, s.CmdCtx)
+	assert.NoError(t, err)
+	s.TearDownAndVerify(t, "No changes detected. Skipping the apply.")
+}
+
+func TestApply(t *testing.T) {
+	s := setupApply(t, config.Config{Force: true})
+	mockLiveState(s, nil, nil, &admin.MatchingAttributes{
+		Target: &admin.MatchingAttributes_ExecutionQueueAttributes{
+			ExecutionQueueAttributes: &admin.ExecutionQueueAttributes{Tags: []string{"bar"}},
+		},
+	}, admin.LaunchPlanState_INACTIVE)
+	s.MockAdminClient.EXPECT().RegisterProject(s.Ctx, mock.MatchedBy(func(r *admin.ProjectRegisterRequest) bool {
+		return r.GetProject().GetId() == testProject && r.GetProject().GetLabels().GetValues()["team"] == "ml"
+	})).Return(&admin.ProjectRegisterResponse{}, nil)
+	s.UpdaterExt.EXPECT().UpdateProjectDomainAttributes(s.Ctx, testProject, testDomain,
+		mock.MatchedBy(func(a *admin.MatchingAttributes) bool { return proto.Equal(liveTaskResources, a) })).Return(nil)
+	s.UpdaterExt.EXPECT().UpdateProjectAttributes(s.Ctx, testProject,
+		mock.MatchedBy(func(a *admin.MatchingAttributes) bool { return proto.Equal(liveExecutionQueue, a) })).Return(nil)
+	s.MockAdminClient.EXPECT().UpdateLaunchPlan(s.Ctx, &admin.LaunchPlanUpdateRequest{
+		Id:    launchPlanID(testProject, testDomain, testLPName, "v1"),
+		State: admin.LaunchPlanState_ACTIVE,
+	}).Return(&admin.LaunchPlanUpdateResponse{}, nil)
+
+	err := applyFunc(s.Ctx, nil, s.CmdCtx)
+	assert.NoError(t, err)
+	s.MockAdminClient.AssertNotCalled(t, "UpdateProject", mock.Anything, mock.Anything)
+	s.TearDownAndVerifyContains(t, "Plan: 2 to create, 2 to update, 0 to delete.")
+}
+
+func TestApplyDryRun(t *testing.T) {
+	s := setupApply(t, config.Config{DryRun: true})
+	mockLiveState(s, &admin.Project{Id: testProject, Name: "old"}, liveTaskResources, liveExecutionQueue,
+		admin.LaunchPlanState_ACTIVE)
+
+	err := applyFunc(s.Ctx, nil, s.CmdCtx)
+	assert.NoError(t, err)
+	s.MockAdminClient.AssertNotCalled(t, "UpdateProject", mock.Anything, mock.Anything)
+	s.TearDownAndVerifyContains(t, "skipping apply (DryRun)")
+}
+
+func TestApplyPrune(t *testing.T) {
+	s := setupApply(t, config.Config{Force: true, Prune: true})
+	mockLiveState(s, liveProject, liveTaskResources, liveExecutionQueue, admin.LaunchPlanState_ACTIVE)
+	s.MockAdminClient.EXPECT().ListMatchableAttributes(s.Ctx, mock.MatchedBy(func(r *admin.ListMatchableAttributesRequest) bool {
+		return r.GetResourceType() == admin.MatchableResource_TASK_RESOURCE
+	})).Return(&admin.ListMatchableAttributesResponse{Configurations: []*admin.MatchableAttributesConfiguration{
+		{Project: testProject, Domain: testDomain, Attributes: liveTaskResources},
+		{Project: testProject, Domain: testDomain, Workflow: "core.basic.hello", Attributes: liveTaskResources},
+		{Project: testProject, Domain: testDomain, Workflow: "core.basic.hello", LaunchPlan: testLPName,
+			Attributes: liveTaskResources},
+		{Project: "other", Domain: testDomain, Attributes: liveTaskResources},
+	}}, nil)
+	s.MockAdminClient.EXPECT().ListMatchableAttributes(s.Ctx, mock.Anything).
+		Return(&admin.ListMatchableAttributesResponse{}, nil)
+	s.FetcherExt.EXPECT().GetDomains(s.Ctx).Return(&admin.GetDomainsResponse{Domains: []*admin.Domain{{Id: testDomain}}}, nil)
+	s.MockAdminClient.EXPECT().ListActiveLaunchPlans(s.Ctx, &admin.ActiveLaunchPlanListRequest{
+		Project: testProject,
+		Domain:  testDomain,
+		Limit:   listPageSize,
+	}).Return(&admin.LaunchPlanList{LaunchPlans: []*admin.LaunchPlan{
+		{Id: launchPlanID(testProject, testDomain, testLPName, "v1")},
+		{Id: launchPlanID(testProject, testDomain, "core.basic.lp.undeclared", "v3")},
+	}}, nil)
+	s.DeleterExt.EXPECT().DeleteWorkflowAttributes(s.Ctx, testProject, testDomain, "core.basic.hello",
+		admin.MatchableResource_TASK_RESOURCE).Return(nil)
+	s.MockAdminClient.EXPECT().UpdateLaunchPlan(s.Ctx, &admin.LaunchPlanUpdateRequest{
+		Id:    launchPlanID(testProject, testDomain, "core.basic.lp.undeclared", "v3"),
+		State: admin.LaunchPlanState_INACTIVE,
+	}).Return(&admin.LaunchPlanUpdateResponse{}, nil)
+
+	err := applyFunc(s.Ctx, nil, s.CmdCtx)
+	assert.NoError(t, err)
+	s.DeleterExt.AssertNumberOfCalls(t, "DeleteWorkflowAttributes", 1)
+	s.MockAdminClient.AssertNumberOfCalls(t, "UpdateLaunchPlan", 1)
+	s.TearDownAndVerifyContains(t, "Plan: 0 to create, 0 to update, 2 to delete.")
+}
+
+func TestApplyRequiresFiles(t *testing.T) {
+	s := testutils.Setup(t)
+	err := applyFunc(s.Ctx, nil, s.CmdCtx)
+	assert.ErrorContains(t, err, "at least one manifest file or directory is required")
+}
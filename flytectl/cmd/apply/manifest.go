@@ -0,0 +1,308 @@
+package apply
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/clusterresourceattribute"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/executionclusterlabel"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/executionqueueattribute"
+	pluginoverride "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/plugin_override"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/projectquotaattribute"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/taskresourceattribute"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/workflowexecutionconfig"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	yamlv3 "gopkg.in/yaml.v3"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	kindProject                  = "Project"
+	kindLaunchPlan               = "LaunchPlan"
+	kindTaskResourceAttribute    = "TaskResourceAttribute"
+	kindClusterResourceAttribute = "ClusterResourceAttribute"
+	kindExecutionQueueAttribute  = "ExecutionQueueAttribute"
+	kindExecutionClusterLabel    = "ExecutionClusterLabel"
+	kindPluginOverride           = "PluginOverride"
+	kindWorkflowExecutionConfig  = "WorkflowExecutionConfig"
+	kindProjectQuotaAttribute    = "ProjectQuotaAttribute"
+)
+
+// attributeKind describes a kind of matchable attributes. Its spec is the attribute file format of the matching
+// flytectl update command.
+type attributeKind struct {
+	resourceType admin.MatchableResource
+	newSpec      func() attributeSpec
+}
+
+type attributeSpec interface {
+	Decorate() *admin.MatchingAttributes
+}
+
+// attributeKinds lists the matchable attribute kinds in the order in which they are applied.
+var attributeKinds = []string{
+	kindTaskResourceAttribute,
+	kindClusterResourceAttribute,
+	kindExecutionQueueAttribute,
+	kindExecutionClusterLabel,
+	kindPluginOverride,
+	kindWorkflowExecutionConfig,
+	kindProjectQuotaAttribute,
+}
+
+var attributeKindsByName = map[string]attributeKind{
+	kindTaskResourceAttribute: {
+		resourceType: admin.MatchableResource_TASK_RESOURCE,
+		newSpec:      func() attributeSpec { return &taskresourceattribute.TaskResourceAttrFileConfig{} },
+	},
+	kindClusterResourceAttribute: {
+		resourceType: admin.MatchableResource_CLUSTER_RESOURCE,
+		newSpec:      func() attributeSpec { return &clusterresourceattribute.AttrFileConfig{} },
+	},
+	kindExecutionQueueAttribute: {
+		resourceType: admin.MatchableResource_EXECUTION_QUEUE,
+		newSpec:      func() attributeSpec { return &executionqueueattribute.AttrFileConfig{} },
+	},
+	kindExecutionClusterLabel: {
+		resourceType: admin.MatchableResource_EXECUTION_CLUSTER_LABEL,
+		newSpec:      func() attributeSpec { return &executionclusterlabel.FileConfig{} },
+	},
+	kindPluginOverride: {
+		resourceType: admin.MatchableResource_PLUGIN_OVERRIDE,
+		newSpec:      func() attributeSpec { return &pluginoverride.FileConfig{} },
+	},
+	kindWorkflowExecutionConfig: {
+		resourceType: admin.MatchableResource_WORKFLOW_EXECUTION_CONFIG,
+		newSpec:      func() attributeSpec { return &workflowexecutionconfig.FileConfig{} },
+	},
+	kindProjectQuotaAttribute: {
+		resourceType: admin.MatchableResource_PROJECT_QUOTA,
+		newSpec:      func() attributeSpec { return &projectquotaattribute.ProjectQuotaAttrFileConfig{} },
+	},
+}
+
+// document is a single resource of a manifest file.
+type document struct {
+	Kind string          `json:"kind"`
+	Spec json.RawMessage `json:"spec"`
+}
+
+// ProjectSpec declares a project.
+type ProjectSpec struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Archived    bool              `json:"archived,omitempty"`
+}
+
+// LaunchPlanSpec declares whether a launch plan version is active.
+type LaunchPlanSpec struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Active  bool   `json:"active"`
+}
+
+// attributeScope is the target of a matchable attribute spec.
+type attributeScope struct {
+	Project  string `json:"project"`
+	Domain   string `json:"domain"`
+	Workflow string `json:"workflow,omitempty"`
+}
+
+func (s attributeScope) String() string {
+	parts := []string{s.Project}
+	if len(s.Domain) > 0 {
+		parts = append(parts, s.Domain)
+	}
+	if len(s.Workflow) > 0 {
+		parts = append(parts, s.Workflow)
+	}
+	return strings.Join(parts, "/")
+}
+
+// attributeSpecEntry is a declared matchable attribute.
+type attributeSpecEntry struct {
+	kind  string
+	scope attributeScope
+	spec  attributeSpec
+}
+
+// manifest holds the resources declared in a set of manifest files.
+type manifest struct {
+	projects    []ProjectSpec
+	attributes  []attributeSpecEntry
+	launchPlans []LaunchPlanSpec
+}
+
+// projectIDs returns the projects any declared resource belongs to, sorted.
+func (m *manifest) projectIDs() []string {
+	ids := map[string]bool{}
+	for _, p := range m.projects {
+		ids[p.ID] = true
+	}
+	for _, a := range m.attributes {
+		ids[a.scope.Project] = true
+	}
+	for _, lp := range m.launchPlans {
+		ids[lp.Project] = true
+	}
+	sorted := make([]string, 0, len(ids))
+	for id := range ids {
+		sorted = append(sorted, id)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// manifestFiles expands directories in paths to the yaml files they contain.
+func manifestFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		err = filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if ext := filepath.Ext(p); !d.IsDir() && (ext == ".yaml" || ext == ".yml") {
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// loadManifest reads the resources declared in the yaml files at paths. Each file may hold several resources
+// separated by "---".
+func loadManifest(paths []string) (*manifest, error) {
+	files, err := manifestFiles(paths)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no manifest files found in %v", paths)
+	}
+
+	m := &manifest{}
+	seen := map[string]string{}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		decoder := yamlv3.NewDecoder(bytes.NewReader(data))
+		for i := 1; ; i++ {
+			var node yamlv3.Node
+			if err := decoder.Decode(&node); errors.Is(err, io.EOF) {
+				break
+			} else if err != nil {
+				return nil, fmt.Errorf("%s: %w", file, err)
+			}
+			raw, err := yamlv3.Marshal(&node)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", file, err)
+			}
+			key, err := m.add(raw)
+			if err != nil {
+				return nil, fmt.Errorf("%s: resource %d: %w", file, i, err)
+			}
+			if len(key) == 0 {
+				continue
+			}
+			if previous, ok := seen[key]; ok {
+				return nil, fmt.Errorf("%s: %s is already declared in %s", file, key, previous)
+			}
+			seen[key] = file
+		}
+	}
+	return m, nil
+}
+
+// add parses a single resource and returns the key which identifies it, or an empty key for an empty document.
+func (m *manifest) add(raw []byte) (string, error) {
+	var doc document
+	if err := yaml.UnmarshalStrict(raw, &doc); err != nil {
+		return "", err
+	}
+	if len(doc.Kind) == 0 && len(doc.Spec) == 0 {
+		return "", nil
+	}
+
+	switch doc.Kind {
+	case kindProject:
+		var spec ProjectSpec
+		if err := unmarshalSpec(doc.Spec, &spec); err != nil {
+			return "", err
+		}
+		if len(spec.ID) == 0 {
+			return "", fmt.Errorf("project id is required")
+		}
+		m.projects = append(m.projects, spec)
+		return fmt.Sprintf("%s %s", doc.Kind, spec.ID), nil
+	case kindLaunchPlan:
+		var spec LaunchPlanSpec
+		if err := unmarshalSpec(doc.Spec, &spec); err != nil {
+			return "", err
+		}
+		if len(spec.Project) == 0 || len(spec.Domain) == 0 || len(spec.Name) == 0 || len(spec.Version) == 0 {
+			return "", fmt.Errorf("launch plan project, domain, name and version are required")
+		}
+		m.launchPlans = append(m.launchPlans, spec)
+		// Only a single version of a launch plan can be active.
+		return fmt.Sprintf("%s %s/%s/%s", doc.Kind, spec.Project, spec.Domain, spec.Name), nil
+	}
+
+	kind, ok := attributeKindsByName[doc.Kind]
+	if !ok {
+		return "", fmt.Errorf("unknown kind %q, expected one of %s", doc.Kind,
+			strings.Join(append([]string{kindProject, kindLaunchPlan}, attributeKinds...), ", "))
+	}
+	spec := kind.newSpec()
+	if err := unmarshalSpec(doc.Spec, spec); err != nil {
+		return "", err
+	}
+	var scope attributeScope
+	if err := json.Unmarshal(doc.Spec, &scope); err != nil {
+		return "", err
+	}
+	if len(scope.Project) == 0 {
+		return "", fmt.Errorf("project is required")
+	}
+	if len(scope.Domain) == 0 && len(scope.Workflow) > 0 {
+		return "", fmt.Errorf("domain is required")
+	}
+	m.attributes = append(m.attributes, attributeSpecEntry{kind: doc.Kind, scope: scope, spec: spec})
+	return fmt.Sprintf("%s %s", doc.Kind, scope), nil
+}
+
+func unmarshalSpec(spec json.RawMessage, target interface{}) error {
+	if len(spec) == 0 {
+		return fmt.Errorf("spec is required")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(spec))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(target); err != nil {
+		return fmt.Errorf("invalid spec: %w", err)
+	}
+	return nil
+}
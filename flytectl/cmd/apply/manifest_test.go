@@ -0,0 +1,61 @@
+package apply
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/executionqueueattribute"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/taskresourceattribute"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadManifest(t *testing.T) {
+	m, err := loadManifest([]string{"testdata/manifests"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []ProjectSpec{{
+		ID:          "flytesnacks",
+		Name:        "flytesnacks",
+		Description: "Flyte examples",
+		Labels:      map[string]string{"team": "ml"},
+	}}, m.projects)
+	assert.Equal(t, []LaunchPlanSpec{{
+		Project: "flytesnacks",
+		Domain:  "development",
+		Name:    "core.basic.lp.go_greet",
+		Version: "v1",
+		Active:  true,
+	}}, m.launchPlans)
+
+	if assert.Len(t, m.attributes, 2) {
+		assert.Equal(t, kindTaskResourceAttribute, m.attributes[0].kind)
+		assert.Equal(t, "flytesnacks/development", m.attributes[0].scope.String())
+		taskResources := m.attributes[0].spec.(*taskresourceattribute.TaskResourceAttrFileConfig)
+		assert.Equal(t, "150Mi", taskResources.Defaults.GetMemory())
+		assert.Equal(t, "2", taskResources.Limits.GetCpu())
+
+		assert.Equal(t, kindExecutionQueueAttribute, m.attributes[1].kind)
+		assert.Equal(t, "flytesnacks", m.attributes[1].scope.String())
+		queue := m.attributes[1].spec.(*executionqueueattribute.AttrFileConfig)
+		assert.Equal(t, []string{"foo"}, queue.Tags)
+	}
+	assert.Equal(t, []string{"flytesnacks"}, m.projectIDs())
+}
+
+func TestLoadManifestErrors(t *testing.T) {
+	for _, tc := range []struct {
+		path string
+		err  string
+	}{
+		{"testdata/non-existent", "no such file or directory"},
+		{"testdata/manifests/nested/README.md", "resource 1"},
+		{"testdata/duplicate.yaml", "ExecutionClusterLabel flytesnacks/development is already declared"},
+		{"testdata/unknown_kind.yaml", `unknown kind "Workflow"`},
+		{"testdata/unknown_field.yaml", `unknown field "default"`},
+		{"testdata/workflow_without_domain.yaml", "domain is required"},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			_, err := loadManifest([]string{tc.path})
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
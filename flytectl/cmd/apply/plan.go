@@ -0,0 +1,405 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/pkg/ext"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+)
+
+type action string
+
+const (
+	actionCreate action = "create"
+	actionUpdate action = "update"
+	actionDelete action = "delete"
+)
+
+// listPageSize is the page size used to list live resources while pruning.
+const listPageSize = 100
+
+// change is a single step of a plan.
+type change struct {
+	action action
+	kind   string
+	id     string
+	// before and after are the live and the declared state of the resource, shown in the plan.
+	before interface{}
+	after  interface{}
+	apply  func(ctx context.Context, cmdCtx cmdCore.CommandContext) error
+}
+
+func (c change) String() string {
+	return fmt.Sprintf("%s %s %s", c.action, c.kind, c.id)
+}
+
+// plan computes the changes which bring the live state in line with the manifest, in the order in which they must
+// be applied. With prune, the attributes and active launch plans of the declared projects which are not declared are
+// removed.
+func plan(ctx context.Context, cmdCtx cmdCore.CommandContext, m *manifest, prune bool) ([]change, error) {
+	var changes []change
+	for _, spec := range m.projects {
+		c, err := planProject(ctx, cmdCtx, spec)
+		if err != nil {
+			return nil, err
+		}
+		changes = appendChange(changes, c)
+	}
+
+	attributes := sortedAttributes(m.attributes)
+	for _, entry := range attributes {
+		c, err := planAttribute(ctx, cmdCtx, entry)
+		if err != nil {
+			return nil, err
+		}
+		changes = appendChange(changes, c)
+	}
+
+	for _, spec := range m.launchPlans {
+		c, err := planLaunchPlan(ctx, cmdCtx, spec)
+		if err != nil {
+			return nil, err
+		}
+		changes = appendChange(changes, c)
+	}
+
+	if !prune {
+		return changes, nil
+	}
+	pruned, err := planAttributePrune(ctx, cmdCtx, m)
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, pruned...)
+	pruned, err = planLaunchPlanPrune(ctx, cmdCtx, m)
+	if err != nil {
+		return nil, err
+	}
+	return append(changes, pruned...), nil
+}
+
+func appendChange(changes []change, c *change) []change {
+	if c == nil {
+		return changes
+	}
+	return append(changes, *c)
+}
+
+// sortedAttributes orders attributes by kind, keeping the manifest order within a kind.
+func sortedAttributes(attributes []attributeSpecEntry) []attributeSpecEntry {
+	order := make(map[string]int, len(attributeKinds))
+	for i, kind := range attributeKinds {
+		order[kind] = i
+	}
+	sorted := append([]attributeSpecEntry{}, attributes...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return order[sorted[i].kind] < order[sorted[j].kind]
+	})
+	return sorted
+}
+
+func planProject(ctx context.Context, cmdCtx cmdCore.CommandContext, spec ProjectSpec) (*change, error) {
+	desiredState := admin.Project_ACTIVE
+	if spec.Archived {
+		desiredState = admin.Project_ARCHIVED
+	}
+	var labels *admin.Labels
+	if len(spec.Labels) > 0 {
+		labels = &admin.Labels{Values: spec.Labels}
+	}
+
+	current, err := cmdCtx.AdminFetcherExt().GetProjectByID(ctx, spec.ID)
+	if err != nil && !ext.IsNotFoundError(err) {
+		return nil, fmt.Errorf("plan project %s: could not fetch project: %w", spec.ID, err)
+	}
+	if current == nil {
+		desired := &admin.Project{
+			Id:          spec.ID,
+			Name:        spec.Name,
+			Description: spec.Description,
+			Labels:      labels,
+			State:       desiredState,
+		}
+		return &change{
+			action: actionCreate,
+			kind:   kindProject,
+			id:     spec.ID,
+			after:  desired,
+			apply: func(ctx context.Context, cmdCtx cmdCore.CommandContext) error {
+				if _, err := cmdCtx.AdminClient().RegisterProject(ctx, &admin.ProjectRegisterRequest{Project: desired}); err != nil {
+					return err
+				}
+				if desiredState == admin.Project_ACTIVE {
+					return nil
+				}
+				_, err := cmdCtx.AdminClient().UpdateProject(ctx, desired)
+				return err
+			},
+		}, nil
+	}
+
+	desired := proto.Clone(current).(*admin.Project)
+	desired.Name = spec.Name
+	desired.Description = spec.Description
+	desired.Labels = labels
+	desired.State = desiredState
+	if proto.Equal(current, desired) {
+		return nil, nil
+	}
+	return &change{
+		action: actionUpdate,
+		kind:   kindProject,
+		id:     spec.ID,
+		before: current,
+		after:  desired,
+		apply: func(ctx context.Context, cmdCtx cmdCore.CommandContext) error {
+			_, err := cmdCtx.AdminClient().UpdateProject(ctx, desired)
+			return err
+		},
+	}, nil
+}
+
+func fetchAttributes(ctx context.Context, cmdCtx cmdCore.CommandContext, scope attributeScope,
+	resourceType admin.MatchableResource) (*admin.MatchingAttributes, error) {
+	var attributes *admin.MatchingAttributes
+	var err error
+	switch {
+	case len(scope.Workflow) > 0:
+		var response *admin.WorkflowAttributesGetResponse
+		response, err = cmdCtx.AdminFetcherExt().FetchWorkflowAttributes(ctx, scope.Project, scope.Domain,
+			scope.Workflow, resourceType)
+		attributes = response.GetAttributes().GetMatchingAttributes()
+	case len(scope.Domain) > 0:
+		var response *admin.ProjectDomainAttributesGetResponse
+		response, err = cmdCtx.AdminFetcherExt().FetchProjectDomainAttributes(ctx, scope.Project, scope.Domain,
+			resourceType)
+		attributes = response.GetAttributes().GetMatchingAttributes()
+	default:
+		var response *admin.ProjectAttributesGetResponse
+		response, err = cmdCtx.AdminFetcherExt().FetchProjectAttributes(ctx, scope.Project, resourceType)
+		attributes = response.GetAttributes().GetMatchingAttributes()
+	}
+	if err != nil && !ext.IsNotFoundError(err) {
+		return nil, err
+	}
+	return attributes, nil
+}
+
+func updateAttributes(ctx context.Context, cmdCtx cmdCore.CommandContext, scope attributeScope,
+	attributes *admin.MatchingAttributes) error {
+	switch {
+	case len(scope.Workflow) > 0:
+		return cmdCtx.AdminUpdaterExt().UpdateWorkflowAttributes(ctx, scope.Project, scope.Domain, scope.Workflow,
+			attributes)
+	case len(scope.Domain) > 0:
+		return cmdCtx.AdminUpdaterExt().UpdateProjectDomainAttributes(ctx, scope.Project, scope.Domain, attributes)
+	default:
+		return cmdCtx.AdminUpdaterExt().UpdateProjectAttributes(ctx, scope.Project, attributes)
+	}
+}
+
+func deleteAttributes(ctx context.Context, cmdCtx cmdCore.CommandContext, scope attributeScope,
+	resourceType admin.MatchableResource) error {
+	switch {
+	case len(scope.Workflow) > 0:
+		return cmdCtx.AdminDeleterExt().DeleteWorkflowAttributes(ctx, scope.Project, scope.Domain, scope.Workflow,
+			resourceType)
+	case len(scope.Domain) > 0:
+		return cmdCtx.AdminDeleterExt().DeleteProjectDomainAttributes(ctx, scope.Project, scope.Domain, resourceType)
+	default:
+		return cmdCtx.AdminDeleterExt().DeleteProjectAttributes(ctx, scope.Project, resourceType)
+	}
+}
+
+func planAttribute(ctx context.Context, cmdCtx cmdCore.CommandContext, entry attributeSpecEntry) (*change, error) {
+	resourceType := attributeKindsByName[entry.kind].resourceType
+	current, err := fetchAttributes(ctx, cmdCtx, entry.scope, resourceType)
+	if err != nil {
+		return nil, fmt.Errorf("plan %s %s: could not fetch attributes: %w", entry.kind, entry.scope, err)
+	}
+	desired := entry.spec.Decorate()
+	if proto.Equal(current, desired) {
+		return nil, nil
+	}
+
+	c := &change{
+		action: actionUpdate,
+		kind:   entry.kind,
+		id:     entry.scope.String(),
+		before: current.GetTarget(),
+		after:  desired.GetTarget(),
+		apply: func(ctx context.Context, cmdCtx cmdCore.CommandContext) error {
+			return updateAttributes(ctx, cmdCtx, entry.scope, desired)
+		},
+	}
+	if current == nil {
+		c.action = actionCreate
+		c.before = nil
+	}
+	return c, nil
+}
+
+// planAttributePrune deletes the matchable attributes of the declared projects which are not declared. Attributes
+// of launch plans are never pruned since manifests cannot declare them.
+func planAttributePrune(ctx context.Context, cmdCtx cmdCore.CommandContext, m *manifest) ([]change, error) {
+	projects := map[string]bool{}
+	for _, id := range m.projectIDs() {
+		projects[id] = true
+	}
+	declared := map[string]bool{}
+	for _, entry := range m.attributes {
+		declared[fmt.Sprintf("%s %s", entry.kind, entry.scope)] = true
+	}
+
+	var changes []change
+	for _, kind := range attributeKinds {
+		resourceType := attributeKindsByName[kind].resourceType
+		response, err := cmdCtx.AdminClient().ListMatchableAttributes(ctx, &admin.ListMatchableAttributesRequest{
+			ResourceType: resourceType,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("plan prune of %s: could not list attributes: %w", kind, err)
+		}
+		for _, configuration := range response.GetConfigurations() {
+			if !projects[configuration.GetProject()] || len(configuration.GetLaunchPlan()) > 0 {
+				continue
+			}
+			scope := attributeScope{
+				Project:  configuration.GetProject(),
+				Domain:   configuration.GetDomain(),
+				Workflow: configuration.GetWorkflow(),
+			}
+			if declared[fmt.Sprintf("%s %s", kind, scope)] {
+				continue
+			}
+			changes = append(changes, change{
+				action: actionDelete,
+				kind:   kind,
+				id:     scope.String(),
+				before: configuration.GetAttributes().GetTarget(),
+				apply: func(ctx context.Context, cmdCtx cmdCore.CommandContext) error {
+					return deleteAttributes(ctx, cmdCtx, scope, resourceType)
+				},
+			})
+		}
+	}
+	return changes, nil
+}
+
+type launchPlanState struct {
+	Version string                `json:"version"`
+	State   admin.LaunchPlanState `json:"state"`
+}
+
+func launchPlanID(project, domain, name, version string) *core.Identifier {
+	return &core.Identifier{
+		ResourceType: core.ResourceType_LAUNCH_PLAN,
+		Project:      project,
+		Domain:       domain,
+		Name:         name,
+		Version:      version,
+	}
+}
+
+func planLaunchPlan(ctx context.Context, cmdCtx cmdCore.CommandContext, spec LaunchPlanSpec) (*change, error) {
+	id := fmt.Sprintf("%s/%s/%s", spec.Project, spec.Domain, spec.Name)
+	launchPlan, err := cmdCtx.AdminFetcherExt().FetchLPVersion(ctx, spec.Name, spec.Version, spec.Project, spec.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("plan launch plan %s version %s: could not fetch launch plan: %w", id, spec.Version, err)
+	}
+
+	desiredState := admin.LaunchPlanState_INACTIVE
+	if spec.Active {
+		desiredState = admin.LaunchPlanState_ACTIVE
+	}
+	currentState := launchPlan.GetClosure().GetState()
+	if currentState == desiredState {
+		return nil, nil
+	}
+	return &change{
+		action: actionUpdate,
+		kind:   kindLaunchPlan,
+		id:     id,
+		before: launchPlanState{Version: spec.Version, State: currentState},
+		after:  launchPlanState{Version: spec.Version, State: desiredState},
+		apply: func(ctx context.Context, cmdCtx cmdCore.CommandContext) error {
+			_, err := cmdCtx.AdminClient().UpdateLaunchPlan(ctx, &admin.LaunchPlanUpdateRequest{
+				Id:    launchPlanID(spec.Project, spec.Domain, spec.Name, spec.Version),
+				State: desiredState,
+			})
+			return err
+		},
+	}, nil
+}
+
+// planLaunchPlanPrune deactivates the active launch plans of the declared projects which are not declared.
+func planLaunchPlanPrune(ctx context.Context, cmdCtx cmdCore.CommandContext, m *manifest) ([]change, error) {
+	declared := map[string]bool{}
+	for _, spec := range m.launchPlans {
+		declared[fmt.Sprintf("%s/%s/%s", spec.Project, spec.Domain, spec.Name)] = true
+	}
+	domains, err := cmdCtx.AdminFetcherExt().GetDomains(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("plan prune of launch plans: could not fetch domains: %w", err)
+	}
+
+	var changes []change
+	for _, project := range m.projectIDs() {
+		for _, domain := range domains.GetDomains() {
+			launchPlans, err := listActiveLaunchPlans(ctx, cmdCtx, project, domain.GetId())
+			if err != nil {
+				return nil, fmt.Errorf("plan prune of launch plans in %s/%s: %w", project, domain.GetId(), err)
+			}
+			for _, launchPlan := range launchPlans {
+				lpID := launchPlan.GetId()
+				id := fmt.Sprintf("%s/%s/%s", lpID.GetProject(), lpID.GetDomain(), lpID.GetName())
+				if declared[id] {
+					continue
+				}
+				changes = append(changes, change{
+					action: actionDelete,
+					kind:   kindLaunchPlan,
+					id:     id,
+					before: launchPlanState{Version: lpID.GetVersion(), State: admin.LaunchPlanState_ACTIVE},
+					after:  launchPlanState{Version: lpID.GetVersion(), State: admin.LaunchPlanState_INACTIVE},
+					apply: func(ctx context.Context, cmdCtx cmdCore.CommandContext) error {
+						_, err := cmdCtx.AdminClient().UpdateLaunchPlan(ctx, &admin.LaunchPlanUpdateRequest{
+							Id:    launchPlanID(lpID.GetProject(), lpID.GetDomain(), lpID.GetName(), lpID.GetVersion()),
+							State: admin.LaunchPlanState_INACTIVE,
+						})
+						return err
+					},
+				})
+			}
+		}
+	}
+	return changes, nil
+}
+
+func listActiveLaunchPlans(ctx context.Context, cmdCtx cmdCore.CommandContext, project, domain string) (
+	[]*admin.LaunchPlan, error) {
+	var launchPlans []*admin.LaunchPlan
+	token := ""
+	for {
+		response, err := cmdCtx.AdminClient().ListActiveLaunchPlans(ctx, &admin.ActiveLaunchPlanListRequest{
+			Project: project,
+			Domain:  domain,
+			Limit:   listPageSize,
+			Token:   token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		launchPlans = append(launchPlans, response.GetLaunchPlans()...)
+		token = response.GetToken()
+		if len(strings.TrimSpace(token)) == 0 {
+			return launchPlans, nil
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package bulk
+
+import (
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/bulk"
+	cmdcore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/spf13/cobra"
+)
+
+// Long descriptions are whitespace sensitive when generating docs using Sphinx.
+const (
+	bulkCmdShort = `Terminates, relaunches, recovers or archives all the executions matching a filter.`
+	bulkCmdLong  = `
+Act on all the executions of a project and domain which match a field selector. The matching executions are always
+previewed first, and the operation asks for confirmation before making any modifications unless forced.
+
+Terminate all the running executions of a workflow started before a point in time:
+::
+
+ flytectl bulk terminate -p flytesnacks -d development --filter.fieldSelector="execution.phase=RUNNING,workflow.name=core.basic.hello_world.my_wf,execution.created_at<2026-10-17T00:00:00Z"
+
+Relaunch all the executions which failed since last night with the same inputs, ten at a time:
+::
+
+ flytectl bulk relaunch -p flytesnacks -d development --filter.fieldSelector="execution.phase=FAILED,execution.created_at>2026-10-17T20:00:00Z" --concurrency 10
+`
+)
+
+// CreateBulkCommand will return bulk command
+func CreateBulkCommand() *cobra.Command {
+	bulkCmd := &cobra.Command{
+		Use:   "bulk",
+		Short: bulkCmdShort,
+		Long:  bulkCmdLong,
+	}
+
+	bulkResourcesFuncs := map[string]cmdcore.CommandEntry{
+		"terminate": {CmdFunc: bulkExecutionFunc(terminateOperation), Short: terminateShort, Long: terminateLong,
+			PFlagProvider: bulk.DefaultConfig},
+		"relaunch": {CmdFunc: bulkExecutionFunc(relaunchOperation), Short: relaunchShort, Long: relaunchLong,
+			PFlagProvider: bulk.DefaultConfig},
+		"recover": {CmdFunc: bulkExecutionFunc(recoverOperation), Short: recoverShort, Long: recoverLong,
+			PFlagProvider: bulk.DefaultConfig},
+		"archive": {CmdFunc: bulkExecutionFunc(archiveOperation), Short: archiveShort, Long: archiveLong,
+			PFlagProvider: bulk.DefaultConfig},
+	}
+
+	cmdcore.AddCommands(bulkCmd, bulkResourcesFuncs)
+	return bulkCmd
+}
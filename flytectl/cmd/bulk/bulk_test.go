@@ -0,0 +1,20 @@
+package bulk
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkCommand(t *testing.T) {
+	bulkCommand := CreateBulkCommand()
+	assert.Equal(t, "bulk", bulkCommand.Use)
+	assert.Equal(t, bulkCmdShort, bulkCommand.Short)
+	var names []string
+	for _, c := range bulkCommand.Commands() {
+		names = append(names, c.Use)
+	}
+	sort.Strings(names)
+	assert.Equal(t, []string{"archive", "recover", "relaunch", "terminate"}, names)
+}
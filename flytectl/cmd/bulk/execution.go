@@ -0,0 +1,268 @@
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/bulk"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	cmdUtil "github.com/flyteorg/flyte/flytectl/pkg/commandutils"
+	"github.com/flyteorg/flyte/flytectl/pkg/filters"
+	"github.com/flyteorg/flyte/flytectl/pkg/printer"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	terminateShort = "Terminates all the executions matching a filter"
+	terminateLong  = `
+Terminates all the executions of a project and domain matching the field selector. Executions which already reached a
+terminal phase cannot be terminated, so filter on the phase:
+::
+
+ flytectl bulk terminate -p flytesnacks -d development --filter.fieldSelector="execution.phase=RUNNING,workflow.name=core.basic.hello_world.my_wf"
+
+Preview the matching executions without terminating them:
+::
+
+ flytectl bulk terminate -p flytesnacks -d development --filter.fieldSelector="execution.phase=RUNNING" --dryRun
+
+Usage
+`
+	relaunchShort = "Relaunches all the executions matching a filter with the same inputs"
+	relaunchLong  = `
+Relaunches all the executions of a project and domain matching the field selector, with the same inputs:
+::
+
+ flytectl bulk relaunch -p flytesnacks -d development --filter.fieldSelector="execution.phase=FAILED,execution.created_at>2026-10-17T20:00:00Z"
+
+Skip the cached results of the relaunched executions:
+::
+
+ flytectl bulk relaunch -p flytesnacks -d development --filter.fieldSelector="execution.phase=FAILED" --overwriteCache
+
+Usage
+`
+	recoverShort = "Recovers all the executions matching a filter"
+	recoverLong  = `
+Recovers all the executions of a project and domain matching the field selector, reusing the outputs of the nodes
+which succeeded:
+::
+
+ flytectl bulk recover -p flytesnacks -d development --filter.fieldSelector="execution.phase=FAILED,execution.created_at>2026-10-17T20:00:00Z"
+
+Usage
+`
+	archiveShort = "Archives all the executions matching a filter"
+	archiveLong  = `
+Archives all the executions of a project and domain matching the field selector, hiding them from the CLI and UI:
+::
+
+ flytectl bulk archive -p flytesnacks -d development --filter.fieldSelector="execution.phase=ABORTED"
+
+Usage
+`
+)
+
+var executionColumns = []printer.Column{
+	{Header: "Name", JSONPath: "$.id.name"},
+	{Header: "Launch Plan Name", JSONPath: "$.spec.launchPlan.name"},
+	{Header: "Version", JSONPath: "$.spec.launchPlan.version"},
+	{Header: "Phase", JSONPath: "$.closure.phase"},
+	{Header: "Started", JSONPath: "$.closure.startedAt"},
+}
+
+var resultColumns = []printer.Column{
+	{Header: "Name", JSONPath: "$.name"},
+	{Header: "Result", JSONPath: "$.result"},
+	{Header: "Details", JSONPath: "$.details"},
+}
+
+// confirmationReader is where the confirmation after the preview is read from.
+var confirmationReader io.Reader = os.Stdin
+
+// operation is an action which is run on each of the matching executions. It returns details about the outcome, such
+// as the name of a new execution.
+type operation struct {
+	name string
+	run  func(ctx context.Context, cmdCtx cmdCore.CommandContext, execution *admin.Execution) (string, error)
+}
+
+var terminateOperation = operation{
+	name: "terminate",
+	run: func(ctx context.Context, cmdCtx cmdCore.CommandContext, execution *admin.Execution) (string, error) {
+		_, err := cmdCtx.AdminClient().TerminateExecution(ctx, &admin.ExecutionTerminateRequest{
+			Id:    execution.GetId(),
+			Cause: "terminated by flytectl bulk terminate",
+		})
+		return "", err
+	},
+}
+
+var relaunchOperation = operation{
+	name: "relaunch",
+	run: func(ctx context.Context, cmdCtx cmdCore.CommandContext, execution *admin.Execution) (string, error) {
+		relaunched, err := cmdCtx.AdminClient().RelaunchExecution(ctx, &admin.ExecutionRelaunchRequest{
+			Id:             execution.GetId(),
+			OverwriteCache: bulk.DefaultConfig.OverwriteCache,
+		})
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("relaunched as %s", relaunched.GetId().GetName()), nil
+	},
+}
+
+var recoverOperation = operation{
+	name: "recover",
+	run: func(ctx context.Context, cmdCtx cmdCore.CommandContext, execution *admin.Execution) (string, error) {
+		recovered, err := cmdCtx.AdminClient().RecoverExecution(ctx, &admin.ExecutionRecoverRequest{
+			Id: execution.GetId(),
+		})
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("recovered as %s", recovered.GetId().GetName()), nil
+	},
+}
+
+var archiveOperation = operation{
+	name: "archive",
+	run: func(ctx context.Context, cmdCtx cmdCore.CommandContext, execution *admin.Execution) (string, error) {
+		_, err := cmdCtx.AdminClient().UpdateExecution(ctx, &admin.ExecutionUpdateRequest{
+			Id:    execution.GetId(),
+			State: admin.ExecutionState_EXECUTION_ARCHIVED,
+		})
+		return "", err
+	},
+}
+
+// result is the outcome of an operation on a single execution.
+type result struct {
+	Name    string `json:"name"`
+	Result  string `json:"result"`
+	Details string `json:"details"`
+}
+
+func bulkExecutionFunc(op operation) cmdCore.CommandFunc {
+	return func(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+		cfg := bulk.DefaultConfig
+		if len(args) > 0 {
+			return fmt.Errorf("bulk %s does not take execution names, select the executions with --filter.fieldSelector", op.name)
+		}
+		if len(cfg.Filter.FieldSelector) == 0 {
+			return fmt.Errorf("a field selector is required to select the executions to %s", op.name)
+		}
+		if cfg.Filter.Limit <= 0 {
+			return fmt.Errorf("filter limit must be positive, got %d", cfg.Filter.Limit)
+		}
+		if cfg.Concurrency <= 0 {
+			return fmt.Errorf("concurrency must be positive, got %d", cfg.Concurrency)
+		}
+
+		executions, err := listExecutions(ctx, cmdCtx, config.GetConfig().Project, config.GetConfig().Domain, cfg.Filter)
+		if err != nil {
+			return err
+		}
+		if len(executions) == 0 {
+			fmt.Printf("No executions match the filter.\n")
+			return nil
+		}
+
+		adminPrinter := printer.Printer{}
+		fmt.Printf("The following %d executions will be %s.\n", len(executions), pastTense(op.name))
+		messages := make([]proto.Message, 0, len(executions))
+		for _, e := range executions {
+			messages = append(messages, e)
+		}
+		if err := adminPrinter.Print(config.GetConfig().MustOutputFormat(), executionColumns, messages...); err != nil {
+			return err
+		}
+
+		if cfg.DryRun {
+			fmt.Printf("skipping bulk %s (dryRun)\n", op.name)
+			return nil
+		}
+		if !cfg.Force && !cmdUtil.AskForConfirmation(fmt.Sprintf("%s %d executions?", op.name, len(executions)),
+			confirmationReader) {
+			return fmt.Errorf("bulk %s aborted by user", op.name)
+		}
+
+		results := runOperation(ctx, cmdCtx, op, executions, cfg.Concurrency)
+		failed := 0
+		for _, r := range results {
+			if r.Result != "Succeeded" {
+				failed++
+			}
+		}
+		if err := adminPrinter.PrintInterface(config.GetConfig().MustOutputFormat(), resultColumns, results); err != nil {
+			return err
+		}
+		fmt.Printf("%d succeeded, %d failed\n", len(results)-failed, failed)
+		if failed > 0 {
+			return fmt.Errorf("failed to %s %d of %d executions", op.name, failed, len(results))
+		}
+		return nil
+	}
+}
+
+// listExecutions fetches all the pages of executions matching the filter, starting from its page.
+func listExecutions(ctx context.Context, cmdCtx cmdCore.CommandContext, project, domain string,
+	filter filters.Filters) ([]*admin.Execution, error) {
+	var executions []*admin.Execution
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	for ; ; filter.Page++ {
+		executionList, err := cmdCtx.AdminFetcherExt().ListExecution(ctx, project, domain, filter)
+		if err != nil {
+			return nil, err
+		}
+		executions = append(executions, executionList.GetExecutions()...)
+		if len(executionList.GetExecutions()) < int(filter.Limit) {
+			return executions, nil
+		}
+	}
+}
+
+// runOperation runs the operation on the executions, at most concurrency at a time, and returns the result for each
+// execution in the same order.
+func runOperation(ctx context.Context, cmdCtx cmdCore.CommandContext, op operation, executions []*admin.Execution,
+	concurrency int) []result {
+	results := make([]result, len(executions))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, e := range executions {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, e *admin.Execution) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			name := e.GetId().GetName()
+			details, err := op.run(ctx, cmdCtx, e)
+			if err != nil {
+				logger.Errorf(ctx, "Failed to %s execution %s due to %v", op.name, name, err)
+				results[i] = result{Name: name, Result: "Failed", Details: err.Error()}
+				return
+			}
+			results[i] = result{Name: name, Result: "Succeeded", Details: details}
+		}(i, e)
+	}
+	wg.Wait()
+	return results
+}
+
+func pastTense(name string) string {
+	switch name {
+	case "terminate", "archive":
+		return name + "d"
+	default:
+		return name + "ed"
+	}
+}
@@ -0,0 +1,149 @@
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/bulk"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flytectl/pkg/filters"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+)
+
+const (
+	projectValue = "dummyProject"
+	domainValue  = "dummyDomain"
+)
+
+func setup(t *testing.T, fieldSelector string) testutils.TestStruct {
+	s := testutils.Setup(t)
+	bulk.DefaultConfig = &bulk.Config{
+		Filter:      filters.DefaultFilter,
+		Concurrency: 2,
+		Force:       true,
+	}
+	bulk.DefaultConfig.Filter.FieldSelector = fieldSelector
+	return s
+}
+
+func executions(names ...string) []*admin.Execution {
+	result := make([]*admin.Execution, 0, len(names))
+	for _, name := range names {
+		result = append(result, &admin.Execution{
+			Id:      &core.WorkflowExecutionIdentifier{Project: projectValue, Domain: domainValue, Name: name},
+			Closure: &admin.ExecutionClosure{Phase: core.WorkflowExecution_RUNNING},
+		})
+	}
+	return result
+}
+
+func mockList(s testutils.TestStruct, page int32, executions []*admin.Execution) {
+	s.FetcherExt.EXPECT().ListExecution(s.Ctx, projectValue, domainValue, mock.MatchedBy(func(f filters.Filters) bool {
+		return f.Page == page
+	})).Return(&admin.ExecutionList{Executions: executions}, nil)
+}
+
+func TestTerminate(t *testing.T) {
+	s := setup(t, "execution.phase=RUNNING")
+	mockList(s, 1, executions("e1", "e2"))
+	s.MockAdminClient.EXPECT().TerminateExecution(s.Ctx, mock.Anything).Return(&admin.ExecutionTerminateResponse{}, nil).Times(2)
+
+	err := bulkExecutionFunc(terminateOperation)(s.Ctx, nil, s.CmdCtx)
+	assert.NoError(t, err)
+	s.TearDownAndVerifyContains(t, "2 succeeded, 0 failed")
+}
+
+func TestRelaunchPaginates(t *testing.T) {
+	s := setup(t, "execution.phase=FAILED")
+	bulk.DefaultConfig.Filter.Limit = 2
+	bulk.DefaultConfig.OverwriteCache = true
+	mockList(s, 1, executions("e1", "e2"))
+	mockList(s, 2, executions("e3"))
+	s.MockAdminClient.EXPECT().RelaunchExecution(s.Ctx, mock.MatchedBy(func(r *admin.ExecutionRelaunchRequest) bool {
+		return r.GetOverwriteCache()
+	})).RunAndReturn(func(_ context.Context, r *admin.ExecutionRelaunchRequest, _ ...grpc.CallOption) (*admin.ExecutionCreateResponse, error) {
+		return &admin.ExecutionCreateResponse{Id: &core.WorkflowExecutionIdentifier{Name: r.GetId().GetName() + "-new"}}, nil
+	}).Times(3)
+
+	err := bulkExecutionFunc(relaunchOperation)(s.Ctx, nil, s.CmdCtx)
+	assert.NoError(t, err)
+	s.TearDownAndVerifyContains(t, `"details": "relaunched as e3-new"`)
+}
+
+func TestRecoverReportsFailures(t *testing.T) {
+	s := setup(t, "execution.phase=FAILED")
+	mockList(s, 1, executions("e1", "e2"))
+	s.MockAdminClient.EXPECT().RecoverExecution(s.Ctx, mock.MatchedBy(func(r *admin.ExecutionRecoverRequest) bool {
+		return r.GetId().GetName() == "e1"
+	})).Return(&admin.ExecutionCreateResponse{Id: &core.WorkflowExecutionIdentifier{Name: "r1"}}, nil)
+	s.MockAdminClient.EXPECT().RecoverExecution(s.Ctx, mock.MatchedBy(func(r *admin.ExecutionRecoverRequest) bool {
+		return r.GetId().GetName() == "e2"
+	})).Return(nil, fmt.Errorf("not recoverable"))
+
+	err := bulkExecutionFunc(recoverOperation)(s.Ctx, nil, s.CmdCtx)
+	assert.EqualError(t, err, "failed to recover 1 of 2 executions")
+	s.TearDownAndVerifyContains(t, "1 succeeded, 1 failed")
+}
+
+func TestArchive(t *testing.T) {
+	s := setup(t, "execution.phase=ABORTED")
+	mockList(s, 1, executions("e1"))
+	s.MockAdminClient.EXPECT().UpdateExecution(s.Ctx, &admin.ExecutionUpdateRequest{
+		Id:    executions("e1")[0].GetId(),
+		State: admin.ExecutionState_EXECUTION_ARCHIVED,
+	}).Return(&admin.ExecutionUpdateResponse{}, nil)
+
+	err := bulkExecutionFunc(archiveOperation)(s.Ctx, nil, s.CmdCtx)
+	assert.NoError(t, err)
+}
+
+func TestDryRun(t *testing.T) {
+	s := setup(t, "execution.phase=RUNNING")
+	bulk.DefaultConfig.DryRun = true
+	mockList(s, 1, executions("e1"))
+
+	err := bulkExecutionFunc(terminateOperation)(s.Ctx, nil, s.CmdCtx)
+	assert.NoError(t, err)
+	s.TearDownAndVerifyContains(t, "skipping bulk terminate (dryRun)")
+}
+
+func TestAbortedByUser(t *testing.T) {
+	s := setup(t, "execution.phase=RUNNING")
+	bulk.DefaultConfig.Force = false
+	confirmationReader = strings.NewReader("n\n")
+	defer func() { confirmationReader = os.Stdin }()
+	mockList(s, 1, executions("e1"))
+
+	err := bulkExecutionFunc(terminateOperation)(s.Ctx, nil, s.CmdCtx)
+	assert.EqualError(t, err, "bulk terminate aborted by user")
+}
+
+func TestNoMatchingExecutions(t *testing.T) {
+	s := setup(t, "execution.phase=RUNNING")
+	mockList(s, 1, nil)
+
+	err := bulkExecutionFunc(terminateOperation)(s.Ctx, nil, s.CmdCtx)
+	assert.NoError(t, err)
+	s.TearDownAndVerifyContains(t, "No executions match the filter.")
+}
+
+func TestInvalidArguments(t *testing.T) {
+	s := setup(t, "")
+	err := bulkExecutionFunc(terminateOperation)(s.Ctx, nil, s.CmdCtx)
+	assert.EqualError(t, err, "a field selector is required to select the executions to terminate")
+
+	err = bulkExecutionFunc(terminateOperation)(s.Ctx, []string{"e1"}, s.CmdCtx)
+	assert.EqualError(t, err, "bulk terminate does not take execution names, select the executions with --filter.fieldSelector")
+
+	bulk.DefaultConfig.Filter.FieldSelector = "execution.phase=RUNNING"
+	bulk.DefaultConfig.Concurrency = 0
+	err = bulkExecutionFunc(terminateOperation)(s.Ctx, nil, s.CmdCtx)
+	assert.EqualError(t, err, "concurrency must be positive, got 0")
+}
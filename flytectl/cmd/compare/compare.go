@@ -0,0 +1,39 @@
+package compare
+
+import (
+	cmdcore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/spf13/cobra"
+)
+
+// Long descriptions are whitespace sensitive when generating docs using sphinx.
+const (
+	compareCmdShort = `Compares two runs of Flyte resources such as executions.`
+	compareCmdLong  = `
+Compare a regressed execution to the last good one:
+::
+
+ flytectl compare execution -p flytesnacks -d development oeh94k9r2r f8a2b3c4d5
+
+Print the comparison in json format for automation:
+::
+
+ flytectl compare execution -p flytesnacks -d development oeh94k9r2r f8a2b3c4d5 -o json
+`
+)
+
+// CreateCompareCommand will return compare command
+func CreateCompareCommand() *cobra.Command {
+	compareCmd := &cobra.Command{
+		Use:   "compare",
+		Short: compareCmdShort,
+		Long:  compareCmdLong,
+	}
+
+	compareResourcesFuncs := map[string]cmdcore.CommandEntry{
+		"execution": {CmdFunc: compareExecutionFunc, Aliases: []string{"executions"}, Short: executionShort,
+			Long: executionLong},
+	}
+
+	cmdcore.AddCommands(compareCmd, compareResourcesFuncs)
+	return compareCmd
+}
@@ -0,0 +1,261 @@
+package compare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/pkg/diff"
+	"github.com/flyteorg/flyte/flytectl/pkg/printer"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	executionShort = "Compares the inputs, outputs and node timing of two executions"
+	executionLong  = `
+Compare two executions, typically a known good run and a regressed run of the same launch plan. Reports differing
+execution inputs and outputs, differing node inputs and outputs, nodes which changed cache status, and how much longer
+or shorter each node took, slowest first:
+::
+
+ flytectl compare execution -p flytesnacks -d development oeh94k9r2r f8a2b3c4d5
+
+Offloaded values which carry a hash are compared by their hash, others by their value. Inputs and outputs too large
+for admin to return inline are read through the signed URL admin returns for them, and reported as not compared if
+they cannot be read.
+
+Print the full comparison in json or yaml format for automation:
+::
+
+ flytectl compare execution -p flytesnacks -d development oeh94k9r2r f8a2b3c4d5 -o json
+
+Usage
+`
+)
+
+// nodeExecutionPageSize is the page size used to list the node executions of an execution.
+const nodeExecutionPageSize = 100
+
+var changeColumns = []printer.Column{
+	{Header: "Type", JSONPath: "$.type"},
+	{Header: "Path", JSONPath: "$.path"},
+	{Header: "Before", JSONPath: "$.before"},
+	{Header: "After", JSONPath: "$.after"},
+}
+
+var nodeColumns = []printer.Column{
+	{Header: "Node", JSONPath: "$.node"},
+	{Header: "Phase Before", JSONPath: "$.phaseBefore"},
+	{Header: "Phase After", JSONPath: "$.phaseAfter"},
+	{Header: "Cache Before", JSONPath: "$.cacheStatusBefore"},
+	{Header: "Cache After", JSONPath: "$.cacheStatusAfter"},
+	{Header: "Duration Before", JSONPath: "$.durationBefore"},
+	{Header: "Duration After", JSONPath: "$.durationAfter"},
+	{Header: "Delta", JSONPath: "$.delta"},
+}
+
+// nodeView is a row of the table of node timings.
+type nodeView struct {
+	Node              string `json:"node"`
+	PhaseBefore       string `json:"phaseBefore"`
+	PhaseAfter        string `json:"phaseAfter"`
+	CacheStatusBefore string `json:"cacheStatusBefore"`
+	CacheStatusAfter  string `json:"cacheStatusAfter"`
+	DurationBefore    string `json:"durationBefore"`
+	DurationAfter     string `json:"durationAfter"`
+	Delta             string `json:"delta"`
+}
+
+func compareExecutionFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	if len(args) != 2 {
+		return fmt.Errorf("expected arguments <execution-a> <execution-b>, received %v", len(args))
+	}
+
+	project, domain := config.GetConfig().Project, config.GetConfig().Domain
+	before, err := fetchExecutionData(ctx, cmdCtx, project, domain, args[0])
+	if err != nil {
+		return err
+	}
+
+	after, err := fetchExecutionData(ctx, cmdCtx, project, domain, args[1])
+	if err != nil {
+		return err
+	}
+
+	return printComparison(diff.Executions(before, after))
+}
+
+func fetchExecutionData(ctx context.Context, cmdCtx cmdCore.CommandContext, project, domain, name string) (diff.ExecutionData, error) {
+	execution, err := cmdCtx.AdminFetcherExt().FetchExecution(ctx, name, project, domain)
+	if err != nil {
+		return diff.ExecutionData{}, err
+	}
+
+	data, err := cmdCtx.AdminClient().GetExecutionData(ctx, &admin.WorkflowExecutionGetDataRequest{
+		Id: execution.GetId(),
+	})
+	if err != nil {
+		return diff.ExecutionData{}, err
+	}
+
+	nodes, err := fetchNodeData(ctx, cmdCtx, execution.GetId(), "")
+	if err != nil {
+		return diff.ExecutionData{}, err
+	}
+
+	executionData := diff.ExecutionData{Execution: execution, Nodes: nodes}
+	executionData.Inputs, executionData.InputsURL = fetchLiteralMap(ctx, data.GetFullInputs(), data.GetInputs())
+	executionData.Outputs, executionData.OutputsURL = fetchLiteralMap(ctx, data.GetFullOutputs(), data.GetOutputs())
+	return executionData, nil
+}
+
+// fetchLiteralMap returns the literals admin returned inline or, when they exceed the size admin returns inline, reads
+// them through their signed URL. The URL is returned instead when the literals cannot be read.
+func fetchLiteralMap(ctx context.Context, full *core.LiteralMap, blob *admin.UrlBlob) (*core.LiteralMap, string) {
+	if len(full.GetLiterals()) > 0 || len(blob.GetUrl()) == 0 || blob.GetBytes() == 0 {
+		return full, ""
+	}
+
+	literals, err := readLiteralMap(ctx, blob.GetUrl())
+	if err != nil {
+		logger.Warnf(ctx, "Failed to read literals from %s, err: %v", blob.GetUrl(), err)
+		return nil, blob.GetUrl()
+	}
+	return literals, ""
+}
+
+func readLiteralMap(ctx context.Context, url string) (*core.LiteralMap, error) {
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		return nil, fmt.Errorf("not a signed url")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	literals := &core.LiteralMap{}
+	if err := proto.Unmarshal(raw, literals); err != nil {
+		return nil, err
+	}
+	return literals, nil
+}
+
+// fetchNodeData fetches the node executions under the given parent node, recursively, along with their data.
+func fetchNodeData(ctx context.Context, cmdCtx cmdCore.CommandContext, executionID *core.WorkflowExecutionIdentifier,
+	uniqueParentID string) ([]diff.NodeData, error) {
+	var nodes []diff.NodeData
+	token := ""
+	for {
+		nodeExecutions, err := cmdCtx.AdminClient().ListNodeExecutions(ctx, &admin.NodeExecutionListRequest{
+			WorkflowExecutionId: executionID,
+			UniqueParentId:      uniqueParentID,
+			Limit:               nodeExecutionPageSize,
+			Token:               token,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, nodeExecution := range nodeExecutions.GetNodeExecutions() {
+			nodeID := nodeExecution.GetId().GetNodeId()
+			data, err := cmdCtx.AdminFetcherExt().FetchNodeExecutionData(ctx, nodeID, executionID.GetName(),
+				executionID.GetProject(), executionID.GetDomain())
+			if err != nil {
+				return nil, err
+			}
+			node := diff.NodeData{NodeExecution: nodeExecution}
+			node.Inputs, node.InputsURL = fetchLiteralMap(ctx, data.GetFullInputs(), data.GetInputs())
+			node.Outputs, node.OutputsURL = fetchLiteralMap(ctx, data.GetFullOutputs(), data.GetOutputs())
+			nodes = append(nodes, node)
+
+			if nodeExecution.GetMetadata().GetIsParentNode() {
+				children, err := fetchNodeData(ctx, cmdCtx, executionID, nodeID)
+				if err != nil {
+					return nil, err
+				}
+				nodes = append(nodes, children...)
+			}
+		}
+
+		token = nodeExecutions.GetToken()
+		if len(token) == 0 {
+			return nodes, nil
+		}
+	}
+}
+
+func formatDelta(d diff.Duration) string {
+	if d > 0 {
+		return "+" + d.String()
+	}
+	return d.String()
+}
+
+func printComparison(comparison diff.ExecutionComparison) error {
+	adminPrinter := printer.Printer{}
+	outputFormat := config.GetConfig().MustOutputFormat()
+	if outputFormat == printer.OutputFormatJSON || outputFormat == printer.OutputFormatYAML {
+		return adminPrinter.PrintInterface(outputFormat, nil, comparison)
+	}
+
+	fmt.Printf("Execution %v (%v, %v) vs %v (%v, %v): %v\n", comparison.Before, comparison.PhaseBefore,
+		comparison.DurationBefore, comparison.After, comparison.PhaseAfter, comparison.DurationAfter,
+		formatDelta(comparison.DurationDelta))
+
+	changes := append(append([]diff.Change{}, comparison.Inputs...), comparison.Outputs...)
+	var cacheChanges []string
+	for _, node := range comparison.Nodes {
+		for _, c := range append(append([]diff.Change{}, node.Inputs...), node.Outputs...) {
+			c.Path = fmt.Sprintf("nodes.%s.%s", node.Node, c.Path)
+			changes = append(changes, c)
+		}
+		if node.CacheStatusChanged() {
+			cacheChanges = append(cacheChanges, fmt.Sprintf("%s (%s -> %s)", node.Node, node.CacheStatusBefore,
+				node.CacheStatusAfter))
+		}
+	}
+	if len(cacheChanges) > 0 {
+		fmt.Printf("Nodes which changed cache status: %v\n", strings.Join(cacheChanges, ", "))
+	}
+
+	if len(changes) == 0 {
+		fmt.Printf("No differing inputs or outputs.\n")
+	} else if err := adminPrinter.PrintInterface(outputFormat, changeColumns, changes); err != nil {
+		return err
+	}
+
+	rows := make([]nodeView, 0, len(comparison.Nodes))
+	for _, node := range comparison.Nodes {
+		rows = append(rows, nodeView{
+			Node:              node.Node,
+			PhaseBefore:       node.PhaseBefore,
+			PhaseAfter:        node.PhaseAfter,
+			CacheStatusBefore: node.CacheStatusBefore,
+			CacheStatusAfter:  node.CacheStatusAfter,
+			DurationBefore:    node.DurationBefore.String(),
+			DurationAfter:     node.DurationAfter.String(),
+			Delta:             formatDelta(node.DurationDelta),
+		})
+	}
+	return adminPrinter.PrintInterface(outputFormat, nodeColumns, rows)
+}
@@ -0,0 +1,144 @@
+package compare
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	projectValue = "dummyProject"
+	domainValue  = "dummyDomain"
+)
+
+func TestCompareCommand(t *testing.T) {
+	compareCommand := CreateCompareCommand()
+	assert.Equal(t, "compare", compareCommand.Use)
+	assert.Equal(t, compareCmdShort, compareCommand.Short)
+	if assert.Len(t, compareCommand.Commands(), 1) {
+		assert.Equal(t, "execution", compareCommand.Commands()[0].Use)
+		assert.Equal(t, executionShort, compareCommand.Commands()[0].Short)
+	}
+}
+
+func inlineInputs(input int) *admin.WorkflowExecutionGetDataResponse {
+	return &admin.WorkflowExecutionGetDataResponse{
+		FullInputs: &core.LiteralMap{Literals: map[string]*core.Literal{"x": coreutils.MustMakeLiteral(input)}},
+	}
+}
+
+func mockExecution(s *testutils.TestStruct, name string, duration time.Duration, data *admin.WorkflowExecutionGetDataResponse,
+	cacheStatus core.CatalogCacheStatus) {
+	id := &core.WorkflowExecutionIdentifier{Project: projectValue, Domain: domainValue, Name: name}
+	s.FetcherExt.EXPECT().FetchExecution(s.Ctx, name, projectValue, domainValue).Return(&admin.Execution{
+		Id:      id,
+		Closure: &admin.ExecutionClosure{Phase: core.WorkflowExecution_SUCCEEDED, Duration: durationpb.New(duration)},
+	}, nil)
+	s.MockAdminClient.EXPECT().GetExecutionData(s.Ctx, &admin.WorkflowExecutionGetDataRequest{Id: id}).
+		Return(data, nil)
+
+	s.MockAdminClient.EXPECT().ListNodeExecutions(s.Ctx, mock.MatchedBy(func(r *admin.NodeExecutionListRequest) bool {
+		return r.GetWorkflowExecutionId().GetName() == name && r.GetUniqueParentId() == "" && r.GetToken() == ""
+	})).Return(&admin.NodeExecutionList{
+		NodeExecutions: []*admin.NodeExecution{{
+			Id:       &core.NodeExecutionIdentifier{NodeId: "n0", ExecutionId: id},
+			Metadata: &admin.NodeExecutionMetaData{IsParentNode: true},
+			Closure:  &admin.NodeExecutionClosure{Phase: core.NodeExecution_SUCCEEDED, Duration: durationpb.New(duration)},
+		}},
+		Token: "next",
+	}, nil)
+	s.MockAdminClient.EXPECT().ListNodeExecutions(s.Ctx, mock.MatchedBy(func(r *admin.NodeExecutionListRequest) bool {
+		return r.GetWorkflowExecutionId().GetName() == name && r.GetUniqueParentId() == "" && r.GetToken() == "next"
+	})).Return(&admin.NodeExecutionList{}, nil)
+	s.MockAdminClient.EXPECT().ListNodeExecutions(s.Ctx, mock.MatchedBy(func(r *admin.NodeExecutionListRequest) bool {
+		return r.GetWorkflowExecutionId().GetName() == name && r.GetUniqueParentId() == "n0"
+	})).Return(&admin.NodeExecutionList{
+		NodeExecutions: []*admin.NodeExecution{{
+			Id: &core.NodeExecutionIdentifier{NodeId: "n0-0-n0", ExecutionId: id},
+			Closure: &admin.NodeExecutionClosure{
+				Phase:    core.NodeExecution_SUCCEEDED,
+				Duration: durationpb.New(duration / 2),
+				TargetMetadata: &admin.NodeExecutionClosure_TaskNodeMetadata{
+					TaskNodeMetadata: &admin.TaskNodeMetadata{CacheStatus: cacheStatus},
+				},
+			},
+		}},
+	}, nil)
+	s.FetcherExt.EXPECT().FetchNodeExecutionData(s.Ctx, mock.Anything, name, projectValue, domainValue).
+		Return(&admin.NodeExecutionGetDataResponse{}, nil)
+}
+
+func TestCompareExecutionFunc(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		s := testutils.Setup(t)
+		mockExecution(&s, "good", time.Minute, inlineInputs(1), core.CatalogCacheStatus_CACHE_HIT)
+		mockExecution(&s, "regressed", 3*time.Minute, inlineInputs(2), core.CatalogCacheStatus_CACHE_MISS)
+
+		err := compareExecutionFunc(s.Ctx, []string{"good", "regressed"}, s.CmdCtx)
+		assert.NoError(t, err)
+		s.TearDownAndVerify(t, `{"before": "good", "after": "regressed", "phaseBefore": "SUCCEEDED", "phaseAfter": "SUCCEEDED",
+"durationBefore": "1m0s", "durationAfter": "3m0s", "durationDelta": "2m0s",
+"inputs": [{"type": "Modified", "path": "inputs.x", "before": "1", "after": "2"}], "outputs": [],
+"nodes": [
+{"node": "n0", "phaseBefore": "SUCCEEDED", "phaseAfter": "SUCCEEDED", "durationBefore": "1m0s", "durationAfter": "3m0s", "durationDelta": "2m0s"},
+{"node": "n0-0-n0", "phaseBefore": "SUCCEEDED", "phaseAfter": "SUCCEEDED", "cacheStatusBefore": "CACHE_HIT", "cacheStatusAfter": "CACHE_MISS", "durationBefore": "30s", "durationAfter": "1m30s", "durationDelta": "1m0s"}]}`)
+	})
+
+	t.Run("data too large to return inline", func(t *testing.T) {
+		inputs, err := proto.Marshal(&core.LiteralMap{Literals: map[string]*core.Literal{"x": coreutils.MustMakeLiteral(1)}})
+		assert.NoError(t, err)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/inputs.pb" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			_, _ = w.Write(inputs)
+		}))
+		defer server.Close()
+
+		s := testutils.Setup(t)
+		// The inputs of the good execution are read through their signed url, its outputs can't be read
+		mockExecution(&s, "good", time.Minute, &admin.WorkflowExecutionGetDataResponse{
+			Inputs:      &admin.UrlBlob{Url: server.URL + "/inputs.pb", Bytes: int64(len(inputs))},
+			FullInputs:  &core.LiteralMap{},
+			Outputs:     &admin.UrlBlob{Url: server.URL + "/outputs.pb", Bytes: 1024},
+			FullOutputs: &core.LiteralMap{},
+		}, core.CatalogCacheStatus_CACHE_HIT)
+		mockExecution(&s, "regressed", time.Minute, inlineInputs(2), core.CatalogCacheStatus_CACHE_HIT)
+
+		err = compareExecutionFunc(s.Ctx, []string{"good", "regressed"}, s.CmdCtx)
+		assert.NoError(t, err)
+		s.TearDownAndVerify(t, fmt.Sprintf(`{"before": "good", "after": "regressed", "phaseBefore": "SUCCEEDED", "phaseAfter": "SUCCEEDED",
+"durationBefore": "1m0s", "durationAfter": "1m0s", "durationDelta": "0s",
+"inputs": [{"type": "Modified", "path": "inputs.x", "before": "1", "after": "2"}],
+"outputs": [{"type": "NotCompared", "path": "outputs", "before": "%s/outputs.pb"}],
+"nodes": [
+{"node": "n0", "phaseBefore": "SUCCEEDED", "phaseAfter": "SUCCEEDED", "durationBefore": "1m0s", "durationAfter": "1m0s", "durationDelta": "0s"},
+{"node": "n0-0-n0", "phaseBefore": "SUCCEEDED", "phaseAfter": "SUCCEEDED", "cacheStatusBefore": "CACHE_HIT", "cacheStatusAfter": "CACHE_HIT", "durationBefore": "30s", "durationAfter": "30s", "durationDelta": "0s"}]}`, server.URL))
+	})
+
+	t.Run("missing execution", func(t *testing.T) {
+		s := testutils.Setup(t)
+		err := compareExecutionFunc(s.Ctx, []string{"good"}, s.CmdCtx)
+		assert.EqualError(t, err, "expected arguments <execution-a> <execution-b>, received 1")
+	})
+
+	t.Run("fetch failure", func(t *testing.T) {
+		s := testutils.Setup(t)
+		s.FetcherExt.EXPECT().FetchExecution(s.Ctx, "good", projectValue, domainValue).
+			Return(nil, fmt.Errorf("not found"))
+		err := compareExecutionFunc(s.Ctx, []string{"good", "regressed"}, s.CmdCtx)
+		assert.EqualError(t, err, "not found")
+	})
+}
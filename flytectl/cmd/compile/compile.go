@@ -3,18 +3,34 @@ package compile
 import (
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
+	"sort"
 
+	rootConfig "github.com/flyteorg/flyte/flytectl/cmd/config"
 	config "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/compile"
 	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
 	"github.com/flyteorg/flyte/flytectl/cmd/register"
+	"github.com/flyteorg/flyte/flytectl/pkg/printer"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/common"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/lint"
 )
 
+var lintColumns = []printer.Column{
+	{Header: "Code", JSONPath: "$.code"},
+	{Header: "Workflow", JSONPath: "$.workflowId"},
+	{Header: "Node", JSONPath: "$.nodeId"},
+	{Header: "Description", JSONPath: "$.description"},
+}
+
+// progressOut receives the human readable compilation progress. It is redirected to stderr when lint warnings are
+// printed in a machine-readable format so that stdout only contains the lint report.
+var progressOut io.Writer = os.Stdout
+
 // Utility function for compiling a list of Tasks
 func compileTasks(tasks []*core.TaskTemplate) ([]*core.CompiledTask, error) {
 	res := make([]*core.CompiledTask, 0, len(tasks))
@@ -28,20 +44,49 @@ func compileTasks(tasks []*core.TaskTemplate) ([]*core.CompiledTask, error) {
 	return res, nil
 }
 
+// Package holds the compiled tasks, workflows and launch plans of a flyte package.
+type Package struct {
+	Tasks []*core.CompiledTask
+	// Workflows are the compiled workflows keyed by workflow name.
+	Workflows map[string]*core.CompiledWorkflowClosure
+	// LaunchPlans are the launch plans keyed by launch plan name.
+	LaunchPlans map[string]*admin.LaunchPlan
+}
+
 /*
 Utility to compile a packaged workflow locally.
 compilation is done locally so no flyte cluster is required.
 */
 func compileFromPackage(packagePath string) error {
+	pkg, err := CompilePackage(packagePath, progressOut)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(progressOut, "\nSummary:")
+	fmt.Fprintln(progressOut, len(pkg.Workflows), " workflows found in package")
+	fmt.Fprintln(progressOut, len(pkg.Tasks), " Tasks found in package")
+	fmt.Fprintln(progressOut, len(pkg.LaunchPlans), " Launch plans found in package")
+
+	if config.DefaultCompileConfig.Lint {
+		return lintWorkflows(pkg.Workflows)
+	}
+
+	return nil
+}
+
+// CompilePackage extracts and compiles all the tasks, workflows and launch plans of a flyte package. The compilation
+// progress is written to progress.
+func CompilePackage(packagePath string, progress io.Writer) (*Package, error) {
 	args := []string{packagePath}
 	fileList, tmpDir, err := register.GetSerializeOutputFiles(context.Background(), args, true)
 	defer os.RemoveAll(tmpDir)
 	if err != nil {
-		fmt.Println("Error found while extracting package..")
-		return err
+		fmt.Fprintln(progress, "Error found while extracting package..")
+		return nil, err
 	}
-	fmt.Println("Successfully extracted package...")
-	fmt.Println("Processing Protobuf files...")
+	fmt.Fprintln(progress, "Successfully extracted package...")
+	fmt.Fprintln(progress, "Processing Protobuf files...")
 	workflows := make(map[string]*admin.WorkflowSpec)
 	plans := make(map[string]*admin.LaunchPlan)
 	tasks := []*admin.TaskSpec{}
@@ -49,12 +94,12 @@ func compileFromPackage(packagePath string) error {
 	for _, pbFilePath := range fileList {
 		rawTsk, err := ioutil.ReadFile(pbFilePath)
 		if err != nil {
-			fmt.Printf("error unmarshalling task..")
-			return err
+			fmt.Fprintf(progress, "error unmarshalling task..")
+			return nil, err
 		}
 		spec, err := register.UnMarshalContents(context.Background(), rawTsk, pbFilePath)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		switch v := spec.(type) {
@@ -73,11 +118,11 @@ func compileFromPackage(packagePath string) error {
 		taskTemplates = append(taskTemplates, task.GetTemplate())
 	}
 
-	fmt.Println("\nCompiling tasks...")
+	fmt.Fprintln(progress, "\nCompiling tasks...")
 	compiledTasks, err := compileTasks(taskTemplates)
 	if err != nil {
-		fmt.Println("Error while compiling tasks...")
-		return err
+		fmt.Fprintln(progress, "Error while compiling tasks...")
+		return nil, err
 	}
 
 	var providers []common.InterfaceProvider
@@ -85,19 +130,42 @@ func compileFromPackage(packagePath string) error {
 
 	// compile workflows
 	for _, workflow := range workflows {
-		providers, err = handleWorkflow(workflow, compiledTasks, compiledWorkflows, providers, plans, workflows)
+		providers, err = handleWorkflow(workflow, compiledTasks, compiledWorkflows, providers, plans, workflows, progress)
 
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	fmt.Println("All Workflows compiled successfully!")
-	fmt.Println("\nSummary:")
-	fmt.Println(len(workflows), " workflows found in package")
-	fmt.Println(len(tasks), " Tasks found in package")
-	fmt.Println(len(plans), " Launch plans found in package")
-	return nil
+	fmt.Fprintln(progress, "All Workflows compiled successfully!")
+	return &Package{
+		Tasks:       compiledTasks,
+		Workflows:   compiledWorkflows,
+		LaunchPlans: plans,
+	}, nil
+}
+
+// Runs the lint pass on all compiled workflows and prints the collected warnings in the configured output format.
+// Lint warnings are advisory and never fail the compilation.
+func lintWorkflows(compiledWorkflows map[string]*core.CompiledWorkflowClosure) error {
+	warnings := make([]lint.Warning, 0)
+	for _, wfName := range sortedKeys(compiledWorkflows) {
+		warnings = append(warnings, lint.Lint(compiledWorkflows[wfName])...)
+	}
+
+	fmt.Fprintln(progressOut, "\nLint warnings:")
+	adminPrinter := printer.Printer{}
+	return adminPrinter.PrintInterface(rootConfig.GetConfig().MustOutputFormat(), lintColumns, warnings)
+}
+
+func sortedKeys(compiledWorkflows map[string]*core.CompiledWorkflowClosure) []string {
+	keys := make([]string, 0, len(compiledWorkflows))
+	for k := range compiledWorkflows {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+	return keys
 }
 
 func handleWorkflow(
@@ -106,7 +174,8 @@ func handleWorkflow(
 	compiledWorkflows map[string]*core.CompiledWorkflowClosure,
 	compiledLaunchPlanProviders []common.InterfaceProvider,
 	plans map[string]*admin.LaunchPlan,
-	workflows map[string]*admin.WorkflowSpec) ([]common.InterfaceProvider, error) {
+	workflows map[string]*admin.WorkflowSpec,
+	progress io.Writer) ([]common.InterfaceProvider, error) {
 	reqs, _ := compiler.GetRequirements(workflow.GetTemplate(), workflow.GetSubWorkflows())
 	wfName := workflow.GetTemplate().GetId().GetName()
 
@@ -118,14 +187,14 @@ func handleWorkflow(
 		if compiledWorkflows[lpWfName] == nil {
 			// Recursively compile the missing workflow first
 			err := error(nil)
-			compiledLaunchPlanProviders, err = handleWorkflow(missingWorkflow, compiledTasks, compiledWorkflows, compiledLaunchPlanProviders, plans, workflows)
+			compiledLaunchPlanProviders, err = handleWorkflow(missingWorkflow, compiledTasks, compiledWorkflows, compiledLaunchPlanProviders, plans, workflows, progress)
 			if err != nil {
 				return nil, err
 			}
 		}
 	}
 
-	fmt.Println("\nCompiling workflow:", wfName)
+	fmt.Fprintln(progress, "\nCompiling workflow:", wfName)
 
 	wf, err := compiler.CompileWorkflow(workflow.GetTemplate(),
 		workflow.GetSubWorkflows(),
@@ -133,7 +202,7 @@ func handleWorkflow(
 		compiledLaunchPlanProviders)
 
 	if err != nil {
-		fmt.Println(":( Error Compiling workflow:", wfName)
+		fmt.Fprintln(progress, ":( Error Compiling workflow:", wfName)
 		return nil, err
 	}
 	compiledWorkflows[wfName] = wf
@@ -172,6 +241,13 @@ Validate workflows by compiling flyte's serialized protobuf files  (task, workfl
 
  flytectl compile --file /home/user/dags/my-flyte-package.tgz
 
+Run the lint pass on the compiled workflows to report advisory warnings, e.g. unused node outputs, unreachable branch
+cases or interruptible tasks without retries. Use the output flag for a machine-readable report:
+
+::
+
+ flytectl compile --file my-flyte-package.tgz --lint -o json
+
 .. note::
    Input file is a path to a tgz. This file is generated by either pyflyte or jflyte. tgz file contains protobuf files describing workflows, tasks and launch plans.
 
@@ -183,6 +259,15 @@ func compile(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext)
 	if packageFilePath == "" {
 		return fmt.Errorf("path to package tgz's file is a required flag")
 	}
+
+	if config.DefaultCompileConfig.Lint {
+		switch rootConfig.GetConfig().MustOutputFormat() {
+		case printer.OutputFormatJSON, printer.OutputFormatYAML:
+			progressOut = os.Stderr
+			defer func() { progressOut = os.Stdout }()
+		}
+	}
+
 	return compileFromPackage(packageFilePath)
 }
 
@@ -73,3 +73,11 @@ func TestCompilePackage(t *testing.T) {
 	err = compileFromPackage("testdata/launchplan-in-wf.tgz")
 	assert.Nil(t, err, "unable to compile workflow with launchplans used within workflow")
 }
+
+func TestCompilePackageWithLint(t *testing.T) {
+	config.DefaultCompileConfig.Lint = true
+	defer func() { config.DefaultCompileConfig.Lint = false }()
+
+	err := compileFromPackage("testdata/valid-package.tgz")
+	assert.Nil(t, err, "lint warnings must not fail compilation")
+}
@@ -0,0 +1,132 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/flyteorg/flyte/flyteidl/clients/go/admin"
+	"github.com/flyteorg/flyte/flytestdlib/config"
+)
+
+var (
+	// The section is not named context since the context flag is bound to that key.
+	contextsSection = config.MustRegisterSection("contexts", &ContextsConfig{})
+
+	// configFile is the config file flytectl was initialized from.
+	configFile string
+)
+
+// ContextsConfig holds named contexts, each of which points flytectl at an admin endpoint with its own auth settings
+// and default project and domain.
+type ContextsConfig struct {
+	Current     string    `json:"current"`
+	Definitions []Context `json:"definitions"`
+}
+
+// Context is a named profile. The admin settings of the current context override those of the admin section.
+type Context struct {
+	Name       string                 `json:"name"`
+	Project    string                 `json:"project,omitempty"`
+	Domain     string                 `json:"domain,omitempty"`
+	Production bool                   `json:"production,omitempty"`
+	Admin      map[string]interface{} `json:"admin,omitempty"`
+}
+
+// GetContextsConfig returns the contexts config.
+func GetContextsConfig() *ContextsConfig {
+	return contextsSection.GetConfig().(*ContextsConfig)
+}
+
+// SetConfigFile records the config file flytectl was initialized from.
+func SetConfigFile(path string) {
+	configFile = path
+}
+
+// GetConfigFile returns the config file flytectl was initialized from.
+func GetConfigFile() string {
+	return configFile
+}
+
+// Get returns the context with the given name.
+func (c ContextsConfig) Get(name string) (Context, bool) {
+	for _, ctx := range c.Definitions {
+		if ctx.Name == name {
+			return ctx, true
+		}
+	}
+	return Context{}, false
+}
+
+// CurrentContext returns the current context, if any. It fails if the current context is not defined.
+func (c ContextsConfig) CurrentContext() (*Context, error) {
+	if len(c.Current) == 0 {
+		return nil, nil
+	}
+	ctx, ok := c.Get(c.Current)
+	if !ok {
+		return nil, fmt.Errorf("context [%s] is not defined, defined contexts are %v", c.Current, c.Names())
+	}
+	return &ctx, nil
+}
+
+// Names returns the names of the defined contexts.
+func (c ContextsConfig) Names() []string {
+	names := make([]string, 0, len(c.Definitions))
+	for _, ctx := range c.Definitions {
+		names = append(names, ctx.Name)
+	}
+	return names
+}
+
+// Endpoint returns the admin endpoint of the context, or an empty string if it does not set one.
+func (c Context) Endpoint() string {
+	for key, value := range c.Admin {
+		if strings.EqualFold(key, "endpoint") {
+			return fmt.Sprintf("%v", value)
+		}
+	}
+	return ""
+}
+
+// AdminConfig overlays the admin settings of the context on top of base. Settings for which skip returns true, e.g.
+// because they were passed as flags, are left as they are in base.
+func (c Context) AdminConfig(base *admin.Config, skip func(key string) bool) (*admin.Config, error) {
+	overrides := filterSettings(c.Admin, "", skip)
+	raw, err := json.Marshal(overrides)
+	if err != nil {
+		return nil, fmt.Errorf("context [%s]: invalid admin settings: %w", c.Name, err)
+	}
+
+	cfg := *base
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("context [%s]: invalid admin settings: %w", c.Name, err)
+	}
+	return &cfg, nil
+}
+
+// filterSettings drops the settings for which skip returns true. Keys of nested settings are joined with dots.
+func filterSettings(settings map[string]interface{}, prefix string, skip func(key string) bool) map[string]interface{} {
+	filtered := make(map[string]interface{}, len(settings))
+	for key, value := range settings {
+		if skip(prefix + key) {
+			continue
+		}
+		switch nested := value.(type) {
+		case map[string]interface{}:
+			filtered[key] = filterSettings(nested, prefix+key+".", skip)
+		case map[interface{}]interface{}:
+			asStrings := make(map[string]interface{}, len(nested))
+			for k, v := range nested {
+				asStrings[fmt.Sprintf("%v", k)] = v
+			}
+			filtered[key] = filterSettings(asStrings, prefix+key+".", skip)
+		default:
+			filtered[key] = value
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,68 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyte/flyteidl/clients/go/admin"
+	stdConfig "github.com/flyteorg/flyte/flytestdlib/config"
+	"github.com/stretchr/testify/assert"
+)
+
+var testContexts = ContextsConfig{
+	Current: "prod",
+	Definitions: []Context{
+		{Name: "sandbox", Project: "flytesnacks", Domain: "development"},
+		{
+			Name:       "prod",
+			Production: true,
+			Admin: map[string]interface{}{
+				"endpoint": "dns:///flyte.example.com",
+				"authtype": "Pkce",
+				"pkceConfig": map[interface{}]interface{}{
+					"timeout": "1m",
+				},
+			},
+		},
+	},
+}
+
+func TestContextsConfig_CurrentContext(t *testing.T) {
+	current, err := testContexts.CurrentContext()
+	assert.NoError(t, err)
+	assert.Equal(t, "prod", current.Name)
+	assert.Equal(t, "dns:///flyte.example.com", current.Endpoint())
+
+	current, err = ContextsConfig{}.CurrentContext()
+	assert.NoError(t, err)
+	assert.Nil(t, current)
+
+	_, err = ContextsConfig{Current: "staging", Definitions: testContexts.Definitions}.CurrentContext()
+	assert.EqualError(t, err, "context [staging] is not defined, defined contexts are [sandbox prod]")
+}
+
+func TestContext_AdminConfig(t *testing.T) {
+	base := &admin.Config{
+		Endpoint:              stdConfig.URL{},
+		UseInsecureConnection: true,
+		ClientID:              "flytectl",
+	}
+	prod, _ := testContexts.Get("prod")
+
+	cfg, err := prod.AdminConfig(base, func(key string) bool { return false })
+	assert.NoError(t, err)
+	assert.Equal(t, "dns:///flyte.example.com", cfg.Endpoint.String())
+	assert.Equal(t, admin.AuthTypePkce, cfg.AuthType)
+	assert.Equal(t, time.Minute, cfg.PkceConfig.BrowserSessionTimeout.Duration)
+	assert.True(t, cfg.UseInsecureConnection)
+	assert.Equal(t, "flytectl", cfg.ClientID)
+	assert.Empty(t, base.Endpoint.String())
+
+	cfg, err = prod.AdminConfig(base, func(key string) bool { return key == "authtype" })
+	assert.NoError(t, err)
+	assert.Equal(t, admin.AuthTypeClientSecret, cfg.AuthType)
+
+	_, err = Context{Name: "invalid", Admin: map[string]interface{}{"endpoints": "x"}}.
+		AdminConfig(base, func(key string) bool { return false })
+	assert.ErrorContains(t, err, "context [invalid]: invalid admin settings")
+}
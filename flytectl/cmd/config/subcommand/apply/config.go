@@ -0,0 +1,14 @@
+package apply
+
+//go:generate pflags Config --default-var DefaultConfig --bind-default-var
+var (
+	DefaultConfig = &Config{}
+)
+
+// Config stores the flags required by apply command
+type Config struct {
+	Files  []string `json:"files" pflag:",Manifest files or directories of manifest files to apply."`
+	DryRun bool     `json:"dryRun" pflag:",Print the plan without applying it."`
+	Force  bool     `json:"force" pflag:",Apply the plan without asking for confirmation."`
+	Prune  bool     `json:"prune" pflag:",Delete the attributes and deactivate the launch plans of the declared projects which are not declared."`
+}
@@ -0,0 +1,22 @@
+package bulk
+
+import (
+	"github.com/flyteorg/flyte/flytectl/pkg/filters"
+)
+
+//go:generate pflags Config --default-var DefaultConfig --bind-default-var
+var (
+	DefaultConfig = &Config{
+		Filter:      filters.DefaultFilter,
+		Concurrency: 10,
+	}
+)
+
+// Config stores the flags required by the bulk execution commands
+type Config struct {
+	Filter         filters.Filters `json:"filter" pflag:","`
+	Concurrency    int             `json:"concurrency" pflag:",number of executions to act on concurrently."`
+	DryRun         bool            `json:"dryRun" pflag:",only preview the matching executions without making any modifications."`
+	Force          bool            `json:"force" pflag:",do not ask for confirmation after the preview."`
+	OverwriteCache bool            `json:"overwriteCache" pflag:",skip cached results when relaunching executions."`
+}
@@ -8,4 +8,5 @@ var (
 // Config stores the flags required by compile command
 type Config struct {
 	File string `json:"file" pflag:",Path to a flyte package file. Flyte packages are tgz files generated by pyflyte or jflyte."`
+	Lint bool   `json:"lint" pflag:",Run the lint pass on compiled workflows and report warnings."`
 }
@@ -51,5 +51,6 @@ func (Config) mustMarshalJSON(v json.Marshaler) string {
 func (cfg Config) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags := pflag.NewFlagSet("Config", pflag.ExitOnError)
 	cmdFlags.StringVar(&DefaultCompileConfig.File, fmt.Sprintf("%v%v", prefix, "file"), DefaultCompileConfig.File, "Path to a flyte package file. Flyte packages are tgz files generated by pyflyte or jflyte.")
+	cmdFlags.BoolVar(&DefaultCompileConfig.Lint, fmt.Sprintf("%v%v", prefix, "lint"), DefaultCompileConfig.Lint, "Run the lint pass on compiled workflows and report warnings.")
 	return cmdFlags
 }
@@ -113,4 +113,18 @@ func TestConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_lint", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("lint", testValue)
+			if vBool, err := cmdFlags.GetBool("lint"); err == nil {
+				testDecodeJson_Config(t, fmt.Sprintf("%v", vBool), &actual.Lint)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 }
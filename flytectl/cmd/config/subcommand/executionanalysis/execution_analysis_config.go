@@ -0,0 +1,16 @@
+package executionanalysis
+
+//go:generate pflags Config --default-var DefaultConfig --bind-default-var
+var (
+	DefaultConfig = &Config{
+		Depth: 10,
+		Top:   10,
+	}
+)
+
+// Config stores the flags required by get execution-analysis command
+type Config struct {
+	Depth        int32 `json:"depth" pflag:",Number of levels of nested workflows and launch plans to break the execution down into."`
+	Top          int   `json:"top" pflag:",Number of nodes contributing the most time to the critical path to list. Lists all nodes if 0."`
+	CriticalPath bool  `json:"criticalPath" pflag:",List the segments of the critical path instead of the nodes contributing the most time to it."`
+}
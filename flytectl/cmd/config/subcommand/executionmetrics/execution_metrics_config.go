@@ -0,0 +1,16 @@
+package executionmetrics
+
+//go:generate pflags Config --default-var DefaultConfig --bind-default-var
+var (
+	DefaultConfig = &Config{
+		Format: "chrome-trace",
+		Depth:  10,
+	}
+)
+
+// Config stores the flags required by get execution-metrics command
+type Config struct {
+	Format     string `json:"format" pflag:",Trace format to export the execution metrics in, either chrome-trace or otlp."`
+	Depth      int32  `json:"depth" pflag:",Number of levels of nested workflows and launch plans to break the execution down into."`
+	OutputFile string `json:"outputFile" pflag:",Path of the file to write the trace to. Writes the trace to stdout if unset."`
+}
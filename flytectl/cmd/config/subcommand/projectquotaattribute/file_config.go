@@ -0,0 +1,45 @@
+package projectquotaattribute
+
+import (
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+// ProjectQuotaAttrFileConfig shadow Config for ProjectQuotaAttribute.
+// Quotas apply to a project or to a domain of it, so unlike other attributes it cannot be set for a workflow.
+type ProjectQuotaAttrFileConfig struct {
+	Project string `json:"project"`
+	Domain  string `json:"domain"`
+	*admin.ProjectQuotaAttributes
+}
+
+// Decorate decorator over ProjectQuotaAttributes.
+func (t ProjectQuotaAttrFileConfig) Decorate() *admin.MatchingAttributes {
+	return &admin.MatchingAttributes{
+		Target: &admin.MatchingAttributes_ProjectQuotaAttributes{
+			ProjectQuotaAttributes: t.ProjectQuotaAttributes,
+		},
+	}
+}
+
+// UnDecorate to uncover ProjectQuotaAttributes.
+func (t *ProjectQuotaAttrFileConfig) UnDecorate(matchingAttribute *admin.MatchingAttributes) {
+	if matchingAttribute == nil {
+		return
+	}
+	t.ProjectQuotaAttributes = matchingAttribute.GetProjectQuotaAttributes()
+}
+
+// GetProject from the ProjectQuotaAttrFileConfig
+func (t ProjectQuotaAttrFileConfig) GetProject() string {
+	return t.Project
+}
+
+// GetDomain from the ProjectQuotaAttrFileConfig
+func (t ProjectQuotaAttrFileConfig) GetDomain() string {
+	return t.Domain
+}
+
+// GetWorkflow is always empty as quotas are not set for workflows.
+func (t ProjectQuotaAttrFileConfig) GetWorkflow() string {
+	return ""
+}
@@ -0,0 +1,42 @@
+package projectquotaattribute
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileConfig(t *testing.T) {
+	quotaAttrFileConfig := ProjectQuotaAttrFileConfig{
+		Project: "dummyProject",
+		Domain:  "dummyDomain",
+		ProjectQuotaAttributes: &admin.ProjectQuotaAttributes{
+			MaxConcurrentExecutions: 10,
+			MaxGpu:                  "4",
+			Overflow:                admin.ProjectQuotaAttributes_QUEUE,
+		},
+	}
+	matchingAttr := &admin.MatchingAttributes{
+		Target: &admin.MatchingAttributes_ProjectQuotaAttributes{
+			ProjectQuotaAttributes: quotaAttrFileConfig.ProjectQuotaAttributes,
+		},
+	}
+	t.Run("decorate", func(t *testing.T) {
+		assert.Equal(t, matchingAttr, quotaAttrFileConfig.Decorate())
+	})
+
+	t.Run("undecorate", func(t *testing.T) {
+		quotaAttrFileConfigNew := ProjectQuotaAttrFileConfig{
+			Project: "dummyProject",
+			Domain:  "dummyDomain",
+		}
+		quotaAttrFileConfigNew.UnDecorate(matchingAttr)
+		assert.Equal(t, quotaAttrFileConfig, quotaAttrFileConfigNew)
+	})
+	t.Run("get project domain workflow", func(t *testing.T) {
+		assert.Equal(t, "dummyProject", quotaAttrFileConfig.GetProject())
+		assert.Equal(t, "dummyDomain", quotaAttrFileConfig.GetDomain())
+		assert.Empty(t, quotaAttrFileConfig.GetWorkflow())
+	})
+}
@@ -0,0 +1,18 @@
+package runlocal
+
+//go:generate pflags Config --default-var DefaultConfig --bind-default-var
+var (
+	DefaultConfig = &Config{
+		ContainerRuntime: "docker",
+	}
+)
+
+// Config stores the flags required by run-local command
+type Config struct {
+	File             string `json:"file" pflag:",Path to a flyte package file. Flyte packages are tgz files generated by pyflyte or jflyte."`
+	Inputs           string `json:"inputs" pflag:",Path to a yaml file with the inputs of the workflow."`
+	Mocks            string `json:"mocks" pflag:",Path to a yaml file with the outputs to substitute for tasks and nodes instead of running them."`
+	MocksOnly        bool   `json:"mocksOnly" pflag:",Fail tasks without mocked outputs instead of running their containers."`
+	ContainerRuntime string `json:"containerRuntime" pflag:",Container runtime used to run container tasks, e.g. docker or podman."`
+	DataDir          string `json:"dataDir" pflag:",Directory to keep the inputs and outputs of the nodes in. Defaults to a temporary directory removed after the run."`
+}
@@ -70,6 +70,20 @@ func CreateConfigCommand() *cobra.Command {
 			DisableFlyteClient:       true,
 			Short:                    initCmdShort,
 			Long:                     initCmdLong, PFlagProvider: initConfig.DefaultConfig},
+		"get-contexts": {
+			CmdFunc:                  getContextsFunc,
+			ProjectDomainNotRequired: true,
+			DisableFlyteClient:       true,
+			Short:                    getContextsCmdShort,
+			Long:                     getContextsCmdLong,
+		},
+		"use-context": {
+			CmdFunc:                  useContextFunc,
+			ProjectDomainNotRequired: true,
+			DisableFlyteClient:       true,
+			Short:                    useContextCmdShort,
+			Long:                     useContextCmdLong,
+		},
 	}
 
 	configCmd.Flags().BoolVar(&initConfig.DefaultConfig.Force, "force", false, "Force to overwrite the default config file without confirmation")
@@ -22,7 +22,7 @@ func TestCreateInitCommand(t *testing.T) {
 	assert.Equal(t, configCmd.Use, "config")
 	assert.Equal(t, configCmd.Short, "Runs various config commands, look at the help of this command to get a list of available commands..")
 	fmt.Println(configCmd.Commands())
-	assert.Equal(t, 4, len(configCmd.Commands()))
+	assert.Equal(t, 6, len(configCmd.Commands()))
 	cmdNouns := configCmd.Commands()
 	// Sort by Use value.
 	sort.Slice(cmdNouns, func(i, j int) bool {
@@ -34,10 +34,14 @@ func TestCreateInitCommand(t *testing.T) {
 	assert.Equal(t, "docs", cmdNouns[1].Use)
 	assert.Equal(t, "Generate configuration documentation in rst format", cmdNouns[1].Short)
 
-	assert.Equal(t, "init", cmdNouns[2].Use)
-	assert.Equal(t, initCmdShort, cmdNouns[2].Short)
-	assert.Equal(t, "validate", cmdNouns[3].Use)
-	assert.Equal(t, "Validates the loaded config.", cmdNouns[3].Short)
+	assert.Equal(t, "get-contexts", cmdNouns[2].Use)
+	assert.Equal(t, getContextsCmdShort, cmdNouns[2].Short)
+	assert.Equal(t, "init", cmdNouns[3].Use)
+	assert.Equal(t, initCmdShort, cmdNouns[3].Short)
+	assert.Equal(t, "use-context", cmdNouns[4].Use)
+	assert.Equal(t, useContextCmdShort, cmdNouns[4].Short)
+	assert.Equal(t, "validate", cmdNouns[5].Use)
+	assert.Equal(t, "Validates the loaded config.", cmdNouns[5].Short)
 }
 
 func TestSetupConfigFunc(t *testing.T) {
@@ -0,0 +1,169 @@
+package configuration
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	cmdcore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/pkg/printer"
+	"gopkg.in/yaml.v3"
+)
+
+// Long descriptions are whitespace sensitive when generating docs using Sphinx.
+const (
+	getContextsCmdShort = `Lists the contexts defined in the config file.`
+	getContextsCmdLong  = `
+A context is a named set of admin endpoint, auth and default project and domain settings. Contexts are defined in the
+contexts section of the config file, e.g.
+
+.. code-block:: yaml
+
+    admin:
+      endpoint: dns:///localhost:30080
+      insecure: true
+    contexts:
+      current: sandbox
+      definitions:
+        - name: sandbox
+          project: flytesnacks
+          domain: development
+        - name: prod
+          production: true
+          project: flytesnacks
+          domain: production
+          admin:
+            endpoint: dns:///flyte.myexample.com
+            insecure: false
+            authType: Pkce
+
+The admin settings of a context override those of the admin section, and its project and domain are used when no
+project or domain flags are passed. Commands which change resources ask for confirmation in contexts marked as
+production, unless forced, run as a dry run or run with --yes or the FLYTECTL_YES env var, e.g. in CI. Each context
+caches its auth token separately.
+
+To list the contexts, with the current one marked:
+::
+
+ flytectl config get-contexts
+
+To run a single command in another context:
+::
+
+ flytectl get project --context prod
+`
+	useContextCmdShort = `Sets the current context in the config file.`
+	useContextCmdLong  = `
+Sets the context used by all subsequent commands:
+::
+
+ flytectl config use-context prod
+`
+)
+
+type contextRow struct {
+	Current    string `json:"current"`
+	Name       string `json:"name"`
+	Endpoint   string `json:"endpoint"`
+	Project    string `json:"project"`
+	Domain     string `json:"domain"`
+	Production string `json:"production"`
+}
+
+var contextColumns = []printer.Column{
+	{Header: "Current", JSONPath: "$.current"},
+	{Header: "Name", JSONPath: "$.name"},
+	{Header: "Endpoint", JSONPath: "$.endpoint"},
+	{Header: "Project", JSONPath: "$.project"},
+	{Header: "Domain", JSONPath: "$.domain"},
+	{Header: "Production", JSONPath: "$.production"},
+}
+
+func getContextsFunc(ctx context.Context, args []string, cmdCtx cmdcore.CommandContext) error {
+	contexts := config.GetContextsConfig()
+	rows := make([]contextRow, 0, len(contexts.Definitions))
+	for _, c := range contexts.Definitions {
+		row := contextRow{
+			Name:       c.Name,
+			Endpoint:   c.Endpoint(),
+			Project:    c.Project,
+			Domain:     c.Domain,
+			Production: fmt.Sprintf("%v", c.Production),
+		}
+		if c.Name == contexts.Current {
+			row.Current = "*"
+		}
+		rows = append(rows, row)
+	}
+	adminPrinter := printer.Printer{}
+	return adminPrinter.PrintInterface(config.GetConfig().MustOutputFormat(), contextColumns, rows)
+}
+
+func useContextFunc(ctx context.Context, args []string, cmdCtx cmdcore.CommandContext) error {
+	if len(args) != 1 {
+		return fmt.Errorf("exactly one context name is required")
+	}
+	name := args[0]
+	if _, ok := config.GetContextsConfig().Get(name); !ok {
+		return fmt.Errorf("context [%s] is not defined, defined contexts are %v", name,
+			config.GetContextsConfig().Names())
+	}
+	if err := setCurrentContext(config.GetConfigFile(), name); err != nil {
+		return err
+	}
+	fmt.Printf("Switched to context [%s]\n", name)
+	return nil
+}
+
+// setCurrentContext rewrites the current context in the config file, keeping the rest of the file as it is.
+func setCurrentContext(configFile, name string) error {
+	info, err := os.Stat(configFile)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(configFile)
+	if err != nil {
+		return err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to parse config file [%s]: %w", configFile, err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return fmt.Errorf("config file [%s] does not define any contexts", configFile)
+	}
+
+	contextSection := mappingValue(doc.Content[0], "contexts")
+	if contextSection == nil || contextSection.Kind != yaml.MappingNode {
+		return fmt.Errorf("config file [%s] does not define any contexts", configFile)
+	}
+	if current := mappingValue(contextSection, "current"); current != nil {
+		current.Kind = yaml.ScalarNode
+		current.Tag = "!!str"
+		current.Value = name
+	} else {
+		contextSection.Content = append([]*yaml.Node{
+			{Kind: yaml.ScalarNode, Tag: "!!str", Value: "current"},
+			{Kind: yaml.ScalarNode, Tag: "!!str", Value: name},
+		}, contextSection.Content...)
+	}
+
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return err
+	}
+	return os.WriteFile(configFile, out.Bytes(), info.Mode().Perm())
+}
+
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package configuration
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetCurrentContext(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(configFile, []byte(`# admin of the sandbox
+admin:
+  endpoint: dns:///localhost:30080
+contexts:
+  definitions:
+    - name: sandbox
+    - name: prod
+`), 0600))
+
+	assert.NoError(t, setCurrentContext(configFile, "prod"))
+	assert.NoError(t, setCurrentContext(configFile, "sandbox"))
+	data, err := os.ReadFile(configFile)
+	assert.NoError(t, err)
+	assert.Equal(t, `# admin of the sandbox
+admin:
+  endpoint: dns:///localhost:30080
+contexts:
+  current: sandbox
+  definitions:
+    - name: sandbox
+    - name: prod
+`, string(data))
+
+	info, err := os.Stat(configFile)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestSetCurrentContextWithoutContexts(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(configFile, []byte("admin:\n  endpoint: dns:///localhost:30080\n"), 0600))
+
+	err := setCurrentContext(configFile, "prod")
+	assert.ErrorContains(t, err, "does not define any contexts")
+}
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	cmdUtil "github.com/flyteorg/flyte/flytectl/pkg/commandutils"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/admin"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	contextEnvVar   = "FLYTECTL_CONTEXT"
+	assumeYesEnvVar = "FLYTECTL_YES"
+)
+
+// mutatingCommands are the top level commands which change Flyte resources. They ask for confirmation when run
+// against a production context unless forced, run with --yes or FLYTECTL_YES, or run as a dry run.
+var mutatingCommands = map[string]bool{
+	"apply":    true,
+	"bulk":     true,
+	"create":   true,
+	"delete":   true,
+	"register": true,
+	"update":   true,
+}
+
+// confirmationReader is where the production context confirmation is read from.
+var confirmationReader io.Reader = os.Stdin
+
+// applyContext overlays the settings of the selected context on top of the loaded config. The context is picked by
+// the context flag, the FLYTECTL_CONTEXT env var or the current context of the config file, in this order. Flags take
+// precedence over the settings of the context.
+func applyContext(rootCmd, cmd *cobra.Command) error {
+	contexts := config.GetContextsConfig()
+	if len(contextName) > 0 {
+		contexts.Current = contextName
+	} else if len(os.Getenv(contextEnvVar)) > 0 {
+		contexts.Current = os.Getenv(contextEnvVar)
+	}
+	current, err := contexts.CurrentContext()
+	if err != nil && topLevelCommand(cmd) == "config" {
+		// Keep the config commands usable to fix the current context.
+		return nil
+	}
+	if err != nil || current == nil {
+		return err
+	}
+
+	flags := rootCmd.PersistentFlags()
+	if len(current.Project) > 0 && !flags.Changed("project") {
+		config.GetConfig().Project = current.Project
+	}
+	if len(current.Domain) > 0 && !flags.Changed("domain") {
+		config.GetConfig().Domain = current.Domain
+	}
+
+	adminCfg, err := current.AdminConfig(admin.GetConfig(context.TODO()), func(key string) bool {
+		return flagChanged(flags, "admin."+key)
+	})
+	if err != nil {
+		return err
+	}
+	if err := admin.SetConfig(adminCfg); err != nil {
+		return err
+	}
+
+	if current.Production && mutatingCommands[topLevelCommand(cmd)] && !confirmed(cmd) {
+		if !cmdUtil.AskForConfirmation(fmt.Sprintf("Context [%s] is marked as production. Do you want to continue?",
+			current.Name), confirmationReader) {
+			return fmt.Errorf("aborted by user in production context [%s]", current.Name)
+		}
+	}
+	return nil
+}
+
+// flagChanged reports whether the flag was set on the command line. Config keys are case insensitive.
+func flagChanged(flags *pflag.FlagSet, name string) bool {
+	changed := false
+	flags.VisitAll(func(f *pflag.Flag) {
+		if f.Changed && strings.EqualFold(f.Name, name) {
+			changed = true
+		}
+	})
+	return changed
+}
+
+func topLevelCommand(cmd *cobra.Command) string {
+	for cmd.HasParent() && cmd.Parent().HasParent() {
+		cmd = cmd.Parent()
+	}
+	return cmd.Name()
+}
+
+// confirmed reports whether the command may run against a production context without asking. Confirmation cannot be
+// read when stdin is not a terminal, e.g. in CI, where it has to be given with --yes or FLYTECTL_YES.
+func confirmed(cmd *cobra.Command) bool {
+	if assumeYes || boolFlag(cmd, "force") || boolFlag(cmd, "dryRun") {
+		return true
+	}
+	yes, err := strconv.ParseBool(os.Getenv(assumeYesEnvVar))
+	return err == nil && yes
+}
+
+func boolFlag(cmd *cobra.Command, name string) bool {
+	flag := cmd.Flags().Lookup(name)
+	return flag != nil && flag.Value.String() == "true"
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/admin"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupContexts(t *testing.T, contexts config.ContextsConfig) {
+	originalContexts := *config.GetContextsConfig()
+	originalRoot := *config.GetConfig()
+	originalAdmin := *admin.GetConfig(context.Background())
+	*config.GetContextsConfig() = contexts
+	t.Cleanup(func() {
+		*config.GetContextsConfig() = originalContexts
+		*config.GetConfig() = originalRoot
+		assert.NoError(t, admin.SetConfig(&originalAdmin))
+		contextName = ""
+		assumeYes = false
+		confirmationReader = strings.NewReader("")
+	})
+}
+
+var testContexts = config.ContextsConfig{
+	Current: "sandbox",
+	Definitions: []config.Context{
+		{
+			Name:    "sandbox",
+			Project: "flytesnacks",
+			Domain:  "development",
+			Admin:   map[string]interface{}{"endpoint": "dns:///localhost:30080", "insecure": true},
+		},
+		{
+			Name:       "prod",
+			Project:    "flytesnacks",
+			Domain:     "production",
+			Production: true,
+			Admin:      map[string]interface{}{"endpoint": "dns:///flyte.example.com"},
+		},
+	},
+}
+
+func TestApplyContext(t *testing.T) {
+	setupContexts(t, testContexts)
+	rootCmd := newRootCmd()
+	getCmd, _, err := rootCmd.Find([]string{"get", "project"})
+	assert.NoError(t, err)
+
+	assert.NoError(t, applyContext(rootCmd, getCmd))
+	assert.Equal(t, "dns:///localhost:30080", admin.GetConfig(context.Background()).Endpoint.String())
+	assert.True(t, admin.GetConfig(context.Background()).UseInsecureConnection)
+	assert.Equal(t, "development", config.GetConfig().Domain)
+
+	contextName = "prod"
+	assert.NoError(t, rootCmd.PersistentFlags().Set("domain", "staging"))
+	assert.NoError(t, applyContext(rootCmd, getCmd))
+	assert.Equal(t, "prod", config.GetContextsConfig().Current)
+	assert.Equal(t, "dns:///flyte.example.com", admin.GetConfig(context.Background()).Endpoint.String())
+	assert.Equal(t, "staging", config.GetConfig().Domain)
+}
+
+func TestApplyContextProductionConfirmation(t *testing.T) {
+	setupContexts(t, testContexts)
+	rootCmd := newRootCmd()
+	contextName = "prod"
+	updateCmd, _, err := rootCmd.Find([]string{"update", "execution"})
+	assert.NoError(t, err)
+
+	confirmationReader = strings.NewReader("n")
+	assert.EqualError(t, applyContext(rootCmd, updateCmd), "aborted by user in production context [prod]")
+
+	confirmationReader = strings.NewReader("y")
+	assert.NoError(t, applyContext(rootCmd, updateCmd))
+
+	confirmationReader = strings.NewReader("")
+	assert.NoError(t, updateCmd.Flags().Set("force", "true"))
+	assert.NoError(t, applyContext(rootCmd, updateCmd))
+}
+
+func TestApplyContextProductionConfirmationSkipped(t *testing.T) {
+	setupContexts(t, testContexts)
+	rootCmd := newRootCmd()
+	contextName = "prod"
+	deleteCmd, _, err := rootCmd.Find([]string{"delete", "task-resource-attribute"})
+	assert.NoError(t, err)
+
+	// Without a terminal the confirmation reads EOF and aborts.
+	assert.Error(t, applyContext(rootCmd, deleteCmd))
+
+	t.Run("yes flag", func(t *testing.T) {
+		assert.NoError(t, rootCmd.PersistentFlags().Set("yes", "true"))
+		defer func() { assumeYes = false }()
+		assert.NoError(t, applyContext(rootCmd, deleteCmd))
+	})
+	t.Run("yes env var", func(t *testing.T) {
+		t.Setenv(assumeYesEnvVar, "true")
+		assert.NoError(t, applyContext(rootCmd, deleteCmd))
+	})
+	t.Run("dry run", func(t *testing.T) {
+		assert.NoError(t, deleteCmd.Flags().Set("dryRun", "true"))
+		defer func() { assert.NoError(t, deleteCmd.Flags().Set("dryRun", "false")) }()
+		assert.NoError(t, applyContext(rootCmd, deleteCmd))
+	})
+}
+
+func TestApplyContextUndefined(t *testing.T) {
+	setupContexts(t, testContexts)
+	rootCmd := newRootCmd()
+	contextName = "staging"
+	getCmd, _, err := rootCmd.Find([]string{"get", "project"})
+	assert.NoError(t, err)
+	assert.ErrorContains(t, applyContext(rootCmd, getCmd), "context [staging] is not defined")
+
+	useContextCmd, _, err := rootCmd.Find([]string{"config", "use-context"})
+	assert.NoError(t, err)
+	assert.NoError(t, applyContext(rootCmd, useContextCmd))
+}
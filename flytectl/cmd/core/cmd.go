@@ -73,7 +73,7 @@ func generateCommandFunc(cmdEntry CommandEntry) func(cmd *cobra.Command, args []
 			clientSet, err := admin.ClientSetBuilder().WithConfig(admin.GetConfig(ctx)).
 				WithTokenCache(pkce.NewTokenCacheKeyringProvider(
 					pkce.KeyRingServiceName,
-					fmt.Sprintf("%s:%s", adminCfg.Endpoint.String(), pkce.KeyRingServiceUser),
+					pkce.KeyRingServiceUserFor(adminCfg.Endpoint.String(), config.GetContextsConfig().Current),
 				)).Build(ctx)
 			if err != nil {
 				return err
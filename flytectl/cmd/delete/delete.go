@@ -6,6 +6,7 @@ import (
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/executionclusterlabel"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/executionqueueattribute"
 	pluginoverride "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/plugin_override"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/projectquotaattribute"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/taskresourceattribute"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/workflowexecutionconfig"
 	cmdcore "github.com/flyteorg/flyte/flytectl/cmd/core"
@@ -36,6 +37,9 @@ func RemoteDeleteCommand() *cobra.Command {
 		"task-resource-attribute": {CmdFunc: deleteTaskResourceAttributes, Aliases: []string{"task-resource-attributes"},
 			Short: taskResourceAttributesShort,
 			Long:  taskResourceAttributesLong, PFlagProvider: taskresourceattribute.DefaultDelConfig, ProjectDomainNotRequired: true},
+		"project-quota-attribute": {CmdFunc: deleteProjectQuotaAttributes, Aliases: []string{"project-quota-attributes"},
+			Short: projectQuotaAttributesShort,
+			Long:  projectQuotaAttributesLong, PFlagProvider: projectquotaattribute.DefaultDelConfig, ProjectDomainNotRequired: true},
 		"cluster-resource-attribute": {CmdFunc: deleteClusterResourceAttributes, Aliases: []string{"cluster-resource-attributes"},
 			Short: clusterResourceAttributesShort,
 			Long:  clusterResourceAttributesLong, PFlagProvider: clusterresourceattribute.DefaultDelConfig, ProjectDomainNotRequired: true},
@@ -0,0 +1,63 @@
+package delete
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	sconfig "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/projectquotaattribute"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+const (
+	projectQuotaAttributesShort = "Deletes matchable resources of project quota attributes."
+	projectQuotaAttributesLong  = `
+Delete the quota of the given project and domain.
+
+For project flytesnacks and development domain, run:
+::
+
+ flytectl delete project-quota-attribute -p flytesnacks -d development
+
+To delete project quota attribute using the config file which was used to create it, run:
+
+::
+
+ flytectl delete project-quota-attribute --attrFile pqa.yaml
+
+For example, here's the config file pqa.yaml:
+
+.. code-block:: yaml
+
+    domain: development
+    project: flytesnacks
+    max_concurrent_executions: 10
+    overflow: 1
+
+The limits are optional in the file as they are unread during the delete command, but can be retained since the same file can be used for 'get', 'update' and 'delete' commands.
+
+Usage
+`
+)
+
+func deleteProjectQuotaAttributes(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	var pwdGetter sconfig.ProjectDomainWorkflowGetter
+	pwdGetter = sconfig.PDWGetterCommandLine{Config: config.GetConfig()}
+	delConfig := projectquotaattribute.DefaultDelConfig
+
+	// Get the project domain from the config file or commandline params
+	if len(delConfig.AttrFile) > 0 {
+		pwdGetter = &projectquotaattribute.ProjectQuotaAttrFileConfig{}
+		if err := sconfig.ReadConfigFromFile(pwdGetter, delConfig.AttrFile); err != nil {
+			return err
+		}
+	}
+
+	if err := deleteMatchableAttr(ctx, pwdGetter.GetProject(), pwdGetter.GetDomain(), "", cmdCtx.AdminDeleterExt(),
+		admin.MatchableResource_PROJECT_QUOTA, delConfig.DryRun); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package delete
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/projectquotaattribute"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func deleteProjectQuotaAttributeSetup() {
+	projectquotaattribute.DefaultDelConfig = &projectquotaattribute.AttrDeleteConfig{}
+}
+
+func TestDeleteProjectQuotaAttributes(t *testing.T) {
+	t.Run("successful project domain attribute deletion commandline", func(t *testing.T) {
+		s := testutils.Setup(t)
+
+		deleteProjectQuotaAttributeSetup()
+		s.DeleterExt.EXPECT().DeleteProjectDomainAttributes(mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything).Return(nil)
+		err := deleteProjectQuotaAttributes(s.Ctx, []string{}, s.CmdCtx)
+		assert.Nil(t, err)
+		s.DeleterExt.AssertCalled(t, "DeleteProjectDomainAttributes",
+			s.Ctx, config.GetConfig().Project, config.GetConfig().Domain, admin.MatchableResource_PROJECT_QUOTA)
+	})
+	t.Run("successful project domain attribute deletion file", func(t *testing.T) {
+		s := testutils.Setup(t)
+
+		deleteProjectQuotaAttributeSetup()
+		projectquotaattribute.DefaultDelConfig.AttrFile = "testdata/valid_project_domain_project_quota_attribute.yaml"
+		s.DeleterExt.EXPECT().DeleteProjectDomainAttributes(mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything).Return(nil)
+		err := deleteProjectQuotaAttributes(s.Ctx, []string{}, s.CmdCtx)
+		assert.Nil(t, err)
+		s.DeleterExt.AssertCalled(t, "DeleteProjectDomainAttributes",
+			s.Ctx, "flytesnacks", "development", admin.MatchableResource_PROJECT_QUOTA)
+	})
+}
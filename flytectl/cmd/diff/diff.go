@@ -0,0 +1,73 @@
+package diff
+
+import (
+	"fmt"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	cmdcore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/pkg/diff"
+	"github.com/flyteorg/flyte/flytectl/pkg/printer"
+	"github.com/spf13/cobra"
+)
+
+// Long descriptions are whitespace sensitive when generating docs using sphinx.
+const (
+	diffCmdShort = `Compares two registered versions of Flyte resources such as workflows, launch plans and tasks.`
+	diffCmdLong  = `
+Compare two versions of a workflow:
+::
+
+ flytectl diff workflow -p flytesnacks -d development core.basic.lp.go_greet v1 v2
+
+Print the differences in json format for automation:
+::
+
+ flytectl diff workflow -p flytesnacks -d development core.basic.lp.go_greet v1 v2 -o json
+`
+)
+
+var changeColumns = []printer.Column{
+	{Header: "Type", JSONPath: "$.type"},
+	{Header: "Path", JSONPath: "$.path"},
+	{Header: "Before", JSONPath: "$.before"},
+	{Header: "After", JSONPath: "$.after"},
+}
+
+// CreateDiffCommand will return diff command
+func CreateDiffCommand() *cobra.Command {
+	diffCmd := &cobra.Command{
+		Use:   "diff",
+		Short: diffCmdShort,
+		Long:  diffCmdLong,
+	}
+
+	diffResourcesFuncs := map[string]cmdcore.CommandEntry{
+		"workflow": {CmdFunc: diffWorkflowFunc, Aliases: []string{"workflows"}, Short: workflowShort,
+			Long: workflowLong},
+		"launchplan": {CmdFunc: diffLaunchPlanFunc, Aliases: []string{"launchplans"}, Short: launchPlanShort,
+			Long: launchPlanLong},
+		"task": {CmdFunc: diffTaskFunc, Aliases: []string{"tasks"}, Short: taskShort,
+			Long: taskLong},
+	}
+
+	cmdcore.AddCommands(diffCmd, diffResourcesFuncs)
+	return diffCmd
+}
+
+// parseArgs extracts the entity name and the two versions to compare from the positional arguments.
+func parseArgs(args []string) (name, versionA, versionB string, err error) {
+	if len(args) != 3 {
+		return "", "", "", fmt.Errorf("expected arguments <name> <version-a> <version-b>, received %v", len(args))
+	}
+
+	return args[0], args[1], args[2], nil
+}
+
+func printChanges(changes []diff.Change) error {
+	if changes == nil {
+		changes = []diff.Change{}
+	}
+
+	adminPrinter := printer.Printer{}
+	return adminPrinter.PrintInterface(config.GetConfig().MustOutputFormat(), changeColumns, changes)
+}
@@ -0,0 +1,94 @@
+package diff
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	projectValue = "dummyProject"
+	domainValue  = "dummyDomain"
+)
+
+func TestDiffCommand(t *testing.T) {
+	diffCommand := CreateDiffCommand()
+	assert.Equal(t, diffCommand.Use, "diff")
+	assert.Equal(t, diffCommand.Short, diffCmdShort)
+	assert.Equal(t, diffCommand.Long, diffCmdLong)
+	cmdNouns := diffCommand.Commands()
+	sort.Slice(cmdNouns, func(i, j int) bool {
+		return cmdNouns[i].Use < cmdNouns[j].Use
+	})
+	useArray := []string{"launchplan", "task", "workflow"}
+	aliases := [][]string{{"launchplans"}, {"tasks"}, {"workflows"}}
+	shortArray := []string{launchPlanShort, taskShort, workflowShort}
+	longArray := []string{launchPlanLong, taskLong, workflowLong}
+	if assert.Len(t, cmdNouns, 3) {
+		for i := range cmdNouns {
+			assert.Equal(t, cmdNouns[i].Use, useArray[i])
+			assert.Equal(t, cmdNouns[i].Aliases, aliases[i])
+			assert.Equal(t, cmdNouns[i].Short, shortArray[i])
+			assert.Equal(t, cmdNouns[i].Long, longArray[i])
+		}
+	}
+}
+
+func TestDiffTaskFunc(t *testing.T) {
+	task := func(image string) *admin.Task {
+		return &admin.Task{Closure: &admin.TaskClosure{CompiledTask: &core.CompiledTask{
+			Template: &core.TaskTemplate{
+				Target: &core.TaskTemplate_Container{Container: &core.Container{Image: image}},
+			},
+		}}}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		s := testutils.Setup(t)
+		s.FetcherExt.EXPECT().FetchTaskVersion(s.Ctx, "task1", "v1", projectValue, domainValue).Return(task("img:1"), nil)
+		s.FetcherExt.EXPECT().FetchTaskVersion(s.Ctx, "task1", "v2", projectValue, domainValue).Return(task("img:2"), nil)
+		err := diffTaskFunc(s.Ctx, []string{"task1", "v1", "v2"}, s.CmdCtx)
+		assert.Nil(t, err)
+		s.TearDownAndVerify(t, `[{"type": "Modified", "path": "task.image", "before": "img:1", "after": "img:2"}]`)
+	})
+
+	t.Run("missing version", func(t *testing.T) {
+		s := testutils.Setup(t)
+		err := diffTaskFunc(s.Ctx, []string{"task1", "v1"}, s.CmdCtx)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("fetch failure", func(t *testing.T) {
+		s := testutils.Setup(t)
+		s.FetcherExt.EXPECT().FetchTaskVersion(s.Ctx, "task1", "v1", projectValue, domainValue).Return(nil, fmt.Errorf("not found"))
+		err := diffTaskFunc(s.Ctx, []string{"task1", "v1", "v2"}, s.CmdCtx)
+		assert.EqualError(t, err, "not found")
+	})
+}
+
+func TestDiffWorkflowFunc(t *testing.T) {
+	s := testutils.Setup(t)
+	wf := &admin.Workflow{}
+	s.FetcherExt.EXPECT().FetchWorkflowVersion(s.Ctx, "wf1", "v1", projectValue, domainValue).Return(wf, nil)
+	s.FetcherExt.EXPECT().FetchWorkflowVersion(s.Ctx, "wf1", "v2", projectValue, domainValue).Return(wf, nil)
+	err := diffWorkflowFunc(s.Ctx, []string{"wf1", "v1", "v2"}, s.CmdCtx)
+	assert.Nil(t, err)
+	s.TearDownAndVerify(t, `[]`)
+}
+
+func TestDiffLaunchPlanFunc(t *testing.T) {
+	s := testutils.Setup(t)
+	lp := func(version string) *admin.LaunchPlan {
+		return &admin.LaunchPlan{Spec: &admin.LaunchPlanSpec{WorkflowId: &core.Identifier{Name: "wf1", Version: version}}}
+	}
+	s.FetcherExt.EXPECT().FetchLPVersion(s.Ctx, "lp1", "v1", projectValue, domainValue).Return(lp("v1"), nil)
+	s.FetcherExt.EXPECT().FetchLPVersion(s.Ctx, "lp1", "v2", projectValue, domainValue).Return(lp("v2"), nil)
+	err := diffLaunchPlanFunc(s.Ctx, []string{"lp1", "v1", "v2"}, s.CmdCtx)
+	assert.Nil(t, err)
+	s.TearDownAndVerify(t, `[{"type": "Modified", "path": "workflow", "before": "wf1:v1", "after": "wf1:v2"}]`)
+}
@@ -0,0 +1,42 @@
+package diff
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/pkg/diff"
+)
+
+const (
+	launchPlanShort = "Compares two versions of a launch plan"
+	launchPlanLong  = `
+Compare the specs of two versions of a launch plan. Reports a changed workflow reference, schedule, default inputs and
+fixed inputs:
+::
+
+ flytectl diff launchplan -p flytesnacks -d development core.basic.lp.go_greet v1 v2
+
+Usage
+`
+)
+
+func diffLaunchPlanFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	name, versionA, versionB, err := parseArgs(args)
+	if err != nil {
+		return err
+	}
+
+	project, domain := config.GetConfig().Project, config.GetConfig().Domain
+	before, err := cmdCtx.AdminFetcherExt().FetchLPVersion(ctx, name, versionA, project, domain)
+	if err != nil {
+		return err
+	}
+
+	after, err := cmdCtx.AdminFetcherExt().FetchLPVersion(ctx, name, versionB, project, domain)
+	if err != nil {
+		return err
+	}
+
+	return printChanges(diff.LaunchPlans(before, after))
+}
@@ -0,0 +1,42 @@
+package diff
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/pkg/diff"
+)
+
+const (
+	taskShort = "Compares two versions of a task"
+	taskLong  = `
+Compare the templates of two versions of a task. Reports changed types, images, resources, retries, cache versions and
+interface types:
+::
+
+ flytectl diff task -p flytesnacks -d development core.basic.lp.greet v1 v2
+
+Usage
+`
+)
+
+func diffTaskFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	name, versionA, versionB, err := parseArgs(args)
+	if err != nil {
+		return err
+	}
+
+	project, domain := config.GetConfig().Project, config.GetConfig().Domain
+	before, err := cmdCtx.AdminFetcherExt().FetchTaskVersion(ctx, name, versionA, project, domain)
+	if err != nil {
+		return err
+	}
+
+	after, err := cmdCtx.AdminFetcherExt().FetchTaskVersion(ctx, name, versionB, project, domain)
+	if err != nil {
+		return err
+	}
+
+	return printChanges(diff.Tasks(before, after))
+}
@@ -0,0 +1,42 @@
+package diff
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/pkg/diff"
+)
+
+const (
+	workflowShort = "Compares two versions of a workflow"
+	workflowLong  = `
+Compare the compiled closures of two versions of a workflow. Reports added and removed nodes, changed edges, changed
+interface types and changed images, resources and interfaces of the referenced tasks:
+::
+
+ flytectl diff workflow -p flytesnacks -d development core.basic.lp.go_greet v1 v2
+
+Usage
+`
+)
+
+func diffWorkflowFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	name, versionA, versionB, err := parseArgs(args)
+	if err != nil {
+		return err
+	}
+
+	project, domain := config.GetConfig().Project, config.GetConfig().Domain
+	before, err := cmdCtx.AdminFetcherExt().FetchWorkflowVersion(ctx, name, versionA, project, domain)
+	if err != nil {
+		return err
+	}
+
+	after, err := cmdCtx.AdminFetcherExt().FetchWorkflowVersion(ctx, name, versionB, project, domain)
+	if err != nil {
+		return err
+	}
+
+	return printChanges(diff.Workflows(before, after))
+}
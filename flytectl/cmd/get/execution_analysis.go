@@ -0,0 +1,189 @@
+package get
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/executionanalysis"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/pkg/printer"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+const (
+	executionAnalysisShort = "Analyzes the critical path of an execution."
+	executionAnalysisLong  = `
+Compute the critical path of an execution, attribute its duration to queueing, pod scheduling, image pulls, init
+containers, task runtime and propeller overhead, and list the nodes contributing the most time to it. Speeding up a
+node shortens the execution by at most the time the node contributes to the critical path:
+::
+
+ flytectl get execution-analysis -p flytesnacks -d development oeh94k9r2r
+
+List only the three nodes contributing the most time:
+::
+
+ flytectl get execution-analysis -p flytesnacks -d development oeh94k9r2r --top 3
+
+List the segments of the critical path in chronological order instead:
+::
+
+ flytectl get execution-analysis -p flytesnacks -d development oeh94k9r2r --criticalPath
+
+Print the full analysis in json or yaml format:
+::
+
+ flytectl get execution-analysis -p flytesnacks -d development oeh94k9r2r -o json
+
+Usage
+`
+)
+
+var bottleneckColumns = []printer.Column{
+	{Header: "Node", JSONPath: "$.node"},
+	{Header: "Task", JSONPath: "$.task"},
+	{Header: "Critical Path", JSONPath: "$.duration"},
+	{Header: "Share", JSONPath: "$.share"},
+	{Header: "Queueing", JSONPath: "$.queueing"},
+	{Header: "Pod Scheduling", JSONPath: "$.podScheduling"},
+	{Header: "Image Pull", JSONPath: "$.imagePull"},
+	{Header: "Initialization", JSONPath: "$.initialization"},
+	{Header: "Runtime", JSONPath: "$.runtime"},
+	{Header: "Idle", JSONPath: "$.idle"},
+	{Header: "Propeller Overhead", JSONPath: "$.propellerOverhead"},
+}
+
+var criticalPathColumns = []printer.Column{
+	{Header: "Start", JSONPath: "$.start"},
+	{Header: "Duration", JSONPath: "$.duration"},
+	{Header: "Activity", JSONPath: "$.activity"},
+	{Header: "Node", JSONPath: "$.node"},
+	{Header: "Task", JSONPath: "$.task"},
+	{Header: "Operation", JSONPath: "$.operation"},
+}
+
+// bottleneckView is a row of the table of the nodes contributing the most time to the critical path.
+type bottleneckView struct {
+	Node              string `json:"node"`
+	Task              string `json:"task"`
+	Duration          string `json:"duration"`
+	Share             string `json:"share"`
+	Queueing          string `json:"queueing"`
+	PodScheduling     string `json:"podScheduling"`
+	ImagePull         string `json:"imagePull"`
+	Initialization    string `json:"initialization"`
+	Runtime           string `json:"runtime"`
+	Idle              string `json:"idle"`
+	PropellerOverhead string `json:"propellerOverhead"`
+}
+
+// criticalPathView is a row of the table of the segments of the critical path.
+type criticalPathView struct {
+	Start     string `json:"start"`
+	Duration  string `json:"duration"`
+	Activity  string `json:"activity"`
+	Node      string `json:"node"`
+	Task      string `json:"task"`
+	Operation string `json:"operation"`
+}
+
+func getExecutionAnalysisFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	if len(args) != 1 {
+		return fmt.Errorf("execution name is required")
+	}
+
+	analysis, err := cmdCtx.AdminClient().GetExecutionAnalysis(ctx, &admin.WorkflowExecutionGetAnalysisRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: config.GetConfig().Project,
+			Domain:  config.GetConfig().Domain,
+			Name:    args[0],
+		},
+		Depth: executionanalysis.DefaultConfig.Depth,
+	})
+	if err != nil {
+		return err
+	}
+	if top := executionanalysis.DefaultConfig.Top; top > 0 && len(analysis.GetBottlenecks()) > top {
+		analysis.Bottlenecks = analysis.GetBottlenecks()[:top]
+	}
+
+	adminPrinter := printer.Printer{}
+	outputFormat := config.GetConfig().MustOutputFormat()
+	if outputFormat == printer.OutputFormatJSON || outputFormat == printer.OutputFormatYAML {
+		return adminPrinter.Print(outputFormat, nil, analysis)
+	}
+
+	total := analysis.GetDuration().AsDuration()
+	fmt.Printf("Execution %v took %v: %v\n", args[0], total, formatBreakdown(total, analysis.GetBreakdown()))
+	if executionanalysis.DefaultConfig.CriticalPath {
+		segments := make([]criticalPathView, 0, len(analysis.GetCriticalPath()))
+		for _, segment := range analysis.GetCriticalPath() {
+			start, end := segment.GetStartTime().AsTime(), segment.GetEndTime().AsTime()
+			segments = append(segments, criticalPathView{
+				Start:     start.Format(time.RFC3339),
+				Duration:  end.Sub(start).String(),
+				Activity:  formatActivity(segment.GetActivity()),
+				Node:      segment.GetNodeId(),
+				Task:      segment.GetTaskName(),
+				Operation: segment.GetOperationId(),
+			})
+		}
+		return adminPrinter.PrintInterface(outputFormat, criticalPathColumns, segments)
+	}
+	rows := make([]bottleneckView, 0, len(analysis.GetBottlenecks()))
+	for _, bottleneck := range analysis.GetBottlenecks() {
+		rows = append(rows, bottleneckView{
+			Node:              bottleneck.GetNodeId(),
+			Task:              bottleneck.GetTaskName(),
+			Duration:          bottleneck.GetDuration().AsDuration().String(),
+			Share:             formatShare(bottleneck.GetShare()),
+			Queueing:          formatActivityDuration(bottleneck.GetBreakdown(), admin.ActivityDuration_QUEUEING),
+			PodScheduling:     formatActivityDuration(bottleneck.GetBreakdown(), admin.ActivityDuration_POD_SCHEDULING),
+			ImagePull:         formatActivityDuration(bottleneck.GetBreakdown(), admin.ActivityDuration_IMAGE_PULL),
+			Initialization:    formatActivityDuration(bottleneck.GetBreakdown(), admin.ActivityDuration_INITIALIZATION),
+			Runtime:           formatActivityDuration(bottleneck.GetBreakdown(), admin.ActivityDuration_RUNTIME),
+			Idle:              formatActivityDuration(bottleneck.GetBreakdown(), admin.ActivityDuration_IDLE),
+			PropellerOverhead: formatActivityDuration(bottleneck.GetBreakdown(), admin.ActivityDuration_PROPELLER_OVERHEAD),
+		})
+	}
+	return adminPrinter.PrintInterface(outputFormat, bottleneckColumns, rows)
+}
+
+// formatBreakdown describes how the duration of an execution is split between the activities, e.g.
+// "runtime 2h30m0s (83.3%), queueing 30m0s (16.7%)".
+func formatBreakdown(total time.Duration, breakdown []*admin.ActivityDuration) string {
+	parts := make([]string, 0, len(breakdown))
+	for _, d := range breakdown {
+		if total > 0 {
+			duration := d.GetDuration().AsDuration()
+			parts = append(parts, fmt.Sprintf("%v %v (%v)", formatActivity(d.GetActivity()), duration,
+				formatShare(float64(duration)/float64(total))))
+		}
+	}
+	if len(parts) == 0 {
+		return "no spans to analyze"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatActivity returns the activity in lower case, e.g. "pod scheduling".
+func formatActivity(activity admin.ActivityDuration_Activity) string {
+	return strings.ToLower(strings.ReplaceAll(activity.String(), "_", " "))
+}
+
+func formatActivityDuration(breakdown []*admin.ActivityDuration, activity admin.ActivityDuration_Activity) string {
+	for _, d := range breakdown {
+		if d.GetActivity() == activity {
+			return d.GetDuration().AsDuration().String()
+		}
+	}
+	return ""
+}
+
+func formatShare(share float64) string {
+	return fmt.Sprintf("%.1f%%", share*100)
+}
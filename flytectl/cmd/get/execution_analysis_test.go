@@ -0,0 +1,105 @@
+package get
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/executionanalysis"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flytectl/pkg/printer"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func getExecutionAnalysisSetup(t *testing.T) testutils.TestStruct {
+	executionanalysis.DefaultConfig.Depth = 10
+	executionanalysis.DefaultConfig.Top = 10
+	executionanalysis.DefaultConfig.CriticalPath = false
+
+	s := testutils.Setup(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.MockAdminClient.EXPECT().GetExecutionAnalysis(s.Ctx, mock.MatchedBy(func(request *admin.WorkflowExecutionGetAnalysisRequest) bool {
+		return request.GetId().GetName() == executionNameValue && request.GetDepth() == executionanalysis.DefaultConfig.Depth
+	})).Return(&admin.WorkflowExecutionGetAnalysisResponse{
+		Duration: durationpb.New(time.Hour),
+		Breakdown: []*admin.ActivityDuration{
+			{Activity: admin.ActivityDuration_QUEUEING, Duration: durationpb.New(9 * time.Minute)},
+			{Activity: admin.ActivityDuration_RUNTIME, Duration: durationpb.New(50 * time.Minute)},
+			{Activity: admin.ActivityDuration_PROPELLER_OVERHEAD, Duration: durationpb.New(time.Minute)},
+		},
+		CriticalPath: []*admin.CriticalPathSegment{
+			{
+				StartTime:   timestamppb.New(start),
+				EndTime:     timestamppb.New(start.Add(time.Minute)),
+				Activity:    admin.ActivityDuration_PROPELLER_OVERHEAD,
+				OperationId: "WORKFLOW_SETUP",
+			},
+			{
+				StartTime:   timestamppb.New(start.Add(time.Minute)),
+				EndTime:     timestamppb.New(start.Add(10 * time.Minute)),
+				Activity:    admin.ActivityDuration_QUEUEING,
+				OperationId: "TASK_SETUP",
+				NodeId:      "n0",
+			},
+			{
+				StartTime:   timestamppb.New(start.Add(10 * time.Minute)),
+				EndTime:     timestamppb.New(start.Add(time.Hour)),
+				Activity:    admin.ActivityDuration_RUNTIME,
+				OperationId: "TASK_RUNTIME",
+				NodeId:      "n0",
+			},
+		},
+		Bottlenecks: []*admin.CriticalPathNode{
+			{
+				NodeId:   "n0",
+				Duration: durationpb.New(59 * time.Minute),
+				Share:    59.0 / 60,
+				Breakdown: []*admin.ActivityDuration{
+					{Activity: admin.ActivityDuration_QUEUEING, Duration: durationpb.New(9 * time.Minute)},
+					{Activity: admin.ActivityDuration_RUNTIME, Duration: durationpb.New(50 * time.Minute)},
+				},
+			},
+		},
+	}, nil)
+	return s
+}
+
+func TestGetExecutionAnalysisFunc(t *testing.T) {
+	t.Run("bottlenecks", func(t *testing.T) {
+		s := getExecutionAnalysisSetup(t)
+		config.GetConfig().Output = printer.OutputFormatTABLE.String()
+		assert.NoError(t, getExecutionAnalysisFunc(s.Ctx, []string{executionNameValue}, s.CmdCtx))
+		s.MockAdminClient.AssertNumberOfCalls(t, "GetExecutionAnalysis", 1)
+	})
+
+	t.Run("critical path", func(t *testing.T) {
+		s := getExecutionAnalysisSetup(t)
+		config.GetConfig().Output = printer.OutputFormatTABLE.String()
+		executionanalysis.DefaultConfig.CriticalPath = true
+		assert.NoError(t, getExecutionAnalysisFunc(s.Ctx, []string{executionNameValue}, s.CmdCtx))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		s := getExecutionAnalysisSetup(t)
+		config.GetConfig().Output = printer.OutputFormatJSON.String()
+		assert.NoError(t, getExecutionAnalysisFunc(s.Ctx, []string{executionNameValue}, s.CmdCtx))
+	})
+
+	t.Run("missing execution name", func(t *testing.T) {
+		s := testutils.Setup(t)
+		assert.Error(t, getExecutionAnalysisFunc(s.Ctx, []string{}, s.CmdCtx))
+	})
+}
+
+func TestFormatBreakdown(t *testing.T) {
+	assert.Equal(t, "queueing 15m0s (25.0%), pod scheduling 45m0s (75.0%)", formatBreakdown(time.Hour,
+		[]*admin.ActivityDuration{
+			{Activity: admin.ActivityDuration_QUEUEING, Duration: durationpb.New(15 * time.Minute)},
+			{Activity: admin.ActivityDuration_POD_SCHEDULING, Duration: durationpb.New(45 * time.Minute)},
+		}))
+	assert.Equal(t, "no spans to analyze", formatBreakdown(0, nil))
+}
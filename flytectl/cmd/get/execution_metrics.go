@@ -0,0 +1,74 @@
+package get
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/executionmetrics"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/spanexport"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+const (
+	executionMetricsShort = "Exports the span tree of an execution as a trace."
+	executionMetricsLong  = `
+Export the breakdown of an execution into nodes, tasks and operations in the Chrome trace event format, which can be
+loaded into Perfetto (https://ui.perfetto.dev) or chrome://tracing:
+::
+
+ flytectl get execution-metrics -p flytesnacks -d development oeh94k9r2r --outputFile trace.json
+
+Export the execution in the OpenTelemetry protocol JSON format instead, which Jaeger and most OpenTelemetry backends
+can import:
+::
+
+ flytectl get execution-metrics -p flytesnacks -d development oeh94k9r2r --format otlp --outputFile trace.json
+
+Limit how many levels of nested workflows and launch plans the execution is broken down into using the --depth flag:
+::
+
+ flytectl get execution-metrics -p flytesnacks -d development oeh94k9r2r --depth 2
+
+Usage
+`
+)
+
+func getExecutionMetricsFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	if len(args) != 1 {
+		return fmt.Errorf("execution name is required")
+	}
+	format, err := spanexport.ParseFormat(executionmetrics.DefaultConfig.Format)
+	if err != nil {
+		return err
+	}
+
+	resp, err := cmdCtx.AdminClient().GetExecutionMetrics(ctx, &admin.WorkflowExecutionGetMetricsRequest{
+		Id: &core.WorkflowExecutionIdentifier{
+			Project: config.GetConfig().Project,
+			Domain:  config.GetConfig().Domain,
+			Name:    args[0],
+		},
+		Depth: executionmetrics.DefaultConfig.Depth,
+	})
+	if err != nil {
+		return err
+	}
+	raw, err := spanexport.Export(resp.GetSpan(), format)
+	if err != nil {
+		return err
+	}
+
+	if len(executionmetrics.DefaultConfig.OutputFile) > 0 {
+		if err := os.WriteFile(executionmetrics.DefaultConfig.OutputFile, raw, 0600); err != nil {
+			return err
+		}
+		fmt.Printf("Exported execution %v to %v\n", args[0], executionmetrics.DefaultConfig.OutputFile)
+		return nil
+	}
+	_, err = fmt.Fprintln(cmdCtx.OutputPipe(), string(raw))
+	return err
+}
@@ -0,0 +1,69 @@
+package get
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/executionmetrics"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/spanexport"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func getExecutionMetricsSetup(t *testing.T) testutils.TestStruct {
+	executionmetrics.DefaultConfig.Format = string(spanexport.FormatChromeTrace)
+	executionmetrics.DefaultConfig.Depth = 10
+	executionmetrics.DefaultConfig.OutputFile = ""
+
+	s := testutils.Setup(t)
+	executionID := &core.WorkflowExecutionIdentifier{Project: projectValue, Domain: domainValue, Name: executionNameValue}
+	s.MockAdminClient.EXPECT().GetExecutionMetrics(s.Ctx, mock.MatchedBy(func(request *admin.WorkflowExecutionGetMetricsRequest) bool {
+		return request.GetId().GetName() == executionNameValue && request.GetDepth() == executionmetrics.DefaultConfig.Depth
+	})).Return(&admin.WorkflowExecutionGetMetricsResponse{
+		Span: &core.Span{
+			StartTime: timestamppb.Now(),
+			EndTime:   timestamppb.Now(),
+			Id:        &core.Span_WorkflowId{WorkflowId: executionID},
+		},
+	}, nil)
+	return s
+}
+
+func TestGetExecutionMetricsFunc(t *testing.T) {
+	t.Run("chrome trace to file", func(t *testing.T) {
+		s := getExecutionMetricsSetup(t)
+		executionmetrics.DefaultConfig.OutputFile = filepath.Join(t.TempDir(), "trace.json")
+		assert.NoError(t, getExecutionMetricsFunc(s.Ctx, []string{executionNameValue}, s.CmdCtx))
+
+		raw, err := os.ReadFile(executionmetrics.DefaultConfig.OutputFile)
+		assert.NoError(t, err)
+		trace := &spanexport.ChromeTrace{}
+		assert.NoError(t, json.Unmarshal(raw, trace))
+		assert.Equal(t, executionNameValue, trace.TraceEvents[1].Name)
+	})
+
+	t.Run("otlp", func(t *testing.T) {
+		s := getExecutionMetricsSetup(t)
+		executionmetrics.DefaultConfig.Format = string(spanexport.FormatOTLP)
+		assert.NoError(t, getExecutionMetricsFunc(s.Ctx, []string{executionNameValue}, s.CmdCtx))
+		s.MockAdminClient.AssertNumberOfCalls(t, "GetExecutionMetrics", 1)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		s := testutils.Setup(t)
+		executionmetrics.DefaultConfig.Format = "jaeger"
+		err := getExecutionMetricsFunc(s.Ctx, []string{executionNameValue}, s.CmdCtx)
+		assert.EqualError(t, err, "unsupported trace format [jaeger], supported formats are [chrome-trace otlp]")
+	})
+
+	t.Run("missing execution name", func(t *testing.T) {
+		s := testutils.Setup(t)
+		assert.Error(t, getExecutionMetricsFunc(s.Ctx, []string{}, s.CmdCtx))
+	})
+}
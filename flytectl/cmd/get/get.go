@@ -3,11 +3,14 @@ package get
 import (
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/clusterresourceattribute"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/execution"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/executionanalysis"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/executionclusterlabel"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/executionmetrics"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/executionqueueattribute"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/launchplan"
 	pluginoverride "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/plugin_override"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/project"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/projectquotaattribute"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/task"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/taskresourceattribute"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/workflow"
@@ -47,9 +50,16 @@ func CreateGetCommand() *cobra.Command {
 			Long: launchPlanLong, PFlagProvider: launchplan.DefaultConfig},
 		"execution": {CmdFunc: getExecutionFunc, Aliases: []string{"executions"}, Short: executionShort,
 			Long: executionLong, PFlagProvider: execution.DefaultConfig},
+		"execution-metrics": {CmdFunc: getExecutionMetricsFunc, Short: executionMetricsShort,
+			Long: executionMetricsLong, PFlagProvider: executionmetrics.DefaultConfig},
+		"execution-analysis": {CmdFunc: getExecutionAnalysisFunc, Short: executionAnalysisShort,
+			Long: executionAnalysisLong, PFlagProvider: executionanalysis.DefaultConfig},
 		"task-resource-attribute": {CmdFunc: getTaskResourceAttributes, Aliases: []string{"task-resource-attributes"},
 			Short: taskResourceAttributesShort,
 			Long:  taskResourceAttributesLong, PFlagProvider: taskresourceattribute.DefaultFetchConfig},
+		"project-quota-attribute": {CmdFunc: getProjectQuotaAttributes, Aliases: []string{"project-quota-attributes"},
+			Short: projectQuotaAttributesShort,
+			Long:  projectQuotaAttributesLong, PFlagProvider: projectquotaattribute.DefaultFetchConfig},
 		"cluster-resource-attribute": {CmdFunc: getClusterResourceAttributes, Aliases: []string{"cluster-resource-attributes"},
 			Short: clusterResourceAttributesShort,
 			Long:  clusterResourceAttributesLong, PFlagProvider: clusterresourceattribute.DefaultFetchConfig},
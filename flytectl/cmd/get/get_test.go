@@ -28,20 +28,20 @@ func TestCreateGetCommand(t *testing.T) {
 	assert.Equal(t, getCommand.Use, "get")
 	assert.Equal(t, getCommand.Short, "Fetches various Flyte resources such as tasks, workflows, launch plans, executions, and projects.")
 	fmt.Println(getCommand.Commands())
-	assert.Equal(t, len(getCommand.Commands()), 11)
+	assert.Equal(t, len(getCommand.Commands()), 14)
 	cmdNouns := getCommand.Commands()
 	// Sort by Use value.
 	sort.Slice(cmdNouns, func(i, j int) bool {
 		return cmdNouns[i].Use < cmdNouns[j].Use
 	})
-	useArray := []string{"cluster-resource-attribute", "execution", "execution-cluster-label",
-		"execution-queue-attribute", "launchplan", "plugin-override", "project", "task", "task-resource-attribute", "workflow", "workflow-execution-config"}
-	aliases := [][]string{{"cluster-resource-attributes"}, {"executions"}, {"execution-cluster-labels"},
-		{"execution-queue-attributes"}, {"launchplans"}, {"plugin-overrides"}, {"projects"}, {"tasks"}, {"task-resource-attributes"}, {"workflows"}, {"workflow-execution-config"}}
-	shortArray := []string{clusterResourceAttributesShort, executionShort, executionClusterLabelShort, executionQueueAttributesShort, launchPlanShort,
-		pluginOverrideShort, projectShort, taskShort, taskResourceAttributesShort, workflowShort, workflowExecutionConfigShort}
-	longArray := []string{clusterResourceAttributesLong, executionLong, executionClusterLabelLong, executionQueueAttributesLong, launchPlanLong,
-		pluginOverrideLong, projectLong, taskLong, taskResourceAttributesLong, workflowLong, workflowExecutionConfigLong}
+	useArray := []string{"cluster-resource-attribute", "execution", "execution-analysis", "execution-cluster-label",
+		"execution-metrics", "execution-queue-attribute", "launchplan", "plugin-override", "project", "project-quota-attribute", "task", "task-resource-attribute", "workflow", "workflow-execution-config"}
+	aliases := [][]string{{"cluster-resource-attributes"}, {"executions"}, nil, {"execution-cluster-labels"},
+		nil, {"execution-queue-attributes"}, {"launchplans"}, {"plugin-overrides"}, {"projects"}, {"project-quota-attributes"}, {"tasks"}, {"task-resource-attributes"}, {"workflows"}, {"workflow-execution-config"}}
+	shortArray := []string{clusterResourceAttributesShort, executionShort, executionAnalysisShort, executionClusterLabelShort, executionMetricsShort, executionQueueAttributesShort, launchPlanShort,
+		pluginOverrideShort, projectShort, projectQuotaAttributesShort, taskShort, taskResourceAttributesShort, workflowShort, workflowExecutionConfigShort}
+	longArray := []string{clusterResourceAttributesLong, executionLong, executionAnalysisLong, executionClusterLabelLong, executionMetricsLong, executionQueueAttributesLong, launchPlanLong,
+		pluginOverrideLong, projectLong, projectQuotaAttributesLong, taskLong, taskResourceAttributesLong, workflowLong, workflowExecutionConfigLong}
 	for i := range cmdNouns {
 		assert.Equal(t, cmdNouns[i].Use, useArray[i])
 		assert.Equal(t, cmdNouns[i].Aliases, aliases[i])
@@ -0,0 +1,69 @@
+package get
+
+import (
+	"context"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	sconfig "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/projectquotaattribute"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+const (
+	projectQuotaAttributesShort = "Gets matchable resources of project quota attributes."
+	projectQuotaAttributesLong  = `
+Retrieve the quota of the given project and domain.
+For project flytesnacks and development domain:
+::
+
+ flytectl get project-quota-attribute -p flytesnacks -d development
+
+Example: output from the command:
+
+.. code-block:: json
+
+ {"project":"flytesnacks","domain":"development","max_concurrent_executions":10,"max_running_tasks":50,"max_gpu":"8","overflow":1}
+
+Write the project quota attributes to a file. If there are no project quota attributes, a file would be populated with the basic data.
+The config file is written to pqa.yaml file.
+Example: content of pqa.yaml:
+
+::
+
+ flytectl get -p flytesnacks -d development project-quota-attribute --attrFile pqa.yaml
+
+
+.. code-block:: yaml
+
+    domain: development
+    project: flytesnacks
+    max_concurrent_executions: 10
+    max_running_tasks: 50
+    max_gpu: "8"
+    overflow: 1 # What happens to executions created at the execution limit. 0 : REJECT , 1: QUEUE
+
+Usage
+`
+)
+
+func getProjectQuotaAttributes(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	// Project and domain are mandatory for this command, quotas are not defined for workflows.
+	project := config.GetConfig().Project
+	domain := config.GetConfig().Domain
+	projectQuotaAttrFileConfig := projectquotaattribute.ProjectQuotaAttrFileConfig{Project: project, Domain: domain}
+	// Get the attribute file name from the command line config
+	fileName := projectquotaattribute.DefaultFetchConfig.AttrFile
+
+	// Updates the projectQuotaAttrFileConfig with the fetched matchable attribute
+	if err := FetchAndUnDecorateMatchableAttr(ctx, project, domain, "", cmdCtx.AdminFetcherExt(),
+		&projectQuotaAttrFileConfig, admin.MatchableResource_PROJECT_QUOTA); err != nil {
+		return err
+	}
+
+	// Write the config to the file which can be used for update
+	if err := sconfig.DumpTaskResourceAttr(projectQuotaAttrFileConfig, fileName); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package get
+
+import (
+	"os"
+	"testing"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/projectquotaattribute"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func getProjectQuotaAttributeSetup() {
+	projectquotaattribute.DefaultFetchConfig = &projectquotaattribute.AttrFetchConfig{}
+	// Clean up the temp directory.
+	_ = os.Remove(testDataTempFile)
+}
+
+func TestGetProjectQuotaAttributes(t *testing.T) {
+	projectDomainResp := &admin.ProjectDomainAttributesGetResponse{
+		Attributes: &admin.ProjectDomainAttributes{
+			Project: config.GetConfig().Project,
+			Domain:  config.GetConfig().Domain,
+			MatchingAttributes: &admin.MatchingAttributes{
+				Target: &admin.MatchingAttributes_ProjectQuotaAttributes{
+					ProjectQuotaAttributes: &admin.ProjectQuotaAttributes{
+						MaxConcurrentExecutions: 10,
+						MaxGpu:                  "8",
+						Overflow:                admin.ProjectQuotaAttributes_QUEUE,
+					},
+				},
+			},
+		},
+	}
+	t.Run("successful get project domain attribute", func(t *testing.T) {
+		s := testutils.Setup(t)
+
+		getProjectQuotaAttributeSetup()
+		s.FetcherExt.EXPECT().FetchProjectDomainAttributes(mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything).Return(projectDomainResp, nil)
+		err := getProjectQuotaAttributes(s.Ctx, []string{}, s.CmdCtx)
+		assert.Nil(t, err)
+		s.FetcherExt.AssertCalled(t, "FetchProjectDomainAttributes",
+			s.Ctx, config.GetConfig().Project, config.GetConfig().Domain, admin.MatchableResource_PROJECT_QUOTA)
+		s.TearDownAndVerify(t, `{"project":"dummyProject","domain":"dummyDomain","max_concurrent_executions":10,"max_gpu":"8","overflow":1}`)
+	})
+}
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/flyteorg/flyte/flytectl/cmd/apply"
+	"github.com/flyteorg/flyte/flytectl/cmd/bulk"
+	"github.com/flyteorg/flyte/flytectl/cmd/compare"
 	"github.com/flyteorg/flyte/flytectl/cmd/compile"
 	"github.com/flyteorg/flyte/flytectl/cmd/config"
 	configuration "github.com/flyteorg/flyte/flytectl/cmd/configuration"
@@ -12,8 +15,10 @@ import (
 	"github.com/flyteorg/flyte/flytectl/cmd/create"
 	"github.com/flyteorg/flyte/flytectl/cmd/delete"
 	"github.com/flyteorg/flyte/flytectl/cmd/demo"
+	"github.com/flyteorg/flyte/flytectl/cmd/diff"
 	"github.com/flyteorg/flyte/flytectl/cmd/get"
 	"github.com/flyteorg/flyte/flytectl/cmd/register"
+	"github.com/flyteorg/flyte/flytectl/cmd/runlocal"
 	"github.com/flyteorg/flyte/flytectl/cmd/sandbox"
 	"github.com/flyteorg/flyte/flytectl/cmd/update"
 	"github.com/flyteorg/flyte/flytectl/cmd/upgrade"
@@ -29,6 +34,8 @@ import (
 
 var (
 	cfgFile        string
+	contextName    string
+	assumeYes      bool
 	configAccessor = viper.NewAccessor(stdConfig.Options{StrictMode: true})
 )
 
@@ -47,6 +54,8 @@ func newRootCmd() *cobra.Command {
 	}
 
 	rootCmd.PersistentFlags().StringVarP(&cfgFile, "config", "c", "", "config file (default is $HOME/.flyte/config.yaml)")
+	rootCmd.PersistentFlags().StringVar(&contextName, "context", "", "Name of the config context to use instead of the current context.")
+	rootCmd.PersistentFlags().BoolVar(&assumeYes, "yes", false, "Skip the confirmation of commands run against a production context. Can also be set with the FLYTECTL_YES env var.")
 
 	configAccessor.InitializePflags(rootCmd.PersistentFlags())
 
@@ -60,10 +69,15 @@ func newRootCmd() *cobra.Command {
 	rootCmd.AddCommand(get.CreateGetCommand())
 	compileCmd := compile.CreateCompileCommand()
 	cmdCore.AddCommands(rootCmd, compileCmd)
+	cmdCore.AddCommands(rootCmd, runlocal.CreateRunLocalCommand())
 	rootCmd.AddCommand(create.RemoteCreateCommand())
 	rootCmd.AddCommand(update.CreateUpdateCommand())
+	cmdCore.AddCommands(rootCmd, apply.CreateApplyCommand())
 	rootCmd.AddCommand(register.RemoteRegisterCommand())
 	rootCmd.AddCommand(delete.RemoteDeleteCommand())
+	rootCmd.AddCommand(diff.CreateDiffCommand())
+	rootCmd.AddCommand(compare.CreateCompareCommand())
+	rootCmd.AddCommand(bulk.CreateBulkCommand())
 	rootCmd.AddCommand(sandbox.CreateSandboxCommand())
 	rootCmd.AddCommand(demo.CreateDemoCommand())
 	rootCmd.AddCommand(configuration.CreateConfigCommand())
@@ -133,8 +147,9 @@ func initConfig(cmd *cobra.Command, _ []string) error {
 	if err != nil {
 		return err
 	}
+	config.SetConfigFile(configFile)
 
-	return nil
+	return applyContext(rootCmd, cmd)
 }
 
 func GenerateDocs() error {
@@ -0,0 +1,295 @@
+package runlocal
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/compile"
+	rootConfig "github.com/flyteorg/flyte/flytectl/cmd/config"
+	config "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/runlocal"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flytectl/cmd/create"
+	"github.com/flyteorg/flyte/flytectl/pkg/localexec"
+	"github.com/flyteorg/flyte/flytectl/pkg/printer"
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytestdlib/contextutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils/labeled"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	runLocalShort = `Runs a workflow of a flyte package locally without a Flyte cluster.`
+	runLocalLong  = `
+Compile a flyte package and run one of its launch plans or workflows in-process. Branches, subworkflows, launch plans
+and dynamic tasks are run the way propeller runs them, as are node retries, the failure policy and the failure node of
+workflows. Container tasks are run with the local container runtime, and the outputs of any task can be mocked instead.
+This makes it quick to iterate on the logic of a workflow and to test it in CI without a cluster.
+
+Not everything propeller supports can be run locally:
+
+* Array nodes, e.g. map tasks, gate nodes, i.e. approve, signal and sleep, and timeouts fail the run before any node runs.
+* Task caching, interruptible tasks and resource requests and limits are ignored as they don't change the outputs.
+* Tasks which are not container tasks, e.g. plugin tasks, must have their outputs mocked.
+
+Run the default launch plan of a workflow with the inputs from a yaml file:
+
+::
+
+ flytectl run-local --file my-flyte-package.tgz --inputs inputs.yaml core.control_flow.conditions.consume_outputs
+
+The inputs file maps input names to values:
+
+.. code-block:: yaml
+
+    my_input: 0.4
+    seed: 5
+
+Mock the outputs of tasks, by task name, or of single nodes, by node path, and fail the tasks without mocked outputs
+instead of running their containers:
+
+::
+
+ flytectl run-local --file my-flyte-package.tgz --mocks mocks.yaml --mocksOnly core.control_flow.conditions.consume_outputs
+
+.. code-block:: yaml
+
+    tasks:
+      core.control_flow.conditions.square:
+        o0: 16
+    nodes:
+      n1/n0:
+        o0: 4
+
+Nodes of branches, subworkflows and dynamic tasks are identified by the path of their parent node, e.g. n1/n0. Use
+podman instead of docker and keep the inputs and outputs of the nodes for inspection:
+
+::
+
+ flytectl run-local --file my-flyte-package.tgz --containerRuntime podman --dataDir ./local-run core.control_flow.conditions.consume_outputs
+
+.. note::
+   Input file is a path to a tgz. This file is generated by either pyflyte or jflyte. tgz file contains protobuf files describing workflows, tasks and launch plans.
+
+`
+)
+
+// localContainer is the container, in the storage sense, which holds the data of local runs.
+const localContainer = "flytectl-local"
+
+var nodeColumns = []printer.Column{
+	{Header: "Node", JSONPath: "$.node"},
+	{Header: "Type", JSONPath: "$.type"},
+	{Header: "Phase", JSONPath: "$.phase"},
+	{Header: "Mocked", JSONPath: "$.mocked"},
+	{Header: "Duration", JSONPath: "$.duration"},
+	{Header: "Error", JSONPath: "$.error"},
+}
+
+var outputColumns = []printer.Column{
+	{Header: "Output", JSONPath: "$.name"},
+	{Header: "Value", JSONPath: "$.value"},
+}
+
+// nodeRow is a row of the table of nodes.
+type nodeRow struct {
+	localexec.NodeResult
+	Mocked string `json:"mocked"`
+}
+
+type outputRow struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// runResult is the result printed in json or yaml format.
+type runResult struct {
+	Nodes   []localexec.NodeResult `json:"nodes"`
+	Outputs map[string]interface{} `json:"outputs,omitempty"`
+	Error   string                 `json:"error,omitempty"`
+}
+
+func runLocal(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	cfg := config.DefaultConfig
+	if len(args) != 1 {
+		return fmt.Errorf("exactly one launch plan or workflow name is required")
+	}
+	if cfg.File == "" {
+		return fmt.Errorf("path to package tgz's file is a required flag")
+	}
+	if cfg.MocksOnly && cfg.Mocks == "" {
+		return fmt.Errorf("a mocks file is required with mocksOnly")
+	}
+
+	pkg, err := compile.CompilePackage(cfg.File, os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	dataDir := cfg.DataDir
+	if dataDir == "" {
+		if dataDir, err = os.MkdirTemp("", "flytectl-run-local"); err != nil {
+			return err
+		}
+		defer os.RemoveAll(dataDir)
+	} else if err := os.MkdirAll(dataDir, 0755); err != nil {
+		return err
+	}
+	// The directory is mounted into task containers, which requires an absolute path.
+	if dataDir, err = filepath.Abs(dataDir); err != nil {
+		return err
+	}
+	store, err := newLocalStore(dataDir)
+	if err != nil {
+		return err
+	}
+
+	runner, err := newRunner(cfg, store, dataDir)
+	if err != nil {
+		return err
+	}
+
+	rawInputs := map[string]interface{}{}
+	if cfg.Inputs != "" {
+		data, err := os.ReadFile(cfg.Inputs)
+		if err != nil {
+			return err
+		}
+		if err := yaml.Unmarshal(data, &rawInputs); err != nil {
+			return fmt.Errorf("failed to parse inputs file [%s]: %w", cfg.Inputs, err)
+		}
+	}
+
+	name := args[0]
+	executionDir, err := store.ConstructReference(ctx, store.GetBaseContainerFQN(ctx),
+		fmt.Sprintf("%s-%s", name, time.Now().Format("20060102-150405")))
+	if err != nil {
+		return err
+	}
+
+	executor := localexec.NewExecutor(store, runner, pkg.Workflows, pkg.LaunchPlans)
+	var result *localexec.Result
+	var runErr error
+	if launchPlan, ok := pkg.LaunchPlans[name]; ok {
+		literals, err := create.MakeLiteralForParams(rawInputs, launchPlan.GetClosure().GetExpectedInputs().GetParameters())
+		if err != nil {
+			return err
+		}
+		result, runErr = executor.ExecuteLaunchPlan(ctx, launchPlan, &core.LiteralMap{Literals: literals}, executionDir)
+	} else if workflow, ok := pkg.Workflows[name]; ok {
+		literals, err := create.MakeLiteralForVariables(rawInputs,
+			workflow.GetPrimary().GetTemplate().GetInterface().GetInputs().GetVariables())
+		if err != nil {
+			return err
+		}
+		result, runErr = executor.ExecuteWorkflow(ctx, workflow, &core.LiteralMap{Literals: literals}, executionDir)
+	} else {
+		return fmt.Errorf("no launch plan or workflow named [%s] in package [%s]", name, cfg.File)
+	}
+	if result == nil {
+		return runErr
+	}
+
+	if err := printResult(result, runErr); err != nil {
+		return err
+	}
+	if cfg.DataDir != "" {
+		fmt.Fprintf(os.Stderr, "Node inputs and outputs are kept in [%s]\n", dataDir)
+	}
+	return runErr
+}
+
+// newLocalStore returns a data store backed by a local directory.
+func newLocalStore(dataDir string) (*storage.DataStore, error) {
+	labeled.SetMetricKeys(contextutils.AppNameKey, contextutils.ProjectKey, contextutils.DomainKey)
+	return storage.NewDataStore(&storage.Config{
+		Type:          storage.TypeLocal,
+		InitContainer: localContainer,
+		Stow: storage.StowConfig{
+			Kind:   "local",
+			Config: map[string]string{"path": dataDir},
+		},
+	}, promutils.NewTestScope().NewSubScope("flytectl"))
+}
+
+func newRunner(cfg *config.Config, store *storage.DataStore, dataDir string) (localexec.TaskRunner, error) {
+	var runner localexec.TaskRunner
+	if !cfg.MocksOnly {
+		runner = localexec.ContainerRunner{Runtime: cfg.ContainerRuntime, DataDir: dataDir}
+	}
+	if cfg.Mocks == "" {
+		return runner, nil
+	}
+
+	mocks, err := localexec.LoadMocks(cfg.Mocks)
+	if err != nil {
+		return nil, err
+	}
+	return localexec.MockRunner{Mocks: mocks, Store: store, Next: runner}, nil
+}
+
+func printResult(result *localexec.Result, runErr error) error {
+	outputs := map[string]interface{}{}
+	for name, literal := range result.Outputs.GetLiterals() {
+		value, err := coreutils.ExtractFromLiteral(literal)
+		if err != nil {
+			return err
+		}
+		outputs[name] = value
+	}
+
+	adminPrinter := printer.Printer{}
+	outputFormat := rootConfig.GetConfig().MustOutputFormat()
+	if outputFormat == printer.OutputFormatJSON || outputFormat == printer.OutputFormatYAML {
+		r := runResult{Nodes: result.Nodes, Outputs: outputs}
+		if runErr != nil {
+			r.Error = runErr.Error()
+		}
+		return adminPrinter.PrintInterface(outputFormat, nil, r)
+	}
+
+	rows := make([]nodeRow, 0, len(result.Nodes))
+	for _, n := range result.Nodes {
+		row := nodeRow{NodeResult: n}
+		if n.Mocked {
+			row.Mocked = "yes"
+		}
+		rows = append(rows, row)
+	}
+	if err := adminPrinter.PrintInterface(outputFormat, nodeColumns, rows); err != nil {
+		return err
+	}
+	if runErr != nil || len(outputs) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(outputs))
+	for name := range outputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	outputRows := make([]outputRow, 0, len(outputs))
+	for _, name := range names {
+		outputRows = append(outputRows, outputRow{Name: name, Value: fmt.Sprintf("%v", outputs[name])})
+	}
+	return adminPrinter.PrintInterface(outputFormat, outputColumns, outputRows)
+}
+
+func CreateRunLocalCommand() map[string]cmdCore.CommandEntry {
+	return map[string]cmdCore.CommandEntry{
+		"run-local": {
+			Short:                    runLocalShort,
+			Long:                     runLocalLong,
+			CmdFunc:                  runLocal,
+			PFlagProvider:            config.DefaultConfig,
+			ProjectDomainNotRequired: true,
+			DisableFlyteClient:       true,
+		},
+	}
+}
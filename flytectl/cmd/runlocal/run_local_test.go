@@ -0,0 +1,63 @@
+package runlocal
+
+import (
+	"testing"
+
+	config "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/runlocal"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+const testPackage = "../compile/testdata/launchplan-in-wf.tgz"
+
+func setConfig(t *testing.T, cfg config.Config) {
+	original := *config.DefaultConfig
+	*config.DefaultConfig = cfg
+	t.Cleanup(func() {
+		*config.DefaultConfig = original
+	})
+}
+
+func TestRunLocalLaunchPlan(t *testing.T) {
+	s := testutils.Setup(t)
+	setConfig(t, config.Config{File: testPackage, Mocks: "testdata/mocks.yaml", MocksOnly: true})
+
+	err := runLocal(s.Ctx, []string{"workflows.wf.outer_workflow"}, s.CmdCtx)
+	assert.NoError(t, err)
+	s.TearDownAndVerifyContains(t, `"outputs": {"o0": 43}`)
+}
+
+func TestRunLocalWorkflow(t *testing.T) {
+	s := testutils.Setup(t)
+	setConfig(t, config.Config{File: testPackage, Inputs: "testdata/inputs.yaml", Mocks: "testdata/mocks.yaml",
+		MocksOnly: true, DataDir: t.TempDir()})
+
+	err := runLocal(s.Ctx, []string{"workflows.wf.inner_workflow"}, s.CmdCtx)
+	assert.NoError(t, err)
+	s.TearDownAndVerifyContains(t, `"mocked": true`)
+}
+
+func TestRunLocalErrors(t *testing.T) {
+	s := testutils.Setup(t)
+
+	setConfig(t, config.Config{File: testPackage})
+	err := runLocal(s.Ctx, nil, s.CmdCtx)
+	assert.EqualError(t, err, "exactly one launch plan or workflow name is required")
+
+	setConfig(t, config.Config{})
+	err = runLocal(s.Ctx, []string{"workflows.wf.outer_workflow"}, s.CmdCtx)
+	assert.EqualError(t, err, "path to package tgz's file is a required flag")
+
+	setConfig(t, config.Config{File: testPackage, MocksOnly: true})
+	err = runLocal(s.Ctx, []string{"workflows.wf.outer_workflow"}, s.CmdCtx)
+	assert.EqualError(t, err, "a mocks file is required with mocksOnly")
+
+	setConfig(t, config.Config{File: testPackage, Mocks: "testdata/mocks.yaml", MocksOnly: true})
+	err = runLocal(s.Ctx, []string{"unknown"}, s.CmdCtx)
+	assert.EqualError(t, err, "no launch plan or workflow named [unknown] in package [../compile/testdata/launchplan-in-wf.tgz]")
+
+	// Tasks without mocked outputs fail with mocksOnly.
+	setConfig(t, config.Config{File: testPackage, Mocks: "testdata/empty.yaml", MocksOnly: true})
+	err = runLocal(s.Ctx, []string{"workflows.wf.outer_workflow"}, s.CmdCtx)
+	assert.EqualError(t, err, "node [n0] failed: node [n0/n0] failed: no mocked outputs for node [n0/n0] or task [workflows.wf.my_task]")
+}
@@ -0,0 +1,68 @@
+package update
+
+import (
+	"context"
+	"fmt"
+
+	sconfig "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/projectquotaattribute"
+	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+)
+
+const (
+	projectQuotaAttributesShort = "Update matchable resources of project quota attributes"
+	projectQuotaAttributesLong  = `
+Updates the quota of the given project, or of a domain of it. A quota defined for a domain takes precedence over the one
+defined for its project.
+
+Updating the project quota attribute is only available from a generated file. See the get section for generating this file.
+This will completely overwrite any existing quota of the project and domain combination.
+Refer to get project-quota-attribute section on how to generate this file.
+It takes input for project quota attributes from the config file pqa.yaml,
+Example: content of pqa.yaml:
+
+.. code-block:: yaml
+
+    domain: development
+    project: flytesnacks
+    max_concurrent_executions: 10
+    max_running_tasks: 50
+    max_cpu: "64"
+    max_gpu: "8"
+    overflow: 1 # What happens to executions created at the execution limit. 0 : REJECT , 1: QUEUE
+
+::
+
+ flytectl update project-quota-attribute --attrFile pqa.yaml
+
+Zero and empty limits are not enforced. Task pods requesting more than the whole quota fail, other task pods wait for
+the running ones of the project and domain to complete.
+
+Usage
+
+`
+)
+
+func updateProjectQuotaAttributesFunc(ctx context.Context, args []string, cmdCtx cmdCore.CommandContext) error {
+	updateConfig := projectquotaattribute.DefaultUpdateConfig
+	if len(updateConfig.AttrFile) == 0 {
+		return fmt.Errorf("attrFile is mandatory while calling update for project quota attribute")
+	}
+
+	projectQuotaAttrFileConfig := projectquotaattribute.ProjectQuotaAttrFileConfig{}
+	if err := sconfig.ReadConfigFromFile(&projectQuotaAttrFileConfig, updateConfig.AttrFile); err != nil {
+		return err
+	}
+
+	// Get project domain from the read file.
+	project := projectQuotaAttrFileConfig.Project
+	domain := projectQuotaAttrFileConfig.Domain
+
+	if err := DecorateAndUpdateMatchableAttr(ctx, cmdCtx, project, domain, "",
+		admin.MatchableResource_PROJECT_QUOTA, projectQuotaAttrFileConfig,
+		updateConfig.DryRun, updateConfig.Force); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package update
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/projectquotaattribute"
+	"github.com/flyteorg/flyte/flytectl/cmd/testutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+)
+
+const validProjectDomainProjectQuotaAttributesFilePath = "testdata/valid_project_domain_project_quota_attribute.yaml"
+
+func projectQuotaAttributeUpdateSetup() {
+	projectquotaattribute.DefaultUpdateConfig = &projectquotaattribute.AttrUpdateConfig{}
+}
+
+func TestProjectQuotaAttributeUpdateRequiresAttributeFile(t *testing.T) {
+	s := testutils.Setup(t)
+	projectQuotaAttributeUpdateSetup()
+
+	err := updateProjectQuotaAttributesFunc(s.Ctx, nil, s.CmdCtx)
+	assert.ErrorContains(t, err, "attrFile is mandatory")
+	s.UpdaterExt.AssertNotCalled(t, "UpdateProjectDomainAttributes", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestProjectQuotaAttributeUpdate(t *testing.T) {
+	expected := &admin.MatchingAttributes{
+		Target: &admin.MatchingAttributes_ProjectQuotaAttributes{
+			ProjectQuotaAttributes: &admin.ProjectQuotaAttributes{
+				MaxConcurrentExecutions: 10,
+				MaxRunningTasks:         50,
+				MaxGpu:                  "8",
+				Overflow:                admin.ProjectQuotaAttributes_QUEUE,
+			},
+		},
+	}
+
+	t.Run("domain", func(t *testing.T) {
+		s := testutils.Setup(t)
+		projectQuotaAttributeUpdateSetup()
+		projectquotaattribute.DefaultUpdateConfig.AttrFile = validProjectDomainProjectQuotaAttributesFilePath
+		projectquotaattribute.DefaultUpdateConfig.Force = true
+		s.FetcherExt.EXPECT().FetchProjectDomainAttributes(s.Ctx, "flytesnacks", "development",
+			admin.MatchableResource_PROJECT_QUOTA).Return(nil, nil)
+		s.UpdaterExt.EXPECT().UpdateProjectDomainAttributes(s.Ctx, "flytesnacks", "development",
+			mock.MatchedBy(func(attributes *admin.MatchingAttributes) bool {
+				return proto.Equal(expected, attributes)
+			})).Return(nil)
+
+		err := updateProjectQuotaAttributesFunc(s.Ctx, nil, s.CmdCtx)
+		assert.Nil(t, err)
+		s.TearDownAndVerifyContains(t, `Updated attributes from flytesnacks project and domain development`)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		s := testutils.Setup(t)
+		projectQuotaAttributeUpdateSetup()
+		projectquotaattribute.DefaultUpdateConfig.AttrFile = validProjectDomainProjectQuotaAttributesFilePath
+		projectquotaattribute.DefaultUpdateConfig.DryRun = true
+		s.FetcherExt.EXPECT().FetchProjectDomainAttributes(s.Ctx, "flytesnacks", "development",
+			admin.MatchableResource_PROJECT_QUOTA).Return(nil, nil)
+
+		err := updateProjectQuotaAttributesFunc(s.Ctx, nil, s.CmdCtx)
+		assert.Nil(t, err)
+		s.UpdaterExt.AssertNotCalled(t, "UpdateProjectDomainAttributes", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
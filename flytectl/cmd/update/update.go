@@ -8,6 +8,7 @@ import (
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/launchplan"
 	pluginoverride "github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/plugin_override"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/project"
+	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/projectquotaattribute"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/taskresourceattribute"
 	"github.com/flyteorg/flyte/flytectl/cmd/config/subcommand/workflowexecutionconfig"
 	cmdCore "github.com/flyteorg/flyte/flytectl/cmd/core"
@@ -49,6 +50,8 @@ func CreateUpdateCommand() *cobra.Command {
 			Short: updateWorkflowShort, Long: updateWorkflowLong},
 		"task-resource-attribute": {CmdFunc: updateTaskResourceAttributesFunc, Aliases: []string{}, PFlagProvider: taskresourceattribute.DefaultUpdateConfig,
 			Short: taskResourceAttributesShort, Long: taskResourceAttributesLong, ProjectDomainNotRequired: true},
+		"project-quota-attribute": {CmdFunc: updateProjectQuotaAttributesFunc, Aliases: []string{}, PFlagProvider: projectquotaattribute.DefaultUpdateConfig,
+			Short: projectQuotaAttributesShort, Long: projectQuotaAttributesLong, ProjectDomainNotRequired: true},
 		"cluster-resource-attribute": {CmdFunc: updateClusterResourceAttributesFunc, Aliases: []string{}, PFlagProvider: clusterresourceattribute.DefaultUpdateConfig,
 			Short: clusterResourceAttributesShort, Long: clusterResourceAttributesLong, ProjectDomainNotRequired: true},
 		"execution-queue-attribute": {CmdFunc: updateExecutionQueueAttributesFunc, Aliases: []string{}, PFlagProvider: executionqueueattribute.DefaultUpdateConfig,
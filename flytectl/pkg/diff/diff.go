@@ -0,0 +1,323 @@
+// Package diff computes semantic differences between two versions of registered flyte entities.
+package diff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	startNodeID = "start-node"
+	endNodeID   = "end-node"
+)
+
+type ChangeType string
+
+const (
+	ChangeTypeAdded    ChangeType = "Added"
+	ChangeTypeRemoved  ChangeType = "Removed"
+	ChangeTypeModified ChangeType = "Modified"
+	// ChangeTypeNotCompared marks values which could not be read for one of the compared entities.
+	ChangeTypeNotCompared ChangeType = "NotCompared"
+)
+
+// Change describes a single semantic difference at a path within the compared entity.
+type Change struct {
+	Type   ChangeType `json:"type"`
+	Path   string     `json:"path"`
+	Before string     `json:"before,omitempty"`
+	After  string     `json:"after,omitempty"`
+}
+
+func (c Change) String() string {
+	switch c.Type {
+	case ChangeTypeAdded:
+		return fmt.Sprintf("+ %s: %s", c.Path, c.After)
+	case ChangeTypeRemoved:
+		return fmt.Sprintf("- %s: %s", c.Path, c.Before)
+	case ChangeTypeNotCompared:
+		return fmt.Sprintf("? %s: not compared", c.Path)
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", c.Path, c.Before, c.After)
+	}
+}
+
+type changes []Change
+
+func (c *changes) compare(path, before, after string) {
+	switch {
+	case before == after:
+		return
+	case before == "":
+		*c = append(*c, Change{Type: ChangeTypeAdded, Path: path, After: after})
+	case after == "":
+		*c = append(*c, Change{Type: ChangeTypeRemoved, Path: path, Before: before})
+	default:
+		*c = append(*c, Change{Type: ChangeTypeModified, Path: path, Before: before, After: after})
+	}
+}
+
+var marshaler = jsonpb.Marshaler{}
+
+func protoString(msg proto.Message) string {
+	if msg == nil || proto.Size(msg) == 0 {
+		return ""
+	}
+
+	str, err := marshaler.MarshalToString(msg)
+	if err != nil {
+		return msg.String()
+	}
+
+	return str
+}
+
+func literalTypeString(t *core.LiteralType) string {
+	if t == nil {
+		return ""
+	}
+
+	if _, isSimple := t.GetType().(*core.LiteralType_Simple); isSimple {
+		return t.GetSimple().String()
+	}
+
+	return protoString(t)
+}
+
+func literalString(l *core.Literal) string {
+	if l == nil {
+		return ""
+	}
+
+	if v, err := coreutils.ExtractFromLiteral(l); err == nil && v != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return protoString(l)
+}
+
+func identifierString(id *core.Identifier) string {
+	if id == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:%s", id.GetName(), id.GetVersion())
+}
+
+func sortedKeys[V any](maps ...map[string]V) []string {
+	keys := map[string]bool{}
+	for _, m := range maps {
+		for k := range m {
+			keys[k] = true
+		}
+	}
+
+	res := make([]string, 0, len(keys))
+	for k := range keys {
+		res = append(res, k)
+	}
+
+	sort.Strings(res)
+	return res
+}
+
+func (c *changes) compareVariables(path string, before, after *core.VariableMap) {
+	for _, name := range sortedKeys(before.GetVariables(), after.GetVariables()) {
+		c.compare(fmt.Sprintf("%s.%s", path, name), literalTypeString(before.GetVariables()[name].GetType()),
+			literalTypeString(after.GetVariables()[name].GetType()))
+	}
+}
+
+func (c *changes) compareInterface(path string, before, after *core.TypedInterface) {
+	c.compareVariables(path+".inputs", before.GetInputs(), after.GetInputs())
+	c.compareVariables(path+".outputs", before.GetOutputs(), after.GetOutputs())
+}
+
+func resourceMap(entries []*core.Resources_ResourceEntry) map[string]string {
+	res := make(map[string]string, len(entries))
+	for _, e := range entries {
+		res[strings.ToLower(e.GetName().String())] = e.GetValue()
+	}
+
+	return res
+}
+
+func (c *changes) compareResources(path string, before, after *core.Resources) {
+	for _, entries := range []struct {
+		name          string
+		before, after map[string]string
+	}{
+		{"requests", resourceMap(before.GetRequests()), resourceMap(after.GetRequests())},
+		{"limits", resourceMap(before.GetLimits()), resourceMap(after.GetLimits())},
+	} {
+		for _, name := range sortedKeys(entries.before, entries.after) {
+			c.compare(fmt.Sprintf("%s.%s.%s", path, entries.name, name), entries.before[name], entries.after[name])
+		}
+	}
+}
+
+func (c *changes) compareTaskTemplates(path string, before, after *core.TaskTemplate) {
+	c.compare(path+".type", before.GetType(), after.GetType())
+	c.compare(path+".image", before.GetContainer().GetImage(), after.GetContainer().GetImage())
+	c.compareResources(path+".resources", before.GetContainer().GetResources(), after.GetContainer().GetResources())
+	c.compare(path+".retries", fmt.Sprint(before.GetMetadata().GetRetries().GetRetries()),
+		fmt.Sprint(after.GetMetadata().GetRetries().GetRetries()))
+	c.compare(path+".cacheVersion", before.GetMetadata().GetDiscoveryVersion(), after.GetMetadata().GetDiscoveryVersion())
+	c.compareInterface(path+".interface", before.GetInterface(), after.GetInterface())
+}
+
+func nodeTarget(n *core.Node) string {
+	switch {
+	case n.GetTaskNode() != nil:
+		return "task " + identifierString(n.GetTaskNode().GetReferenceId())
+	case n.GetWorkflowNode().GetSubWorkflowRef() != nil:
+		return "subworkflow " + identifierString(n.GetWorkflowNode().GetSubWorkflowRef())
+	case n.GetWorkflowNode().GetLaunchplanRef() != nil:
+		return "launchplan " + identifierString(n.GetWorkflowNode().GetLaunchplanRef())
+	case n.GetBranchNode() != nil:
+		return "branch"
+	case n.GetGateNode() != nil:
+		return "gate"
+	case n.GetArrayNode() != nil:
+		return "array " + nodeTarget(n.GetArrayNode().GetNode())
+	}
+
+	return "unknown"
+}
+
+func nodeIndex(wf *core.CompiledWorkflow) map[string]string {
+	res := make(map[string]string, len(wf.GetTemplate().GetNodes()))
+	for _, n := range wf.GetTemplate().GetNodes() {
+		if n.GetId() == startNodeID || n.GetId() == endNodeID {
+			continue
+		}
+
+		res[n.GetId()] = nodeTarget(n)
+	}
+
+	return res
+}
+
+func edgeIndex(wf *core.CompiledWorkflow) map[string]string {
+	res := map[string]string{}
+	for from, to := range wf.GetConnections().GetDownstream() {
+		for _, id := range to.GetIds() {
+			edge := fmt.Sprintf("%s -> %s", from, id)
+			res[edge] = edge
+		}
+	}
+
+	return res
+}
+
+func (c *changes) compareCompiledWorkflows(path string, before, after *core.CompiledWorkflow) {
+	c.compareInterface(path+"interface", before.GetTemplate().GetInterface(), after.GetTemplate().GetInterface())
+
+	beforeNodes, afterNodes := nodeIndex(before), nodeIndex(after)
+	for _, id := range sortedKeys(beforeNodes, afterNodes) {
+		c.compare(fmt.Sprintf("%snodes.%s", path, id), beforeNodes[id], afterNodes[id])
+	}
+
+	beforeEdges, afterEdges := edgeIndex(before), edgeIndex(after)
+	for _, edge := range sortedKeys(beforeEdges, afterEdges) {
+		c.compare(path+"edges", beforeEdges[edge], afterEdges[edge])
+	}
+}
+
+// Workflows compares the compiled closures of two workflow versions. It reports changed interfaces, added or removed
+// nodes and edges, and changed images, resources and interfaces of the tasks they reference.
+func Workflows(before, after *admin.Workflow) []Change {
+	c := changes{}
+	beforeClosure := before.GetClosure().GetCompiledWorkflow()
+	afterClosure := after.GetClosure().GetCompiledWorkflow()
+	c.compareCompiledWorkflows("", beforeClosure.GetPrimary(), afterClosure.GetPrimary())
+
+	subWorkflowIndex := func(closure *core.CompiledWorkflowClosure) map[string]*core.CompiledWorkflow {
+		res := make(map[string]*core.CompiledWorkflow, len(closure.GetSubWorkflows()))
+		for _, wf := range closure.GetSubWorkflows() {
+			res[wf.GetTemplate().GetId().GetName()] = wf
+		}
+
+		return res
+	}
+
+	beforeSubWfs, afterSubWfs := subWorkflowIndex(beforeClosure), subWorkflowIndex(afterClosure)
+	for _, name := range sortedKeys(beforeSubWfs, afterSubWfs) {
+		path := fmt.Sprintf("subworkflows.%s", name)
+		beforeWf, afterWf := beforeSubWfs[name], afterSubWfs[name]
+		if beforeWf == nil || afterWf == nil {
+			c.compare(path, identifierString(beforeWf.GetTemplate().GetId()), identifierString(afterWf.GetTemplate().GetId()))
+			continue
+		}
+
+		c.compareCompiledWorkflows(path+".", beforeWf, afterWf)
+	}
+
+	taskIndex := func(closure *core.CompiledWorkflowClosure) map[string]*core.TaskTemplate {
+		res := make(map[string]*core.TaskTemplate, len(closure.GetTasks()))
+		for _, t := range closure.GetTasks() {
+			res[t.GetTemplate().GetId().GetName()] = t.GetTemplate()
+		}
+
+		return res
+	}
+
+	beforeTasks, afterTasks := taskIndex(beforeClosure), taskIndex(afterClosure)
+	for _, name := range sortedKeys(beforeTasks, afterTasks) {
+		path := fmt.Sprintf("tasks.%s", name)
+		beforeTask, afterTask := beforeTasks[name], afterTasks[name]
+		if beforeTask == nil || afterTask == nil {
+			c.compare(path, identifierString(beforeTask.GetId()), identifierString(afterTask.GetId()))
+			continue
+		}
+
+		c.compareTaskTemplates(path, beforeTask, afterTask)
+	}
+
+	return c
+}
+
+// Tasks compares the templates of two task versions.
+func Tasks(before, after *admin.Task) []Change {
+	c := changes{}
+	c.compareTaskTemplates("task", before.GetClosure().GetCompiledTask().GetTemplate(),
+		after.GetClosure().GetCompiledTask().GetTemplate())
+	return c
+}
+
+// LaunchPlans compares the specs of two launch plan versions, including their default and fixed inputs.
+func LaunchPlans(before, after *admin.LaunchPlan) []Change {
+	c := changes{}
+	beforeSpec, afterSpec := before.GetSpec(), after.GetSpec()
+	c.compare("workflow", identifierString(beforeSpec.GetWorkflowId()), identifierString(afterSpec.GetWorkflowId()))
+	c.compare("schedule", protoString(beforeSpec.GetEntityMetadata().GetSchedule()),
+		protoString(afterSpec.GetEntityMetadata().GetSchedule()))
+
+	beforeDefaults, afterDefaults := beforeSpec.GetDefaultInputs().GetParameters(), afterSpec.GetDefaultInputs().GetParameters()
+	for _, name := range sortedKeys(beforeDefaults, afterDefaults) {
+		path := fmt.Sprintf("defaultInputs.%s", name)
+		beforeParam, afterParam := beforeDefaults[name], afterDefaults[name]
+		if beforeParam == nil || afterParam == nil {
+			c.compare(path, literalTypeString(beforeParam.GetVar().GetType()), literalTypeString(afterParam.GetVar().GetType()))
+			continue
+		}
+
+		c.compare(path+".type", literalTypeString(beforeParam.GetVar().GetType()), literalTypeString(afterParam.GetVar().GetType()))
+		c.compare(path+".default", literalString(beforeParam.GetDefault()), literalString(afterParam.GetDefault()))
+		c.compare(path+".required", fmt.Sprint(beforeParam.GetRequired()), fmt.Sprint(afterParam.GetRequired()))
+	}
+
+	beforeFixed, afterFixed := beforeSpec.GetFixedInputs().GetLiterals(), afterSpec.GetFixedInputs().GetLiterals()
+	for _, name := range sortedKeys(beforeFixed, afterFixed) {
+		c.compare(fmt.Sprintf("fixedInputs.%s", name), literalString(beforeFixed[name]), literalString(afterFixed[name]))
+	}
+
+	return c
+}
@@ -0,0 +1,139 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func intType() *core.LiteralType {
+	return &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}}
+}
+
+func stringType() *core.LiteralType {
+	return &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_STRING}}
+}
+
+func taskTemplate(version, image, memory string, inputType *core.LiteralType) *core.TaskTemplate {
+	return &core.TaskTemplate{
+		Id:   &core.Identifier{Name: "t1", Version: version},
+		Type: "python-task",
+		Interface: &core.TypedInterface{
+			Inputs: &core.VariableMap{Variables: map[string]*core.Variable{"x": {Type: inputType}}},
+		},
+		Target: &core.TaskTemplate_Container{
+			Container: &core.Container{
+				Image: image,
+				Resources: &core.Resources{
+					Requests: []*core.Resources_ResourceEntry{{Name: core.Resources_MEMORY, Value: memory}},
+				},
+			},
+		},
+	}
+}
+
+func taskNode(id, version string) *core.Node {
+	return &core.Node{
+		Id: id,
+		Target: &core.Node_TaskNode{
+			TaskNode: &core.TaskNode{
+				Reference: &core.TaskNode_ReferenceId{ReferenceId: &core.Identifier{Name: "t1", Version: version}},
+			},
+		},
+	}
+}
+
+func workflow(version string, task *core.TaskTemplate, nodes []*core.Node, downstream map[string][]string) *admin.Workflow {
+	connections := &core.ConnectionSet{Downstream: map[string]*core.ConnectionSet_IdList{}}
+	for from, to := range downstream {
+		connections.Downstream[from] = &core.ConnectionSet_IdList{Ids: to}
+	}
+
+	return &admin.Workflow{
+		Closure: &admin.WorkflowClosure{
+			CompiledWorkflow: &core.CompiledWorkflowClosure{
+				Primary: &core.CompiledWorkflow{
+					Template: &core.WorkflowTemplate{
+						Id:        &core.Identifier{Name: "wf", Version: version},
+						Interface: task.GetInterface(),
+						Nodes:     nodes,
+					},
+					Connections: connections,
+				},
+				Tasks: []*core.CompiledTask{{Template: task}},
+			},
+		},
+	}
+}
+
+func TestWorkflows(t *testing.T) {
+	t.Run("identical", func(t *testing.T) {
+		wf := workflow("v1", taskTemplate("v1", "img:1", "1Gi", intType()), []*core.Node{taskNode("n0", "v1")},
+			map[string][]string{"start-node": {"n0"}, "n0": {"end-node"}})
+		assert.Empty(t, Workflows(wf, wf))
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		before := workflow("v1", taskTemplate("v1", "img:1", "1Gi", intType()),
+			[]*core.Node{taskNode("n0", "v1"), taskNode("n1", "v1")},
+			map[string][]string{"start-node": {"n0"}, "n0": {"n1"}, "n1": {"end-node"}})
+		after := workflow("v2", taskTemplate("v2", "img:2", "2Gi", stringType()),
+			[]*core.Node{taskNode("n0", "v2")},
+			map[string][]string{"start-node": {"n0"}, "n0": {"end-node"}})
+
+		assert.Equal(t, []Change{
+			{Type: ChangeTypeModified, Path: "interface.inputs.x", Before: "INTEGER", After: "STRING"},
+			{Type: ChangeTypeModified, Path: "nodes.n0", Before: "task t1:v1", After: "task t1:v2"},
+			{Type: ChangeTypeRemoved, Path: "nodes.n1", Before: "task t1:v1"},
+			{Type: ChangeTypeAdded, Path: "edges", After: "n0 -> end-node"},
+			{Type: ChangeTypeRemoved, Path: "edges", Before: "n0 -> n1"},
+			{Type: ChangeTypeRemoved, Path: "edges", Before: "n1 -> end-node"},
+			{Type: ChangeTypeModified, Path: "tasks.t1.image", Before: "img:1", After: "img:2"},
+			{Type: ChangeTypeModified, Path: "tasks.t1.resources.requests.memory", Before: "1Gi", After: "2Gi"},
+			{Type: ChangeTypeModified, Path: "tasks.t1.interface.inputs.x", Before: "INTEGER", After: "STRING"},
+		}, Workflows(before, after))
+	})
+}
+
+func TestTasks(t *testing.T) {
+	before := &admin.Task{Closure: &admin.TaskClosure{CompiledTask: &core.CompiledTask{
+		Template: taskTemplate("v1", "img:1", "1Gi", intType()),
+	}}}
+	after := &admin.Task{Closure: &admin.TaskClosure{CompiledTask: &core.CompiledTask{
+		Template: taskTemplate("v2", "img:1", "", intType()),
+	}}}
+
+	assert.Equal(t, []Change{
+		{Type: ChangeTypeRemoved, Path: "task.resources.requests.memory", Before: "1Gi"},
+	}, Tasks(before, after))
+}
+
+func TestLaunchPlans(t *testing.T) {
+	launchPlan := func(version string, defaultValue int, fixed map[string]*core.Literal) *admin.LaunchPlan {
+		return &admin.LaunchPlan{
+			Spec: &admin.LaunchPlanSpec{
+				WorkflowId: &core.Identifier{Name: "wf", Version: version},
+				DefaultInputs: &core.ParameterMap{Parameters: map[string]*core.Parameter{
+					"x": {
+						Var:      &core.Variable{Type: intType()},
+						Behavior: &core.Parameter_Default{Default: coreutils.MustMakeLiteral(defaultValue)},
+					},
+				}},
+				FixedInputs: &core.LiteralMap{Literals: fixed},
+			},
+		}
+	}
+
+	before := launchPlan("v1", 1, nil)
+	after := launchPlan("v2", 2, map[string]*core.Literal{"y": coreutils.MustMakeLiteral("hello")})
+	changes := LaunchPlans(before, after)
+	assert.Equal(t, []Change{
+		{Type: ChangeTypeModified, Path: "workflow", Before: "wf:v1", After: "wf:v2"},
+		{Type: ChangeTypeModified, Path: "defaultInputs.x.default", Before: "1", After: "2"},
+		{Type: ChangeTypeAdded, Path: "fixedInputs.y", After: "hello"},
+	}, changes)
+	assert.Equal(t, "~ workflow: wf:v1 -> wf:v2", changes[0].String())
+}
@@ -0,0 +1,183 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Duration is a time.Duration which is marshalled to JSON in its human readable form, e.g. "1m30s".
+type Duration time.Duration
+
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// ExecutionData holds an execution along with the data needed to compare it to another one.
+type ExecutionData struct {
+	Execution *admin.Execution
+	Inputs    *core.LiteralMap
+	Outputs   *core.LiteralMap
+	// InputsURL and OutputsURL locate the inputs and outputs which could not be read. Such inputs and outputs are
+	// reported as not compared instead of being compared as empty.
+	InputsURL  string
+	OutputsURL string
+	Nodes      []NodeData
+}
+
+// NodeData holds a node execution along with its inputs and outputs, see ExecutionData for the URLs.
+type NodeData struct {
+	NodeExecution *admin.NodeExecution
+	Inputs        *core.LiteralMap
+	Outputs       *core.LiteralMap
+	InputsURL     string
+	OutputsURL    string
+}
+
+// ExecutionComparison describes how an execution differs from another one.
+type ExecutionComparison struct {
+	Before         string           `json:"before"`
+	After          string           `json:"after"`
+	PhaseBefore    string           `json:"phaseBefore"`
+	PhaseAfter     string           `json:"phaseAfter"`
+	DurationBefore Duration         `json:"durationBefore"`
+	DurationAfter  Duration         `json:"durationAfter"`
+	DurationDelta  Duration         `json:"durationDelta"`
+	Inputs         []Change         `json:"inputs"`
+	Outputs        []Change         `json:"outputs"`
+	Nodes          []NodeComparison `json:"nodes"`
+}
+
+// NodeComparison describes how a node execution differs between two executions. Nodes which ran in only one of the
+// executions have an empty phase in the other one.
+type NodeComparison struct {
+	Node              string   `json:"node"`
+	PhaseBefore       string   `json:"phaseBefore,omitempty"`
+	PhaseAfter        string   `json:"phaseAfter,omitempty"`
+	CacheStatusBefore string   `json:"cacheStatusBefore,omitempty"`
+	CacheStatusAfter  string   `json:"cacheStatusAfter,omitempty"`
+	DurationBefore    Duration `json:"durationBefore"`
+	DurationAfter     Duration `json:"durationAfter"`
+	// DurationDelta is how much longer the node took in the second execution.
+	DurationDelta Duration `json:"durationDelta"`
+	Inputs        []Change `json:"inputs,omitempty"`
+	Outputs       []Change `json:"outputs,omitempty"`
+}
+
+// CacheStatusChanged reports whether the node hit or populated the cache differently in the two executions.
+func (n NodeComparison) CacheStatusChanged() bool {
+	return n.PhaseBefore != "" && n.PhaseAfter != "" && n.CacheStatusBefore != n.CacheStatusAfter
+}
+
+// literalValueString identifies the value of a literal. Offloaded values which carry a hash are compared by their
+// hash since they are stored at a different location by every execution.
+func literalValueString(l *core.Literal) string {
+	if len(l.GetHash()) > 0 {
+		return fmt.Sprintf("hash:%s", l.GetHash())
+	}
+
+	return literalString(l)
+}
+
+// compareLiteralData compares the literal maps, unless either one could not be read.
+func (c *changes) compareLiteralData(path string, before *core.LiteralMap, beforeURL string, after *core.LiteralMap,
+	afterURL string) {
+	if len(beforeURL) > 0 || len(afterURL) > 0 {
+		*c = append(*c, Change{Type: ChangeTypeNotCompared, Path: path, Before: beforeURL, After: afterURL})
+		return
+	}
+
+	c.compareLiteralMaps(path, before, after)
+}
+
+func (c *changes) compareLiteralMaps(path string, before, after *core.LiteralMap) {
+	for _, name := range sortedKeys(before.GetLiterals(), after.GetLiterals()) {
+		c.compare(fmt.Sprintf("%s.%s", path, name), literalValueString(before.GetLiterals()[name]),
+			literalValueString(after.GetLiterals()[name]))
+	}
+}
+
+func nodeDuration(n *admin.NodeExecution) Duration {
+	return Duration(n.GetClosure().GetDuration().AsDuration())
+}
+
+func cacheStatus(n *admin.NodeExecution) string {
+	if n.GetClosure().GetTaskNodeMetadata() == nil {
+		return ""
+	}
+
+	return n.GetClosure().GetTaskNodeMetadata().GetCacheStatus().String()
+}
+
+func nodeIndexByID(nodes []NodeData) map[string]NodeData {
+	index := make(map[string]NodeData, len(nodes))
+	for _, n := range nodes {
+		if id := n.NodeExecution.GetId().GetNodeId(); id != startNodeID && id != endNodeID {
+			index[id] = n
+		}
+	}
+
+	return index
+}
+
+// Executions compares the inputs, outputs and node executions of two executions, typically a known good run and a
+// regressed run of the same launch plan. Nodes are matched by their ids and sorted by how much slower they got.
+func Executions(before, after ExecutionData) ExecutionComparison {
+	comparison := ExecutionComparison{
+		Before:         before.Execution.GetId().GetName(),
+		After:          after.Execution.GetId().GetName(),
+		PhaseBefore:    before.Execution.GetClosure().GetPhase().String(),
+		PhaseAfter:     after.Execution.GetClosure().GetPhase().String(),
+		DurationBefore: Duration(before.Execution.GetClosure().GetDuration().AsDuration()),
+		DurationAfter:  Duration(after.Execution.GetClosure().GetDuration().AsDuration()),
+	}
+	comparison.DurationDelta = comparison.DurationAfter - comparison.DurationBefore
+
+	inputs := changes{}
+	inputs.compareLiteralData("inputs", before.Inputs, before.InputsURL, after.Inputs, after.InputsURL)
+	comparison.Inputs = inputs
+	outputs := changes{}
+	outputs.compareLiteralData("outputs", before.Outputs, before.OutputsURL, after.Outputs, after.OutputsURL)
+	comparison.Outputs = outputs
+
+	beforeNodes, afterNodes := nodeIndexByID(before.Nodes), nodeIndexByID(after.Nodes)
+	for _, id := range sortedKeys(beforeNodes, afterNodes) {
+		b, bOk := beforeNodes[id]
+		a, aOk := afterNodes[id]
+		node := NodeComparison{Node: id}
+		if bOk {
+			node.PhaseBefore = b.NodeExecution.GetClosure().GetPhase().String()
+			node.CacheStatusBefore = cacheStatus(b.NodeExecution)
+			node.DurationBefore = nodeDuration(b.NodeExecution)
+		}
+		if aOk {
+			node.PhaseAfter = a.NodeExecution.GetClosure().GetPhase().String()
+			node.CacheStatusAfter = cacheStatus(a.NodeExecution)
+			node.DurationAfter = nodeDuration(a.NodeExecution)
+		}
+		node.DurationDelta = node.DurationAfter - node.DurationBefore
+		if bOk && aOk {
+			nodeInputs := changes{}
+			nodeInputs.compareLiteralData("inputs", b.Inputs, b.InputsURL, a.Inputs, a.InputsURL)
+			node.Inputs = nodeInputs
+			nodeOutputs := changes{}
+			nodeOutputs.compareLiteralData("outputs", b.Outputs, b.OutputsURL, a.Outputs, a.OutputsURL)
+			node.Outputs = nodeOutputs
+		}
+		comparison.Nodes = append(comparison.Nodes, node)
+	}
+
+	sort.SliceStable(comparison.Nodes, func(i, j int) bool {
+		return comparison.Nodes[i].DurationDelta > comparison.Nodes[j].DurationDelta
+	})
+
+	return comparison
+}
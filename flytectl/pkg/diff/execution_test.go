@@ -0,0 +1,95 @@
+package diff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func nodeExecution(id string, duration time.Duration, cacheStatus core.CatalogCacheStatus) *admin.NodeExecution {
+	return &admin.NodeExecution{
+		Id: &core.NodeExecutionIdentifier{NodeId: id},
+		Closure: &admin.NodeExecutionClosure{
+			Phase:    core.NodeExecution_SUCCEEDED,
+			Duration: durationpb.New(duration),
+			TargetMetadata: &admin.NodeExecutionClosure_TaskNodeMetadata{
+				TaskNodeMetadata: &admin.TaskNodeMetadata{CacheStatus: cacheStatus},
+			},
+		},
+	}
+}
+
+func execution(name string, duration time.Duration) *admin.Execution {
+	return &admin.Execution{
+		Id: &core.WorkflowExecutionIdentifier{Name: name},
+		Closure: &admin.ExecutionClosure{
+			Phase:    core.WorkflowExecution_SUCCEEDED,
+			Duration: durationpb.New(duration),
+		},
+	}
+}
+
+func TestExecutions(t *testing.T) {
+	hashed := func(hash string) *core.Literal {
+		l := coreutils.MustMakeLiteral("s3://bucket/" + hash)
+		l.Hash = hash
+		return l
+	}
+
+	before := ExecutionData{
+		Execution: execution("good", time.Minute),
+		Inputs:    &core.LiteralMap{Literals: map[string]*core.Literal{"x": coreutils.MustMakeLiteral(1)}},
+		Outputs:   &core.LiteralMap{Literals: map[string]*core.Literal{"o0": hashed("abc")}},
+		Nodes: []NodeData{
+			{NodeExecution: nodeExecution("start-node", 0, core.CatalogCacheStatus_CACHE_DISABLED)},
+			{
+				NodeExecution: nodeExecution("n0", 10*time.Second, core.CatalogCacheStatus_CACHE_HIT),
+				Outputs:       &core.LiteralMap{Literals: map[string]*core.Literal{"o0": coreutils.MustMakeLiteral("a")}},
+			},
+			{NodeExecution: nodeExecution("n1", 20*time.Second, core.CatalogCacheStatus_CACHE_MISS)},
+			{NodeExecution: nodeExecution("n2", 5*time.Second, core.CatalogCacheStatus_CACHE_DISABLED)},
+		},
+	}
+	after := ExecutionData{
+		Execution: execution("regressed", 3*time.Minute),
+		Inputs:    &core.LiteralMap{Literals: map[string]*core.Literal{"x": coreutils.MustMakeLiteral(2)}},
+		Outputs:   &core.LiteralMap{Literals: map[string]*core.Literal{"o0": hashed("abc")}},
+		Nodes: []NodeData{
+			{
+				NodeExecution: nodeExecution("n0", 2*time.Minute, core.CatalogCacheStatus_CACHE_MISS),
+				Outputs:       &core.LiteralMap{Literals: map[string]*core.Literal{"o0": coreutils.MustMakeLiteral("b")}},
+			},
+			{NodeExecution: nodeExecution("n1", 15*time.Second, core.CatalogCacheStatus_CACHE_MISS)},
+			{NodeExecution: nodeExecution("n3", 30*time.Second, core.CatalogCacheStatus_CACHE_DISABLED)},
+		},
+	}
+
+	comparison := Executions(before, after)
+	assert.Equal(t, "good", comparison.Before)
+	assert.Equal(t, "regressed", comparison.After)
+	assert.Equal(t, Duration(2*time.Minute), comparison.DurationDelta)
+	assert.Equal(t, []Change{{Type: ChangeTypeModified, Path: "inputs.x", Before: "1", After: "2"}}, comparison.Inputs)
+	assert.Empty(t, comparison.Outputs)
+
+	if assert.Len(t, comparison.Nodes, 4) {
+		n0 := comparison.Nodes[0]
+		assert.Equal(t, "n0", n0.Node)
+		assert.Equal(t, Duration(110*time.Second), n0.DurationDelta)
+		assert.True(t, n0.CacheStatusChanged())
+		assert.Equal(t, "CACHE_HIT", n0.CacheStatusBefore)
+		assert.Equal(t, []Change{{Type: ChangeTypeModified, Path: "outputs.o0", Before: "a", After: "b"}}, n0.Outputs)
+
+		assert.Equal(t, "n3", comparison.Nodes[1].Node)
+		assert.Empty(t, comparison.Nodes[1].PhaseBefore)
+		assert.False(t, comparison.Nodes[1].CacheStatusChanged())
+		assert.Equal(t, "n1", comparison.Nodes[2].Node)
+		assert.False(t, comparison.Nodes[2].CacheStatusChanged())
+		assert.Equal(t, "n2", comparison.Nodes[3].Node)
+		assert.Equal(t, Duration(-5*time.Second), comparison.Nodes[3].DurationDelta)
+	}
+}
@@ -0,0 +1,707 @@
+// Package localexec runs compiled workflows in-process without a Flyte cluster. Node inputs and outputs are kept in a
+// storage backend with the same layout propeller uses, branches are decided with propeller's branch evaluator and tasks
+// are run by a TaskRunner, e.g. with the local container runtime or by substituting mock outputs.
+package localexec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/common"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/branch"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	startNodeID = "start-node"
+	endNodeID   = "end-node"
+
+	inputsFile  = "inputs.pb"
+	outputsFile = "outputs.pb"
+	futuresFile = "futures.pb"
+	errorFile   = "error.pb"
+)
+
+// Node phases reported in the results.
+const (
+	PhaseSucceeded = "SUCCEEDED"
+	PhaseFailed    = "FAILED"
+	PhaseSkipped   = "SKIPPED"
+)
+
+// NodeResult describes how a node ran. Nodes of branches, subworkflows and dynamic tasks are reported under the path
+// of their parent node, e.g. n1/n0.
+type NodeResult struct {
+	Node     string `json:"node"`
+	Type     string `json:"type"`
+	Phase    string `json:"phase"`
+	Mocked   bool   `json:"mocked,omitempty"`
+	Duration string `json:"duration"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Result is the outcome of a local execution.
+type Result struct {
+	Outputs *core.LiteralMap
+	Nodes   []NodeResult
+}
+
+// Executor runs compiled workflows in-process.
+type Executor struct {
+	store  *storage.DataStore
+	runner TaskRunner
+	// workflows are the compiled workflows which launch plans can reference, keyed by workflow name.
+	workflows map[string]*core.CompiledWorkflowClosure
+	// launchPlans are the launch plans which workflows can reference, keyed by launch plan name.
+	launchPlans map[string]*admin.LaunchPlan
+	nodes       []NodeResult
+}
+
+// NewExecutor returns an executor which keeps node data in the store and runs tasks with the runner.
+func NewExecutor(store *storage.DataStore, runner TaskRunner, workflows map[string]*core.CompiledWorkflowClosure,
+	launchPlans map[string]*admin.LaunchPlan) *Executor {
+	return &Executor{
+		store:       store,
+		runner:      runner,
+		workflows:   workflows,
+		launchPlans: launchPlans,
+	}
+}
+
+// scope is a workflow being executed, either the top level one, a subworkflow or the workflow of a dynamic task.
+type scope struct {
+	workflow     *core.CompiledWorkflow
+	subWorkflows []*core.CompiledWorkflow
+	tasks        map[string]*core.TaskTemplate
+	// prefix is prepended to the ids of the nodes of the workflow in the results.
+	prefix  string
+	dataDir storage.DataReference
+	// outputs are the outputs of the nodes which ran, keyed by node id. The start node outputs the workflow inputs.
+	outputs map[string]*core.LiteralMap
+	// failure is the error the workflow failed with, passed to its failure node.
+	failure *core.ExecutionError
+}
+
+func newScope(closure *core.CompiledWorkflowClosure, workflow *core.CompiledWorkflow, prefix string,
+	dataDir storage.DataReference) *scope {
+	tasks := make(map[string]*core.TaskTemplate, len(closure.GetTasks()))
+	for _, t := range closure.GetTasks() {
+		tasks[idKey(t.GetTemplate().GetId())] = t.GetTemplate()
+	}
+
+	return &scope{
+		workflow:     workflow,
+		subWorkflows: closure.GetSubWorkflows(),
+		tasks:        tasks,
+		prefix:       prefix,
+		dataDir:      dataDir,
+		outputs:      map[string]*core.LiteralMap{},
+	}
+}
+
+func idKey(id *core.Identifier) string {
+	return fmt.Sprintf("%s:%s:%s:%s:%s:%s", id.GetResourceType(), id.GetOrg(), id.GetProject(), id.GetDomain(),
+		id.GetName(), id.GetVersion())
+}
+
+// ExecuteWorkflow runs a compiled workflow with the given inputs. Node data is written under dataDir. Workflows with
+// nodes which cannot be run locally fail before any node runs.
+func (e *Executor) ExecuteWorkflow(ctx context.Context, closure *core.CompiledWorkflowClosure, inputs *core.LiteralMap,
+	dataDir storage.DataReference) (*Result, error) {
+	e.nodes = nil
+	if err := validateClosure(closure); err != nil {
+		return &Result{}, err
+	}
+	outputs, err := e.runWorkflow(ctx, newScope(closure, closure.GetPrimary(), "", dataDir), inputs)
+	return &Result{Outputs: outputs, Nodes: e.nodes}, err
+}
+
+// ExecuteLaunchPlan runs the workflow of a launch plan. The default and fixed inputs of the launch plan are used for the
+// inputs which are not given.
+func (e *Executor) ExecuteLaunchPlan(ctx context.Context, launchPlan *admin.LaunchPlan, inputs *core.LiteralMap,
+	dataDir storage.DataReference) (*Result, error) {
+	closure, err := e.launchPlanWorkflow(launchPlan)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.ExecuteWorkflow(ctx, closure, LaunchPlanInputs(launchPlan, inputs), dataDir)
+}
+
+func (e *Executor) launchPlanWorkflow(launchPlan *admin.LaunchPlan) (*core.CompiledWorkflowClosure, error) {
+	workflowName := launchPlan.GetSpec().GetWorkflowId().GetName()
+	closure, ok := e.workflows[workflowName]
+	if !ok {
+		return nil, fmt.Errorf("workflow [%s] of launch plan [%s] is not available locally", workflowName,
+			launchPlan.GetId().GetName())
+	}
+
+	return closure, nil
+}
+
+// LaunchPlanInputs merges the inputs with the default and fixed inputs of a launch plan. Given inputs take precedence
+// over defaults, fixed inputs take precedence over everything.
+func LaunchPlanInputs(launchPlan *admin.LaunchPlan, inputs *core.LiteralMap) *core.LiteralMap {
+	literals := map[string]*core.Literal{}
+	for name, parameter := range launchPlan.GetSpec().GetDefaultInputs().GetParameters() {
+		if parameter.GetDefault() != nil {
+			literals[name] = parameter.GetDefault()
+		}
+	}
+	for name, literal := range inputs.GetLiterals() {
+		literals[name] = literal
+	}
+	for name, literal := range launchPlan.GetSpec().GetFixedInputs().GetLiterals() {
+		literals[name] = literal
+	}
+
+	return &core.LiteralMap{Literals: literals}
+}
+
+// runWorkflow runs the nodes of a workflow one at a time in topological order and returns the workflow outputs. The
+// first failing node fails the workflow right away, unless the workflow is to fail after its executable nodes complete,
+// in which case the nodes which don't depend on a failed node still run. The failure node of the workflow, if any, runs
+// once the workflow failed.
+func (e *Executor) runWorkflow(ctx context.Context, s *scope, inputs *core.LiteralMap) (*core.LiteralMap, error) {
+	s.outputs[startNodeID] = inputs
+	for name, variable := range s.workflow.GetTemplate().GetInterface().GetInputs().GetVariables() {
+		if _, ok := inputs.GetLiterals()[name]; !ok {
+			return nil, fmt.Errorf("missing input [%s] of type [%s] for workflow [%s]", name, variable.GetType(),
+				s.workflow.GetTemplate().GetId().GetName())
+		}
+	}
+
+	nodes := map[string]*core.Node{}
+	for _, n := range s.workflow.GetTemplate().GetNodes() {
+		if n.GetId() != startNodeID && n.GetId() != endNodeID {
+			nodes[n.GetId()] = n
+		}
+	}
+
+	upstream := s.workflow.GetConnections().GetUpstream()
+	failAfterExecutableNodes := s.workflow.GetTemplate().GetMetadata().GetOnFailure() ==
+		core.WorkflowMetadata_FAIL_AFTER_EXECUTABLE_NODES_COMPLETE
+	// failed holds the nodes which failed or which cannot run because an upstream node failed.
+	failed := map[string]bool{}
+	var workflowErr error
+	for len(nodes) > 0 {
+		ready := make([]string, 0, len(nodes))
+		for id := range nodes {
+			if isReady(upstream[id].GetIds(), nodes) {
+				ready = append(ready, id)
+			}
+		}
+		if len(ready) == 0 {
+			return nil, fmt.Errorf("workflow [%s] has a cycle between nodes %v",
+				s.workflow.GetTemplate().GetId().GetName(), mapKeys(nodes))
+		}
+
+		sort.Strings(ready)
+		for _, id := range ready {
+			node := nodes[id]
+			delete(nodes, id)
+			if anyFailed(upstream[id].GetIds(), failed) {
+				failed[id] = true
+				continue
+			}
+
+			if err := e.runNode(ctx, s, node); err != nil {
+				if !failAfterExecutableNodes {
+					return nil, e.runFailureNode(ctx, s, err)
+				}
+				failed[id] = true
+				if workflowErr == nil {
+					workflowErr = err
+				}
+			}
+		}
+	}
+
+	if workflowErr != nil {
+		return nil, e.runFailureNode(ctx, s, workflowErr)
+	}
+	return e.resolveBindings(s, s.workflow.GetTemplate().GetOutputs())
+}
+
+// runFailureNode runs the failure node of a failed workflow, if it has one, and returns the error the workflow fails
+// with. Like propeller, the workflow fails with the error of the failure node if that fails too.
+func (e *Executor) runFailureNode(ctx context.Context, s *scope, workflowErr error) error {
+	failureNode := s.workflow.GetTemplate().GetFailureNode()
+	if failureNode == nil {
+		return workflowErr
+	}
+
+	s.failure = &core.ExecutionError{Message: workflowErr.Error()}
+	if err := e.runNode(ctx, s, failureNode); err != nil {
+		return err
+	}
+	return workflowErr
+}
+
+func anyFailed(upstream []string, failed map[string]bool) bool {
+	for _, id := range upstream {
+		if failed[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// isReady reports whether none of the upstream nodes is still pending.
+func isReady(upstream []string, pending map[string]*core.Node) bool {
+	for _, id := range upstream {
+		if _, ok := pending[id]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+func mapKeys(nodes map[string]*core.Node) []string {
+	keys := make([]string, 0, len(nodes))
+	for k := range nodes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func nodeType(node *core.Node) string {
+	switch node.GetTarget().(type) {
+	case *core.Node_TaskNode:
+		return "task"
+	case *core.Node_BranchNode:
+		return "branch"
+	case *core.Node_WorkflowNode:
+		return "workflow"
+	case *core.Node_GateNode:
+		return "gate"
+	case *core.Node_ArrayNode:
+		return "array"
+	default:
+		return "unknown"
+	}
+}
+
+func (e *Executor) runNode(ctx context.Context, s *scope, node *core.Node) error {
+	result := NodeResult{Node: s.prefix + node.GetId(), Type: nodeType(node)}
+	// Reserve the position of the node in the results ahead of the nodes it runs.
+	index := len(e.nodes)
+	e.nodes = append(e.nodes, result)
+
+	start := time.Now()
+	outputs, mocked, err := e.runNodeTarget(ctx, s, node)
+	result.Duration = time.Since(start).Round(time.Millisecond).String()
+	result.Mocked = mocked
+	if err != nil {
+		result.Phase = PhaseFailed
+		result.Error = err.Error()
+		e.nodes[index] = result
+		return fmt.Errorf("node [%s] failed: %w", result.Node, err)
+	}
+
+	result.Phase = PhaseSucceeded
+	e.nodes[index] = result
+	s.outputs[node.GetId()] = outputs
+	logger.Infof(ctx, "Node [%s] succeeded", result.Node)
+	return nil
+}
+
+func (e *Executor) runNodeTarget(ctx context.Context, s *scope, node *core.Node) (*core.LiteralMap, bool, error) {
+	inputs, err := e.resolveBindings(s, node.GetInputs())
+	if err != nil {
+		return nil, false, err
+	}
+	if s.failure != nil && node.GetId() == s.workflow.GetTemplate().GetFailureNode().GetId() {
+		if err := nodes.ResolveOnFailureNodeInput(ctx, inputs, node.GetId(), s.failure); err != nil {
+			return nil, false, err
+		}
+	}
+
+	switch target := node.GetTarget().(type) {
+	case *core.Node_TaskNode:
+		return e.runTaskNode(ctx, s, node, target.TaskNode, inputs)
+	case *core.Node_BranchNode:
+		outputs, err := e.runBranchNode(ctx, s, node, target.BranchNode, inputs)
+		return outputs, false, err
+	case *core.Node_WorkflowNode:
+		outputs, err := e.runWorkflowNode(ctx, s, node, target.WorkflowNode, inputs)
+		return outputs, false, err
+	default:
+		return nil, false, fmt.Errorf("%s nodes cannot be run locally", nodeType(node))
+	}
+}
+
+// runTaskNode runs the task of a node, retrying it as many times as the retry strategy of the node allows unless the
+// task reports a non-recoverable error. Tasks which fail without an error document, e.g. a container which could not
+// start, are retried as well. Like in propeller, the inputs are written to the data directory of the node
+// and every attempt writes its outputs to a directory of its own under it.
+func (e *Executor) runTaskNode(ctx context.Context, s *scope, node *core.Node, taskNode *core.TaskNode,
+	inputs *core.LiteralMap) (*core.LiteralMap, bool, error) {
+	task, ok := s.tasks[idKey(taskNode.GetReferenceId())]
+	if !ok {
+		return nil, false, fmt.Errorf("task [%s] is not available locally", taskNode.GetReferenceId().GetName())
+	}
+
+	dataDir, err := e.store.ConstructReference(ctx, s.dataDir, node.GetId())
+	if err != nil {
+		return nil, false, err
+	}
+
+	retries := node.GetMetadata().GetRetries().GetRetries()
+	for attempt := uint32(0); ; attempt++ {
+		outputs, mocked, err := e.runTaskAttempt(ctx, s, node, task, inputs, dataDir, attempt)
+		var taskErr *taskError
+		if err == nil || attempt >= retries || (errors.As(err, &taskErr) && !taskErr.recoverable()) {
+			return outputs, mocked, err
+		}
+		logger.Warnf(ctx, "Retrying node [%s] after attempt [%d] failed: %v", s.prefix+node.GetId(), attempt, err)
+	}
+}
+
+func (e *Executor) runTaskAttempt(ctx context.Context, s *scope, node *core.Node, task *core.TaskTemplate,
+	inputs *core.LiteralMap, dataDir storage.DataReference, attempt uint32) (*core.LiteralMap, bool, error) {
+	outputDir, err := e.store.ConstructReference(ctx, dataDir, strconv.FormatUint(uint64(attempt), 10))
+	if err != nil {
+		return nil, false, err
+	}
+	execution, err := e.newTaskExecution(ctx, s.prefix+node.GetId(), task, inputs, dataDir, outputDir)
+	if err != nil {
+		return nil, false, err
+	}
+
+	taskResult, err := e.runner.Run(ctx, execution)
+	if err != nil {
+		return nil, taskResult.Mocked, err
+	}
+
+	outputs, futures, err := e.readTaskOutputs(ctx, execution)
+	if err != nil {
+		return nil, taskResult.Mocked, err
+	}
+	if futures != nil {
+		outputs, err = e.runDynamic(ctx, s, node, task, futures, inputs, dataDir)
+	}
+	return outputs, taskResult.Mocked, err
+}
+
+func (e *Executor) newTaskExecution(ctx context.Context, nodePath string, task *core.TaskTemplate,
+	inputs *core.LiteralMap, dataDir, outputDir storage.DataReference) (TaskExecution, error) {
+	inputPath, err := e.store.ConstructReference(ctx, dataDir, inputsFile)
+	if err != nil {
+		return TaskExecution{}, err
+	}
+	if err := e.store.WriteProtobuf(ctx, inputPath, storage.Options{}, inputs); err != nil {
+		return TaskExecution{}, fmt.Errorf("failed to write inputs to [%s]: %w", inputPath, err)
+	}
+	rawOutputPrefix, err := e.store.ConstructReference(ctx, outputDir, "raw")
+	if err != nil {
+		return TaskExecution{}, err
+	}
+	checkpointPrefix, err := e.store.ConstructReference(ctx, outputDir, "checkpoint")
+	if err != nil {
+		return TaskExecution{}, err
+	}
+
+	return TaskExecution{
+		NodeID:           nodePath,
+		Task:             task,
+		Inputs:           inputs,
+		InputPath:        inputPath,
+		InputPrefix:      dataDir,
+		OutputPrefix:     outputDir,
+		RawOutputPrefix:  rawOutputPrefix,
+		CheckpointPrefix: checkpointPrefix,
+	}, nil
+}
+
+// readTaskOutputs reads what a task wrote under its output prefix: an error document, the futures of a dynamic task
+// or its outputs.
+func (e *Executor) readTaskOutputs(ctx context.Context, execution TaskExecution) (*core.LiteralMap,
+	*core.DynamicJobSpec, error) {
+	errorDoc := &core.ErrorDocument{}
+	if ok, err := e.readIfExists(ctx, execution.OutputPrefix, errorFile, errorDoc); err != nil {
+		return nil, nil, err
+	} else if ok {
+		return nil, nil, &taskError{err: errorDoc.GetError()}
+	}
+
+	futures := &core.DynamicJobSpec{}
+	if ok, err := e.readIfExists(ctx, execution.OutputPrefix, futuresFile, futures); err != nil {
+		return nil, nil, err
+	} else if ok {
+		return nil, futures, nil
+	}
+
+	outputs := &core.LiteralMap{}
+	if ok, err := e.readIfExists(ctx, execution.OutputPrefix, outputsFile, outputs); err != nil {
+		return nil, nil, err
+	} else if !ok && len(execution.Task.GetInterface().GetOutputs().GetVariables()) > 0 {
+		return nil, nil, fmt.Errorf("task did not write its outputs to [%s]", execution.OutputPrefix)
+	}
+	return outputs, nil, nil
+}
+
+// taskError is the error a task reported in its error document.
+type taskError struct {
+	err *core.ContainerError
+}
+
+func (t *taskError) Error() string {
+	return fmt.Sprintf("task failed with %s: %s", t.err.GetCode(), t.err.GetMessage())
+}
+
+// recoverable reports whether the task may succeed if it is retried.
+func (t *taskError) recoverable() bool {
+	return t.err.GetKind() == core.ContainerError_RECOVERABLE
+}
+
+func (e *Executor) readIfExists(ctx context.Context, prefix storage.DataReference, file string,
+	msg proto.Message) (bool, error) {
+	ref, err := e.store.ConstructReference(ctx, prefix, file)
+	if err != nil {
+		return false, err
+	}
+	metadata, err := e.store.Head(ctx, ref)
+	if err != nil {
+		return false, err
+	}
+	if !metadata.Exists() {
+		return false, nil
+	}
+	if err := e.store.ReadProtobuf(ctx, ref, msg); err != nil {
+		return false, fmt.Errorf("failed to read [%s]: %w", ref, err)
+	}
+	return true, nil
+}
+
+// runDynamic compiles the futures written by a dynamic task into a workflow, the same way propeller does, and runs it.
+func (e *Executor) runDynamic(ctx context.Context, s *scope, node *core.Node, task *core.TaskTemplate,
+	futures *core.DynamicJobSpec, inputs *core.LiteralMap, dataDir storage.DataReference) (*core.LiteralMap, error) {
+	compiledTasks := make([]*core.CompiledTask, 0, len(futures.GetTasks()))
+	for _, t := range futures.GetTasks() {
+		compiledTask, err := compiler.CompileTask(t)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile task [%s] of the dynamic workflow: %w", t.GetId().GetName(), err)
+		}
+		compiledTasks = append(compiledTasks, compiledTask)
+	}
+
+	launchPlans := make([]common.InterfaceProvider, 0, len(e.launchPlans))
+	for _, lp := range e.launchPlans {
+		launchPlans = append(launchPlans, compiler.NewLaunchPlanInterfaceProvider(lp))
+	}
+
+	id := task.GetId()
+	template := &core.WorkflowTemplate{
+		Id: &core.Identifier{
+			ResourceType: core.ResourceType_WORKFLOW,
+			Project:      id.GetProject(),
+			Domain:       id.GetDomain(),
+			Name:         fmt.Sprintf("%s-%s-dynamic", id.GetName(), node.GetId()),
+			Version:      id.GetVersion(),
+		},
+		Interface: task.GetInterface(),
+		Nodes:     futures.GetNodes(),
+		Outputs:   futures.GetOutputs(),
+	}
+	closure, err := compiler.CompileWorkflow(template, futures.GetSubworkflows(), compiledTasks, launchPlans)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile the dynamic workflow: %w", err)
+	}
+	if err := validateClosure(closure); err != nil {
+		return nil, err
+	}
+
+	child := newScope(closure, closure.GetPrimary(), s.prefix+node.GetId()+"/", dataDir)
+	return e.runWorkflow(ctx, child, inputs)
+}
+
+// runBranchNode runs the first case whose condition holds, else the else node. Nodes of the other cases are reported
+// as skipped.
+func (e *Executor) runBranchNode(ctx context.Context, s *scope, node *core.Node, branchNode *core.BranchNode,
+	inputs *core.LiteralMap) (*core.LiteralMap, error) {
+	ifElse := branchNode.GetIfElse()
+	cases := append([]*core.IfBlock{ifElse.GetCase()}, ifElse.GetOther()...)
+	var taken *core.Node
+	var skipped []*core.Node
+	for _, c := range cases {
+		if taken != nil {
+			skipped = append(skipped, c.GetThenNode())
+			continue
+		}
+		ok, err := branch.EvaluateBooleanExpression(c.GetCondition(), inputs)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			taken = c.GetThenNode()
+		} else {
+			skipped = append(skipped, c.GetThenNode())
+		}
+	}
+	if taken == nil {
+		if ifElse.GetError() != nil {
+			return nil, fmt.Errorf("no branch was taken: %s", ifElse.GetError().GetMessage())
+		}
+		taken = ifElse.GetElseNode()
+	} else if ifElse.GetElseNode() != nil {
+		skipped = append(skipped, ifElse.GetElseNode())
+	}
+
+	for _, n := range skipped {
+		e.nodes = append(e.nodes, NodeResult{Node: s.prefix + n.GetId(), Type: nodeType(n), Phase: PhaseSkipped})
+	}
+	if taken == nil {
+		return &core.LiteralMap{}, nil
+	}
+
+	if err := e.runNode(ctx, s, taken); err != nil {
+		return nil, err
+	}
+	return s.outputs[taken.GetId()], nil
+}
+
+func (e *Executor) runWorkflowNode(ctx context.Context, s *scope, node *core.Node, workflowNode *core.WorkflowNode,
+	inputs *core.LiteralMap) (*core.LiteralMap, error) {
+	dataDir, err := e.store.ConstructReference(ctx, s.dataDir, node.GetId())
+	if err != nil {
+		return nil, err
+	}
+	prefix := s.prefix + node.GetId() + "/"
+
+	if ref := workflowNode.GetSubWorkflowRef(); ref != nil {
+		for _, subWorkflow := range s.subWorkflows {
+			if idKey(subWorkflow.GetTemplate().GetId()) == idKey(ref) {
+				child := &scope{
+					workflow:     subWorkflow,
+					subWorkflows: s.subWorkflows,
+					tasks:        s.tasks,
+					prefix:       prefix,
+					dataDir:      dataDir,
+					outputs:      map[string]*core.LiteralMap{},
+				}
+				return e.runWorkflow(ctx, child, inputs)
+			}
+		}
+		return nil, fmt.Errorf("subworkflow [%s] is not available locally", ref.GetName())
+	}
+
+	ref := workflowNode.GetLaunchplanRef()
+	launchPlan, ok := e.launchPlans[ref.GetName()]
+	if !ok {
+		return nil, fmt.Errorf("launch plan [%s] is not available locally", ref.GetName())
+	}
+	closure, err := e.launchPlanWorkflow(launchPlan)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateClosure(closure); err != nil {
+		return nil, err
+	}
+	return e.runWorkflow(ctx, newScope(closure, closure.GetPrimary(), prefix, dataDir),
+		LaunchPlanInputs(launchPlan, inputs))
+}
+
+func (e *Executor) resolveBindings(s *scope, bindings []*core.Binding) (*core.LiteralMap, error) {
+	literals := make(map[string]*core.Literal, len(bindings))
+	for _, b := range bindings {
+		l, err := e.resolveBindingData(s, b.GetBinding())
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind [%s]: %w", b.GetVar(), err)
+		}
+		literals[b.GetVar()] = l
+	}
+
+	return &core.LiteralMap{Literals: literals}, nil
+}
+
+func (e *Executor) resolveBindingData(s *scope, data *core.BindingData) (*core.Literal, error) {
+	switch value := data.GetValue().(type) {
+	case *core.BindingData_Scalar:
+		return &core.Literal{Value: &core.Literal_Scalar{Scalar: value.Scalar}}, nil
+	case *core.BindingData_Collection:
+		literals := make([]*core.Literal, 0, len(value.Collection.GetBindings()))
+		for _, b := range value.Collection.GetBindings() {
+			l, err := e.resolveBindingData(s, b)
+			if err != nil {
+				return nil, err
+			}
+			literals = append(literals, l)
+		}
+		return &core.Literal{Value: &core.Literal_Collection{Collection: &core.LiteralCollection{Literals: literals}}}, nil
+	case *core.BindingData_Map:
+		literals := make(map[string]*core.Literal, len(value.Map.GetBindings()))
+		for k, b := range value.Map.GetBindings() {
+			l, err := e.resolveBindingData(s, b)
+			if err != nil {
+				return nil, err
+			}
+			literals[k] = l
+		}
+		return &core.Literal{Value: &core.Literal_Map{Map: &core.LiteralMap{Literals: literals}}}, nil
+	case *core.BindingData_Promise:
+		return resolvePromise(s, value.Promise)
+	default:
+		return nil, fmt.Errorf("unsupported binding %T", data.GetValue())
+	}
+}
+
+func resolvePromise(s *scope, promise *core.OutputReference) (*core.Literal, error) {
+	outputs, ok := s.outputs[promise.GetNodeId()]
+	if !ok {
+		return nil, fmt.Errorf("node [%s] has not run", promise.GetNodeId())
+	}
+
+	name := outputVar(s, promise.GetNodeId(), promise.GetVar())
+	literal, ok := outputs.GetLiterals()[name]
+	if !ok {
+		return nil, fmt.Errorf("node [%s] has no output [%s]", promise.GetNodeId(), promise.GetVar())
+	}
+
+	for _, attr := range promise.GetAttrPath() {
+		switch {
+		case literal.GetMap() != nil && attr.GetStringValue() != "":
+			literal, ok = literal.GetMap().GetLiterals()[attr.GetStringValue()]
+		case literal.GetCollection() != nil && attr.GetStringValue() == "":
+			index := int(attr.GetIntValue())
+			ok = index < len(literal.GetCollection().GetLiterals())
+			if ok {
+				literal = literal.GetCollection().GetLiterals()[index]
+			}
+		default:
+			return nil, fmt.Errorf("attribute path of output [%s] of node [%s] cannot be resolved locally",
+				promise.GetVar(), promise.GetNodeId())
+		}
+		if !ok {
+			return nil, fmt.Errorf("attribute [%v] of output [%s] of node [%s] does not exist", attr,
+				promise.GetVar(), promise.GetNodeId())
+		}
+	}
+	return literal, nil
+}
+
+// outputVar maps an output alias of a node to the name of the output.
+func outputVar(s *scope, nodeID, name string) string {
+	for _, n := range s.workflow.GetTemplate().GetNodes() {
+		if n.GetId() != nodeID {
+			continue
+		}
+		for _, alias := range n.GetOutputAliases() {
+			if alias.GetAlias() == name {
+				return alias.GetVar()
+			}
+		}
+	}
+	return name
+}
@@ -0,0 +1,489 @@
+package localexec
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/compiler/common"
+	"github.com/flyteorg/flyte/flytestdlib/contextutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils/labeled"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func init() {
+	labeled.SetMetricKeys(contextutils.AppNameKey, contextutils.ProjectKey, contextutils.DomainKey)
+}
+
+var intType = &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}}
+
+func intVariables(names ...string) *core.VariableMap {
+	variables := map[string]*core.Variable{}
+	for _, name := range names {
+		variables[name] = &core.Variable{Type: intType}
+	}
+	return &core.VariableMap{Variables: variables}
+}
+
+func id(resourceType core.ResourceType, name string) *core.Identifier {
+	return &core.Identifier{ResourceType: resourceType, Project: "p", Domain: "d", Name: name, Version: "v1"}
+}
+
+func task(name string) *core.TaskTemplate {
+	return &core.TaskTemplate{
+		Id:   id(core.ResourceType_TASK, name),
+		Type: "python-task",
+		Interface: &core.TypedInterface{
+			Inputs:  intVariables("x"),
+			Outputs: intVariables("o0"),
+		},
+		Target: &core.TaskTemplate_Container{Container: &core.Container{Image: "image", Command: []string{"run"}}},
+	}
+}
+
+func promise(name, nodeID, output string) *core.Binding {
+	return &core.Binding{Var: name, Binding: &core.BindingData{Value: &core.BindingData_Promise{
+		Promise: &core.OutputReference{NodeId: nodeID, Var: output},
+	}}}
+}
+
+func taskNode(nodeID, taskName string, x *core.Binding) *core.Node {
+	return &core.Node{
+		Id:     nodeID,
+		Inputs: []*core.Binding{x},
+		Target: &core.Node_TaskNode{TaskNode: &core.TaskNode{
+			Reference: &core.TaskNode_ReferenceId{ReferenceId: id(core.ResourceType_TASK, taskName)},
+		}},
+	}
+}
+
+func workflow(name string, nodes []*core.Node, outputs ...*core.Binding) *core.WorkflowTemplate {
+	return &core.WorkflowTemplate{
+		Id:        id(core.ResourceType_WORKFLOW, name),
+		Interface: &core.TypedInterface{Inputs: intVariables("x"), Outputs: intVariables("o0")},
+		Nodes:     nodes,
+		Outputs:   outputs,
+	}
+}
+
+func compile(t *testing.T, wf *core.WorkflowTemplate, subWorkflows []*core.WorkflowTemplate,
+	tasks ...*core.TaskTemplate) *core.CompiledWorkflowClosure {
+	compiledTasks := make([]*core.CompiledTask, 0, len(tasks))
+	for _, tsk := range tasks {
+		compiledTask, err := compiler.CompileTask(tsk)
+		require.NoError(t, err)
+		compiledTasks = append(compiledTasks, compiledTask)
+	}
+	closure, err := compiler.CompileWorkflow(wf, subWorkflows, compiledTasks, nil)
+	require.NoError(t, err)
+	return closure
+}
+
+func newStore(t *testing.T) *storage.DataStore {
+	store, err := storage.NewDataStore(&storage.Config{Type: storage.TypeMemory}, promutils.NewTestScope())
+	require.NoError(t, err)
+	return store
+}
+
+func inputs(x int) *core.LiteralMap {
+	return &core.LiteralMap{Literals: map[string]*core.Literal{"x": coreutils.MustMakeLiteral(x)}}
+}
+
+func output(t *testing.T, result *Result) interface{} {
+	value, err := coreutils.ExtractFromLiteral(result.Outputs.GetLiterals()["o0"])
+	require.NoError(t, err)
+	return value
+}
+
+func phases(result *Result) map[string]string {
+	p := map[string]string{}
+	for _, n := range result.Nodes {
+		p[n.Node] = n.Phase
+	}
+	return p
+}
+
+// runnerFunc adapts a function to a TaskRunner.
+type runnerFunc func(ctx context.Context, execution TaskExecution) (TaskResult, error)
+
+func (f runnerFunc) Run(ctx context.Context, execution TaskExecution) (TaskResult, error) {
+	return f(ctx, execution)
+}
+
+// addOne is a runner which outputs its input plus one.
+func addOne(store *storage.DataStore) TaskRunner {
+	return runnerFunc(func(ctx context.Context, execution TaskExecution) (TaskResult, error) {
+		x := execution.Inputs.GetLiterals()["x"].GetScalar().GetPrimitive().GetInteger()
+		ref, err := store.ConstructReference(ctx, execution.OutputPrefix, outputsFile)
+		if err != nil {
+			return TaskResult{}, err
+		}
+		return TaskResult{}, store.WriteProtobuf(ctx, ref, storage.Options{}, &core.LiteralMap{
+			Literals: map[string]*core.Literal{"o0": coreutils.MustMakeLiteral(x + 1)},
+		})
+	})
+}
+
+func TestExecuteWorkflow(t *testing.T) {
+	ctx := context.Background()
+	wf := workflow("wf", []*core.Node{
+		taskNode("n0", "add", promise("x", "start-node", "x")),
+		taskNode("n1", "add", promise("x", "n0", "o0")),
+		taskNode("n2", "square", promise("x", "n1", "o0")),
+	}, promise("o0", "n2", "o0"))
+	closure := compile(t, wf, nil, task("add"), task("square"))
+
+	store := newStore(t)
+	runner := MockRunner{
+		Mocks: &Mocks{Tasks: map[string]map[string]interface{}{"square": {"o0": 100}}},
+		Store: store,
+		Next:  addOne(store),
+	}
+	result, err := NewExecutor(store, runner, nil, nil).ExecuteWorkflow(ctx, closure, inputs(1), "mem://run")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), output(t, result))
+	if assert.Len(t, result.Nodes, 3) {
+		assert.Equal(t, "n0", result.Nodes[0].Node)
+		assert.False(t, result.Nodes[0].Mocked)
+		assert.Equal(t, "n2", result.Nodes[2].Node)
+		assert.True(t, result.Nodes[2].Mocked)
+	}
+
+	// The inputs of the nodes are kept in the store.
+	nodeInputs := &core.LiteralMap{}
+	assert.NoError(t, store.ReadProtobuf(ctx, "mem://run/n2/inputs.pb", nodeInputs))
+	assert.True(t, proto.Equal(coreutils.MustMakeLiteral(3), nodeInputs.GetLiterals()["x"]))
+}
+
+func TestExecuteWorkflowMissingInput(t *testing.T) {
+	wf := workflow("wf", []*core.Node{taskNode("n0", "add", promise("x", "start-node", "x"))},
+		promise("o0", "n0", "o0"))
+	closure := compile(t, wf, nil, task("add"))
+	store := newStore(t)
+
+	_, err := NewExecutor(store, addOne(store), nil, nil).ExecuteWorkflow(context.Background(), closure,
+		&core.LiteralMap{}, "mem://run")
+	assert.EqualError(t, err, "missing input [x] of type [simple:INTEGER] for workflow [wf]")
+}
+
+func TestExecuteUnsupportedNodes(t *testing.T) {
+	slow := task("slow")
+	slow.Metadata = &core.TaskMetadata{Timeout: durationpb.New(time.Minute)}
+	wf := workflow("wf", []*core.Node{
+		taskNode("n0", "slow", promise("x", "start-node", "x")),
+		{
+			Id: "n1",
+			Target: &core.Node_GateNode{GateNode: &core.GateNode{Condition: &core.GateNode_Sleep{
+				Sleep: &core.SleepCondition{Duration: durationpb.New(time.Minute)},
+			}}},
+		},
+	}, promise("o0", "n0", "o0"))
+	closure := compile(t, wf, nil, slow)
+	store := newStore(t)
+
+	result, err := NewExecutor(store, addOne(store), nil, nil).ExecuteWorkflow(context.Background(), closure, inputs(1),
+		"mem://run")
+	assert.EqualError(t, err, "workflow [wf] cannot be run locally: task [slow] of node [n0] has a timeout; "+
+		"node [n1] is a gate node")
+	// No node runs.
+	assert.Empty(t, result.Nodes)
+}
+
+func TestExecuteBranch(t *testing.T) {
+	condition := &core.BooleanExpression{Expr: &core.BooleanExpression_Comparison{Comparison: &core.ComparisonExpression{
+		Operator:   core.ComparisonExpression_GT,
+		LeftValue:  &core.Operand{Val: &core.Operand_Var{Var: "x"}},
+		RightValue: &core.Operand{Val: &core.Operand_Primitive{Primitive: coreutils.MustMakePrimitive(5)}},
+	}}}
+	branchNode := &core.Node{
+		Id:     "n0",
+		Inputs: []*core.Binding{promise("x", "start-node", "x")},
+		Target: &core.Node_BranchNode{BranchNode: &core.BranchNode{IfElse: &core.IfElseBlock{
+			Case: &core.IfBlock{
+				Condition: condition,
+				ThenNode:  taskNode("n0", "add", promise("x", "start-node", "x")),
+			},
+			Default: &core.IfElseBlock_ElseNode{ElseNode: taskNode("n1", "square", promise("x", "start-node", "x"))},
+		}}},
+	}
+	wf := workflow("wf", []*core.Node{branchNode}, promise("o0", "n0", "o0"))
+	closure := compile(t, wf, nil, task("add"), task("square"))
+
+	store := newStore(t)
+	runner := MockRunner{
+		Mocks: &Mocks{Tasks: map[string]map[string]interface{}{"square": {"o0": 0}}},
+		Store: store,
+		Next:  addOne(store),
+	}
+	executor := NewExecutor(store, runner, nil, nil)
+
+	result, err := executor.ExecuteWorkflow(context.Background(), closure, inputs(10), "mem://then")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), output(t, result))
+	assert.Equal(t, map[string]string{"n0": PhaseSucceeded, "n0-n0": PhaseSucceeded, "n0-n1": PhaseSkipped},
+		phases(result))
+
+	result, err = executor.ExecuteWorkflow(context.Background(), closure, inputs(1), "mem://else")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), output(t, result))
+	assert.Equal(t, map[string]string{"n0": PhaseSucceeded, "n0-n0": PhaseSkipped, "n0-n1": PhaseSucceeded},
+		phases(result))
+}
+
+func TestExecuteSubWorkflowAndLaunchPlan(t *testing.T) {
+	sub := workflow("sub", []*core.Node{taskNode("n0", "add", promise("x", "start-node", "x"))},
+		promise("o0", "n0", "o0"))
+	subCompiled := compile(t, sub, nil, task("add"))
+	launchPlan := &admin.LaunchPlan{
+		Id: id(core.ResourceType_LAUNCH_PLAN, "sub_lp"),
+		Spec: &admin.LaunchPlanSpec{
+			WorkflowId: sub.GetId(),
+			FixedInputs: &core.LiteralMap{Literals: map[string]*core.Literal{
+				"x": coreutils.MustMakeLiteral(40),
+			}},
+		},
+		Closure: &admin.LaunchPlanClosure{
+			ExpectedInputs:  &core.ParameterMap{},
+			ExpectedOutputs: intVariables("o0"),
+		},
+	}
+
+	wf := workflow("wf", []*core.Node{
+		{
+			Id:     "n0",
+			Inputs: []*core.Binding{promise("x", "start-node", "x")},
+			Target: &core.Node_WorkflowNode{WorkflowNode: &core.WorkflowNode{
+				Reference: &core.WorkflowNode_SubWorkflowRef{SubWorkflowRef: sub.GetId()},
+			}},
+		},
+		{
+			Id: "n1",
+			Target: &core.Node_WorkflowNode{WorkflowNode: &core.WorkflowNode{
+				Reference: &core.WorkflowNode_LaunchplanRef{LaunchplanRef: launchPlan.GetId()},
+			}},
+		},
+		taskNode("n2", "add", promise("x", "n1", "o0")),
+	}, promise("o0", "n2", "o0"))
+	compiledTask, err := compiler.CompileTask(task("add"))
+	require.NoError(t, err)
+	closure, err := compiler.CompileWorkflow(wf, []*core.WorkflowTemplate{sub}, []*core.CompiledTask{compiledTask},
+		[]common.InterfaceProvider{compiler.NewLaunchPlanInterfaceProvider(launchPlan)})
+	require.NoError(t, err)
+
+	store := newStore(t)
+	executor := NewExecutor(store, addOne(store), map[string]*core.CompiledWorkflowClosure{"sub": subCompiled},
+		map[string]*admin.LaunchPlan{"sub_lp": launchPlan})
+	result, err := executor.ExecuteWorkflow(context.Background(), closure, inputs(1), "mem://run")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), output(t, result))
+	assert.Equal(t, map[string]string{
+		"n0": PhaseSucceeded, "n0/n0": PhaseSucceeded,
+		"n1": PhaseSucceeded, "n1/n0": PhaseSucceeded,
+		"n2": PhaseSucceeded,
+	}, phases(result))
+}
+
+func TestExecuteDynamic(t *testing.T) {
+	dynamic := task("dynamic")
+	wf := workflow("wf", []*core.Node{taskNode("n0", "dynamic", promise("x", "start-node", "x"))},
+		promise("o0", "n0", "o0"))
+	closure := compile(t, wf, nil, dynamic)
+
+	store := newStore(t)
+	next := addOne(store)
+	// The dynamic task yields two nodes adding one to its input in sequence.
+	runner := runnerFunc(func(ctx context.Context, execution TaskExecution) (TaskResult, error) {
+		if execution.Task.GetId().GetName() != "dynamic" {
+			return next.Run(ctx, execution)
+		}
+		ref, err := store.ConstructReference(ctx, execution.OutputPrefix, futuresFile)
+		if err != nil {
+			return TaskResult{}, err
+		}
+		return TaskResult{}, store.WriteProtobuf(ctx, ref, storage.Options{}, &core.DynamicJobSpec{
+			Nodes: []*core.Node{
+				taskNode("dn0", "add", promise("x", "start-node", "x")),
+				taskNode("dn1", "add", promise("x", "dn0", "o0")),
+			},
+			Tasks:   []*core.TaskTemplate{task("add")},
+			Outputs: []*core.Binding{promise("o0", "dn1", "o0")},
+		})
+	})
+
+	result, err := NewExecutor(store, runner, nil, nil).ExecuteWorkflow(context.Background(), closure, inputs(1),
+		"mem://run")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), output(t, result))
+	assert.Equal(t, map[string]string{"n0": PhaseSucceeded, "n0/dn0": PhaseSucceeded, "n0/dn1": PhaseSucceeded},
+		phases(result))
+}
+
+func TestExecuteTaskFailure(t *testing.T) {
+	wf := workflow("wf", []*core.Node{
+		taskNode("n0", "fail", promise("x", "start-node", "x")),
+		taskNode("n1", "add", promise("x", "n0", "o0")),
+	}, promise("o0", "n1", "o0"))
+	closure := compile(t, wf, nil, task("fail"), task("add"))
+
+	store := newStore(t)
+	runner := runnerFunc(func(ctx context.Context, execution TaskExecution) (TaskResult, error) {
+		ref, err := store.ConstructReference(ctx, execution.OutputPrefix, errorFile)
+		if err != nil {
+			return TaskResult{}, err
+		}
+		return TaskResult{}, store.WriteProtobuf(ctx, ref, storage.Options{}, &core.ErrorDocument{
+			Error: &core.ContainerError{Code: "USER:ValueError", Message: "x must be positive"},
+		})
+	})
+
+	result, err := NewExecutor(store, runner, nil, nil).ExecuteWorkflow(context.Background(), closure, inputs(1),
+		"mem://run")
+	assert.EqualError(t, err, "node [n0] failed: task failed with USER:ValueError: x must be positive")
+	if assert.Len(t, result.Nodes, 1) {
+		assert.Equal(t, PhaseFailed, result.Nodes[0].Phase)
+		assert.Equal(t, "task failed with USER:ValueError: x must be positive", result.Nodes[0].Error)
+	}
+}
+
+// failing is a runner which fails the tasks of the given nodes with an error document, and runs the others with next.
+func failing(store *storage.DataStore, kind core.ContainerError_Kind, next TaskRunner, nodeIDs ...string) TaskRunner {
+	return runnerFunc(func(ctx context.Context, execution TaskExecution) (TaskResult, error) {
+		for _, nodeID := range nodeIDs {
+			if execution.NodeID != nodeID {
+				continue
+			}
+			ref, err := store.ConstructReference(ctx, execution.OutputPrefix, errorFile)
+			if err != nil {
+				return TaskResult{}, err
+			}
+			return TaskResult{}, store.WriteProtobuf(ctx, ref, storage.Options{}, &core.ErrorDocument{
+				Error: &core.ContainerError{Code: "USER:ValueError", Message: "x must be positive", Kind: kind},
+			})
+		}
+		return next.Run(ctx, execution)
+	})
+}
+
+func TestExecuteRetries(t *testing.T) {
+	ctx := context.Background()
+	node := taskNode("n0", "add", promise("x", "start-node", "x"))
+	node.Metadata = &core.NodeMetadata{Retries: &core.RetryStrategy{Retries: 2}}
+	closure := compile(t, workflow("wf", []*core.Node{node}, promise("o0", "n0", "o0")), nil, task("add"))
+
+	t.Run("recoverable", func(t *testing.T) {
+		store := newStore(t)
+		var attempts []storage.DataReference
+		runner := runnerFunc(func(ctx context.Context, execution TaskExecution) (TaskResult, error) {
+			attempts = append(attempts, execution.OutputPrefix)
+			if len(attempts) < 3 {
+				return failing(store, core.ContainerError_RECOVERABLE, nil, "n0").Run(ctx, execution)
+			}
+			return addOne(store).Run(ctx, execution)
+		})
+
+		result, err := NewExecutor(store, runner, nil, nil).ExecuteWorkflow(ctx, closure, inputs(1), "mem://run")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), output(t, result))
+		assert.Equal(t, []storage.DataReference{"mem://run/n0/0", "mem://run/n0/1", "mem://run/n0/2"}, attempts)
+	})
+
+	t.Run("exhausted", func(t *testing.T) {
+		store := newStore(t)
+		attempts := 0
+		runner := runnerFunc(func(ctx context.Context, execution TaskExecution) (TaskResult, error) {
+			attempts++
+			return TaskResult{}, fmt.Errorf("container exited with 1")
+		})
+
+		_, err := NewExecutor(store, runner, nil, nil).ExecuteWorkflow(ctx, closure, inputs(1), "mem://run")
+		assert.EqualError(t, err, "node [n0] failed: container exited with 1")
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("non-recoverable", func(t *testing.T) {
+		store := newStore(t)
+		attempts := 0
+		runner := runnerFunc(func(ctx context.Context, execution TaskExecution) (TaskResult, error) {
+			attempts++
+			return failing(store, core.ContainerError_NON_RECOVERABLE, nil, "n0").Run(ctx, execution)
+		})
+
+		_, err := NewExecutor(store, runner, nil, nil).ExecuteWorkflow(ctx, closure, inputs(1), "mem://run")
+		assert.EqualError(t, err, "node [n0] failed: task failed with USER:ValueError: x must be positive")
+		assert.Equal(t, 1, attempts)
+	})
+}
+
+func TestExecuteFailurePolicy(t *testing.T) {
+	ctx := context.Background()
+	failurePolicyWorkflow := func(policy core.WorkflowMetadata_OnFailurePolicy) *core.CompiledWorkflowClosure {
+		wf := workflow("wf", []*core.Node{
+			taskNode("n0", "add", promise("x", "start-node", "x")),
+			taskNode("n1", "add", promise("x", "start-node", "x")),
+			taskNode("n2", "add", promise("x", "n0", "o0")),
+			taskNode("n3", "add", promise("x", "n1", "o0")),
+		}, promise("o0", "n3", "o0"))
+		wf.Metadata = &core.WorkflowMetadata{OnFailure: policy}
+		wf.FailureNode = taskNode("fn0", "add", promise("x", "start-node", "x"))
+		return compile(t, wf, nil, task("add"))
+	}
+
+	t.Run("fail immediately", func(t *testing.T) {
+		store := newStore(t)
+		runner := failing(store, core.ContainerError_NON_RECOVERABLE, addOne(store), "n0")
+		result, err := NewExecutor(store, runner, nil, nil).ExecuteWorkflow(ctx,
+			failurePolicyWorkflow(core.WorkflowMetadata_FAIL_IMMEDIATELY), inputs(1), "mem://run")
+		assert.EqualError(t, err, "node [n0] failed: task failed with USER:ValueError: x must be positive")
+		assert.Equal(t, map[string]string{"n0": PhaseFailed, "fn0": PhaseSucceeded}, phases(result))
+	})
+
+	t.Run("fail after executable nodes complete", func(t *testing.T) {
+		store := newStore(t)
+		runner := failing(store, core.ContainerError_NON_RECOVERABLE, addOne(store), "n0")
+		result, err := NewExecutor(store, runner, nil, nil).ExecuteWorkflow(ctx,
+			failurePolicyWorkflow(core.WorkflowMetadata_FAIL_AFTER_EXECUTABLE_NODES_COMPLETE), inputs(1), "mem://run")
+		assert.EqualError(t, err, "node [n0] failed: task failed with USER:ValueError: x must be positive")
+		// The nodes which don't depend on the failed node still run, the failure node runs last.
+		assert.Equal(t, map[string]string{"n0": PhaseFailed, "n1": PhaseSucceeded, "n3": PhaseSucceeded,
+			"fn0": PhaseSucceeded}, phases(result))
+		assert.Equal(t, "fn0", result.Nodes[len(result.Nodes)-1].Node)
+	})
+
+	t.Run("failure node fails", func(t *testing.T) {
+		store := newStore(t)
+		runner := failing(store, core.ContainerError_NON_RECOVERABLE, addOne(store), "n0", "fn0")
+		_, err := NewExecutor(store, runner, nil, nil).ExecuteWorkflow(ctx,
+			failurePolicyWorkflow(core.WorkflowMetadata_FAIL_IMMEDIATELY), inputs(1), "mem://run")
+		assert.EqualError(t, err, "node [fn0] failed: task failed with USER:ValueError: x must be positive")
+	})
+}
+
+func TestLaunchPlanInputs(t *testing.T) {
+	launchPlan := &admin.LaunchPlan{Spec: &admin.LaunchPlanSpec{
+		DefaultInputs: &core.ParameterMap{Parameters: map[string]*core.Parameter{
+			"a": {Behavior: &core.Parameter_Default{Default: coreutils.MustMakeLiteral(1)}},
+			"b": {Behavior: &core.Parameter_Default{Default: coreutils.MustMakeLiteral(2)}},
+			"c": {Behavior: &core.Parameter_Required{Required: true}},
+		}},
+		FixedInputs: &core.LiteralMap{Literals: map[string]*core.Literal{"d": coreutils.MustMakeLiteral(4)}},
+	}}
+
+	merged := LaunchPlanInputs(launchPlan, &core.LiteralMap{Literals: map[string]*core.Literal{
+		"b": coreutils.MustMakeLiteral(20),
+		"c": coreutils.MustMakeLiteral(30),
+		"d": coreutils.MustMakeLiteral(40),
+	}})
+	expected, err := coreutils.MakeLiteralMap(map[string]interface{}{"a": 1, "b": 20, "c": 30, "d": 4})
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(expected, merged))
+}
@@ -0,0 +1,76 @@
+package localexec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flyteorg/flyte/flyteidl/clients/go/coreutils"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadMocks(t *testing.T) {
+	mocks, err := LoadMocks("testdata/mocks.yaml")
+	assert.NoError(t, err)
+	assert.Equal(t, &Mocks{
+		Tasks: map[string]map[string]interface{}{"add": {"o0": float64(1)}},
+		Nodes: map[string]map[string]interface{}{"n1/n0": {"o0": float64(2)}},
+	}, mocks)
+
+	_, err = LoadMocks("testdata/missing.yaml")
+	assert.Error(t, err)
+}
+
+func TestMockRunner(t *testing.T) {
+	ctx := context.Background()
+	store := newStore(t)
+	mocks, err := LoadMocks("testdata/mocks.yaml")
+	require.NoError(t, err)
+	runner := MockRunner{Mocks: mocks, Store: store}
+
+	run := func(nodeID string, tsk *core.TaskTemplate) (TaskResult, *core.LiteralMap, error) {
+		prefix := storage.DataReference("mem://run/" + nodeID)
+		result, err := runner.Run(ctx, TaskExecution{NodeID: nodeID, Task: tsk, OutputPrefix: prefix})
+		if err != nil {
+			return result, nil, err
+		}
+		outputs := &core.LiteralMap{}
+		require.NoError(t, store.ReadProtobuf(ctx, prefix+"/outputs.pb", outputs))
+		return result, outputs, nil
+	}
+
+	t.Run("task", func(t *testing.T) {
+		result, outputs, err := run("n0", task("add"))
+		assert.NoError(t, err)
+		assert.True(t, result.Mocked)
+		assert.True(t, proto.Equal(coreutils.MustMakeLiteral(1), outputs.GetLiterals()["o0"]))
+	})
+
+	t.Run("node takes precedence", func(t *testing.T) {
+		_, outputs, err := run("n1/n0", task("add"))
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(coreutils.MustMakeLiteral(2), outputs.GetLiterals()["o0"]))
+	})
+
+	t.Run("not mocked", func(t *testing.T) {
+		_, _, err := run("n2", task("square"))
+		assert.EqualError(t, err, "no mocked outputs for node [n2] or task [square]")
+	})
+
+	t.Run("unknown output", func(t *testing.T) {
+		tsk := task("add")
+		tsk.Interface.Outputs = intVariables("out")
+		_, _, err := run("n3", tsk)
+		assert.EqualError(t, err, "task [add] has no output [o0]")
+	})
+
+	t.Run("missing output", func(t *testing.T) {
+		tsk := task("add")
+		tsk.Interface.Outputs = intVariables("o0", "o1")
+		_, _, err := run("n4", tsk)
+		assert.EqualError(t, err, "missing mocked outputs [o1] of task [add]")
+	})
+}
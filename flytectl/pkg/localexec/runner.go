@@ -0,0 +1,202 @@
+package localexec
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core/template"
+	"github.com/flyteorg/flyte/flytestdlib/storage"
+)
+
+// TaskExecution is a task to run along with the locations of its data.
+type TaskExecution struct {
+	// NodeID is the path of the node running the task, e.g. n1/n0 for a node of a subworkflow.
+	NodeID           string
+	Task             *core.TaskTemplate
+	Inputs           *core.LiteralMap
+	InputPath        storage.DataReference
+	InputPrefix      storage.DataReference
+	OutputPrefix     storage.DataReference
+	RawOutputPrefix  storage.DataReference
+	CheckpointPrefix storage.DataReference
+}
+
+// TaskResult describes how a task was run.
+type TaskResult struct {
+	// Mocked is set when the outputs of the task were substituted instead of running it.
+	Mocked bool
+}
+
+// TaskRunner runs tasks. A task writes its outputs, the futures of a dynamic task or an error document under its
+// output prefix the same way it does when run by propeller.
+type TaskRunner interface {
+	Run(ctx context.Context, execution TaskExecution) (TaskResult, error)
+}
+
+// containerDataDir is where the local data directory is mounted in task containers.
+const containerDataDir = "/var/flyte/local"
+
+// ContainerRunner runs container tasks with a local container runtime such as docker or podman. The local directory
+// backing the data store is mounted into the task containers so that tasks read their inputs and write their outputs
+// in place.
+type ContainerRunner struct {
+	// Runtime is the container runtime binary.
+	Runtime string
+	// DataDir is the local directory backing the data store.
+	DataDir string
+}
+
+func (r ContainerRunner) Run(ctx context.Context, execution TaskExecution) (TaskResult, error) {
+	args, err := r.runtimeArgs(ctx, execution)
+	if err != nil {
+		return TaskResult{}, err
+	}
+
+	cmd := exec.CommandContext(ctx, r.Runtime, args...) // #nosec G204
+	// Keep stdout for the results of the execution.
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return TaskResult{}, fmt.Errorf("failed to run task [%s] with %s: %w", execution.Task.GetId().GetName(),
+			r.Runtime, err)
+	}
+	return TaskResult{}, nil
+}
+
+// runtimeArgs returns the arguments of the container runtime to run the task.
+func (r ContainerRunner) runtimeArgs(ctx context.Context, execution TaskExecution) ([]string, error) {
+	container := execution.Task.GetContainer()
+	if container == nil {
+		return nil, fmt.Errorf("task [%s] of type [%s] has no container and cannot be run locally, mock its outputs instead",
+			execution.Task.GetId().GetName(), execution.Task.GetType())
+	}
+
+	args := []string{"run", "--rm", "-v", fmt.Sprintf("%s:%s", r.DataDir, containerDataDir)}
+	for _, env := range container.GetEnv() {
+		args = append(args, "-e", fmt.Sprintf("%s=%s", env.GetKey(), env.GetValue()))
+	}
+
+	command := container.GetCommand()
+	if len(command) > 0 {
+		args = append(args, "--entrypoint", command[0])
+	}
+	args = append(args, container.GetImage())
+
+	var taskArgs []string
+	if len(command) > 0 {
+		taskArgs = append(taskArgs, command[1:]...)
+	}
+	taskArgs = append(taskArgs, container.GetArgs()...)
+	data, err := newContainerTaskData(execution)
+	if err != nil {
+		return nil, err
+	}
+	rendered, err := template.Render(ctx, taskArgs, template.Parameters{
+		TaskExecMetadata: localTaskExecutionMetadata{nodeID: execution.NodeID},
+		Inputs:           data,
+		OutputPath:       data,
+		Task:             data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(args, rendered...), nil
+}
+
+// containerTaskData exposes the data of a task execution to template.Render at its paths in the task container.
+type containerTaskData struct {
+	inputs           *core.LiteralMap
+	inputPath        storage.DataReference
+	inputPrefix      storage.DataReference
+	outputPrefix     storage.DataReference
+	rawOutputPrefix  storage.DataReference
+	checkpointPrefix storage.DataReference
+}
+
+func newContainerTaskData(execution TaskExecution) (containerTaskData, error) {
+	data := containerTaskData{inputs: execution.Inputs}
+	for _, p := range []struct {
+		ref  storage.DataReference
+		path *storage.DataReference
+	}{
+		{execution.InputPath, &data.inputPath},
+		{execution.InputPrefix, &data.inputPrefix},
+		{execution.OutputPrefix, &data.outputPrefix},
+		{execution.RawOutputPrefix, &data.rawOutputPrefix},
+		{execution.CheckpointPrefix, &data.checkpointPrefix},
+	} {
+		if len(p.ref) == 0 {
+			continue
+		}
+		containerRef, err := containerPath(p.ref)
+		if err != nil {
+			return containerTaskData{}, err
+		}
+		*p.path = storage.DataReference(containerRef)
+	}
+	return data, nil
+}
+
+func (d containerTaskData) GetInputPrefixPath() storage.DataReference { return d.inputPrefix }
+func (d containerTaskData) GetInputPath() storage.DataReference       { return d.inputPath }
+func (d containerTaskData) Get(context.Context) (*core.LiteralMap, error) {
+	return d.inputs, nil
+}
+func (d containerTaskData) GetRawOutputPrefix() storage.DataReference { return d.rawOutputPrefix }
+
+// Tasks never resume from the checkpoint of a previous attempt in local runs.
+func (d containerTaskData) GetPreviousCheckpointsPrefix() storage.DataReference { return "" }
+func (d containerTaskData) GetCheckpointPrefix() storage.DataReference          { return d.checkpointPrefix }
+func (d containerTaskData) GetOutputPrefixPath() storage.DataReference          { return d.outputPrefix }
+func (d containerTaskData) GetOutputPath() storage.DataReference {
+	return storage.DataReference(path.Join(string(d.outputPrefix), outputsFile))
+}
+func (d containerTaskData) GetDeckPath() storage.DataReference {
+	return storage.DataReference(path.Join(string(d.outputPrefix), "deck.html"))
+}
+func (d containerTaskData) GetErrorPath() storage.DataReference {
+	return storage.DataReference(path.Join(string(d.outputPrefix), errorFile))
+}
+
+// Path fails as the task template is not uploaded in local runs.
+func (d containerTaskData) Path(context.Context) (storage.DataReference, error) {
+	return "", fmt.Errorf("the task template path is not available in local runs")
+}
+
+// localTaskExecutionMetadata provides the metadata template.Render reads. The unique key of the task execution is
+// derived from the path of its node, and its namespace is "local".
+type localTaskExecutionMetadata struct {
+	pluginsCore.TaskExecutionMetadata
+	nodeID string
+}
+
+func (m localTaskExecutionMetadata) GetTaskExecutionID() pluginsCore.TaskExecutionID {
+	return localTaskExecutionID{generatedName: m.nodeID}
+}
+
+func (m localTaskExecutionMetadata) GetNamespace() string {
+	return "local"
+}
+
+type localTaskExecutionID struct {
+	pluginsCore.TaskExecutionID
+	generatedName string
+}
+
+func (id localTaskExecutionID) GetGeneratedName() string {
+	return id.generatedName
+}
+
+// containerPath maps a reference in the local data store to its path in task containers.
+func containerPath(ref storage.DataReference) (string, error) {
+	_, container, key, err := ref.Split()
+	if err != nil {
+		return "", err
+	}
+	return path.Join(containerDataDir, container, key), nil
+}
@@ -0,0 +1,54 @@
+package localexec
+
+import (
+	"context"
+
+	"testing"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerRunnerArgs(t *testing.T) {
+	tsk := task("add")
+	tsk.GetContainer().Command = []string{"pyflyte-execute"}
+	tsk.GetContainer().Args = []string{
+		"--inputs", "{{.input}}",
+		"--output-prefix", "{{.outputPrefix}}",
+		"--raw-output-data-prefix", "{{.rawOutputDataPrefix}}",
+		"--checkpoint-path", "{{.checkpointOutputPrefix}}",
+		"--prev-checkpoint", "{{.prevCheckpointPrefix}}",
+		"--key", "{{.perRetryUniqueKey}}",
+	}
+	tsk.GetContainer().Env = []*core.KeyValuePair{{Key: "FOO", Value: "bar"}}
+
+	runner := ContainerRunner{Runtime: "docker", DataDir: "/tmp/data"}
+	args, err := runner.runtimeArgs(context.Background(), TaskExecution{
+		NodeID:           "n1/n0",
+		Task:             tsk,
+		InputPath:        "file://flytectl-local/run/n1/n0/inputs.pb",
+		InputPrefix:      "file://flytectl-local/run/n1/n0",
+		OutputPrefix:     "file://flytectl-local/run/n1/n0",
+		RawOutputPrefix:  "file://flytectl-local/run/n1/n0/raw",
+		CheckpointPrefix: "file://flytectl-local/run/n1/n0/checkpoint",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"run", "--rm", "-v", "/tmp/data:/var/flyte/local", "-e", "FOO=bar", "--entrypoint", "pyflyte-execute", "image",
+		"--inputs", "/var/flyte/local/flytectl-local/run/n1/n0/inputs.pb",
+		"--output-prefix", "/var/flyte/local/flytectl-local/run/n1/n0",
+		"--raw-output-data-prefix", "/var/flyte/local/flytectl-local/run/n1/n0/raw",
+		"--checkpoint-path", "/var/flyte/local/flytectl-local/run/n1/n0/checkpoint",
+		"--prev-checkpoint", `""`,
+		"--key", "n1_n0",
+	}, args)
+}
+
+func TestContainerRunnerWithoutContainer(t *testing.T) {
+	tsk := task("query")
+	tsk.Type = "sql"
+	tsk.Target = &core.TaskTemplate_Sql{Sql: &core.Sql{Statement: "select 1"}}
+
+	_, err := ContainerRunner{Runtime: "docker"}.runtimeArgs(context.Background(), TaskExecution{Task: tsk})
+	assert.EqualError(t, err, "task [query] of type [sql] has no container and cannot be run locally, mock its outputs instead")
+}
@@ -0,0 +1,68 @@
+package localexec
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// validateClosure returns an error naming every node of a workflow, its subworkflows and branches which cannot be run
+// locally, so that the run fails before any node runs rather than midway. Task caching, interruptible tasks and
+// resource requests and limits are ignored, as they don't change the outputs of a run.
+func validateClosure(closure *core.CompiledWorkflowClosure) error {
+	tasks := make(map[string]*core.TaskTemplate, len(closure.GetTasks()))
+	for _, t := range closure.GetTasks() {
+		tasks[idKey(t.GetTemplate().GetId())] = t.GetTemplate()
+	}
+
+	var reasons []string
+	var validateNode func(prefix string, node *core.Node)
+	validateNode = func(prefix string, node *core.Node) {
+		nodeID := prefix + node.GetId()
+		if node.GetMetadata().GetTimeout() != nil {
+			reasons = append(reasons, fmt.Sprintf("node [%s] has a timeout", nodeID))
+		}
+		switch target := node.GetTarget().(type) {
+		case *core.Node_TaskNode:
+			if tasks[idKey(target.TaskNode.GetReferenceId())].GetMetadata().GetTimeout() != nil {
+				reasons = append(reasons, fmt.Sprintf("task [%s] of node [%s] has a timeout",
+					target.TaskNode.GetReferenceId().GetName(), nodeID))
+			}
+		case *core.Node_BranchNode:
+			ifElse := target.BranchNode.GetIfElse()
+			validateNode(nodeID+"/", ifElse.GetCase().GetThenNode())
+			for _, c := range ifElse.GetOther() {
+				validateNode(nodeID+"/", c.GetThenNode())
+			}
+			if ifElse.GetElseNode() != nil {
+				validateNode(nodeID+"/", ifElse.GetElseNode())
+			}
+		case *core.Node_WorkflowNode:
+			// Subworkflows are validated with the closure, launch plans when they are launched.
+		default:
+			reasons = append(reasons, fmt.Sprintf("node [%s] is a %s node", nodeID, nodeType(node)))
+		}
+	}
+
+	for _, workflow := range append([]*core.CompiledWorkflow{closure.GetPrimary()}, closure.GetSubWorkflows()...) {
+		prefix := ""
+		if workflow != closure.GetPrimary() {
+			prefix = workflow.GetTemplate().GetId().GetName() + ":"
+		}
+		for _, node := range workflow.GetTemplate().GetNodes() {
+			if node.GetId() != startNodeID && node.GetId() != endNodeID {
+				validateNode(prefix, node)
+			}
+		}
+		if failureNode := workflow.GetTemplate().GetFailureNode(); failureNode != nil {
+			validateNode(prefix, failureNode)
+		}
+	}
+
+	if len(reasons) > 0 {
+		return fmt.Errorf("workflow [%s] cannot be run locally: %s",
+			closure.GetPrimary().GetTemplate().GetId().GetName(), strings.Join(reasons, "; "))
+	}
+	return nil
+}
@@ -17,6 +17,15 @@ const (
 	KeyRingServiceName = "flytectl"
 )
 
+// KeyRingServiceUserFor returns the keyring user under which the token for an admin endpoint is cached. Tokens of
+// different contexts are cached separately even if they point at the same endpoint.
+func KeyRingServiceUserFor(endpoint, contextName string) string {
+	if len(contextName) == 0 {
+		return fmt.Sprintf("%s:%s", endpoint, KeyRingServiceUser)
+	}
+	return fmt.Sprintf("%s:%s:%s", contextName, endpoint, KeyRingServiceUser)
+}
+
 // TokenCacheKeyringProvider wraps the logic to save and retrieve tokens from the OS's keyring implementation.
 type TokenCacheKeyringProvider struct {
 	ServiceName string
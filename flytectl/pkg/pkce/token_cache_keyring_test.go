@@ -62,3 +62,9 @@ func TestSaveAndGetToken(t *testing.T) {
 		assert.Nil(t, savedToken)
 	})
 }
+
+func TestKeyRingServiceUserFor(t *testing.T) {
+	assert.Equal(t, "dns:///localhost:30080:flytectl-user", KeyRingServiceUserFor("dns:///localhost:30080", ""))
+	assert.Equal(t, "sandbox:dns:///localhost:30080:flytectl-user",
+		KeyRingServiceUserFor("dns:///localhost:30080", "sandbox"))
+}
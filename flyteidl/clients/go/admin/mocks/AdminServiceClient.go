@@ -1135,6 +1135,80 @@ func (_c *AdminServiceClient_GetExecution_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// GetExecutionAnalysis provides a mock function with given fields: ctx, in, opts
+func (_m *AdminServiceClient) GetExecutionAnalysis(ctx context.Context, in *admin.WorkflowExecutionGetAnalysisRequest, opts ...grpc.CallOption) (*admin.WorkflowExecutionGetAnalysisResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExecutionAnalysis")
+	}
+
+	var r0 *admin.WorkflowExecutionGetAnalysisResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.WorkflowExecutionGetAnalysisRequest, ...grpc.CallOption) (*admin.WorkflowExecutionGetAnalysisResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.WorkflowExecutionGetAnalysisRequest, ...grpc.CallOption) *admin.WorkflowExecutionGetAnalysisResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.WorkflowExecutionGetAnalysisResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.WorkflowExecutionGetAnalysisRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminServiceClient_GetExecutionAnalysis_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExecutionAnalysis'
+type AdminServiceClient_GetExecutionAnalysis_Call struct {
+	*mock.Call
+}
+
+// GetExecutionAnalysis is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *admin.WorkflowExecutionGetAnalysisRequest
+//   - opts ...grpc.CallOption
+func (_e *AdminServiceClient_Expecter) GetExecutionAnalysis(ctx interface{}, in interface{}, opts ...interface{}) *AdminServiceClient_GetExecutionAnalysis_Call {
+	return &AdminServiceClient_GetExecutionAnalysis_Call{Call: _e.mock.On("GetExecutionAnalysis",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *AdminServiceClient_GetExecutionAnalysis_Call) Run(run func(ctx context.Context, in *admin.WorkflowExecutionGetAnalysisRequest, opts ...grpc.CallOption)) *AdminServiceClient_GetExecutionAnalysis_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*admin.WorkflowExecutionGetAnalysisRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *AdminServiceClient_GetExecutionAnalysis_Call) Return(_a0 *admin.WorkflowExecutionGetAnalysisResponse, _a1 error) *AdminServiceClient_GetExecutionAnalysis_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminServiceClient_GetExecutionAnalysis_Call) RunAndReturn(run func(context.Context, *admin.WorkflowExecutionGetAnalysisRequest, ...grpc.CallOption) (*admin.WorkflowExecutionGetAnalysisResponse, error)) *AdminServiceClient_GetExecutionAnalysis_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetExecutionData provides a mock function with given fields: ctx, in, opts
 func (_m *AdminServiceClient) GetExecutionData(ctx context.Context, in *admin.WorkflowExecutionGetDataRequest, opts ...grpc.CallOption) (*admin.WorkflowExecutionGetDataResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -1801,6 +1875,80 @@ func (_c *AdminServiceClient_GetProjectDomainAttributes_Call) RunAndReturn(run f
 	return _c
 }
 
+// GetProjectDomainQuotaUsage provides a mock function with given fields: ctx, in, opts
+func (_m *AdminServiceClient) GetProjectDomainQuotaUsage(ctx context.Context, in *admin.ProjectDomainQuotaUsageGetRequest, opts ...grpc.CallOption) (*admin.ProjectDomainQuotaUsageGetResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectDomainQuotaUsage")
+	}
+
+	var r0 *admin.ProjectDomainQuotaUsageGetResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ProjectDomainQuotaUsageGetRequest, ...grpc.CallOption) (*admin.ProjectDomainQuotaUsageGetResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ProjectDomainQuotaUsageGetRequest, ...grpc.CallOption) *admin.ProjectDomainQuotaUsageGetResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.ProjectDomainQuotaUsageGetResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.ProjectDomainQuotaUsageGetRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminServiceClient_GetProjectDomainQuotaUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectDomainQuotaUsage'
+type AdminServiceClient_GetProjectDomainQuotaUsage_Call struct {
+	*mock.Call
+}
+
+// GetProjectDomainQuotaUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *admin.ProjectDomainQuotaUsageGetRequest
+//   - opts ...grpc.CallOption
+func (_e *AdminServiceClient_Expecter) GetProjectDomainQuotaUsage(ctx interface{}, in interface{}, opts ...interface{}) *AdminServiceClient_GetProjectDomainQuotaUsage_Call {
+	return &AdminServiceClient_GetProjectDomainQuotaUsage_Call{Call: _e.mock.On("GetProjectDomainQuotaUsage",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *AdminServiceClient_GetProjectDomainQuotaUsage_Call) Run(run func(ctx context.Context, in *admin.ProjectDomainQuotaUsageGetRequest, opts ...grpc.CallOption)) *AdminServiceClient_GetProjectDomainQuotaUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*admin.ProjectDomainQuotaUsageGetRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *AdminServiceClient_GetProjectDomainQuotaUsage_Call) Return(_a0 *admin.ProjectDomainQuotaUsageGetResponse, _a1 error) *AdminServiceClient_GetProjectDomainQuotaUsage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminServiceClient_GetProjectDomainQuotaUsage_Call) RunAndReturn(run func(context.Context, *admin.ProjectDomainQuotaUsageGetRequest, ...grpc.CallOption) (*admin.ProjectDomainQuotaUsageGetResponse, error)) *AdminServiceClient_GetProjectDomainQuotaUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTask provides a mock function with given fields: ctx, in, opts
 func (_m *AdminServiceClient) GetTask(ctx context.Context, in *admin.ObjectGetRequest, opts ...grpc.CallOption) (*admin.Task, error) {
 	_va := make([]interface{}, len(opts))
@@ -908,6 +908,65 @@ func (_c *AdminServiceServer_GetExecution_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// GetExecutionAnalysis provides a mock function with given fields: _a0, _a1
+func (_m *AdminServiceServer) GetExecutionAnalysis(_a0 context.Context, _a1 *admin.WorkflowExecutionGetAnalysisRequest) (*admin.WorkflowExecutionGetAnalysisResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExecutionAnalysis")
+	}
+
+	var r0 *admin.WorkflowExecutionGetAnalysisResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.WorkflowExecutionGetAnalysisRequest) (*admin.WorkflowExecutionGetAnalysisResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.WorkflowExecutionGetAnalysisRequest) *admin.WorkflowExecutionGetAnalysisResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.WorkflowExecutionGetAnalysisResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.WorkflowExecutionGetAnalysisRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminServiceServer_GetExecutionAnalysis_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExecutionAnalysis'
+type AdminServiceServer_GetExecutionAnalysis_Call struct {
+	*mock.Call
+}
+
+// GetExecutionAnalysis is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *admin.WorkflowExecutionGetAnalysisRequest
+func (_e *AdminServiceServer_Expecter) GetExecutionAnalysis(_a0 interface{}, _a1 interface{}) *AdminServiceServer_GetExecutionAnalysis_Call {
+	return &AdminServiceServer_GetExecutionAnalysis_Call{Call: _e.mock.On("GetExecutionAnalysis", _a0, _a1)}
+}
+
+func (_c *AdminServiceServer_GetExecutionAnalysis_Call) Run(run func(_a0 context.Context, _a1 *admin.WorkflowExecutionGetAnalysisRequest)) *AdminServiceServer_GetExecutionAnalysis_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.WorkflowExecutionGetAnalysisRequest))
+	})
+	return _c
+}
+
+func (_c *AdminServiceServer_GetExecutionAnalysis_Call) Return(_a0 *admin.WorkflowExecutionGetAnalysisResponse, _a1 error) *AdminServiceServer_GetExecutionAnalysis_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminServiceServer_GetExecutionAnalysis_Call) RunAndReturn(run func(context.Context, *admin.WorkflowExecutionGetAnalysisRequest) (*admin.WorkflowExecutionGetAnalysisResponse, error)) *AdminServiceServer_GetExecutionAnalysis_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetExecutionData provides a mock function with given fields: _a0, _a1
 func (_m *AdminServiceServer) GetExecutionData(_a0 context.Context, _a1 *admin.WorkflowExecutionGetDataRequest) (*admin.WorkflowExecutionGetDataResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1439,6 +1498,65 @@ func (_c *AdminServiceServer_GetProjectDomainAttributes_Call) RunAndReturn(run f
 	return _c
 }
 
+// GetProjectDomainQuotaUsage provides a mock function with given fields: _a0, _a1
+func (_m *AdminServiceServer) GetProjectDomainQuotaUsage(_a0 context.Context, _a1 *admin.ProjectDomainQuotaUsageGetRequest) (*admin.ProjectDomainQuotaUsageGetResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectDomainQuotaUsage")
+	}
+
+	var r0 *admin.ProjectDomainQuotaUsageGetResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ProjectDomainQuotaUsageGetRequest) (*admin.ProjectDomainQuotaUsageGetResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.ProjectDomainQuotaUsageGetRequest) *admin.ProjectDomainQuotaUsageGetResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.ProjectDomainQuotaUsageGetResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *admin.ProjectDomainQuotaUsageGetRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AdminServiceServer_GetProjectDomainQuotaUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectDomainQuotaUsage'
+type AdminServiceServer_GetProjectDomainQuotaUsage_Call struct {
+	*mock.Call
+}
+
+// GetProjectDomainQuotaUsage is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *admin.ProjectDomainQuotaUsageGetRequest
+func (_e *AdminServiceServer_Expecter) GetProjectDomainQuotaUsage(_a0 interface{}, _a1 interface{}) *AdminServiceServer_GetProjectDomainQuotaUsage_Call {
+	return &AdminServiceServer_GetProjectDomainQuotaUsage_Call{Call: _e.mock.On("GetProjectDomainQuotaUsage", _a0, _a1)}
+}
+
+func (_c *AdminServiceServer_GetProjectDomainQuotaUsage_Call) Run(run func(_a0 context.Context, _a1 *admin.ProjectDomainQuotaUsageGetRequest)) *AdminServiceServer_GetProjectDomainQuotaUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*admin.ProjectDomainQuotaUsageGetRequest))
+	})
+	return _c
+}
+
+func (_c *AdminServiceServer_GetProjectDomainQuotaUsage_Call) Return(_a0 *admin.ProjectDomainQuotaUsageGetResponse, _a1 error) *AdminServiceServer_GetProjectDomainQuotaUsage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AdminServiceServer_GetProjectDomainQuotaUsage_Call) RunAndReturn(run func(context.Context, *admin.ProjectDomainQuotaUsageGetRequest) (*admin.ProjectDomainQuotaUsageGetResponse, error)) *AdminServiceServer_GetProjectDomainQuotaUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTask provides a mock function with given fields: _a0, _a1
 func (_m *AdminServiceServer) GetTask(_a0 context.Context, _a1 *admin.ObjectGetRequest) (*admin.Task, error) {
 	ret := _m.Called(_a0, _a1)
@@ -0,0 +1,230 @@
+// Package spananalysis computes the critical path of the span tree flyteadmin reconstructs for an execution and
+// attributes the time on it to queueing, pod scheduling, image pulls, init containers, task runtime and propeller
+// overhead, so that the question "why did this execution take that long?" can be answered without digging through
+// the individual spans.
+package spananalysis
+
+import (
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Activities lists all activities in the order breakdowns are reported in.
+var Activities = []admin.ActivityDuration_Activity{
+	admin.ActivityDuration_QUEUEING,
+	admin.ActivityDuration_POD_SCHEDULING,
+	admin.ActivityDuration_IMAGE_PULL,
+	admin.ActivityDuration_INITIALIZATION,
+	admin.ActivityDuration_RUNTIME,
+	admin.ActivityDuration_IDLE,
+	admin.ActivityDuration_PROPELLER_OVERHEAD,
+}
+
+// operationActivities maps the operation ids of the span tree to the activity they are attributed to. Operations which
+// are not listed are propeller overhead. TASK_SETUP spans are only broken down into queueing, scheduling, image pulls
+// and init containers if the task reported why it was pending, otherwise all of it is attributed to queueing.
+var operationActivities = map[string]admin.ActivityDuration_Activity{
+	"TASK_SETUP":          admin.ActivityDuration_QUEUEING,
+	"TASK_QUEUEING":       admin.ActivityDuration_QUEUEING,
+	"TASK_SCHEDULING":     admin.ActivityDuration_POD_SCHEDULING,
+	"TASK_IMAGE_PULL":     admin.ActivityDuration_IMAGE_PULL,
+	"TASK_INITIALIZATION": admin.ActivityDuration_INITIALIZATION,
+	"TASK_RUNTIME":        admin.ActivityDuration_RUNTIME,
+	"NODE_IDLE":           admin.ActivityDuration_IDLE,
+}
+
+// segment is a contiguous part of the critical path spent on a single activity.
+type segment struct {
+	start     time.Time
+	end       time.Time
+	activity  admin.ActivityDuration_Activity
+	operation string
+	node      string
+	task      string
+}
+
+// breakdown sums up the time spent on each activity.
+type breakdown map[admin.ActivityDuration_Activity]time.Duration
+
+func (b breakdown) toProto() []*admin.ActivityDuration {
+	durations := make([]*admin.ActivityDuration, 0, len(b))
+	for _, activity := range Activities {
+		if d, ok := b[activity]; ok {
+			durations = append(durations, &admin.ActivityDuration{Activity: activity, Duration: durationpb.New(d)})
+		}
+	}
+	return durations
+}
+
+type bottleneck struct {
+	node      string
+	task      string
+	duration  time.Duration
+	breakdown breakdown
+}
+
+// Analyze computes the critical path of the span tree of an execution. The span tree does not record the dependencies
+// between nodes, so the critical path is found by walking backwards from the end of every span: the child span which
+// was the last to finish before the current point in time is the one the remaining work waited for. Time not covered
+// by any child span is attributed to the span itself.
+func Analyze(span *core.Span) *admin.WorkflowExecutionGetAnalysisResponse {
+	analysis := &admin.WorkflowExecutionGetAnalysisResponse{
+		Duration:     durationpb.New(0),
+		Breakdown:    []*admin.ActivityDuration{},
+		CriticalPath: []*admin.CriticalPathSegment{},
+		Bottlenecks:  []*admin.CriticalPathNode{},
+	}
+	if span == nil {
+		return analysis
+	}
+	start, end := span.GetStartTime().AsTime(), span.GetEndTime().AsTime()
+	if !end.After(start) {
+		return analysis
+	}
+	total := end.Sub(start)
+	analysis.Duration = durationpb.New(total)
+
+	w := &walker{}
+	w.walk(span, start, end, "", "")
+	// segments are collected walking backwards in time
+	var path []segment
+	for i := len(w.segments) - 1; i >= 0; i-- {
+		next := w.segments[i]
+		if last := len(path) - 1; last >= 0 && path[last].continuedBy(next) {
+			path[last].end = next.end
+			continue
+		}
+		path = append(path, next)
+	}
+
+	totals := breakdown{}
+	bottlenecks := map[string]*bottleneck{}
+	for _, s := range path {
+		d := s.end.Sub(s.start)
+		totals[s.activity] += d
+		analysis.CriticalPath = append(analysis.CriticalPath, &admin.CriticalPathSegment{
+			StartTime:   timestamppb.New(s.start),
+			EndTime:     timestamppb.New(s.end),
+			Activity:    s.activity,
+			OperationId: s.operation,
+			NodeId:      s.node,
+			TaskName:    s.task,
+		})
+		if len(s.node) == 0 {
+			continue
+		}
+		b, ok := bottlenecks[s.node]
+		if !ok {
+			b = &bottleneck{node: s.node, breakdown: breakdown{}}
+			bottlenecks[s.node] = b
+		}
+		if len(s.task) > 0 {
+			b.task = s.task
+		}
+		b.duration += d
+		b.breakdown[s.activity] += d
+	}
+	analysis.Breakdown = totals.toProto()
+
+	sorted := make([]*bottleneck, 0, len(bottlenecks))
+	for _, b := range bottlenecks {
+		sorted = append(sorted, b)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].duration != sorted[j].duration {
+			return sorted[i].duration > sorted[j].duration
+		}
+		return sorted[i].node < sorted[j].node
+	})
+	for _, b := range sorted {
+		analysis.Bottlenecks = append(analysis.Bottlenecks, &admin.CriticalPathNode{
+			NodeId:    b.node,
+			TaskName:  b.task,
+			Duration:  durationpb.New(b.duration),
+			Share:     float64(b.duration) / float64(total),
+			Breakdown: b.breakdown.toProto(),
+		})
+	}
+	return analysis
+}
+
+// continuedBy returns true if the next segment directly follows this one and was spent on the same activity.
+func (s segment) continuedBy(next segment) bool {
+	return s.end.Equal(next.start) && s.activity == next.activity && s.operation == next.operation &&
+		s.node == next.node && s.task == next.task
+}
+
+type walker struct {
+	segments []segment
+}
+
+// walk collects the critical path of the span between floor and end, latest segment first.
+func (w *walker) walk(span *core.Span, floor, end time.Time, node, task string) {
+	switch id := span.GetId().(type) {
+	case *core.Span_NodeId:
+		node = id.NodeId.GetNodeId()
+	case *core.Span_TaskId:
+		task = id.TaskId.GetTaskId().GetName()
+	}
+	start := span.GetStartTime().AsTime()
+	if start.Before(floor) {
+		start = floor
+	}
+	activity, operation := categorize(span)
+
+	cursor := end
+	for cursor.After(start) {
+		var next *core.Span
+		var nextStart, nextEnd time.Time
+		for _, child := range span.GetSpans() {
+			childStart, childEnd := child.GetStartTime().AsTime(), child.GetEndTime().AsTime()
+			if childEnd.After(cursor) {
+				childEnd = cursor
+			}
+			if !childStart.Before(cursor) || !childEnd.After(childStart) || !childEnd.After(start) {
+				continue
+			}
+			// prefer the child finishing last, and the longest one of those finishing at the same time
+			if next == nil || childEnd.After(nextEnd) || (childEnd.Equal(nextEnd) && childStart.Before(nextStart)) {
+				next, nextStart, nextEnd = child, childStart, childEnd
+			}
+		}
+		if next == nil {
+			break
+		}
+
+		w.add(nextEnd, cursor, activity, operation, node, task)
+		w.walk(next, start, nextEnd, node, task)
+		cursor = nextStart
+	}
+	w.add(start, cursor, activity, operation, node, task)
+}
+
+func (w *walker) add(start, end time.Time, activity admin.ActivityDuration_Activity, operation, node, task string) {
+	if !end.After(start) {
+		return
+	}
+	w.segments = append(w.segments, segment{
+		start:     start,
+		end:       end,
+		activity:  activity,
+		operation: operation,
+		node:      node,
+		task:      task,
+	})
+}
+
+// categorize returns the activity and operation id time spent on the span itself is attributed to.
+func categorize(span *core.Span) (admin.ActivityDuration_Activity, string) {
+	operation := span.GetOperationId()
+	if activity, ok := operationActivities[operation]; ok {
+		return activity, operation
+	}
+	return admin.ActivityDuration_PROPELLER_OVERHEAD, operation
+}
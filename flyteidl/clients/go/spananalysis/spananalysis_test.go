@@ -0,0 +1,190 @@
+package spananalysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+var (
+	baseTime    = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	executionID = &core.WorkflowExecutionIdentifier{Project: "flytesnacks", Domain: "development", Name: "f8a8c3e2b1"}
+)
+
+func newSpan(start, end int, children ...*core.Span) *core.Span {
+	return &core.Span{
+		StartTime: timestamppb.New(baseTime.Add(time.Duration(start) * time.Second)),
+		EndTime:   timestamppb.New(baseTime.Add(time.Duration(end) * time.Second)),
+		Spans:     children,
+	}
+}
+
+func newOperation(operation string, start, end int, children ...*core.Span) *core.Span {
+	span := newSpan(start, end, children...)
+	span.Id = &core.Span_OperationId{OperationId: operation}
+	return span
+}
+
+func newNode(nodeID string, start, end int, children ...*core.Span) *core.Span {
+	span := newSpan(start, end, children...)
+	span.Id = &core.Span_NodeId{NodeId: &core.NodeExecutionIdentifier{NodeId: nodeID, ExecutionId: executionID}}
+	return span
+}
+
+func newTask(name string, start, end int, children ...*core.Span) *core.Span {
+	span := newSpan(start, end, children...)
+	span.Id = &core.Span_TaskId{TaskId: &core.TaskExecutionIdentifier{TaskId: &core.Identifier{Name: name}}}
+	return span
+}
+
+// getSpanTree returns an execution of two parallel nodes n0 and n1, followed by n2 which depends on both.
+func getSpanTree() *core.Span {
+	root := newSpan(0, 100,
+		newOperation("WORKFLOW_SETUP", 0, 2),
+		newNode("n0", 2, 30,
+			newOperation("NODE_SETUP", 2, 3),
+			newTask("prepare", 3, 30,
+				newOperation("TASK_SETUP", 3, 10,
+					newOperation("TASK_QUEUEING", 3, 5),
+					newOperation("TASK_SCHEDULING", 5, 7),
+					newOperation("TASK_IMAGE_PULL", 7, 10)),
+				newOperation("TASK_RUNTIME", 10, 30))),
+		newNode("n1", 2, 20,
+			newOperation("NODE_SETUP", 2, 3),
+			newTask("download", 3, 20,
+				newOperation("TASK_SETUP", 3, 4),
+				newOperation("TASK_RUNTIME", 4, 20))),
+		newNode("n2", 30, 95,
+			newOperation("NODE_TRANSITION", 30, 31),
+			newOperation("NODE_SETUP", 31, 32),
+			newTask("train", 32, 95,
+				newOperation("TASK_SETUP", 32, 35),
+				newOperation("TASK_RUNTIME", 35, 95))),
+		newOperation("WORKFLOW_TEARDOWN", 95, 100),
+	)
+	root.Id = &core.Span_WorkflowId{WorkflowId: executionID}
+	return root
+}
+
+func seconds(s int) time.Duration {
+	return time.Duration(s) * time.Second
+}
+
+// durations returns the breakdown as a map to compare it regardless of its order.
+func durations(breakdown []*admin.ActivityDuration) map[admin.ActivityDuration_Activity]time.Duration {
+	res := map[admin.ActivityDuration_Activity]time.Duration{}
+	for _, d := range breakdown {
+		res[d.GetActivity()] = d.GetDuration().AsDuration()
+	}
+	return res
+}
+
+func TestAnalyze(t *testing.T) {
+	analysis := Analyze(getSpanTree())
+
+	assert.Equal(t, seconds(100), analysis.GetDuration().AsDuration())
+	assert.Equal(t, []*admin.ActivityDuration{
+		{Activity: admin.ActivityDuration_QUEUEING, Duration: durationpb.New(seconds(5))},
+		{Activity: admin.ActivityDuration_POD_SCHEDULING, Duration: durationpb.New(seconds(2))},
+		{Activity: admin.ActivityDuration_IMAGE_PULL, Duration: durationpb.New(seconds(3))},
+		{Activity: admin.ActivityDuration_RUNTIME, Duration: durationpb.New(seconds(80))},
+		{Activity: admin.ActivityDuration_PROPELLER_OVERHEAD, Duration: durationpb.New(seconds(10))},
+	}, analysis.GetBreakdown())
+
+	type step struct {
+		operation string
+		node      string
+		duration  time.Duration
+	}
+	var path []step
+	for i, segment := range analysis.GetCriticalPath() {
+		if i > 0 {
+			assert.Equal(t, analysis.GetCriticalPath()[i-1].GetEndTime().AsTime(), segment.GetStartTime().AsTime(),
+				"the critical path is contiguous")
+		}
+		path = append(path, step{segment.GetOperationId(), segment.GetNodeId(),
+			segment.GetEndTime().AsTime().Sub(segment.GetStartTime().AsTime())})
+	}
+	assert.Equal(t, []step{
+		{"WORKFLOW_SETUP", "", seconds(2)},
+		{"NODE_SETUP", "n0", seconds(1)},
+		{"TASK_QUEUEING", "n0", seconds(2)},
+		{"TASK_SCHEDULING", "n0", seconds(2)},
+		{"TASK_IMAGE_PULL", "n0", seconds(3)},
+		{"TASK_RUNTIME", "n0", seconds(20)},
+		{"NODE_TRANSITION", "n2", seconds(1)},
+		{"NODE_SETUP", "n2", seconds(1)},
+		{"TASK_SETUP", "n2", seconds(3)},
+		{"TASK_RUNTIME", "n2", seconds(60)},
+		{"WORKFLOW_TEARDOWN", "", seconds(5)},
+	}, path)
+
+	bottlenecks := analysis.GetBottlenecks()
+	assert.Len(t, bottlenecks, 2)
+	assert.Equal(t, "n2", bottlenecks[0].GetNodeId())
+	assert.Equal(t, "train", bottlenecks[0].GetTaskName())
+	assert.Equal(t, seconds(65), bottlenecks[0].GetDuration().AsDuration())
+	assert.Equal(t, 0.65, bottlenecks[0].GetShare())
+	assert.Equal(t, map[admin.ActivityDuration_Activity]time.Duration{
+		admin.ActivityDuration_PROPELLER_OVERHEAD: seconds(2),
+		admin.ActivityDuration_QUEUEING:           seconds(3),
+		admin.ActivityDuration_RUNTIME:            seconds(60),
+	}, durations(bottlenecks[0].GetBreakdown()))
+	assert.Equal(t, "n0", bottlenecks[1].GetNodeId())
+	assert.Equal(t, "prepare", bottlenecks[1].GetTaskName())
+	assert.Equal(t, seconds(28), bottlenecks[1].GetDuration().AsDuration())
+	assert.Equal(t, 0.28, bottlenecks[1].GetShare())
+	assert.Equal(t, map[admin.ActivityDuration_Activity]time.Duration{
+		admin.ActivityDuration_PROPELLER_OVERHEAD: seconds(1),
+		admin.ActivityDuration_QUEUEING:           seconds(2),
+		admin.ActivityDuration_POD_SCHEDULING:     seconds(2),
+		admin.ActivityDuration_IMAGE_PULL:         seconds(3),
+		admin.ActivityDuration_RUNTIME:            seconds(20),
+	}, durations(bottlenecks[1].GetBreakdown()))
+}
+
+func TestAnalyze_UncoveredTime(t *testing.T) {
+	// time between the spans of a node is attributed to the node itself
+	root := newSpan(0, 10, newNode("n0", 0, 10, newOperation("NODE_SETUP", 0, 2), newOperation("NODE_IDLE", 4, 10)))
+	analysis := Analyze(root)
+
+	assert.Equal(t, map[admin.ActivityDuration_Activity]time.Duration{
+		admin.ActivityDuration_PROPELLER_OVERHEAD: seconds(4),
+		admin.ActivityDuration_IDLE:               seconds(6),
+	}, durations(analysis.GetBreakdown()))
+	assert.Len(t, analysis.GetCriticalPath(), 3)
+	assert.True(t, proto.Equal(&admin.CriticalPathSegment{
+		StartTime: timestamppb.New(baseTime.Add(2 * time.Second)),
+		EndTime:   timestamppb.New(baseTime.Add(4 * time.Second)),
+		Activity:  admin.ActivityDuration_PROPELLER_OVERHEAD,
+		NodeId:    "n0",
+	}, analysis.GetCriticalPath()[1]))
+}
+
+func TestAnalyze_Initialization(t *testing.T) {
+	root := newSpan(0, 10, newNode("n0", 0, 10,
+		newOperation("TASK_IMAGE_PULL", 0, 4), newOperation("TASK_INITIALIZATION", 4, 10)))
+	analysis := Analyze(root)
+
+	assert.Equal(t, map[admin.ActivityDuration_Activity]time.Duration{
+		admin.ActivityDuration_IMAGE_PULL:     seconds(4),
+		admin.ActivityDuration_INITIALIZATION: seconds(6),
+	}, durations(analysis.GetBreakdown()))
+}
+
+func TestAnalyze_Empty(t *testing.T) {
+	analysis := Analyze(nil)
+	assert.Empty(t, analysis.GetCriticalPath())
+	assert.Empty(t, analysis.GetBottlenecks())
+
+	analysis = Analyze(newSpan(5, 5))
+	assert.Equal(t, time.Duration(0), analysis.GetDuration().AsDuration())
+	assert.Empty(t, analysis.GetCriticalPath())
+}
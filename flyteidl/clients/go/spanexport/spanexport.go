@@ -0,0 +1,268 @@
+// Package spanexport converts the span tree flyteadmin reconstructs for an execution into trace formats understood by
+// common trace viewers, so that a slow execution can be inspected in e.g. Perfetto or Jaeger.
+package spanexport
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+// Format is a trace format spans can be exported to.
+type Format string
+
+const (
+	// FormatChromeTrace is the Chrome trace event JSON format, which Perfetto and chrome://tracing load.
+	FormatChromeTrace Format = "chrome-trace"
+	// FormatOTLP is the OpenTelemetry protocol JSON encoding of a trace, which Jaeger and most OpenTelemetry backends load.
+	FormatOTLP Format = "otlp"
+)
+
+// Formats lists the supported formats.
+var Formats = []Format{FormatChromeTrace, FormatOTLP}
+
+const (
+	categoryWorkflow  = "workflow"
+	categoryNode      = "node"
+	categoryTask      = "task"
+	categoryOperation = "operation"
+
+	serviceName = "flyte"
+	scopeName   = "flyteadmin"
+)
+
+// ParseFormat returns the format with the name, or an error if the format is not supported.
+func ParseFormat(name string) (Format, error) {
+	for _, format := range Formats {
+		if string(format) == name {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported trace format [%v], supported formats are %v", name, Formats)
+}
+
+// Export encodes the span tree in the format.
+func Export(span *core.Span, format Format) ([]byte, error) {
+	switch format {
+	case FormatChromeTrace:
+		return json.Marshal(ToChromeTrace(span))
+	case FormatOTLP:
+		return json.Marshal(ToOTLP(span))
+	}
+	return nil, fmt.Errorf("unsupported trace format [%v]", format)
+}
+
+// attribute is a key value pair describing a span.
+type attribute struct {
+	key   string
+	value string
+}
+
+// describe returns the category, name and attributes of a span.
+func describe(span *core.Span) (string, string, []attribute) {
+	switch id := span.GetId().(type) {
+	case *core.Span_WorkflowId:
+		return categoryWorkflow, id.WorkflowId.GetName(), []attribute{
+			{"flyte.project", id.WorkflowId.GetProject()},
+			{"flyte.domain", id.WorkflowId.GetDomain()},
+			{"flyte.execution", id.WorkflowId.GetName()},
+		}
+	case *core.Span_NodeId:
+		return categoryNode, id.NodeId.GetNodeId(), []attribute{
+			{"flyte.node", id.NodeId.GetNodeId()},
+		}
+	case *core.Span_TaskId:
+		return categoryTask, fmt.Sprintf("%v (attempt %d)", id.TaskId.GetTaskId().GetName(), id.TaskId.GetRetryAttempt()),
+			[]attribute{
+				{"flyte.node", id.TaskId.GetNodeExecutionId().GetNodeId()},
+				{"flyte.task", id.TaskId.GetTaskId().GetName()},
+				{"flyte.task_version", id.TaskId.GetTaskId().GetVersion()},
+				{"flyte.retry_attempt", strconv.FormatUint(uint64(id.TaskId.GetRetryAttempt()), 10)},
+			}
+	case *core.Span_OperationId:
+		return categoryOperation, id.OperationId, nil
+	}
+	return categoryOperation, "unknown", nil
+}
+
+// ChromeTrace is a trace in the Chrome trace event JSON format.
+type ChromeTrace struct {
+	TraceEvents     []ChromeTraceEvent `json:"traceEvents"`
+	DisplayTimeUnit string             `json:"displayTimeUnit"`
+}
+
+// ChromeTraceEvent is a single event of a Chrome trace. Spans are complete events (phase X); thread names are metadata
+// events (phase M).
+type ChromeTraceEvent struct {
+	Name      string            `json:"name"`
+	Category  string            `json:"cat,omitempty"`
+	Phase     string            `json:"ph"`
+	Timestamp int64             `json:"ts"`
+	Duration  int64             `json:"dur,omitempty"`
+	ProcessID int               `json:"pid"`
+	ThreadID  int               `json:"tid"`
+	Args      map[string]string `json:"args,omitempty"`
+}
+
+// ToChromeTrace converts the span tree to a Chrome trace. Every node gets its own track, named after the node, so that
+// nodes running in parallel do not overlap; task and operation spans are nested on the track of their node.
+func ToChromeTrace(span *core.Span) *ChromeTrace {
+	trace := &ChromeTrace{DisplayTimeUnit: "ms"}
+	nextThreadID := 0
+	var visit func(span *core.Span, threadID int)
+	visit = func(span *core.Span, threadID int) {
+		category, name, attributes := describe(span)
+		if category == categoryWorkflow || category == categoryNode {
+			threadID = nextThreadID
+			nextThreadID++
+			trace.TraceEvents = append(trace.TraceEvents, ChromeTraceEvent{
+				Name:     "thread_name",
+				Phase:    "M",
+				ThreadID: threadID,
+				Args:     map[string]string{"name": name},
+			})
+		}
+
+		var args map[string]string
+		if len(attributes) > 0 {
+			args = make(map[string]string, len(attributes))
+			for _, a := range attributes {
+				args[a.key] = a.value
+			}
+		}
+		start := span.GetStartTime().AsTime().UnixMicro()
+		trace.TraceEvents = append(trace.TraceEvents, ChromeTraceEvent{
+			Name:      name,
+			Category:  category,
+			Phase:     "X",
+			Timestamp: start,
+			Duration:  span.GetEndTime().AsTime().UnixMicro() - start,
+			ThreadID:  threadID,
+			Args:      args,
+		})
+
+		for _, child := range span.GetSpans() {
+			visit(child, threadID)
+		}
+	}
+	if span != nil {
+		visit(span, 0)
+	}
+	return trace
+}
+
+// OTLPTrace is the OTLP/JSON encoding of an ExportTraceServiceRequest holding a single trace.
+type OTLPTrace struct {
+	ResourceSpans []OTLPResourceSpans `json:"resourceSpans"`
+}
+
+type OTLPResourceSpans struct {
+	Resource   OTLPResource     `json:"resource"`
+	ScopeSpans []OTLPScopeSpans `json:"scopeSpans"`
+}
+
+type OTLPResource struct {
+	Attributes []OTLPAttribute `json:"attributes"`
+}
+
+type OTLPScopeSpans struct {
+	Scope OTLPScope  `json:"scope"`
+	Spans []OTLPSpan `json:"spans"`
+}
+
+type OTLPScope struct {
+	Name string `json:"name"`
+}
+
+// OTLPSpan is a span in the OTLP/JSON encoding, which encodes ids as hex strings and timestamps as decimal strings.
+type OTLPSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []OTLPAttribute `json:"attributes,omitempty"`
+}
+
+type OTLPAttribute struct {
+	Key   string       `json:"key"`
+	Value OTLPAnyValue `json:"value"`
+}
+
+type OTLPAnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+// otlpSpanKindInternal is the kind of spans which represent internal operations rather than remote calls.
+const otlpSpanKindInternal = 1
+
+// ToOTLP converts the span tree to an OTLP trace. Trace and span ids are derived from the root span and the position of
+// every span in the tree, so exporting the same execution twice yields the same ids.
+func ToOTLP(span *core.Span) *OTLPTrace {
+	var spans []OTLPSpan
+	if span != nil {
+		_, rootName, rootAttributes := describe(span)
+		traceID := hashID(16, append([]string{rootName}, attributeValues(rootAttributes)...)...)
+
+		var visit func(span *core.Span, path, parentSpanID string)
+		visit = func(span *core.Span, path, parentSpanID string) {
+			category, name, attributes := describe(span)
+			spanID := hashID(8, traceID, path)
+			otlpAttributes := []OTLPAttribute{newOTLPAttribute("flyte.span_type", category)}
+			for _, a := range attributes {
+				otlpAttributes = append(otlpAttributes, newOTLPAttribute(a.key, a.value))
+			}
+			spans = append(spans, OTLPSpan{
+				TraceID:           traceID,
+				SpanID:            spanID,
+				ParentSpanID:      parentSpanID,
+				Name:              name,
+				Kind:              otlpSpanKindInternal,
+				StartTimeUnixNano: strconv.FormatInt(span.GetStartTime().AsTime().UnixNano(), 10),
+				EndTimeUnixNano:   strconv.FormatInt(span.GetEndTime().AsTime().UnixNano(), 10),
+				Attributes:        otlpAttributes,
+			})
+			for idx, child := range span.GetSpans() {
+				visit(child, path+"/"+strconv.Itoa(idx), spanID)
+			}
+		}
+		visit(span, "", "")
+	}
+
+	return &OTLPTrace{
+		ResourceSpans: []OTLPResourceSpans{{
+			Resource: OTLPResource{
+				Attributes: []OTLPAttribute{newOTLPAttribute("service.name", serviceName)},
+			},
+			ScopeSpans: []OTLPScopeSpans{{
+				Scope: OTLPScope{Name: scopeName},
+				Spans: spans,
+			}},
+		}},
+	}
+}
+
+func newOTLPAttribute(key, value string) OTLPAttribute {
+	return OTLPAttribute{Key: key, Value: OTLPAnyValue{StringValue: value}}
+}
+
+func attributeValues(attributes []attribute) []string {
+	values := make([]string, 0, len(attributes))
+	for _, a := range attributes {
+		values = append(values, a.value)
+	}
+	return values
+}
+
+// hashID returns a hex encoded id of size bytes derived from the parts.
+func hashID(size int, parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:size])
+}
@@ -0,0 +1,116 @@
+package spanexport
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+var baseTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newSpan(start, end time.Duration, children ...*core.Span) *core.Span {
+	return &core.Span{
+		StartTime: timestamppb.New(baseTime.Add(start)),
+		EndTime:   timestamppb.New(baseTime.Add(end)),
+		Spans:     children,
+	}
+}
+
+func getSpanTree() *core.Span {
+	executionID := &core.WorkflowExecutionIdentifier{Project: "flytesnacks", Domain: "development", Name: "f8a8c3e2b1"}
+	nodeID := func(id string) *core.NodeExecutionIdentifier {
+		return &core.NodeExecutionIdentifier{NodeId: id, ExecutionId: executionID}
+	}
+
+	task := newSpan(2*time.Second, 8*time.Second, newSpan(2*time.Second, 3*time.Second))
+	task.GetSpans()[0].Id = &core.Span_OperationId{OperationId: "EXECUTION_OVERHEAD"}
+	task.Id = &core.Span_TaskId{TaskId: &core.TaskExecutionIdentifier{
+		TaskId:          &core.Identifier{Name: "train", Version: "v1"},
+		NodeExecutionId: nodeID("n0"),
+		RetryAttempt:    1,
+	}}
+	n0 := newSpan(time.Second, 9*time.Second, task)
+	n0.Id = &core.Span_NodeId{NodeId: nodeID("n0")}
+	n1 := newSpan(time.Second, 4*time.Second)
+	n1.Id = &core.Span_NodeId{NodeId: nodeID("n1")}
+	root := newSpan(0, 10*time.Second, n0, n1)
+	root.Id = &core.Span_WorkflowId{WorkflowId: executionID}
+	return root
+}
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat("otlp")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatOTLP, format)
+
+	_, err = ParseFormat("jaeger")
+	assert.EqualError(t, err, "unsupported trace format [jaeger], supported formats are [chrome-trace otlp]")
+}
+
+func TestToChromeTrace(t *testing.T) {
+	trace := ToChromeTrace(getSpanTree())
+
+	start := baseTime.UnixMicro()
+	assert.Equal(t, []ChromeTraceEvent{
+		{Name: "thread_name", Phase: "M", ThreadID: 0, Args: map[string]string{"name": "f8a8c3e2b1"}},
+		{Name: "f8a8c3e2b1", Category: "workflow", Phase: "X", Timestamp: start, Duration: 10e6, ThreadID: 0,
+			Args: map[string]string{"flyte.project": "flytesnacks", "flyte.domain": "development", "flyte.execution": "f8a8c3e2b1"}},
+		{Name: "thread_name", Phase: "M", ThreadID: 1, Args: map[string]string{"name": "n0"}},
+		{Name: "n0", Category: "node", Phase: "X", Timestamp: start + 1e6, Duration: 8e6, ThreadID: 1,
+			Args: map[string]string{"flyte.node": "n0"}},
+		{Name: "train (attempt 1)", Category: "task", Phase: "X", Timestamp: start + 2e6, Duration: 6e6, ThreadID: 1,
+			Args: map[string]string{"flyte.node": "n0", "flyte.task": "train", "flyte.task_version": "v1", "flyte.retry_attempt": "1"}},
+		{Name: "EXECUTION_OVERHEAD", Category: "operation", Phase: "X", Timestamp: start + 2e6, Duration: 1e6, ThreadID: 1},
+		{Name: "thread_name", Phase: "M", ThreadID: 2, Args: map[string]string{"name": "n1"}},
+		{Name: "n1", Category: "node", Phase: "X", Timestamp: start + 1e6, Duration: 3e6, ThreadID: 2,
+			Args: map[string]string{"flyte.node": "n1"}},
+	}, trace.TraceEvents)
+}
+
+func TestToOTLP(t *testing.T) {
+	trace := ToOTLP(getSpanTree())
+	require.Len(t, trace.ResourceSpans, 1)
+	require.Len(t, trace.ResourceSpans[0].ScopeSpans, 1)
+	spans := trace.ResourceSpans[0].ScopeSpans[0].Spans
+	require.Len(t, spans, 5)
+
+	root := spans[0]
+	assert.Len(t, root.TraceID, 32)
+	assert.Len(t, root.SpanID, 16)
+	assert.Empty(t, root.ParentSpanID)
+	assert.Equal(t, "f8a8c3e2b1", root.Name)
+	assert.Equal(t, "1704067200000000000", root.StartTimeUnixNano)
+	assert.Equal(t, "1704067210000000000", root.EndTimeUnixNano)
+
+	// Spans are listed depth first and point to their parents.
+	parents := map[string]string{"n0": root.SpanID, "train (attempt 1)": spans[1].SpanID,
+		"EXECUTION_OVERHEAD": spans[2].SpanID, "n1": root.SpanID}
+	spanIDs := map[string]bool{root.SpanID: true}
+	for _, span := range spans[1:] {
+		assert.Equal(t, root.TraceID, span.TraceID)
+		assert.Equal(t, parents[span.Name], span.ParentSpanID, span.Name)
+		assert.False(t, spanIDs[span.SpanID], "span ids are unique")
+		spanIDs[span.SpanID] = true
+	}
+	assert.Contains(t, spans[2].Attributes, newOTLPAttribute("flyte.span_type", "task"))
+
+	// Exporting the same execution again yields the same ids.
+	assert.Equal(t, trace, ToOTLP(getSpanTree()))
+}
+
+func TestExport(t *testing.T) {
+	for _, format := range Formats {
+		raw, err := Export(getSpanTree(), format)
+		assert.NoError(t, err)
+		assert.True(t, json.Valid(raw))
+	}
+
+	_, err := Export(getSpanTree(), "jaeger")
+	assert.Error(t, err)
+}
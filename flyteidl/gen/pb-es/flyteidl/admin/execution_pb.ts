@@ -1599,3 +1599,371 @@ export class WorkflowExecutionGetMetricsResponse extends Message<WorkflowExecuti
   }
 }
 
+/**
+ * WorkflowExecutionGetAnalysisRequest represents a request to analyze the critical path of the specified workflow
+ * execution.
+ *
+ * @generated from message flyteidl.admin.WorkflowExecutionGetAnalysisRequest
+ */
+export class WorkflowExecutionGetAnalysisRequest extends Message<WorkflowExecutionGetAnalysisRequest> {
+  /**
+   * id defines the workflow execution to analyze.
+   *
+   * @generated from field: flyteidl.core.WorkflowExecutionIdentifier id = 1;
+   */
+  id?: WorkflowExecutionIdentifier;
+
+  /**
+   * depth defines the number of Flyte entity levels to traverse when breaking down execution details.
+   *
+   * @generated from field: int32 depth = 2;
+   */
+  depth = 0;
+
+  constructor(data?: PartialMessage<WorkflowExecutionGetAnalysisRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.WorkflowExecutionGetAnalysisRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "message", T: WorkflowExecutionIdentifier },
+    { no: 2, name: "depth", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WorkflowExecutionGetAnalysisRequest {
+    return new WorkflowExecutionGetAnalysisRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WorkflowExecutionGetAnalysisRequest {
+    return new WorkflowExecutionGetAnalysisRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WorkflowExecutionGetAnalysisRequest {
+    return new WorkflowExecutionGetAnalysisRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WorkflowExecutionGetAnalysisRequest | PlainMessage<WorkflowExecutionGetAnalysisRequest> | undefined, b: WorkflowExecutionGetAnalysisRequest | PlainMessage<WorkflowExecutionGetAnalysisRequest> | undefined): boolean {
+    return proto3.util.equals(WorkflowExecutionGetAnalysisRequest, a, b);
+  }
+}
+
+/**
+ * ActivityDuration is the time spent on a single kind of activity.
+ *
+ * @generated from message flyteidl.admin.ActivityDuration
+ */
+export class ActivityDuration extends Message<ActivityDuration> {
+  /**
+   * @generated from field: flyteidl.admin.ActivityDuration.Activity activity = 1;
+   */
+  activity = ActivityDuration_Activity.PROPELLER_OVERHEAD;
+
+  /**
+   * @generated from field: google.protobuf.Duration duration = 2;
+   */
+  duration?: Duration;
+
+  constructor(data?: PartialMessage<ActivityDuration>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.ActivityDuration";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "activity", kind: "enum", T: proto3.getEnumType(ActivityDuration_Activity) },
+    { no: 2, name: "duration", kind: "message", T: Duration },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ActivityDuration {
+    return new ActivityDuration().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ActivityDuration {
+    return new ActivityDuration().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ActivityDuration {
+    return new ActivityDuration().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ActivityDuration | PlainMessage<ActivityDuration> | undefined, b: ActivityDuration | PlainMessage<ActivityDuration> | undefined): boolean {
+    return proto3.util.equals(ActivityDuration, a, b);
+  }
+}
+
+/**
+ * Activity is a kind of activity the time spent on the critical path of an execution is attributed to.
+ *
+ * @generated from enum flyteidl.admin.ActivityDuration.Activity
+ */
+export enum ActivityDuration_Activity {
+  /**
+   * Time spent by propeller evaluating the workflow and transitioning between nodes and attempts.
+   *
+   * @generated from enum value: PROPELLER_OVERHEAD = 0;
+   */
+  PROPELLER_OVERHEAD = 0,
+
+  /**
+   * Time a task waited for resources or quotas before it was submitted or scheduled.
+   *
+   * @generated from enum value: QUEUEING = 1;
+   */
+  QUEUEING = 1,
+
+  /**
+   * Time a pod waited to be scheduled on a node.
+   *
+   * @generated from enum value: POD_SCHEDULING = 2;
+   */
+  POD_SCHEDULING = 2,
+
+  /**
+   * Time spent pulling images and creating the containers of a pod.
+   *
+   * @generated from enum value: IMAGE_PULL = 3;
+   */
+  IMAGE_PULL = 3,
+
+  /**
+   * Time the init containers of a pod ran before its containers started.
+   *
+   * @generated from enum value: INITIALIZATION = 4;
+   */
+  INITIALIZATION = 4,
+
+  /**
+   * Time a task was running.
+   *
+   * @generated from enum value: RUNTIME = 5;
+   */
+  RUNTIME = 5,
+
+  /**
+   * Time a gate node waited for a signal, an approval or a sleep to elapse.
+   *
+   * @generated from enum value: IDLE = 6;
+   */
+  IDLE = 6,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ActivityDuration_Activity)
+proto3.util.setEnumType(ActivityDuration_Activity, "flyteidl.admin.ActivityDuration.Activity", [
+  { no: 0, name: "PROPELLER_OVERHEAD" },
+  { no: 1, name: "QUEUEING" },
+  { no: 2, name: "POD_SCHEDULING" },
+  { no: 3, name: "IMAGE_PULL" },
+  { no: 4, name: "INITIALIZATION" },
+  { no: 5, name: "RUNTIME" },
+  { no: 6, name: "IDLE" },
+]);
+
+/**
+ * CriticalPathSegment is a contiguous part of the critical path of an execution spent on a single activity.
+ *
+ * @generated from message flyteidl.admin.CriticalPathSegment
+ */
+export class CriticalPathSegment extends Message<CriticalPathSegment> {
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 1;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 2;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: flyteidl.admin.ActivityDuration.Activity activity = 3;
+   */
+  activity = ActivityDuration_Activity.PROPELLER_OVERHEAD;
+
+  /**
+   * operation_id is the operation of the span the segment was taken from, empty if the time is not covered by any
+   * operation of a node or workflow.
+   *
+   * @generated from field: string operation_id = 4;
+   */
+  operationId = "";
+
+  /**
+   * node_id is the id of the innermost node the segment belongs to, empty for time spent on the workflow itself.
+   *
+   * @generated from field: string node_id = 5;
+   */
+  nodeId = "";
+
+  /**
+   * @generated from field: string task_name = 6;
+   */
+  taskName = "";
+
+  constructor(data?: PartialMessage<CriticalPathSegment>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.CriticalPathSegment";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "start_time", kind: "message", T: Timestamp },
+    { no: 2, name: "end_time", kind: "message", T: Timestamp },
+    { no: 3, name: "activity", kind: "enum", T: proto3.getEnumType(ActivityDuration_Activity) },
+    { no: 4, name: "operation_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "node_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "task_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CriticalPathSegment {
+    return new CriticalPathSegment().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CriticalPathSegment {
+    return new CriticalPathSegment().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CriticalPathSegment {
+    return new CriticalPathSegment().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CriticalPathSegment | PlainMessage<CriticalPathSegment> | undefined, b: CriticalPathSegment | PlainMessage<CriticalPathSegment> | undefined): boolean {
+    return proto3.util.equals(CriticalPathSegment, a, b);
+  }
+}
+
+/**
+ * CriticalPathNode is a node on the critical path of an execution.
+ *
+ * @generated from message flyteidl.admin.CriticalPathNode
+ */
+export class CriticalPathNode extends Message<CriticalPathNode> {
+  /**
+   * @generated from field: string node_id = 1;
+   */
+  nodeId = "";
+
+  /**
+   * @generated from field: string task_name = 2;
+   */
+  taskName = "";
+
+  /**
+   * duration is the time the node contributes to the critical path, which is the most that speeding up the node
+   * could shorten the execution by.
+   *
+   * @generated from field: google.protobuf.Duration duration = 3;
+   */
+  duration?: Duration;
+
+  /**
+   * share is the fraction of the duration of the execution the node contributes to the critical path.
+   *
+   * @generated from field: double share = 4;
+   */
+  share = 0;
+
+  /**
+   * breakdown attributes the duration to activities.
+   *
+   * @generated from field: repeated flyteidl.admin.ActivityDuration breakdown = 5;
+   */
+  breakdown: ActivityDuration[] = [];
+
+  constructor(data?: PartialMessage<CriticalPathNode>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.CriticalPathNode";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "node_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "task_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "duration", kind: "message", T: Duration },
+    { no: 4, name: "share", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 5, name: "breakdown", kind: "message", T: ActivityDuration, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CriticalPathNode {
+    return new CriticalPathNode().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CriticalPathNode {
+    return new CriticalPathNode().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CriticalPathNode {
+    return new CriticalPathNode().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CriticalPathNode | PlainMessage<CriticalPathNode> | undefined, b: CriticalPathNode | PlainMessage<CriticalPathNode> | undefined): boolean {
+    return proto3.util.equals(CriticalPathNode, a, b);
+  }
+}
+
+/**
+ * WorkflowExecutionGetAnalysisResponse represents the critical path analysis of the specified workflow execution.
+ *
+ * @generated from message flyteidl.admin.WorkflowExecutionGetAnalysisResponse
+ */
+export class WorkflowExecutionGetAnalysisResponse extends Message<WorkflowExecutionGetAnalysisResponse> {
+  /**
+   * @generated from field: google.protobuf.Duration duration = 1;
+   */
+  duration?: Duration;
+
+  /**
+   * breakdown attributes the duration of the execution to activities.
+   *
+   * @generated from field: repeated flyteidl.admin.ActivityDuration breakdown = 2;
+   */
+  breakdown: ActivityDuration[] = [];
+
+  /**
+   * critical_path lists the segments of the critical path in chronological order.
+   *
+   * @generated from field: repeated flyteidl.admin.CriticalPathSegment critical_path = 3;
+   */
+  criticalPath: CriticalPathSegment[] = [];
+
+  /**
+   * bottlenecks lists the nodes on the critical path, those contributing the most time first.
+   *
+   * @generated from field: repeated flyteidl.admin.CriticalPathNode bottlenecks = 4;
+   */
+  bottlenecks: CriticalPathNode[] = [];
+
+  constructor(data?: PartialMessage<WorkflowExecutionGetAnalysisResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.WorkflowExecutionGetAnalysisResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "duration", kind: "message", T: Duration },
+    { no: 2, name: "breakdown", kind: "message", T: ActivityDuration, repeated: true },
+    { no: 3, name: "critical_path", kind: "message", T: CriticalPathSegment, repeated: true },
+    { no: 4, name: "bottlenecks", kind: "message", T: CriticalPathNode, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WorkflowExecutionGetAnalysisResponse {
+    return new WorkflowExecutionGetAnalysisResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WorkflowExecutionGetAnalysisResponse {
+    return new WorkflowExecutionGetAnalysisResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WorkflowExecutionGetAnalysisResponse {
+    return new WorkflowExecutionGetAnalysisResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WorkflowExecutionGetAnalysisResponse | PlainMessage<WorkflowExecutionGetAnalysisResponse> | undefined, b: WorkflowExecutionGetAnalysisResponse | PlainMessage<WorkflowExecutionGetAnalysisResponse> | undefined): boolean {
+    return proto3.util.equals(WorkflowExecutionGetAnalysisResponse, a, b);
+  }
+}
+
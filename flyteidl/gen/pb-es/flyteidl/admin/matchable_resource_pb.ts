@@ -73,6 +73,13 @@ export enum MatchableResource {
    * @generated from enum value: CLUSTER_ASSIGNMENT = 7;
    */
   CLUSTER_ASSIGNMENT = 7,
+
+  /**
+   * Limits the concurrent executions and running task pods of a project and domain.
+   *
+   * @generated from enum value: PROJECT_QUOTA = 8;
+   */
+  PROJECT_QUOTA = 8,
 }
 // Retrieve enum metadata with: proto3.getEnumType(MatchableResource)
 proto3.util.setEnumType(MatchableResource, "flyteidl.admin.MatchableResource", [
@@ -84,6 +91,7 @@ proto3.util.setEnumType(MatchableResource, "flyteidl.admin.MatchableResource", [
   { no: 5, name: "PLUGIN_OVERRIDE" },
   { no: 6, name: "WORKFLOW_EXECUTION_CONFIG" },
   { no: 7, name: "CLUSTER_ASSIGNMENT" },
+  { no: 8, name: "PROJECT_QUOTA" },
 ]);
 
 /**
@@ -543,6 +551,105 @@ export class WorkflowExecutionConfig extends Message<WorkflowExecutionConfig> {
   }
 }
 
+/**
+ * Limits the executions and task pods of a project and domain. Zero and empty limits are not enforced.
+ * Limits are enforced on a best-effort basis, executions and tasks created concurrently may briefly exceed them.
+ *
+ * @generated from message flyteidl.admin.ProjectQuotaAttributes
+ */
+export class ProjectQuotaAttributes extends Message<ProjectQuotaAttributes> {
+  /**
+   * The maximum number of executions of the project and domain which have not terminated.
+   *
+   * @generated from field: int32 max_concurrent_executions = 1;
+   */
+  maxConcurrentExecutions = 0;
+
+  /**
+   * The maximum number of task pods of the project and domain running at the same time.
+   *
+   * @generated from field: int32 max_running_tasks = 2;
+   */
+  maxRunningTasks = 0;
+
+  /**
+   * The maximum total CPU requested by the running task pods, as a Kubernetes quantity, e.g. "64" or "500m".
+   *
+   * @generated from field: string max_cpu = 3;
+   */
+  maxCpu = "";
+
+  /**
+   * The maximum total GPU requested by the running task pods, as a Kubernetes quantity.
+   *
+   * @generated from field: string max_gpu = 4;
+   */
+  maxGpu = "";
+
+  /**
+   * Defines what happens to executions created while at the execution limit.
+   *
+   * @generated from field: flyteidl.admin.ProjectQuotaAttributes.OverflowBehavior overflow = 5;
+   */
+  overflow = ProjectQuotaAttributes_OverflowBehavior.REJECT;
+
+  constructor(data?: PartialMessage<ProjectQuotaAttributes>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.ProjectQuotaAttributes";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "max_concurrent_executions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "max_running_tasks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "max_cpu", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "max_gpu", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "overflow", kind: "enum", T: proto3.getEnumType(ProjectQuotaAttributes_OverflowBehavior) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectQuotaAttributes {
+    return new ProjectQuotaAttributes().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProjectQuotaAttributes {
+    return new ProjectQuotaAttributes().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProjectQuotaAttributes {
+    return new ProjectQuotaAttributes().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProjectQuotaAttributes | PlainMessage<ProjectQuotaAttributes> | undefined, b: ProjectQuotaAttributes | PlainMessage<ProjectQuotaAttributes> | undefined): boolean {
+    return proto3.util.equals(ProjectQuotaAttributes, a, b);
+  }
+}
+
+/**
+ * @generated from enum flyteidl.admin.ProjectQuotaAttributes.OverflowBehavior
+ */
+export enum ProjectQuotaAttributes_OverflowBehavior {
+  /**
+   * Executions created while at the execution limit are rejected.
+   *
+   * @generated from enum value: REJECT = 0;
+   */
+  REJECT = 0,
+
+  /**
+   * Executions created while at the execution limit are queued until the project and domain has capacity.
+   * Single task executions are always rejected.
+   *
+   * @generated from enum value: QUEUE = 1;
+   */
+  QUEUE = 1,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ProjectQuotaAttributes_OverflowBehavior)
+proto3.util.setEnumType(ProjectQuotaAttributes_OverflowBehavior, "flyteidl.admin.ProjectQuotaAttributes.OverflowBehavior", [
+  { no: 0, name: "REJECT" },
+  { no: 1, name: "QUEUE" },
+]);
+
 /**
  * Generic container for encapsulating all types of the above attributes messages.
  *
@@ -600,6 +707,12 @@ export class MatchingAttributes extends Message<MatchingAttributes> {
      */
     value: ClusterAssignment;
     case: "clusterAssignment";
+  } | {
+    /**
+     * @generated from field: flyteidl.admin.ProjectQuotaAttributes project_quota_attributes = 9;
+     */
+    value: ProjectQuotaAttributes;
+    case: "projectQuotaAttributes";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<MatchingAttributes>) {
@@ -618,6 +731,7 @@ export class MatchingAttributes extends Message<MatchingAttributes> {
     { no: 6, name: "plugin_overrides", kind: "message", T: PluginOverrides, oneof: "target" },
     { no: 7, name: "workflow_execution_config", kind: "message", T: WorkflowExecutionConfig, oneof: "target" },
     { no: 8, name: "cluster_assignment", kind: "message", T: ClusterAssignment, oneof: "target" },
+    { no: 9, name: "project_quota_attributes", kind: "message", T: ProjectQuotaAttributes, oneof: "target" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MatchingAttributes {
@@ -4,8 +4,8 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
-import { MatchableResource, MatchingAttributes } from "./matchable_resource_pb.js";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { MatchableResource, MatchingAttributes, ProjectQuotaAttributes } from "./matchable_resource_pb.js";
 
 /**
  * Defines a set of custom matching attributes which defines resource defaults for a project and domain.
@@ -357,3 +357,127 @@ export class ProjectDomainAttributesDeleteResponse extends Message<ProjectDomain
   }
 }
 
+/**
+ * Request to get the quota usage of a project and domain.
+ *
+ * @generated from message flyteidl.admin.ProjectDomainQuotaUsageGetRequest
+ */
+export class ProjectDomainQuotaUsageGetRequest extends Message<ProjectDomainQuotaUsageGetRequest> {
+  /**
+   * Unique project id for which to get the quota usage.
+   * +required
+   *
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * Unique domain id for which to get the quota usage.
+   * +required
+   *
+   * @generated from field: string domain = 2;
+   */
+  domain = "";
+
+  /**
+   * Optional, org key applied to the project.
+   *
+   * @generated from field: string org = 3;
+   */
+  org = "";
+
+  constructor(data?: PartialMessage<ProjectDomainQuotaUsageGetRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.ProjectDomainQuotaUsageGetRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "org", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectDomainQuotaUsageGetRequest {
+    return new ProjectDomainQuotaUsageGetRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProjectDomainQuotaUsageGetRequest {
+    return new ProjectDomainQuotaUsageGetRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProjectDomainQuotaUsageGetRequest {
+    return new ProjectDomainQuotaUsageGetRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProjectDomainQuotaUsageGetRequest | PlainMessage<ProjectDomainQuotaUsageGetRequest> | undefined, b: ProjectDomainQuotaUsageGetRequest | PlainMessage<ProjectDomainQuotaUsageGetRequest> | undefined): boolean {
+    return proto3.util.equals(ProjectDomainQuotaUsageGetRequest, a, b);
+  }
+}
+
+/**
+ * Usage of a project and domain along with the quota in effect for it, if any.
+ *
+ * @generated from message flyteidl.admin.ProjectDomainQuotaUsageGetResponse
+ */
+export class ProjectDomainQuotaUsageGetResponse extends Message<ProjectDomainQuotaUsageGetResponse> {
+  /**
+   * The :ref:`ref_flyteidl.admin.ProjectQuotaAttributes` matching the project and domain, if any.
+   *
+   * @generated from field: flyteidl.admin.ProjectQuotaAttributes quota = 1;
+   */
+  quota?: ProjectQuotaAttributes;
+
+  /**
+   * Number of launched executions which have not terminated.
+   *
+   * @generated from field: int64 active_executions = 2;
+   */
+  activeExecutions = protoInt64.zero;
+
+  /**
+   * Number of executions queued until the project and domain has capacity.
+   *
+   * @generated from field: int64 queued_executions = 3;
+   */
+  queuedExecutions = protoInt64.zero;
+
+  /**
+   * Number of task executions which have not terminated.
+   *
+   * @generated from field: int64 running_tasks = 4;
+   */
+  runningTasks = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ProjectDomainQuotaUsageGetResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "flyteidl.admin.ProjectDomainQuotaUsageGetResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "quota", kind: "message", T: ProjectQuotaAttributes },
+    { no: 2, name: "active_executions", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "queued_executions", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "running_tasks", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectDomainQuotaUsageGetResponse {
+    return new ProjectDomainQuotaUsageGetResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProjectDomainQuotaUsageGetResponse {
+    return new ProjectDomainQuotaUsageGetResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProjectDomainQuotaUsageGetResponse {
+    return new ProjectDomainQuotaUsageGetResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProjectDomainQuotaUsageGetResponse | PlainMessage<ProjectDomainQuotaUsageGetResponse> | undefined, b: ProjectDomainQuotaUsageGetResponse | PlainMessage<ProjectDomainQuotaUsageGetResponse> | undefined): boolean {
+    return proto3.util.equals(ProjectDomainQuotaUsageGetResponse, a, b);
+  }
+}
+
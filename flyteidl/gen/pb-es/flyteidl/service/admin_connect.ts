@@ -8,12 +8,12 @@ import { MethodKind } from "@bufbuild/protobuf";
 import { NamedEntity, NamedEntityGetRequest, NamedEntityIdentifierList, NamedEntityIdentifierListRequest, NamedEntityList, NamedEntityListRequest, NamedEntityUpdateRequest, NamedEntityUpdateResponse, ObjectGetRequest, ResourceListRequest } from "../admin/common_pb.js";
 import { Workflow, WorkflowCreateRequest, WorkflowCreateResponse, WorkflowList } from "../admin/workflow_pb.js";
 import { ActiveLaunchPlanListRequest, ActiveLaunchPlanRequest, LaunchPlan, LaunchPlanCreateRequest, LaunchPlanCreateResponse, LaunchPlanList, LaunchPlanUpdateRequest, LaunchPlanUpdateResponse } from "../admin/launch_plan_pb.js";
-import { Execution, ExecutionCreateRequest, ExecutionCreateResponse, ExecutionList, ExecutionRecoverRequest, ExecutionRelaunchRequest, ExecutionTerminateRequest, ExecutionTerminateResponse, ExecutionUpdateRequest, ExecutionUpdateResponse, WorkflowExecutionGetDataRequest, WorkflowExecutionGetDataResponse, WorkflowExecutionGetMetricsRequest, WorkflowExecutionGetMetricsResponse, WorkflowExecutionGetRequest } from "../admin/execution_pb.js";
+import { Execution, ExecutionCreateRequest, ExecutionCreateResponse, ExecutionList, ExecutionRecoverRequest, ExecutionRelaunchRequest, ExecutionTerminateRequest, ExecutionTerminateResponse, ExecutionUpdateRequest, ExecutionUpdateResponse, WorkflowExecutionGetAnalysisRequest, WorkflowExecutionGetAnalysisResponse, WorkflowExecutionGetDataRequest, WorkflowExecutionGetDataResponse, WorkflowExecutionGetMetricsRequest, WorkflowExecutionGetMetricsResponse, WorkflowExecutionGetRequest } from "../admin/execution_pb.js";
 import { DynamicNodeWorkflowResponse, GetDynamicNodeWorkflowRequest, NodeExecution, NodeExecutionForTaskListRequest, NodeExecutionGetDataRequest, NodeExecutionGetDataResponse, NodeExecutionGetRequest, NodeExecutionList, NodeExecutionListRequest } from "../admin/node_execution_pb.js";
 import { GetDomainRequest, GetDomainsResponse, Project, ProjectGetRequest, ProjectListRequest, ProjectRegisterRequest, ProjectRegisterResponse, Projects, ProjectUpdateResponse } from "../admin/project_pb.js";
 import { NodeExecutionEventRequest, NodeExecutionEventResponse, TaskExecutionEventRequest, TaskExecutionEventResponse, WorkflowExecutionEventRequest, WorkflowExecutionEventResponse } from "../admin/event_pb.js";
 import { TaskExecution, TaskExecutionGetDataRequest, TaskExecutionGetDataResponse, TaskExecutionGetRequest, TaskExecutionList, TaskExecutionListRequest } from "../admin/task_execution_pb.js";
-import { ProjectDomainAttributesDeleteRequest, ProjectDomainAttributesDeleteResponse, ProjectDomainAttributesGetRequest, ProjectDomainAttributesGetResponse, ProjectDomainAttributesUpdateRequest, ProjectDomainAttributesUpdateResponse } from "../admin/project_domain_attributes_pb.js";
+import { ProjectDomainAttributesDeleteRequest, ProjectDomainAttributesDeleteResponse, ProjectDomainAttributesGetRequest, ProjectDomainAttributesGetResponse, ProjectDomainAttributesUpdateRequest, ProjectDomainAttributesUpdateResponse, ProjectDomainQuotaUsageGetRequest, ProjectDomainQuotaUsageGetResponse } from "../admin/project_domain_attributes_pb.js";
 import { ProjectAttributesDeleteRequest, ProjectAttributesDeleteResponse, ProjectAttributesGetRequest, ProjectAttributesGetResponse, ProjectAttributesUpdateRequest, ProjectAttributesUpdateResponse } from "../admin/project_attributes_pb.js";
 import { WorkflowAttributesDeleteRequest, WorkflowAttributesDeleteResponse, WorkflowAttributesGetRequest, WorkflowAttributesGetResponse, WorkflowAttributesUpdateRequest, WorkflowAttributesUpdateResponse } from "../admin/workflow_attributes_pb.js";
 import { ListMatchableAttributesRequest, ListMatchableAttributesResponse } from "../admin/matchable_resource_pb.js";
@@ -495,6 +495,17 @@ export const AdminService = {
       O: ProjectDomainAttributesDeleteResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Fetches the usage of a project and domain along with the :ref:`ref_flyteidl.admin.ProjectQuotaAttributes` in effect for it.
+     *
+     * @generated from rpc flyteidl.service.AdminService.GetProjectDomainQuotaUsage
+     */
+    getProjectDomainQuotaUsage: {
+      name: "GetProjectDomainQuotaUsage",
+      I: ProjectDomainQuotaUsageGetRequest,
+      O: ProjectDomainQuotaUsageGetResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Creates or updates custom :ref:`ref_flyteidl.admin.MatchableAttributesConfiguration` at the project level
      *
@@ -647,6 +658,17 @@ export const AdminService = {
       O: WorkflowExecutionGetMetricsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Analyzes the critical path of a :ref:`ref_flyteidl.admin.Execution`.
+     *
+     * @generated from rpc flyteidl.service.AdminService.GetExecutionAnalysis
+     */
+    getExecutionAnalysis: {
+      name: "GetExecutionAnalysis",
+      I: WorkflowExecutionGetAnalysisRequest,
+      O: WorkflowExecutionGetAnalysisResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
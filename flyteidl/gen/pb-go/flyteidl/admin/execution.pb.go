@@ -142,6 +142,75 @@ func (ExecutionMetadata_ExecutionMode) EnumDescriptor() ([]byte, []int) {
 	return file_flyteidl_admin_execution_proto_rawDescGZIP(), []int{11, 0}
 }
 
+// Activity is a kind of activity the time spent on the critical path of an execution is attributed to.
+type ActivityDuration_Activity int32
+
+const (
+	// Time spent by propeller evaluating the workflow and transitioning between nodes and attempts.
+	ActivityDuration_PROPELLER_OVERHEAD ActivityDuration_Activity = 0
+	// Time a task waited for resources or quotas before it was submitted or scheduled.
+	ActivityDuration_QUEUEING ActivityDuration_Activity = 1
+	// Time a pod waited to be scheduled on a node.
+	ActivityDuration_POD_SCHEDULING ActivityDuration_Activity = 2
+	// Time spent pulling images and creating the containers of a pod.
+	ActivityDuration_IMAGE_PULL ActivityDuration_Activity = 3
+	// Time the init containers of a pod ran before its containers started.
+	ActivityDuration_INITIALIZATION ActivityDuration_Activity = 4
+	// Time a task was running.
+	ActivityDuration_RUNTIME ActivityDuration_Activity = 5
+	// Time a gate node waited for a signal, an approval or a sleep to elapse.
+	ActivityDuration_IDLE ActivityDuration_Activity = 6
+)
+
+// Enum value maps for ActivityDuration_Activity.
+var (
+	ActivityDuration_Activity_name = map[int32]string{
+		0: "PROPELLER_OVERHEAD",
+		1: "QUEUEING",
+		2: "POD_SCHEDULING",
+		3: "IMAGE_PULL",
+		4: "INITIALIZATION",
+		5: "RUNTIME",
+		6: "IDLE",
+	}
+	ActivityDuration_Activity_value = map[string]int32{
+		"PROPELLER_OVERHEAD": 0,
+		"QUEUEING":           1,
+		"POD_SCHEDULING":     2,
+		"IMAGE_PULL":         3,
+		"INITIALIZATION":     4,
+		"RUNTIME":            5,
+		"IDLE":               6,
+	}
+)
+
+func (x ActivityDuration_Activity) Enum() *ActivityDuration_Activity {
+	p := new(ActivityDuration_Activity)
+	*p = x
+	return p
+}
+
+func (x ActivityDuration_Activity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActivityDuration_Activity) Descriptor() protoreflect.EnumDescriptor {
+	return file_flyteidl_admin_execution_proto_enumTypes[2].Descriptor()
+}
+
+func (ActivityDuration_Activity) Type() protoreflect.EnumType {
+	return &file_flyteidl_admin_execution_proto_enumTypes[2]
+}
+
+func (x ActivityDuration_Activity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActivityDuration_Activity.Descriptor instead.
+func (ActivityDuration_Activity) EnumDescriptor() ([]byte, []int) {
+	return file_flyteidl_admin_execution_proto_rawDescGZIP(), []int{24, 0}
+}
+
 // Request to launch an execution with the given project, domain and optionally-assigned name.
 type ExecutionCreateRequest struct {
 	state         protoimpl.MessageState
@@ -1973,6 +2042,371 @@ func (x *WorkflowExecutionGetMetricsResponse) GetSpan() *core.Span {
 	return nil
 }
 
+// WorkflowExecutionGetAnalysisRequest represents a request to analyze the critical path of the specified workflow
+// execution.
+type WorkflowExecutionGetAnalysisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id defines the workflow execution to analyze.
+	Id *core.WorkflowExecutionIdentifier `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// depth defines the number of Flyte entity levels to traverse when breaking down execution details.
+	Depth int32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+}
+
+func (x *WorkflowExecutionGetAnalysisRequest) Reset() {
+	*x = WorkflowExecutionGetAnalysisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flyteidl_admin_execution_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkflowExecutionGetAnalysisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowExecutionGetAnalysisRequest) ProtoMessage() {}
+
+func (x *WorkflowExecutionGetAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flyteidl_admin_execution_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowExecutionGetAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*WorkflowExecutionGetAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_flyteidl_admin_execution_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WorkflowExecutionGetAnalysisRequest) GetId() *core.WorkflowExecutionIdentifier {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *WorkflowExecutionGetAnalysisRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+// ActivityDuration is the time spent on a single kind of activity.
+type ActivityDuration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Activity ActivityDuration_Activity `protobuf:"varint,1,opt,name=activity,proto3,enum=flyteidl.admin.ActivityDuration_Activity" json:"activity,omitempty"`
+	Duration *durationpb.Duration      `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *ActivityDuration) Reset() {
+	*x = ActivityDuration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flyteidl_admin_execution_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivityDuration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityDuration) ProtoMessage() {}
+
+func (x *ActivityDuration) ProtoReflect() protoreflect.Message {
+	mi := &file_flyteidl_admin_execution_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityDuration.ProtoReflect.Descriptor instead.
+func (*ActivityDuration) Descriptor() ([]byte, []int) {
+	return file_flyteidl_admin_execution_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ActivityDuration) GetActivity() ActivityDuration_Activity {
+	if x != nil {
+		return x.Activity
+	}
+	return ActivityDuration_PROPELLER_OVERHEAD
+}
+
+func (x *ActivityDuration) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+// CriticalPathSegment is a contiguous part of the critical path of an execution spent on a single activity.
+type CriticalPathSegment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime *timestamppb.Timestamp    `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp    `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Activity  ActivityDuration_Activity `protobuf:"varint,3,opt,name=activity,proto3,enum=flyteidl.admin.ActivityDuration_Activity" json:"activity,omitempty"`
+	// operation_id is the operation of the span the segment was taken from, empty if the time is not covered by any
+	// operation of a node or workflow.
+	OperationId string `protobuf:"bytes,4,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	// node_id is the id of the innermost node the segment belongs to, empty for time spent on the workflow itself.
+	NodeId   string `protobuf:"bytes,5,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	TaskName string `protobuf:"bytes,6,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+}
+
+func (x *CriticalPathSegment) Reset() {
+	*x = CriticalPathSegment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flyteidl_admin_execution_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CriticalPathSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriticalPathSegment) ProtoMessage() {}
+
+func (x *CriticalPathSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_flyteidl_admin_execution_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriticalPathSegment.ProtoReflect.Descriptor instead.
+func (*CriticalPathSegment) Descriptor() ([]byte, []int) {
+	return file_flyteidl_admin_execution_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CriticalPathSegment) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CriticalPathSegment) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CriticalPathSegment) GetActivity() ActivityDuration_Activity {
+	if x != nil {
+		return x.Activity
+	}
+	return ActivityDuration_PROPELLER_OVERHEAD
+}
+
+func (x *CriticalPathSegment) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *CriticalPathSegment) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CriticalPathSegment) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+// CriticalPathNode is a node on the critical path of an execution.
+type CriticalPathNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId   string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	TaskName string `protobuf:"bytes,2,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	// duration is the time the node contributes to the critical path, which is the most that speeding up the node
+	// could shorten the execution by.
+	Duration *durationpb.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	// share is the fraction of the duration of the execution the node contributes to the critical path.
+	Share float64 `protobuf:"fixed64,4,opt,name=share,proto3" json:"share,omitempty"`
+	// breakdown attributes the duration to activities.
+	Breakdown []*ActivityDuration `protobuf:"bytes,5,rep,name=breakdown,proto3" json:"breakdown,omitempty"`
+}
+
+func (x *CriticalPathNode) Reset() {
+	*x = CriticalPathNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flyteidl_admin_execution_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CriticalPathNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriticalPathNode) ProtoMessage() {}
+
+func (x *CriticalPathNode) ProtoReflect() protoreflect.Message {
+	mi := &file_flyteidl_admin_execution_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriticalPathNode.ProtoReflect.Descriptor instead.
+func (*CriticalPathNode) Descriptor() ([]byte, []int) {
+	return file_flyteidl_admin_execution_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CriticalPathNode) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CriticalPathNode) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+func (x *CriticalPathNode) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *CriticalPathNode) GetShare() float64 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+func (x *CriticalPathNode) GetBreakdown() []*ActivityDuration {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+// WorkflowExecutionGetAnalysisResponse represents the critical path analysis of the specified workflow execution.
+type WorkflowExecutionGetAnalysisResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Duration *durationpb.Duration `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	// breakdown attributes the duration of the execution to activities.
+	Breakdown []*ActivityDuration `protobuf:"bytes,2,rep,name=breakdown,proto3" json:"breakdown,omitempty"`
+	// critical_path lists the segments of the critical path in chronological order.
+	CriticalPath []*CriticalPathSegment `protobuf:"bytes,3,rep,name=critical_path,json=criticalPath,proto3" json:"critical_path,omitempty"`
+	// bottlenecks lists the nodes on the critical path, those contributing the most time first.
+	Bottlenecks []*CriticalPathNode `protobuf:"bytes,4,rep,name=bottlenecks,proto3" json:"bottlenecks,omitempty"`
+}
+
+func (x *WorkflowExecutionGetAnalysisResponse) Reset() {
+	*x = WorkflowExecutionGetAnalysisResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flyteidl_admin_execution_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkflowExecutionGetAnalysisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowExecutionGetAnalysisResponse) ProtoMessage() {}
+
+func (x *WorkflowExecutionGetAnalysisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flyteidl_admin_execution_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowExecutionGetAnalysisResponse.ProtoReflect.Descriptor instead.
+func (*WorkflowExecutionGetAnalysisResponse) Descriptor() ([]byte, []int) {
+	return file_flyteidl_admin_execution_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WorkflowExecutionGetAnalysisResponse) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *WorkflowExecutionGetAnalysisResponse) GetBreakdown() []*ActivityDuration {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+func (x *WorkflowExecutionGetAnalysisResponse) GetCriticalPath() []*CriticalPathSegment {
+	if x != nil {
+		return x.CriticalPath
+	}
+	return nil
+}
+
+func (x *WorkflowExecutionGetAnalysisResponse) GetBottlenecks() []*CriticalPathNode {
+	if x != nil {
+		return x.Bottlenecks
+	}
+	return nil
+}
+
 var File_flyteidl_admin_execution_proto protoreflect.FileDescriptor
 
 var file_flyteidl_admin_execution_proto_rawDesc = []byte{
@@ -2333,23 +2767,99 @@ var file_flyteidl_admin_execution_proto_rawDesc = []byte{
 	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27,
 	0x0a, 0x04, 0x73, 0x70, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66,
 	0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x70, 0x61,
-	0x6e, 0x52, 0x04, 0x73, 0x70, 0x61, 0x6e, 0x2a, 0x3e, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x58, 0x45,
-	0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12,
-	0x16, 0x0a, 0x12, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x52, 0x43,
-	0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x01, 0x42, 0xba, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e,
-	0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x0e,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6c, 0x79,
-	0x74, 0x65, 0x6f, 0x72, 0x67, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x2f, 0x66, 0x6c, 0x79, 0x74,
-	0x65, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x62, 0x2d, 0x67, 0x6f, 0x2f, 0x66,
-	0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0xa2, 0x02, 0x03,
-	0x46, 0x41, 0x58, 0xaa, 0x02, 0x0e, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0xca, 0x02, 0x0e, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x5c,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0xe2, 0x02, 0x1a, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c,
-	0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x0f, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x3a, 0x3a, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x52, 0x04, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x77, 0x0a, 0x23, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x66, 0x6c, 0x79,
+	0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68,
+	0x22, 0x91, 0x02, 0x0a, 0x10, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69,
+	0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x7f, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12,
+	0x16, 0x0a, 0x12, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x4c, 0x4c, 0x45, 0x52, 0x5f, 0x4f, 0x56, 0x45,
+	0x52, 0x48, 0x45, 0x41, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x51, 0x55, 0x45, 0x55, 0x45,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x44, 0x5f, 0x53, 0x43, 0x48,
+	0x45, 0x44, 0x55, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4d, 0x41,
+	0x47, 0x45, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x49,
+	0x54, 0x49, 0x41, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x0b, 0x0a,
+	0x07, 0x52, 0x55, 0x4e, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x44,
+	0x4c, 0x45, 0x10, 0x06, 0x22, 0xa7, 0x02, 0x0a, 0x13, 0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61,
+	0x6c, 0x50, 0x61, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x45,
+	0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x29, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x08, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd5,
+	0x01, 0x0a, 0x10, 0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x4e,
+	0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64,
+	0x6f, 0x77, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x6c, 0x79, 0x74,
+	0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x22, 0xab, 0x02, 0x0a, 0x24, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x09, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64,
+	0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x6c, 0x79, 0x74,
+	0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x48, 0x0a, 0x0d, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63,
+	0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43,
+	0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0c, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x42, 0x0a, 0x0b, 0x62, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x6e, 0x65, 0x63, 0x6b, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x50,
+	0x61, 0x74, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x62, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x6e,
+	0x65, 0x63, 0x6b, 0x73, 0x2a, 0x3e, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
+	0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56,
+	0x45, 0x44, 0x10, 0x01, 0x42, 0xba, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x6c, 0x79,
+	0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x0e, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x6f,
+	0x72, 0x67, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64,
+	0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x62, 0x2d, 0x67, 0x6f, 0x2f, 0x66, 0x6c, 0x79, 0x74,
+	0x65, 0x69, 0x64, 0x6c, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0xa2, 0x02, 0x03, 0x46, 0x41, 0x58,
+	0xaa, 0x02, 0x0e, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0xca, 0x02, 0x0e, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x5c, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0xe2, 0x02, 0x1a, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x5c, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x0f, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x3a, 0x3a, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2364,123 +2874,141 @@ func file_flyteidl_admin_execution_proto_rawDescGZIP() []byte {
 	return file_flyteidl_admin_execution_proto_rawDescData
 }
 
-var file_flyteidl_admin_execution_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_flyteidl_admin_execution_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_flyteidl_admin_execution_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_flyteidl_admin_execution_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_flyteidl_admin_execution_proto_goTypes = []interface{}{
-	(ExecutionState)(0),                         // 0: flyteidl.admin.ExecutionState
-	(ExecutionMetadata_ExecutionMode)(0),        // 1: flyteidl.admin.ExecutionMetadata.ExecutionMode
-	(*ExecutionCreateRequest)(nil),              // 2: flyteidl.admin.ExecutionCreateRequest
-	(*ExecutionRelaunchRequest)(nil),            // 3: flyteidl.admin.ExecutionRelaunchRequest
-	(*ExecutionRecoverRequest)(nil),             // 4: flyteidl.admin.ExecutionRecoverRequest
-	(*ExecutionCreateResponse)(nil),             // 5: flyteidl.admin.ExecutionCreateResponse
-	(*WorkflowExecutionGetRequest)(nil),         // 6: flyteidl.admin.WorkflowExecutionGetRequest
-	(*Execution)(nil),                           // 7: flyteidl.admin.Execution
-	(*ExecutionList)(nil),                       // 8: flyteidl.admin.ExecutionList
-	(*LiteralMapBlob)(nil),                      // 9: flyteidl.admin.LiteralMapBlob
-	(*AbortMetadata)(nil),                       // 10: flyteidl.admin.AbortMetadata
-	(*ExecutionClosure)(nil),                    // 11: flyteidl.admin.ExecutionClosure
-	(*SystemMetadata)(nil),                      // 12: flyteidl.admin.SystemMetadata
-	(*ExecutionMetadata)(nil),                   // 13: flyteidl.admin.ExecutionMetadata
-	(*NotificationList)(nil),                    // 14: flyteidl.admin.NotificationList
-	(*ExecutionSpec)(nil),                       // 15: flyteidl.admin.ExecutionSpec
-	(*ExecutionTerminateRequest)(nil),           // 16: flyteidl.admin.ExecutionTerminateRequest
-	(*ExecutionTerminateResponse)(nil),          // 17: flyteidl.admin.ExecutionTerminateResponse
-	(*WorkflowExecutionGetDataRequest)(nil),     // 18: flyteidl.admin.WorkflowExecutionGetDataRequest
-	(*WorkflowExecutionGetDataResponse)(nil),    // 19: flyteidl.admin.WorkflowExecutionGetDataResponse
-	(*ExecutionUpdateRequest)(nil),              // 20: flyteidl.admin.ExecutionUpdateRequest
-	(*ExecutionStateChangeDetails)(nil),         // 21: flyteidl.admin.ExecutionStateChangeDetails
-	(*ExecutionUpdateResponse)(nil),             // 22: flyteidl.admin.ExecutionUpdateResponse
-	(*WorkflowExecutionGetMetricsRequest)(nil),  // 23: flyteidl.admin.WorkflowExecutionGetMetricsRequest
-	(*WorkflowExecutionGetMetricsResponse)(nil), // 24: flyteidl.admin.WorkflowExecutionGetMetricsResponse
-	(*core.LiteralMap)(nil),                     // 25: flyteidl.core.LiteralMap
-	(*core.WorkflowExecutionIdentifier)(nil),    // 26: flyteidl.core.WorkflowExecutionIdentifier
-	(*core.ExecutionError)(nil),                 // 27: flyteidl.core.ExecutionError
-	(core.WorkflowExecution_Phase)(0),           // 28: flyteidl.core.WorkflowExecution.Phase
-	(*timestamppb.Timestamp)(nil),               // 29: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),                 // 30: google.protobuf.Duration
-	(*Notification)(nil),                        // 31: flyteidl.admin.Notification
-	(*core.Identifier)(nil),                     // 32: flyteidl.core.Identifier
-	(*core.NodeExecutionIdentifier)(nil),        // 33: flyteidl.core.NodeExecutionIdentifier
-	(*core.ArtifactID)(nil),                     // 34: flyteidl.core.ArtifactID
-	(*Labels)(nil),                              // 35: flyteidl.admin.Labels
-	(*Annotations)(nil),                         // 36: flyteidl.admin.Annotations
-	(*core.SecurityContext)(nil),                // 37: flyteidl.core.SecurityContext
-	(*AuthRole)(nil),                            // 38: flyteidl.admin.AuthRole
-	(*core.QualityOfService)(nil),               // 39: flyteidl.core.QualityOfService
-	(*RawOutputDataConfig)(nil),                 // 40: flyteidl.admin.RawOutputDataConfig
-	(*ClusterAssignment)(nil),                   // 41: flyteidl.admin.ClusterAssignment
-	(*wrapperspb.BoolValue)(nil),                // 42: google.protobuf.BoolValue
-	(*Envs)(nil),                                // 43: flyteidl.admin.Envs
-	(*ExecutionClusterLabel)(nil),               // 44: flyteidl.admin.ExecutionClusterLabel
-	(*core.ExecutionEnvAssignment)(nil),         // 45: flyteidl.core.ExecutionEnvAssignment
-	(*UrlBlob)(nil),                             // 46: flyteidl.admin.UrlBlob
-	(*core.Span)(nil),                           // 47: flyteidl.core.Span
+	(ExecutionState)(0),                          // 0: flyteidl.admin.ExecutionState
+	(ExecutionMetadata_ExecutionMode)(0),         // 1: flyteidl.admin.ExecutionMetadata.ExecutionMode
+	(ActivityDuration_Activity)(0),               // 2: flyteidl.admin.ActivityDuration.Activity
+	(*ExecutionCreateRequest)(nil),               // 3: flyteidl.admin.ExecutionCreateRequest
+	(*ExecutionRelaunchRequest)(nil),             // 4: flyteidl.admin.ExecutionRelaunchRequest
+	(*ExecutionRecoverRequest)(nil),              // 5: flyteidl.admin.ExecutionRecoverRequest
+	(*ExecutionCreateResponse)(nil),              // 6: flyteidl.admin.ExecutionCreateResponse
+	(*WorkflowExecutionGetRequest)(nil),          // 7: flyteidl.admin.WorkflowExecutionGetRequest
+	(*Execution)(nil),                            // 8: flyteidl.admin.Execution
+	(*ExecutionList)(nil),                        // 9: flyteidl.admin.ExecutionList
+	(*LiteralMapBlob)(nil),                       // 10: flyteidl.admin.LiteralMapBlob
+	(*AbortMetadata)(nil),                        // 11: flyteidl.admin.AbortMetadata
+	(*ExecutionClosure)(nil),                     // 12: flyteidl.admin.ExecutionClosure
+	(*SystemMetadata)(nil),                       // 13: flyteidl.admin.SystemMetadata
+	(*ExecutionMetadata)(nil),                    // 14: flyteidl.admin.ExecutionMetadata
+	(*NotificationList)(nil),                     // 15: flyteidl.admin.NotificationList
+	(*ExecutionSpec)(nil),                        // 16: flyteidl.admin.ExecutionSpec
+	(*ExecutionTerminateRequest)(nil),            // 17: flyteidl.admin.ExecutionTerminateRequest
+	(*ExecutionTerminateResponse)(nil),           // 18: flyteidl.admin.ExecutionTerminateResponse
+	(*WorkflowExecutionGetDataRequest)(nil),      // 19: flyteidl.admin.WorkflowExecutionGetDataRequest
+	(*WorkflowExecutionGetDataResponse)(nil),     // 20: flyteidl.admin.WorkflowExecutionGetDataResponse
+	(*ExecutionUpdateRequest)(nil),               // 21: flyteidl.admin.ExecutionUpdateRequest
+	(*ExecutionStateChangeDetails)(nil),          // 22: flyteidl.admin.ExecutionStateChangeDetails
+	(*ExecutionUpdateResponse)(nil),              // 23: flyteidl.admin.ExecutionUpdateResponse
+	(*WorkflowExecutionGetMetricsRequest)(nil),   // 24: flyteidl.admin.WorkflowExecutionGetMetricsRequest
+	(*WorkflowExecutionGetMetricsResponse)(nil),  // 25: flyteidl.admin.WorkflowExecutionGetMetricsResponse
+	(*WorkflowExecutionGetAnalysisRequest)(nil),  // 26: flyteidl.admin.WorkflowExecutionGetAnalysisRequest
+	(*ActivityDuration)(nil),                     // 27: flyteidl.admin.ActivityDuration
+	(*CriticalPathSegment)(nil),                  // 28: flyteidl.admin.CriticalPathSegment
+	(*CriticalPathNode)(nil),                     // 29: flyteidl.admin.CriticalPathNode
+	(*WorkflowExecutionGetAnalysisResponse)(nil), // 30: flyteidl.admin.WorkflowExecutionGetAnalysisResponse
+	(*core.LiteralMap)(nil),                      // 31: flyteidl.core.LiteralMap
+	(*core.WorkflowExecutionIdentifier)(nil),     // 32: flyteidl.core.WorkflowExecutionIdentifier
+	(*core.ExecutionError)(nil),                  // 33: flyteidl.core.ExecutionError
+	(core.WorkflowExecution_Phase)(0),            // 34: flyteidl.core.WorkflowExecution.Phase
+	(*timestamppb.Timestamp)(nil),                // 35: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                  // 36: google.protobuf.Duration
+	(*Notification)(nil),                         // 37: flyteidl.admin.Notification
+	(*core.Identifier)(nil),                      // 38: flyteidl.core.Identifier
+	(*core.NodeExecutionIdentifier)(nil),         // 39: flyteidl.core.NodeExecutionIdentifier
+	(*core.ArtifactID)(nil),                      // 40: flyteidl.core.ArtifactID
+	(*Labels)(nil),                               // 41: flyteidl.admin.Labels
+	(*Annotations)(nil),                          // 42: flyteidl.admin.Annotations
+	(*core.SecurityContext)(nil),                 // 43: flyteidl.core.SecurityContext
+	(*AuthRole)(nil),                             // 44: flyteidl.admin.AuthRole
+	(*core.QualityOfService)(nil),                // 45: flyteidl.core.QualityOfService
+	(*RawOutputDataConfig)(nil),                  // 46: flyteidl.admin.RawOutputDataConfig
+	(*ClusterAssignment)(nil),                    // 47: flyteidl.admin.ClusterAssignment
+	(*wrapperspb.BoolValue)(nil),                 // 48: google.protobuf.BoolValue
+	(*Envs)(nil),                                 // 49: flyteidl.admin.Envs
+	(*ExecutionClusterLabel)(nil),                // 50: flyteidl.admin.ExecutionClusterLabel
+	(*core.ExecutionEnvAssignment)(nil),          // 51: flyteidl.core.ExecutionEnvAssignment
+	(*UrlBlob)(nil),                              // 52: flyteidl.admin.UrlBlob
+	(*core.Span)(nil),                            // 53: flyteidl.core.Span
 }
 var file_flyteidl_admin_execution_proto_depIdxs = []int32{
-	15, // 0: flyteidl.admin.ExecutionCreateRequest.spec:type_name -> flyteidl.admin.ExecutionSpec
-	25, // 1: flyteidl.admin.ExecutionCreateRequest.inputs:type_name -> flyteidl.core.LiteralMap
-	26, // 2: flyteidl.admin.ExecutionRelaunchRequest.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
-	26, // 3: flyteidl.admin.ExecutionRecoverRequest.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
-	13, // 4: flyteidl.admin.ExecutionRecoverRequest.metadata:type_name -> flyteidl.admin.ExecutionMetadata
-	26, // 5: flyteidl.admin.ExecutionCreateResponse.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
-	26, // 6: flyteidl.admin.WorkflowExecutionGetRequest.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
-	26, // 7: flyteidl.admin.Execution.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
-	15, // 8: flyteidl.admin.Execution.spec:type_name -> flyteidl.admin.ExecutionSpec
-	11, // 9: flyteidl.admin.Execution.closure:type_name -> flyteidl.admin.ExecutionClosure
-	7,  // 10: flyteidl.admin.ExecutionList.executions:type_name -> flyteidl.admin.Execution
-	25, // 11: flyteidl.admin.LiteralMapBlob.values:type_name -> flyteidl.core.LiteralMap
-	9,  // 12: flyteidl.admin.ExecutionClosure.outputs:type_name -> flyteidl.admin.LiteralMapBlob
-	27, // 13: flyteidl.admin.ExecutionClosure.error:type_name -> flyteidl.core.ExecutionError
-	10, // 14: flyteidl.admin.ExecutionClosure.abort_metadata:type_name -> flyteidl.admin.AbortMetadata
-	25, // 15: flyteidl.admin.ExecutionClosure.output_data:type_name -> flyteidl.core.LiteralMap
-	25, // 16: flyteidl.admin.ExecutionClosure.computed_inputs:type_name -> flyteidl.core.LiteralMap
-	28, // 17: flyteidl.admin.ExecutionClosure.phase:type_name -> flyteidl.core.WorkflowExecution.Phase
-	29, // 18: flyteidl.admin.ExecutionClosure.started_at:type_name -> google.protobuf.Timestamp
-	30, // 19: flyteidl.admin.ExecutionClosure.duration:type_name -> google.protobuf.Duration
-	29, // 20: flyteidl.admin.ExecutionClosure.created_at:type_name -> google.protobuf.Timestamp
-	29, // 21: flyteidl.admin.ExecutionClosure.updated_at:type_name -> google.protobuf.Timestamp
-	31, // 22: flyteidl.admin.ExecutionClosure.notifications:type_name -> flyteidl.admin.Notification
-	32, // 23: flyteidl.admin.ExecutionClosure.workflow_id:type_name -> flyteidl.core.Identifier
-	21, // 24: flyteidl.admin.ExecutionClosure.state_change_details:type_name -> flyteidl.admin.ExecutionStateChangeDetails
+	16, // 0: flyteidl.admin.ExecutionCreateRequest.spec:type_name -> flyteidl.admin.ExecutionSpec
+	31, // 1: flyteidl.admin.ExecutionCreateRequest.inputs:type_name -> flyteidl.core.LiteralMap
+	32, // 2: flyteidl.admin.ExecutionRelaunchRequest.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
+	32, // 3: flyteidl.admin.ExecutionRecoverRequest.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
+	14, // 4: flyteidl.admin.ExecutionRecoverRequest.metadata:type_name -> flyteidl.admin.ExecutionMetadata
+	32, // 5: flyteidl.admin.ExecutionCreateResponse.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
+	32, // 6: flyteidl.admin.WorkflowExecutionGetRequest.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
+	32, // 7: flyteidl.admin.Execution.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
+	16, // 8: flyteidl.admin.Execution.spec:type_name -> flyteidl.admin.ExecutionSpec
+	12, // 9: flyteidl.admin.Execution.closure:type_name -> flyteidl.admin.ExecutionClosure
+	8,  // 10: flyteidl.admin.ExecutionList.executions:type_name -> flyteidl.admin.Execution
+	31, // 11: flyteidl.admin.LiteralMapBlob.values:type_name -> flyteidl.core.LiteralMap
+	10, // 12: flyteidl.admin.ExecutionClosure.outputs:type_name -> flyteidl.admin.LiteralMapBlob
+	33, // 13: flyteidl.admin.ExecutionClosure.error:type_name -> flyteidl.core.ExecutionError
+	11, // 14: flyteidl.admin.ExecutionClosure.abort_metadata:type_name -> flyteidl.admin.AbortMetadata
+	31, // 15: flyteidl.admin.ExecutionClosure.output_data:type_name -> flyteidl.core.LiteralMap
+	31, // 16: flyteidl.admin.ExecutionClosure.computed_inputs:type_name -> flyteidl.core.LiteralMap
+	34, // 17: flyteidl.admin.ExecutionClosure.phase:type_name -> flyteidl.core.WorkflowExecution.Phase
+	35, // 18: flyteidl.admin.ExecutionClosure.started_at:type_name -> google.protobuf.Timestamp
+	36, // 19: flyteidl.admin.ExecutionClosure.duration:type_name -> google.protobuf.Duration
+	35, // 20: flyteidl.admin.ExecutionClosure.created_at:type_name -> google.protobuf.Timestamp
+	35, // 21: flyteidl.admin.ExecutionClosure.updated_at:type_name -> google.protobuf.Timestamp
+	37, // 22: flyteidl.admin.ExecutionClosure.notifications:type_name -> flyteidl.admin.Notification
+	38, // 23: flyteidl.admin.ExecutionClosure.workflow_id:type_name -> flyteidl.core.Identifier
+	22, // 24: flyteidl.admin.ExecutionClosure.state_change_details:type_name -> flyteidl.admin.ExecutionStateChangeDetails
 	1,  // 25: flyteidl.admin.ExecutionMetadata.mode:type_name -> flyteidl.admin.ExecutionMetadata.ExecutionMode
-	29, // 26: flyteidl.admin.ExecutionMetadata.scheduled_at:type_name -> google.protobuf.Timestamp
-	33, // 27: flyteidl.admin.ExecutionMetadata.parent_node_execution:type_name -> flyteidl.core.NodeExecutionIdentifier
-	26, // 28: flyteidl.admin.ExecutionMetadata.reference_execution:type_name -> flyteidl.core.WorkflowExecutionIdentifier
-	12, // 29: flyteidl.admin.ExecutionMetadata.system_metadata:type_name -> flyteidl.admin.SystemMetadata
-	34, // 30: flyteidl.admin.ExecutionMetadata.artifact_ids:type_name -> flyteidl.core.ArtifactID
-	31, // 31: flyteidl.admin.NotificationList.notifications:type_name -> flyteidl.admin.Notification
-	32, // 32: flyteidl.admin.ExecutionSpec.launch_plan:type_name -> flyteidl.core.Identifier
-	25, // 33: flyteidl.admin.ExecutionSpec.inputs:type_name -> flyteidl.core.LiteralMap
-	13, // 34: flyteidl.admin.ExecutionSpec.metadata:type_name -> flyteidl.admin.ExecutionMetadata
-	14, // 35: flyteidl.admin.ExecutionSpec.notifications:type_name -> flyteidl.admin.NotificationList
-	35, // 36: flyteidl.admin.ExecutionSpec.labels:type_name -> flyteidl.admin.Labels
-	36, // 37: flyteidl.admin.ExecutionSpec.annotations:type_name -> flyteidl.admin.Annotations
-	37, // 38: flyteidl.admin.ExecutionSpec.security_context:type_name -> flyteidl.core.SecurityContext
-	38, // 39: flyteidl.admin.ExecutionSpec.auth_role:type_name -> flyteidl.admin.AuthRole
-	39, // 40: flyteidl.admin.ExecutionSpec.quality_of_service:type_name -> flyteidl.core.QualityOfService
-	40, // 41: flyteidl.admin.ExecutionSpec.raw_output_data_config:type_name -> flyteidl.admin.RawOutputDataConfig
-	41, // 42: flyteidl.admin.ExecutionSpec.cluster_assignment:type_name -> flyteidl.admin.ClusterAssignment
-	42, // 43: flyteidl.admin.ExecutionSpec.interruptible:type_name -> google.protobuf.BoolValue
-	43, // 44: flyteidl.admin.ExecutionSpec.envs:type_name -> flyteidl.admin.Envs
-	44, // 45: flyteidl.admin.ExecutionSpec.execution_cluster_label:type_name -> flyteidl.admin.ExecutionClusterLabel
-	45, // 46: flyteidl.admin.ExecutionSpec.execution_env_assignments:type_name -> flyteidl.core.ExecutionEnvAssignment
-	26, // 47: flyteidl.admin.ExecutionTerminateRequest.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
-	26, // 48: flyteidl.admin.WorkflowExecutionGetDataRequest.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
-	46, // 49: flyteidl.admin.WorkflowExecutionGetDataResponse.outputs:type_name -> flyteidl.admin.UrlBlob
-	46, // 50: flyteidl.admin.WorkflowExecutionGetDataResponse.inputs:type_name -> flyteidl.admin.UrlBlob
-	25, // 51: flyteidl.admin.WorkflowExecutionGetDataResponse.full_inputs:type_name -> flyteidl.core.LiteralMap
-	25, // 52: flyteidl.admin.WorkflowExecutionGetDataResponse.full_outputs:type_name -> flyteidl.core.LiteralMap
-	26, // 53: flyteidl.admin.ExecutionUpdateRequest.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
+	35, // 26: flyteidl.admin.ExecutionMetadata.scheduled_at:type_name -> google.protobuf.Timestamp
+	39, // 27: flyteidl.admin.ExecutionMetadata.parent_node_execution:type_name -> flyteidl.core.NodeExecutionIdentifier
+	32, // 28: flyteidl.admin.ExecutionMetadata.reference_execution:type_name -> flyteidl.core.WorkflowExecutionIdentifier
+	13, // 29: flyteidl.admin.ExecutionMetadata.system_metadata:type_name -> flyteidl.admin.SystemMetadata
+	40, // 30: flyteidl.admin.ExecutionMetadata.artifact_ids:type_name -> flyteidl.core.ArtifactID
+	37, // 31: flyteidl.admin.NotificationList.notifications:type_name -> flyteidl.admin.Notification
+	38, // 32: flyteidl.admin.ExecutionSpec.launch_plan:type_name -> flyteidl.core.Identifier
+	31, // 33: flyteidl.admin.ExecutionSpec.inputs:type_name -> flyteidl.core.LiteralMap
+	14, // 34: flyteidl.admin.ExecutionSpec.metadata:type_name -> flyteidl.admin.ExecutionMetadata
+	15, // 35: flyteidl.admin.ExecutionSpec.notifications:type_name -> flyteidl.admin.NotificationList
+	41, // 36: flyteidl.admin.ExecutionSpec.labels:type_name -> flyteidl.admin.Labels
+	42, // 37: flyteidl.admin.ExecutionSpec.annotations:type_name -> flyteidl.admin.Annotations
+	43, // 38: flyteidl.admin.ExecutionSpec.security_context:type_name -> flyteidl.core.SecurityContext
+	44, // 39: flyteidl.admin.ExecutionSpec.auth_role:type_name -> flyteidl.admin.AuthRole
+	45, // 40: flyteidl.admin.ExecutionSpec.quality_of_service:type_name -> flyteidl.core.QualityOfService
+	46, // 41: flyteidl.admin.ExecutionSpec.raw_output_data_config:type_name -> flyteidl.admin.RawOutputDataConfig
+	47, // 42: flyteidl.admin.ExecutionSpec.cluster_assignment:type_name -> flyteidl.admin.ClusterAssignment
+	48, // 43: flyteidl.admin.ExecutionSpec.interruptible:type_name -> google.protobuf.BoolValue
+	49, // 44: flyteidl.admin.ExecutionSpec.envs:type_name -> flyteidl.admin.Envs
+	50, // 45: flyteidl.admin.ExecutionSpec.execution_cluster_label:type_name -> flyteidl.admin.ExecutionClusterLabel
+	51, // 46: flyteidl.admin.ExecutionSpec.execution_env_assignments:type_name -> flyteidl.core.ExecutionEnvAssignment
+	32, // 47: flyteidl.admin.ExecutionTerminateRequest.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
+	32, // 48: flyteidl.admin.WorkflowExecutionGetDataRequest.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
+	52, // 49: flyteidl.admin.WorkflowExecutionGetDataResponse.outputs:type_name -> flyteidl.admin.UrlBlob
+	52, // 50: flyteidl.admin.WorkflowExecutionGetDataResponse.inputs:type_name -> flyteidl.admin.UrlBlob
+	31, // 51: flyteidl.admin.WorkflowExecutionGetDataResponse.full_inputs:type_name -> flyteidl.core.LiteralMap
+	31, // 52: flyteidl.admin.WorkflowExecutionGetDataResponse.full_outputs:type_name -> flyteidl.core.LiteralMap
+	32, // 53: flyteidl.admin.ExecutionUpdateRequest.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
 	0,  // 54: flyteidl.admin.ExecutionUpdateRequest.state:type_name -> flyteidl.admin.ExecutionState
 	0,  // 55: flyteidl.admin.ExecutionStateChangeDetails.state:type_name -> flyteidl.admin.ExecutionState
-	29, // 56: flyteidl.admin.ExecutionStateChangeDetails.occurred_at:type_name -> google.protobuf.Timestamp
-	26, // 57: flyteidl.admin.WorkflowExecutionGetMetricsRequest.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
-	47, // 58: flyteidl.admin.WorkflowExecutionGetMetricsResponse.span:type_name -> flyteidl.core.Span
-	59, // [59:59] is the sub-list for method output_type
-	59, // [59:59] is the sub-list for method input_type
-	59, // [59:59] is the sub-list for extension type_name
-	59, // [59:59] is the sub-list for extension extendee
-	0,  // [0:59] is the sub-list for field type_name
+	35, // 56: flyteidl.admin.ExecutionStateChangeDetails.occurred_at:type_name -> google.protobuf.Timestamp
+	32, // 57: flyteidl.admin.WorkflowExecutionGetMetricsRequest.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
+	53, // 58: flyteidl.admin.WorkflowExecutionGetMetricsResponse.span:type_name -> flyteidl.core.Span
+	32, // 59: flyteidl.admin.WorkflowExecutionGetAnalysisRequest.id:type_name -> flyteidl.core.WorkflowExecutionIdentifier
+	2,  // 60: flyteidl.admin.ActivityDuration.activity:type_name -> flyteidl.admin.ActivityDuration.Activity
+	36, // 61: flyteidl.admin.ActivityDuration.duration:type_name -> google.protobuf.Duration
+	35, // 62: flyteidl.admin.CriticalPathSegment.start_time:type_name -> google.protobuf.Timestamp
+	35, // 63: flyteidl.admin.CriticalPathSegment.end_time:type_name -> google.protobuf.Timestamp
+	2,  // 64: flyteidl.admin.CriticalPathSegment.activity:type_name -> flyteidl.admin.ActivityDuration.Activity
+	36, // 65: flyteidl.admin.CriticalPathNode.duration:type_name -> google.protobuf.Duration
+	27, // 66: flyteidl.admin.CriticalPathNode.breakdown:type_name -> flyteidl.admin.ActivityDuration
+	36, // 67: flyteidl.admin.WorkflowExecutionGetAnalysisResponse.duration:type_name -> google.protobuf.Duration
+	27, // 68: flyteidl.admin.WorkflowExecutionGetAnalysisResponse.breakdown:type_name -> flyteidl.admin.ActivityDuration
+	28, // 69: flyteidl.admin.WorkflowExecutionGetAnalysisResponse.critical_path:type_name -> flyteidl.admin.CriticalPathSegment
+	29, // 70: flyteidl.admin.WorkflowExecutionGetAnalysisResponse.bottlenecks:type_name -> flyteidl.admin.CriticalPathNode
+	71, // [71:71] is the sub-list for method output_type
+	71, // [71:71] is the sub-list for method input_type
+	71, // [71:71] is the sub-list for extension type_name
+	71, // [71:71] is the sub-list for extension extendee
+	0,  // [0:71] is the sub-list for field type_name
 }
 
 func init() { file_flyteidl_admin_execution_proto_init() }
@@ -2768,6 +3296,66 @@ func file_flyteidl_admin_execution_proto_init() {
 				return nil
 			}
 		}
+		file_flyteidl_admin_execution_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkflowExecutionGetAnalysisRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flyteidl_admin_execution_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivityDuration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flyteidl_admin_execution_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CriticalPathSegment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flyteidl_admin_execution_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CriticalPathNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flyteidl_admin_execution_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkflowExecutionGetAnalysisResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_flyteidl_admin_execution_proto_msgTypes[7].OneofWrappers = []interface{}{
 		(*LiteralMapBlob_Values)(nil),
@@ -2789,8 +3377,8 @@ func file_flyteidl_admin_execution_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_flyteidl_admin_execution_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   23,
+			NumEnums:      3,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -43,6 +43,8 @@ const (
 	MatchableResource_WORKFLOW_EXECUTION_CONFIG MatchableResource = 6
 	// Controls how to select an available cluster on which this execution should run.
 	MatchableResource_CLUSTER_ASSIGNMENT MatchableResource = 7
+	// Limits the concurrent executions and running task pods of a project and domain.
+	MatchableResource_PROJECT_QUOTA MatchableResource = 8
 )
 
 // Enum value maps for MatchableResource.
@@ -56,6 +58,7 @@ var (
 		5: "PLUGIN_OVERRIDE",
 		6: "WORKFLOW_EXECUTION_CONFIG",
 		7: "CLUSTER_ASSIGNMENT",
+		8: "PROJECT_QUOTA",
 	}
 	MatchableResource_value = map[string]int32{
 		"TASK_RESOURCE":                    0,
@@ -66,6 +69,7 @@ var (
 		"PLUGIN_OVERRIDE":                  5,
 		"WORKFLOW_EXECUTION_CONFIG":        6,
 		"CLUSTER_ASSIGNMENT":               7,
+		"PROJECT_QUOTA":                    8,
 	}
 )
 
@@ -144,6 +148,55 @@ func (PluginOverride_MissingPluginBehavior) EnumDescriptor() ([]byte, []int) {
 	return file_flyteidl_admin_matchable_resource_proto_rawDescGZIP(), []int{5, 0}
 }
 
+type ProjectQuotaAttributes_OverflowBehavior int32
+
+const (
+	// Executions created while at the execution limit are rejected.
+	ProjectQuotaAttributes_REJECT ProjectQuotaAttributes_OverflowBehavior = 0
+	// Executions created while at the execution limit are queued until the project and domain has capacity.
+	// Single task executions are always rejected.
+	ProjectQuotaAttributes_QUEUE ProjectQuotaAttributes_OverflowBehavior = 1
+)
+
+// Enum value maps for ProjectQuotaAttributes_OverflowBehavior.
+var (
+	ProjectQuotaAttributes_OverflowBehavior_name = map[int32]string{
+		0: "REJECT",
+		1: "QUEUE",
+	}
+	ProjectQuotaAttributes_OverflowBehavior_value = map[string]int32{
+		"REJECT": 0,
+		"QUEUE":  1,
+	}
+)
+
+func (x ProjectQuotaAttributes_OverflowBehavior) Enum() *ProjectQuotaAttributes_OverflowBehavior {
+	p := new(ProjectQuotaAttributes_OverflowBehavior)
+	*p = x
+	return p
+}
+
+func (x ProjectQuotaAttributes_OverflowBehavior) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProjectQuotaAttributes_OverflowBehavior) Descriptor() protoreflect.EnumDescriptor {
+	return file_flyteidl_admin_matchable_resource_proto_enumTypes[2].Descriptor()
+}
+
+func (ProjectQuotaAttributes_OverflowBehavior) Type() protoreflect.EnumType {
+	return &file_flyteidl_admin_matchable_resource_proto_enumTypes[2]
+}
+
+func (x ProjectQuotaAttributes_OverflowBehavior) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProjectQuotaAttributes_OverflowBehavior.Descriptor instead.
+func (ProjectQuotaAttributes_OverflowBehavior) EnumDescriptor() ([]byte, []int) {
+	return file_flyteidl_admin_matchable_resource_proto_rawDescGZIP(), []int{8, 0}
+}
+
 // Defines a set of overridable task resource attributes set during task registration.
 type TaskResourceSpec struct {
 	state         protoimpl.MessageState
@@ -668,6 +721,92 @@ func (x *WorkflowExecutionConfig) GetExecutionEnvAssignments() []*core.Execution
 	return nil
 }
 
+// Limits the executions and task pods of a project and domain. Zero and empty limits are not enforced.
+// Limits are enforced on a best-effort basis, executions and tasks created concurrently may briefly exceed them.
+type ProjectQuotaAttributes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of executions of the project and domain which have not terminated.
+	MaxConcurrentExecutions int32 `protobuf:"varint,1,opt,name=max_concurrent_executions,json=maxConcurrentExecutions,proto3" json:"max_concurrent_executions,omitempty"`
+	// The maximum number of task pods of the project and domain running at the same time.
+	MaxRunningTasks int32 `protobuf:"varint,2,opt,name=max_running_tasks,json=maxRunningTasks,proto3" json:"max_running_tasks,omitempty"`
+	// The maximum total CPU requested by the running task pods, as a Kubernetes quantity, e.g. "64" or "500m".
+	MaxCpu string `protobuf:"bytes,3,opt,name=max_cpu,json=maxCpu,proto3" json:"max_cpu,omitempty"`
+	// The maximum total GPU requested by the running task pods, as a Kubernetes quantity.
+	MaxGpu string `protobuf:"bytes,4,opt,name=max_gpu,json=maxGpu,proto3" json:"max_gpu,omitempty"`
+	// Defines what happens to executions created while at the execution limit.
+	Overflow ProjectQuotaAttributes_OverflowBehavior `protobuf:"varint,5,opt,name=overflow,proto3,enum=flyteidl.admin.ProjectQuotaAttributes_OverflowBehavior" json:"overflow,omitempty"`
+}
+
+func (x *ProjectQuotaAttributes) Reset() {
+	*x = ProjectQuotaAttributes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flyteidl_admin_matchable_resource_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectQuotaAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectQuotaAttributes) ProtoMessage() {}
+
+func (x *ProjectQuotaAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_flyteidl_admin_matchable_resource_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectQuotaAttributes.ProtoReflect.Descriptor instead.
+func (*ProjectQuotaAttributes) Descriptor() ([]byte, []int) {
+	return file_flyteidl_admin_matchable_resource_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProjectQuotaAttributes) GetMaxConcurrentExecutions() int32 {
+	if x != nil {
+		return x.MaxConcurrentExecutions
+	}
+	return 0
+}
+
+func (x *ProjectQuotaAttributes) GetMaxRunningTasks() int32 {
+	if x != nil {
+		return x.MaxRunningTasks
+	}
+	return 0
+}
+
+func (x *ProjectQuotaAttributes) GetMaxCpu() string {
+	if x != nil {
+		return x.MaxCpu
+	}
+	return ""
+}
+
+func (x *ProjectQuotaAttributes) GetMaxGpu() string {
+	if x != nil {
+		return x.MaxGpu
+	}
+	return ""
+}
+
+func (x *ProjectQuotaAttributes) GetOverflow() ProjectQuotaAttributes_OverflowBehavior {
+	if x != nil {
+		return x.Overflow
+	}
+	return ProjectQuotaAttributes_REJECT
+}
+
 // Generic container for encapsulating all types of the above attributes messages.
 type MatchingAttributes struct {
 	state         protoimpl.MessageState
@@ -684,13 +823,14 @@ type MatchingAttributes struct {
 	//	*MatchingAttributes_PluginOverrides
 	//	*MatchingAttributes_WorkflowExecutionConfig
 	//	*MatchingAttributes_ClusterAssignment
+	//	*MatchingAttributes_ProjectQuotaAttributes
 	Target isMatchingAttributes_Target `protobuf_oneof:"target"`
 }
 
 func (x *MatchingAttributes) Reset() {
 	*x = MatchingAttributes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flyteidl_admin_matchable_resource_proto_msgTypes[8]
+		mi := &file_flyteidl_admin_matchable_resource_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -703,7 +843,7 @@ func (x *MatchingAttributes) String() string {
 func (*MatchingAttributes) ProtoMessage() {}
 
 func (x *MatchingAttributes) ProtoReflect() protoreflect.Message {
-	mi := &file_flyteidl_admin_matchable_resource_proto_msgTypes[8]
+	mi := &file_flyteidl_admin_matchable_resource_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -716,7 +856,7 @@ func (x *MatchingAttributes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchingAttributes.ProtoReflect.Descriptor instead.
 func (*MatchingAttributes) Descriptor() ([]byte, []int) {
-	return file_flyteidl_admin_matchable_resource_proto_rawDescGZIP(), []int{8}
+	return file_flyteidl_admin_matchable_resource_proto_rawDescGZIP(), []int{9}
 }
 
 func (m *MatchingAttributes) GetTarget() isMatchingAttributes_Target {
@@ -782,6 +922,13 @@ func (x *MatchingAttributes) GetClusterAssignment() *ClusterAssignment {
 	return nil
 }
 
+func (x *MatchingAttributes) GetProjectQuotaAttributes() *ProjectQuotaAttributes {
+	if x, ok := x.GetTarget().(*MatchingAttributes_ProjectQuotaAttributes); ok {
+		return x.ProjectQuotaAttributes
+	}
+	return nil
+}
+
 type isMatchingAttributes_Target interface {
 	isMatchingAttributes_Target()
 }
@@ -818,6 +965,10 @@ type MatchingAttributes_ClusterAssignment struct {
 	ClusterAssignment *ClusterAssignment `protobuf:"bytes,8,opt,name=cluster_assignment,json=clusterAssignment,proto3,oneof"`
 }
 
+type MatchingAttributes_ProjectQuotaAttributes struct {
+	ProjectQuotaAttributes *ProjectQuotaAttributes `protobuf:"bytes,9,opt,name=project_quota_attributes,json=projectQuotaAttributes,proto3,oneof"`
+}
+
 func (*MatchingAttributes_TaskResourceAttributes) isMatchingAttributes_Target() {}
 
 func (*MatchingAttributes_ClusterResourceAttributes) isMatchingAttributes_Target() {}
@@ -834,6 +985,8 @@ func (*MatchingAttributes_WorkflowExecutionConfig) isMatchingAttributes_Target()
 
 func (*MatchingAttributes_ClusterAssignment) isMatchingAttributes_Target() {}
 
+func (*MatchingAttributes_ProjectQuotaAttributes) isMatchingAttributes_Target() {}
+
 // Represents a custom set of attributes applied for either a domain (and optional org); a domain and project (and optional org);
 // or domain, project and workflow name (and optional org).
 // These are used to override system level defaults for kubernetes cluster resource management,
@@ -855,7 +1008,7 @@ type MatchableAttributesConfiguration struct {
 func (x *MatchableAttributesConfiguration) Reset() {
 	*x = MatchableAttributesConfiguration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flyteidl_admin_matchable_resource_proto_msgTypes[9]
+		mi := &file_flyteidl_admin_matchable_resource_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -868,7 +1021,7 @@ func (x *MatchableAttributesConfiguration) String() string {
 func (*MatchableAttributesConfiguration) ProtoMessage() {}
 
 func (x *MatchableAttributesConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_flyteidl_admin_matchable_resource_proto_msgTypes[9]
+	mi := &file_flyteidl_admin_matchable_resource_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -881,7 +1034,7 @@ func (x *MatchableAttributesConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchableAttributesConfiguration.ProtoReflect.Descriptor instead.
 func (*MatchableAttributesConfiguration) Descriptor() ([]byte, []int) {
-	return file_flyteidl_admin_matchable_resource_proto_rawDescGZIP(), []int{9}
+	return file_flyteidl_admin_matchable_resource_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *MatchableAttributesConfiguration) GetAttributes() *MatchingAttributes {
@@ -942,7 +1095,7 @@ type ListMatchableAttributesRequest struct {
 func (x *ListMatchableAttributesRequest) Reset() {
 	*x = ListMatchableAttributesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flyteidl_admin_matchable_resource_proto_msgTypes[10]
+		mi := &file_flyteidl_admin_matchable_resource_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -955,7 +1108,7 @@ func (x *ListMatchableAttributesRequest) String() string {
 func (*ListMatchableAttributesRequest) ProtoMessage() {}
 
 func (x *ListMatchableAttributesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_flyteidl_admin_matchable_resource_proto_msgTypes[10]
+	mi := &file_flyteidl_admin_matchable_resource_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -968,7 +1121,7 @@ func (x *ListMatchableAttributesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMatchableAttributesRequest.ProtoReflect.Descriptor instead.
 func (*ListMatchableAttributesRequest) Descriptor() ([]byte, []int) {
-	return file_flyteidl_admin_matchable_resource_proto_rawDescGZIP(), []int{10}
+	return file_flyteidl_admin_matchable_resource_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListMatchableAttributesRequest) GetResourceType() MatchableResource {
@@ -998,7 +1151,7 @@ type ListMatchableAttributesResponse struct {
 func (x *ListMatchableAttributesResponse) Reset() {
 	*x = ListMatchableAttributesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_flyteidl_admin_matchable_resource_proto_msgTypes[11]
+		mi := &file_flyteidl_admin_matchable_resource_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1011,7 +1164,7 @@ func (x *ListMatchableAttributesResponse) String() string {
 func (*ListMatchableAttributesResponse) ProtoMessage() {}
 
 func (x *ListMatchableAttributesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_flyteidl_admin_matchable_resource_proto_msgTypes[11]
+	mi := &file_flyteidl_admin_matchable_resource_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1024,7 +1177,7 @@ func (x *ListMatchableAttributesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMatchableAttributesResponse.ProtoReflect.Descriptor instead.
 func (*ListMatchableAttributesResponse) Descriptor() ([]byte, []int) {
-	return file_flyteidl_admin_matchable_resource_proto_rawDescGZIP(), []int{11}
+	return file_flyteidl_admin_matchable_resource_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListMatchableAttributesResponse) GetConfigurations() []*MatchableAttributesConfiguration {
@@ -1146,113 +1299,140 @@ var file_flyteidl_admin_matchable_resource_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x65, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x76,
 	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x17, 0x65, 0x78, 0x65, 0x63,
 	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x76, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x22, 0x94, 0x06, 0x0a, 0x12, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x62, 0x0a, 0x18, 0x74, 0x61,
-	0x73, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66,
-	0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x54, 0x61,
-	0x73, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x73, 0x48, 0x00, 0x52, 0x16, 0x74, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x6b,
-	0x0a, 0x1b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x48, 0x00,
-	0x52, 0x19, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x68, 0x0a, 0x1a, 0x65,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x61,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x48, 0x00, 0x52, 0x18, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x5f, 0x0a, 0x17, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64,
-	0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x48, 0x00, 0x52,
-	0x15, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x4f, 0x0a, 0x12, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74,
-	0x79, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x10, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x4f, 0x66,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
-	0x65, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4f, 0x76, 0x65, 0x72,
-	0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x65, 0x0a, 0x19, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
-	0x77, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65,
-	0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
-	0x6f, 0x77, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x48, 0x00, 0x52, 0x17, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x52, 0x0a, 0x12,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0xb2, 0x02, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x3a,
+	0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x70,
+	0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x43, 0x70, 0x75, 0x12,
+	0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x70, 0x75, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x61, 0x78, 0x47, 0x70, 0x75, 0x12, 0x53, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72,
+	0x66, 0x6c, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x66, 0x6c, 0x79,
+	0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x42, 0x65, 0x68, 0x61, 0x76,
+	0x69, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x22, 0x29, 0x0a,
+	0x10, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f,
+	0x72, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a,
+	0x05, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0x01, 0x22, 0xf8, 0x06, 0x0a, 0x12, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12,
+	0x62, 0x0a, 0x18, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x48, 0x00, 0x52, 0x16, 0x74, 0x61, 0x73,
+	0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x12, 0x6b, 0x0a, 0x1b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65,
 	0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x11, 0x63,
+	0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x48, 0x00, 0x52, 0x19, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x12, 0x68, 0x0a, 0x1a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x48, 0x00,
+	0x52, 0x18, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x5f, 0x0a, 0x17, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x66, 0x6c,
+	0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x48, 0x00, 0x52, 0x15, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x4f, 0x0a, 0x12, 0x71,
+	0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69,
+	0x64, 0x6c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x4f,
+	0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x10, 0x71, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x10,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64,
+	0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x65, 0x0a, 0x19, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x17, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x52, 0x0a, 0x12, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43,
 	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0xe7, 0x01, 0x0a, 0x20, 0x4d,
-	0x61, 0x74, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x42, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
-	0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
-	0x77, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x5f, 0x70, 0x6c, 0x61, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x50, 0x6c,
-	0x61, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x72, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6f, 0x72, 0x67, 0x22, 0x7a, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e,
-	0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d,
-	0x61, 0x74, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x6f, 0x72, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x72, 0x67,
-	0x22, 0x7b, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x61, 0x62, 0x6c,
-	0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x66, 0x6c,
-	0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x61, 0x74,
-	0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0xe0, 0x01,
-	0x0a, 0x11, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x52, 0x45, 0x53, 0x4f,
-	0x55, 0x52, 0x43, 0x45, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45,
-	0x52, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
-	0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10,
-	0x02, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43,
-	0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x10, 0x03, 0x12, 0x24,
-	0x0a, 0x20, 0x51, 0x55, 0x41, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x45, 0x52,
-	0x56, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x4f,
-	0x56, 0x45, 0x52, 0x52, 0x49, 0x44, 0x45, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x57, 0x4f, 0x52,
-	0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4c, 0x55, 0x53,
-	0x54, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x07,
-	0x42, 0xc2, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64,
-	0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x16, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6c,
-	0x79, 0x74, 0x65, 0x6f, 0x72, 0x67, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x2f, 0x66, 0x6c, 0x79,
-	0x74, 0x65, 0x69, 0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x62, 0x2d, 0x67, 0x6f, 0x2f,
-	0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0xa2, 0x02,
-	0x03, 0x46, 0x41, 0x58, 0xaa, 0x02, 0x0e, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0xca, 0x02, 0x0e, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c,
-	0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0xe2, 0x02, 0x1a, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64,
-	0x6c, 0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x3a, 0x3a,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x48, 0x00, 0x52, 0x11, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x62, 0x0a, 0x18, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69,
+	0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x48,
+	0x00, 0x52, 0x16, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x22, 0xe7, 0x01, 0x0a, 0x20, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x61, 0x62, 0x6c,
+	0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66,
+	0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61,
+	0x75, 0x6e, 0x63, 0x68, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6f,
+	0x72, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x72, 0x67, 0x22, 0x7a, 0x0a,
+	0x1e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x46, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64,
+	0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x72, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x72, 0x67, 0x22, 0x7b, 0x0a, 0x1f, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0xf3, 0x01, 0x0a, 0x11, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x11, 0x0a, 0x0d,
+	0x54, 0x41, 0x53, 0x4b, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x00, 0x12,
+	0x14, 0x0a, 0x10, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x58,
+	0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f,
+	0x4c, 0x41, 0x42, 0x45, 0x4c, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x51, 0x55, 0x41, 0x4c, 0x49,
+	0x54, 0x59, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x13, 0x0a,
+	0x0f, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x52, 0x49, 0x44, 0x45,
+	0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x45,
+	0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10,
+	0x06, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x53,
+	0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f,
+	0x4a, 0x45, 0x43, 0x54, 0x5f, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x10, 0x08, 0x42, 0xc2, 0x01, 0x0a,
+	0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x42, 0x16, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x6f,
+	0x72, 0x67, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64,
+	0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x62, 0x2d, 0x67, 0x6f, 0x2f, 0x66, 0x6c, 0x79, 0x74,
+	0x65, 0x69, 0x64, 0x6c, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0xa2, 0x02, 0x03, 0x46, 0x41, 0x58,
+	0xaa, 0x02, 0x0e, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0xca, 0x02, 0x0e, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x5c, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0xe2, 0x02, 0x1a, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x5c, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x0f, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x3a, 0x3a, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1267,63 +1447,67 @@ func file_flyteidl_admin_matchable_resource_proto_rawDescGZIP() []byte {
 	return file_flyteidl_admin_matchable_resource_proto_rawDescData
 }
 
-var file_flyteidl_admin_matchable_resource_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_flyteidl_admin_matchable_resource_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_flyteidl_admin_matchable_resource_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_flyteidl_admin_matchable_resource_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_flyteidl_admin_matchable_resource_proto_goTypes = []interface{}{
-	(MatchableResource)(0),                    // 0: flyteidl.admin.MatchableResource
-	(PluginOverride_MissingPluginBehavior)(0), // 1: flyteidl.admin.PluginOverride.MissingPluginBehavior
-	(*TaskResourceSpec)(nil),                  // 2: flyteidl.admin.TaskResourceSpec
-	(*TaskResourceAttributes)(nil),            // 3: flyteidl.admin.TaskResourceAttributes
-	(*ClusterResourceAttributes)(nil),         // 4: flyteidl.admin.ClusterResourceAttributes
-	(*ExecutionQueueAttributes)(nil),          // 5: flyteidl.admin.ExecutionQueueAttributes
-	(*ExecutionClusterLabel)(nil),             // 6: flyteidl.admin.ExecutionClusterLabel
-	(*PluginOverride)(nil),                    // 7: flyteidl.admin.PluginOverride
-	(*PluginOverrides)(nil),                   // 8: flyteidl.admin.PluginOverrides
-	(*WorkflowExecutionConfig)(nil),           // 9: flyteidl.admin.WorkflowExecutionConfig
-	(*MatchingAttributes)(nil),                // 10: flyteidl.admin.MatchingAttributes
-	(*MatchableAttributesConfiguration)(nil),  // 11: flyteidl.admin.MatchableAttributesConfiguration
-	(*ListMatchableAttributesRequest)(nil),    // 12: flyteidl.admin.ListMatchableAttributesRequest
-	(*ListMatchableAttributesResponse)(nil),   // 13: flyteidl.admin.ListMatchableAttributesResponse
-	nil,                                       // 14: flyteidl.admin.ClusterResourceAttributes.AttributesEntry
-	(*core.SecurityContext)(nil),              // 15: flyteidl.core.SecurityContext
-	(*RawOutputDataConfig)(nil),               // 16: flyteidl.admin.RawOutputDataConfig
-	(*Labels)(nil),                            // 17: flyteidl.admin.Labels
-	(*Annotations)(nil),                       // 18: flyteidl.admin.Annotations
-	(*wrapperspb.BoolValue)(nil),              // 19: google.protobuf.BoolValue
-	(*Envs)(nil),                              // 20: flyteidl.admin.Envs
-	(*core.ExecutionEnvAssignment)(nil),       // 21: flyteidl.core.ExecutionEnvAssignment
-	(*core.QualityOfService)(nil),             // 22: flyteidl.core.QualityOfService
-	(*ClusterAssignment)(nil),                 // 23: flyteidl.admin.ClusterAssignment
+	(MatchableResource)(0),                       // 0: flyteidl.admin.MatchableResource
+	(PluginOverride_MissingPluginBehavior)(0),    // 1: flyteidl.admin.PluginOverride.MissingPluginBehavior
+	(ProjectQuotaAttributes_OverflowBehavior)(0), // 2: flyteidl.admin.ProjectQuotaAttributes.OverflowBehavior
+	(*TaskResourceSpec)(nil),                     // 3: flyteidl.admin.TaskResourceSpec
+	(*TaskResourceAttributes)(nil),               // 4: flyteidl.admin.TaskResourceAttributes
+	(*ClusterResourceAttributes)(nil),            // 5: flyteidl.admin.ClusterResourceAttributes
+	(*ExecutionQueueAttributes)(nil),             // 6: flyteidl.admin.ExecutionQueueAttributes
+	(*ExecutionClusterLabel)(nil),                // 7: flyteidl.admin.ExecutionClusterLabel
+	(*PluginOverride)(nil),                       // 8: flyteidl.admin.PluginOverride
+	(*PluginOverrides)(nil),                      // 9: flyteidl.admin.PluginOverrides
+	(*WorkflowExecutionConfig)(nil),              // 10: flyteidl.admin.WorkflowExecutionConfig
+	(*ProjectQuotaAttributes)(nil),               // 11: flyteidl.admin.ProjectQuotaAttributes
+	(*MatchingAttributes)(nil),                   // 12: flyteidl.admin.MatchingAttributes
+	(*MatchableAttributesConfiguration)(nil),     // 13: flyteidl.admin.MatchableAttributesConfiguration
+	(*ListMatchableAttributesRequest)(nil),       // 14: flyteidl.admin.ListMatchableAttributesRequest
+	(*ListMatchableAttributesResponse)(nil),      // 15: flyteidl.admin.ListMatchableAttributesResponse
+	nil,                                          // 16: flyteidl.admin.ClusterResourceAttributes.AttributesEntry
+	(*core.SecurityContext)(nil),                 // 17: flyteidl.core.SecurityContext
+	(*RawOutputDataConfig)(nil),                  // 18: flyteidl.admin.RawOutputDataConfig
+	(*Labels)(nil),                               // 19: flyteidl.admin.Labels
+	(*Annotations)(nil),                          // 20: flyteidl.admin.Annotations
+	(*wrapperspb.BoolValue)(nil),                 // 21: google.protobuf.BoolValue
+	(*Envs)(nil),                                 // 22: flyteidl.admin.Envs
+	(*core.ExecutionEnvAssignment)(nil),          // 23: flyteidl.core.ExecutionEnvAssignment
+	(*core.QualityOfService)(nil),                // 24: flyteidl.core.QualityOfService
+	(*ClusterAssignment)(nil),                    // 25: flyteidl.admin.ClusterAssignment
 }
 var file_flyteidl_admin_matchable_resource_proto_depIdxs = []int32{
-	2,  // 0: flyteidl.admin.TaskResourceAttributes.defaults:type_name -> flyteidl.admin.TaskResourceSpec
-	2,  // 1: flyteidl.admin.TaskResourceAttributes.limits:type_name -> flyteidl.admin.TaskResourceSpec
-	14, // 2: flyteidl.admin.ClusterResourceAttributes.attributes:type_name -> flyteidl.admin.ClusterResourceAttributes.AttributesEntry
+	3,  // 0: flyteidl.admin.TaskResourceAttributes.defaults:type_name -> flyteidl.admin.TaskResourceSpec
+	3,  // 1: flyteidl.admin.TaskResourceAttributes.limits:type_name -> flyteidl.admin.TaskResourceSpec
+	16, // 2: flyteidl.admin.ClusterResourceAttributes.attributes:type_name -> flyteidl.admin.ClusterResourceAttributes.AttributesEntry
 	1,  // 3: flyteidl.admin.PluginOverride.missing_plugin_behavior:type_name -> flyteidl.admin.PluginOverride.MissingPluginBehavior
-	7,  // 4: flyteidl.admin.PluginOverrides.overrides:type_name -> flyteidl.admin.PluginOverride
-	15, // 5: flyteidl.admin.WorkflowExecutionConfig.security_context:type_name -> flyteidl.core.SecurityContext
-	16, // 6: flyteidl.admin.WorkflowExecutionConfig.raw_output_data_config:type_name -> flyteidl.admin.RawOutputDataConfig
-	17, // 7: flyteidl.admin.WorkflowExecutionConfig.labels:type_name -> flyteidl.admin.Labels
-	18, // 8: flyteidl.admin.WorkflowExecutionConfig.annotations:type_name -> flyteidl.admin.Annotations
-	19, // 9: flyteidl.admin.WorkflowExecutionConfig.interruptible:type_name -> google.protobuf.BoolValue
-	20, // 10: flyteidl.admin.WorkflowExecutionConfig.envs:type_name -> flyteidl.admin.Envs
-	21, // 11: flyteidl.admin.WorkflowExecutionConfig.execution_env_assignments:type_name -> flyteidl.core.ExecutionEnvAssignment
-	3,  // 12: flyteidl.admin.MatchingAttributes.task_resource_attributes:type_name -> flyteidl.admin.TaskResourceAttributes
-	4,  // 13: flyteidl.admin.MatchingAttributes.cluster_resource_attributes:type_name -> flyteidl.admin.ClusterResourceAttributes
-	5,  // 14: flyteidl.admin.MatchingAttributes.execution_queue_attributes:type_name -> flyteidl.admin.ExecutionQueueAttributes
-	6,  // 15: flyteidl.admin.MatchingAttributes.execution_cluster_label:type_name -> flyteidl.admin.ExecutionClusterLabel
-	22, // 16: flyteidl.admin.MatchingAttributes.quality_of_service:type_name -> flyteidl.core.QualityOfService
-	8,  // 17: flyteidl.admin.MatchingAttributes.plugin_overrides:type_name -> flyteidl.admin.PluginOverrides
-	9,  // 18: flyteidl.admin.MatchingAttributes.workflow_execution_config:type_name -> flyteidl.admin.WorkflowExecutionConfig
-	23, // 19: flyteidl.admin.MatchingAttributes.cluster_assignment:type_name -> flyteidl.admin.ClusterAssignment
-	10, // 20: flyteidl.admin.MatchableAttributesConfiguration.attributes:type_name -> flyteidl.admin.MatchingAttributes
-	0,  // 21: flyteidl.admin.ListMatchableAttributesRequest.resource_type:type_name -> flyteidl.admin.MatchableResource
-	11, // 22: flyteidl.admin.ListMatchableAttributesResponse.configurations:type_name -> flyteidl.admin.MatchableAttributesConfiguration
-	23, // [23:23] is the sub-list for method output_type
-	23, // [23:23] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	8,  // 4: flyteidl.admin.PluginOverrides.overrides:type_name -> flyteidl.admin.PluginOverride
+	17, // 5: flyteidl.admin.WorkflowExecutionConfig.security_context:type_name -> flyteidl.core.SecurityContext
+	18, // 6: flyteidl.admin.WorkflowExecutionConfig.raw_output_data_config:type_name -> flyteidl.admin.RawOutputDataConfig
+	19, // 7: flyteidl.admin.WorkflowExecutionConfig.labels:type_name -> flyteidl.admin.Labels
+	20, // 8: flyteidl.admin.WorkflowExecutionConfig.annotations:type_name -> flyteidl.admin.Annotations
+	21, // 9: flyteidl.admin.WorkflowExecutionConfig.interruptible:type_name -> google.protobuf.BoolValue
+	22, // 10: flyteidl.admin.WorkflowExecutionConfig.envs:type_name -> flyteidl.admin.Envs
+	23, // 11: flyteidl.admin.WorkflowExecutionConfig.execution_env_assignments:type_name -> flyteidl.core.ExecutionEnvAssignment
+	2,  // 12: flyteidl.admin.ProjectQuotaAttributes.overflow:type_name -> flyteidl.admin.ProjectQuotaAttributes.OverflowBehavior
+	4,  // 13: flyteidl.admin.MatchingAttributes.task_resource_attributes:type_name -> flyteidl.admin.TaskResourceAttributes
+	5,  // 14: flyteidl.admin.MatchingAttributes.cluster_resource_attributes:type_name -> flyteidl.admin.ClusterResourceAttributes
+	6,  // 15: flyteidl.admin.MatchingAttributes.execution_queue_attributes:type_name -> flyteidl.admin.ExecutionQueueAttributes
+	7,  // 16: flyteidl.admin.MatchingAttributes.execution_cluster_label:type_name -> flyteidl.admin.ExecutionClusterLabel
+	24, // 17: flyteidl.admin.MatchingAttributes.quality_of_service:type_name -> flyteidl.core.QualityOfService
+	9,  // 18: flyteidl.admin.MatchingAttributes.plugin_overrides:type_name -> flyteidl.admin.PluginOverrides
+	10, // 19: flyteidl.admin.MatchingAttributes.workflow_execution_config:type_name -> flyteidl.admin.WorkflowExecutionConfig
+	25, // 20: flyteidl.admin.MatchingAttributes.cluster_assignment:type_name -> flyteidl.admin.ClusterAssignment
+	11, // 21: flyteidl.admin.MatchingAttributes.project_quota_attributes:type_name -> flyteidl.admin.ProjectQuotaAttributes
+	12, // 22: flyteidl.admin.MatchableAttributesConfiguration.attributes:type_name -> flyteidl.admin.MatchingAttributes
+	0,  // 23: flyteidl.admin.ListMatchableAttributesRequest.resource_type:type_name -> flyteidl.admin.MatchableResource
+	13, // 24: flyteidl.admin.ListMatchableAttributesResponse.configurations:type_name -> flyteidl.admin.MatchableAttributesConfiguration
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_flyteidl_admin_matchable_resource_proto_init() }
@@ -1431,7 +1615,7 @@ func file_flyteidl_admin_matchable_resource_proto_init() {
 			}
 		}
 		file_flyteidl_admin_matchable_resource_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MatchingAttributes); i {
+			switch v := v.(*ProjectQuotaAttributes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1443,7 +1627,7 @@ func file_flyteidl_admin_matchable_resource_proto_init() {
 			}
 		}
 		file_flyteidl_admin_matchable_resource_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MatchableAttributesConfiguration); i {
+			switch v := v.(*MatchingAttributes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1455,7 +1639,7 @@ func file_flyteidl_admin_matchable_resource_proto_init() {
 			}
 		}
 		file_flyteidl_admin_matchable_resource_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMatchableAttributesRequest); i {
+			switch v := v.(*MatchableAttributesConfiguration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1467,6 +1651,18 @@ func file_flyteidl_admin_matchable_resource_proto_init() {
 			}
 		}
 		file_flyteidl_admin_matchable_resource_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMatchableAttributesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flyteidl_admin_matchable_resource_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListMatchableAttributesResponse); i {
 			case 0:
 				return &v.state
@@ -1479,7 +1675,7 @@ func file_flyteidl_admin_matchable_resource_proto_init() {
 			}
 		}
 	}
-	file_flyteidl_admin_matchable_resource_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_flyteidl_admin_matchable_resource_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*MatchingAttributes_TaskResourceAttributes)(nil),
 		(*MatchingAttributes_ClusterResourceAttributes)(nil),
 		(*MatchingAttributes_ExecutionQueueAttributes)(nil),
@@ -1488,14 +1684,15 @@ func file_flyteidl_admin_matchable_resource_proto_init() {
 		(*MatchingAttributes_PluginOverrides)(nil),
 		(*MatchingAttributes_WorkflowExecutionConfig)(nil),
 		(*MatchingAttributes_ClusterAssignment)(nil),
+		(*MatchingAttributes_ProjectQuotaAttributes)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_flyteidl_admin_matchable_resource_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   13,
+			NumEnums:      3,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
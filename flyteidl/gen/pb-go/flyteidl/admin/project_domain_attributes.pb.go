@@ -433,6 +433,151 @@ func (*ProjectDomainAttributesDeleteResponse) Descriptor() ([]byte, []int) {
 	return file_flyteidl_admin_project_domain_attributes_proto_rawDescGZIP(), []int{6}
 }
 
+// Request to get the quota usage of a project and domain.
+type ProjectDomainQuotaUsageGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique project id for which to get the quota usage.
+	// +required
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// Unique domain id for which to get the quota usage.
+	// +required
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	// Optional, org key applied to the project.
+	Org string `protobuf:"bytes,3,opt,name=org,proto3" json:"org,omitempty"`
+}
+
+func (x *ProjectDomainQuotaUsageGetRequest) Reset() {
+	*x = ProjectDomainQuotaUsageGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flyteidl_admin_project_domain_attributes_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectDomainQuotaUsageGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectDomainQuotaUsageGetRequest) ProtoMessage() {}
+
+func (x *ProjectDomainQuotaUsageGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_flyteidl_admin_project_domain_attributes_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectDomainQuotaUsageGetRequest.ProtoReflect.Descriptor instead.
+func (*ProjectDomainQuotaUsageGetRequest) Descriptor() ([]byte, []int) {
+	return file_flyteidl_admin_project_domain_attributes_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProjectDomainQuotaUsageGetRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ProjectDomainQuotaUsageGetRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ProjectDomainQuotaUsageGetRequest) GetOrg() string {
+	if x != nil {
+		return x.Org
+	}
+	return ""
+}
+
+// Usage of a project and domain along with the quota in effect for it, if any.
+type ProjectDomainQuotaUsageGetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The :ref:`ref_flyteidl.admin.ProjectQuotaAttributes` matching the project and domain, if any.
+	Quota *ProjectQuotaAttributes `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	// Number of launched executions which have not terminated.
+	ActiveExecutions int64 `protobuf:"varint,2,opt,name=active_executions,json=activeExecutions,proto3" json:"active_executions,omitempty"`
+	// Number of executions queued until the project and domain has capacity.
+	QueuedExecutions int64 `protobuf:"varint,3,opt,name=queued_executions,json=queuedExecutions,proto3" json:"queued_executions,omitempty"`
+	// Number of task executions which have not terminated.
+	RunningTasks int64 `protobuf:"varint,4,opt,name=running_tasks,json=runningTasks,proto3" json:"running_tasks,omitempty"`
+}
+
+func (x *ProjectDomainQuotaUsageGetResponse) Reset() {
+	*x = ProjectDomainQuotaUsageGetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_flyteidl_admin_project_domain_attributes_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectDomainQuotaUsageGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectDomainQuotaUsageGetResponse) ProtoMessage() {}
+
+func (x *ProjectDomainQuotaUsageGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_flyteidl_admin_project_domain_attributes_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectDomainQuotaUsageGetResponse.ProtoReflect.Descriptor instead.
+func (*ProjectDomainQuotaUsageGetResponse) Descriptor() ([]byte, []int) {
+	return file_flyteidl_admin_project_domain_attributes_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProjectDomainQuotaUsageGetResponse) GetQuota() *ProjectQuotaAttributes {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *ProjectDomainQuotaUsageGetResponse) GetActiveExecutions() int64 {
+	if x != nil {
+		return x.ActiveExecutions
+	}
+	return 0
+}
+
+func (x *ProjectDomainQuotaUsageGetResponse) GetQueuedExecutions() int64 {
+	if x != nil {
+		return x.QueuedExecutions
+	}
+	return 0
+}
+
+func (x *ProjectDomainQuotaUsageGetResponse) GetRunningTasks() int64 {
+	if x != nil {
+		return x.RunningTasks
+	}
+	return 0
+}
+
 var File_flyteidl_admin_project_domain_attributes_proto protoreflect.FileDescriptor
 
 var file_flyteidl_admin_project_domain_attributes_proto_rawDesc = []byte{
@@ -495,20 +640,41 @@ var file_flyteidl_admin_project_domain_attributes_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x72, 0x67, 0x22, 0x27,
 	0x0a, 0x25, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x41,
 	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xc8, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e,
-	0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x1c,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x41, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65,
-	0x6f, 0x72, 0x67, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69,
-	0x64, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x62, 0x2d, 0x67, 0x6f, 0x2f, 0x66, 0x6c, 0x79,
-	0x74, 0x65, 0x69, 0x64, 0x6c, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0xa2, 0x02, 0x03, 0x46, 0x41,
-	0x58, 0xaa, 0x02, 0x0e, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0xca, 0x02, 0x0e, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x5c, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0xe2, 0x02, 0x1a, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x5c, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x0f, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x3a, 0x3a, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x0a, 0x21, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x6f, 0x72, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x72, 0x67,
+	0x22, 0xe1, 0x01, 0x0a, 0x22, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64,
+	0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x05,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x42, 0xc8, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x6c, 0x79,
+	0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0x1c, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x6f, 0x72, 0x67,
+	0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2f,
+	0x67, 0x65, 0x6e, 0x2f, 0x70, 0x62, 0x2d, 0x67, 0x6f, 0x2f, 0x66, 0x6c, 0x79, 0x74, 0x65, 0x69,
+	0x64, 0x6c, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0xa2, 0x02, 0x03, 0x46, 0x41, 0x58, 0xaa, 0x02,
+	0x0e, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0xca,
+	0x02, 0x0e, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x5c, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0xe2, 0x02, 0x1a, 0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x5c, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f,
+	0x46, 0x6c, 0x79, 0x74, 0x65, 0x69, 0x64, 0x6c, 0x3a, 0x3a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -523,7 +689,7 @@ func file_flyteidl_admin_project_domain_attributes_proto_rawDescGZIP() []byte {
 	return file_flyteidl_admin_project_domain_attributes_proto_rawDescData
 }
 
-var file_flyteidl_admin_project_domain_attributes_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_flyteidl_admin_project_domain_attributes_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_flyteidl_admin_project_domain_attributes_proto_goTypes = []interface{}{
 	(*ProjectDomainAttributes)(nil),               // 0: flyteidl.admin.ProjectDomainAttributes
 	(*ProjectDomainAttributesUpdateRequest)(nil),  // 1: flyteidl.admin.ProjectDomainAttributesUpdateRequest
@@ -532,20 +698,24 @@ var file_flyteidl_admin_project_domain_attributes_proto_goTypes = []interface{}{
 	(*ProjectDomainAttributesGetResponse)(nil),    // 4: flyteidl.admin.ProjectDomainAttributesGetResponse
 	(*ProjectDomainAttributesDeleteRequest)(nil),  // 5: flyteidl.admin.ProjectDomainAttributesDeleteRequest
 	(*ProjectDomainAttributesDeleteResponse)(nil), // 6: flyteidl.admin.ProjectDomainAttributesDeleteResponse
-	(*MatchingAttributes)(nil),                    // 7: flyteidl.admin.MatchingAttributes
-	(MatchableResource)(0),                        // 8: flyteidl.admin.MatchableResource
+	(*ProjectDomainQuotaUsageGetRequest)(nil),     // 7: flyteidl.admin.ProjectDomainQuotaUsageGetRequest
+	(*ProjectDomainQuotaUsageGetResponse)(nil),    // 8: flyteidl.admin.ProjectDomainQuotaUsageGetResponse
+	(*MatchingAttributes)(nil),                    // 9: flyteidl.admin.MatchingAttributes
+	(MatchableResource)(0),                        // 10: flyteidl.admin.MatchableResource
+	(*ProjectQuotaAttributes)(nil),                // 11: flyteidl.admin.ProjectQuotaAttributes
 }
 var file_flyteidl_admin_project_domain_attributes_proto_depIdxs = []int32{
-	7, // 0: flyteidl.admin.ProjectDomainAttributes.matching_attributes:type_name -> flyteidl.admin.MatchingAttributes
-	0, // 1: flyteidl.admin.ProjectDomainAttributesUpdateRequest.attributes:type_name -> flyteidl.admin.ProjectDomainAttributes
-	8, // 2: flyteidl.admin.ProjectDomainAttributesGetRequest.resource_type:type_name -> flyteidl.admin.MatchableResource
-	0, // 3: flyteidl.admin.ProjectDomainAttributesGetResponse.attributes:type_name -> flyteidl.admin.ProjectDomainAttributes
-	8, // 4: flyteidl.admin.ProjectDomainAttributesDeleteRequest.resource_type:type_name -> flyteidl.admin.MatchableResource
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	9,  // 0: flyteidl.admin.ProjectDomainAttributes.matching_attributes:type_name -> flyteidl.admin.MatchingAttributes
+	0,  // 1: flyteidl.admin.ProjectDomainAttributesUpdateRequest.attributes:type_name -> flyteidl.admin.ProjectDomainAttributes
+	10, // 2: flyteidl.admin.ProjectDomainAttributesGetRequest.resource_type:type_name -> flyteidl.admin.MatchableResource
+	0,  // 3: flyteidl.admin.ProjectDomainAttributesGetResponse.attributes:type_name -> flyteidl.admin.ProjectDomainAttributes
+	10, // 4: flyteidl.admin.ProjectDomainAttributesDeleteRequest.resource_type:type_name -> flyteidl.admin.MatchableResource
+	11, // 5: flyteidl.admin.ProjectDomainQuotaUsageGetResponse.quota:type_name -> flyteidl.admin.ProjectQuotaAttributes
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_flyteidl_admin_project_domain_attributes_proto_init() }
@@ -639,6 +809,30 @@ func file_flyteidl_admin_project_domain_attributes_proto_init() {
 				return nil
 			}
 		}
+		file_flyteidl_admin_project_domain_attributes_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectDomainQuotaUsageGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_flyteidl_admin_project_domain_attributes_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectDomainQuotaUsageGetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -646,7 +840,7 @@ func file_flyteidl_admin_project_domain_attributes_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_flyteidl_admin_project_domain_attributes_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -62,6 +62,7 @@ const (
 	AdminService_UpdateProjectDomainAttributes_FullMethodName = "/flyteidl.service.AdminService/UpdateProjectDomainAttributes"
 	AdminService_GetProjectDomainAttributes_FullMethodName    = "/flyteidl.service.AdminService/GetProjectDomainAttributes"
 	AdminService_DeleteProjectDomainAttributes_FullMethodName = "/flyteidl.service.AdminService/DeleteProjectDomainAttributes"
+	AdminService_GetProjectDomainQuotaUsage_FullMethodName    = "/flyteidl.service.AdminService/GetProjectDomainQuotaUsage"
 	AdminService_UpdateProjectAttributes_FullMethodName       = "/flyteidl.service.AdminService/UpdateProjectAttributes"
 	AdminService_GetProjectAttributes_FullMethodName          = "/flyteidl.service.AdminService/GetProjectAttributes"
 	AdminService_DeleteProjectAttributes_FullMethodName       = "/flyteidl.service.AdminService/DeleteProjectAttributes"
@@ -76,6 +77,7 @@ const (
 	AdminService_GetDescriptionEntity_FullMethodName          = "/flyteidl.service.AdminService/GetDescriptionEntity"
 	AdminService_ListDescriptionEntities_FullMethodName       = "/flyteidl.service.AdminService/ListDescriptionEntities"
 	AdminService_GetExecutionMetrics_FullMethodName           = "/flyteidl.service.AdminService/GetExecutionMetrics"
+	AdminService_GetExecutionAnalysis_FullMethodName          = "/flyteidl.service.AdminService/GetExecutionAnalysis"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -171,6 +173,8 @@ type AdminServiceClient interface {
 	GetProjectDomainAttributes(ctx context.Context, in *admin.ProjectDomainAttributesGetRequest, opts ...grpc.CallOption) (*admin.ProjectDomainAttributesGetResponse, error)
 	// Deletes custom :ref:`ref_flyteidl.admin.MatchableAttributesConfiguration` for a project and domain.
 	DeleteProjectDomainAttributes(ctx context.Context, in *admin.ProjectDomainAttributesDeleteRequest, opts ...grpc.CallOption) (*admin.ProjectDomainAttributesDeleteResponse, error)
+	// Fetches the usage of a project and domain along with the :ref:`ref_flyteidl.admin.ProjectQuotaAttributes` in effect for it.
+	GetProjectDomainQuotaUsage(ctx context.Context, in *admin.ProjectDomainQuotaUsageGetRequest, opts ...grpc.CallOption) (*admin.ProjectDomainQuotaUsageGetResponse, error)
 	// Creates or updates custom :ref:`ref_flyteidl.admin.MatchableAttributesConfiguration` at the project level
 	UpdateProjectAttributes(ctx context.Context, in *admin.ProjectAttributesUpdateRequest, opts ...grpc.CallOption) (*admin.ProjectAttributesUpdateResponse, error)
 	// Fetches custom :ref:`ref_flyteidl.admin.MatchableAttributesConfiguration` for a project and domain.
@@ -198,6 +202,8 @@ type AdminServiceClient interface {
 	ListDescriptionEntities(ctx context.Context, in *admin.DescriptionEntityListRequest, opts ...grpc.CallOption) (*admin.DescriptionEntityList, error)
 	// Fetches runtime metrics for a :ref:`ref_flyteidl.admin.Execution`.
 	GetExecutionMetrics(ctx context.Context, in *admin.WorkflowExecutionGetMetricsRequest, opts ...grpc.CallOption) (*admin.WorkflowExecutionGetMetricsResponse, error)
+	// Analyzes the critical path of a :ref:`ref_flyteidl.admin.Execution`.
+	GetExecutionAnalysis(ctx context.Context, in *admin.WorkflowExecutionGetAnalysisRequest, opts ...grpc.CallOption) (*admin.WorkflowExecutionGetAnalysisResponse, error)
 }
 
 type adminServiceClient struct {
@@ -586,6 +592,15 @@ func (c *adminServiceClient) DeleteProjectDomainAttributes(ctx context.Context,
 	return out, nil
 }
 
+func (c *adminServiceClient) GetProjectDomainQuotaUsage(ctx context.Context, in *admin.ProjectDomainQuotaUsageGetRequest, opts ...grpc.CallOption) (*admin.ProjectDomainQuotaUsageGetResponse, error) {
+	out := new(admin.ProjectDomainQuotaUsageGetResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetProjectDomainQuotaUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) UpdateProjectAttributes(ctx context.Context, in *admin.ProjectAttributesUpdateRequest, opts ...grpc.CallOption) (*admin.ProjectAttributesUpdateResponse, error) {
 	out := new(admin.ProjectAttributesUpdateResponse)
 	err := c.cc.Invoke(ctx, AdminService_UpdateProjectAttributes_FullMethodName, in, out, opts...)
@@ -712,6 +727,15 @@ func (c *adminServiceClient) GetExecutionMetrics(ctx context.Context, in *admin.
 	return out, nil
 }
 
+func (c *adminServiceClient) GetExecutionAnalysis(ctx context.Context, in *admin.WorkflowExecutionGetAnalysisRequest, opts ...grpc.CallOption) (*admin.WorkflowExecutionGetAnalysisResponse, error) {
+	out := new(admin.WorkflowExecutionGetAnalysisResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetExecutionAnalysis_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations should embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -805,6 +829,8 @@ type AdminServiceServer interface {
 	GetProjectDomainAttributes(context.Context, *admin.ProjectDomainAttributesGetRequest) (*admin.ProjectDomainAttributesGetResponse, error)
 	// Deletes custom :ref:`ref_flyteidl.admin.MatchableAttributesConfiguration` for a project and domain.
 	DeleteProjectDomainAttributes(context.Context, *admin.ProjectDomainAttributesDeleteRequest) (*admin.ProjectDomainAttributesDeleteResponse, error)
+	// Fetches the usage of a project and domain along with the :ref:`ref_flyteidl.admin.ProjectQuotaAttributes` in effect for it.
+	GetProjectDomainQuotaUsage(context.Context, *admin.ProjectDomainQuotaUsageGetRequest) (*admin.ProjectDomainQuotaUsageGetResponse, error)
 	// Creates or updates custom :ref:`ref_flyteidl.admin.MatchableAttributesConfiguration` at the project level
 	UpdateProjectAttributes(context.Context, *admin.ProjectAttributesUpdateRequest) (*admin.ProjectAttributesUpdateResponse, error)
 	// Fetches custom :ref:`ref_flyteidl.admin.MatchableAttributesConfiguration` for a project and domain.
@@ -832,6 +858,8 @@ type AdminServiceServer interface {
 	ListDescriptionEntities(context.Context, *admin.DescriptionEntityListRequest) (*admin.DescriptionEntityList, error)
 	// Fetches runtime metrics for a :ref:`ref_flyteidl.admin.Execution`.
 	GetExecutionMetrics(context.Context, *admin.WorkflowExecutionGetMetricsRequest) (*admin.WorkflowExecutionGetMetricsResponse, error)
+	// Analyzes the critical path of a :ref:`ref_flyteidl.admin.Execution`.
+	GetExecutionAnalysis(context.Context, *admin.WorkflowExecutionGetAnalysisRequest) (*admin.WorkflowExecutionGetAnalysisResponse, error)
 }
 
 // UnimplementedAdminServiceServer should be embedded to have forward compatible implementations.
@@ -964,6 +992,9 @@ func (UnimplementedAdminServiceServer) GetProjectDomainAttributes(context.Contex
 func (UnimplementedAdminServiceServer) DeleteProjectDomainAttributes(context.Context, *admin.ProjectDomainAttributesDeleteRequest) (*admin.ProjectDomainAttributesDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProjectDomainAttributes not implemented")
 }
+func (UnimplementedAdminServiceServer) GetProjectDomainQuotaUsage(context.Context, *admin.ProjectDomainQuotaUsageGetRequest) (*admin.ProjectDomainQuotaUsageGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectDomainQuotaUsage not implemented")
+}
 func (UnimplementedAdminServiceServer) UpdateProjectAttributes(context.Context, *admin.ProjectAttributesUpdateRequest) (*admin.ProjectAttributesUpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectAttributes not implemented")
 }
@@ -1006,6 +1037,9 @@ func (UnimplementedAdminServiceServer) ListDescriptionEntities(context.Context,
 func (UnimplementedAdminServiceServer) GetExecutionMetrics(context.Context, *admin.WorkflowExecutionGetMetricsRequest) (*admin.WorkflowExecutionGetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetExecutionMetrics not implemented")
 }
+func (UnimplementedAdminServiceServer) GetExecutionAnalysis(context.Context, *admin.WorkflowExecutionGetAnalysisRequest) (*admin.WorkflowExecutionGetAnalysisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExecutionAnalysis not implemented")
+}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to AdminServiceServer will
@@ -1774,6 +1808,24 @@ func _AdminService_DeleteProjectDomainAttributes_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetProjectDomainQuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(admin.ProjectDomainQuotaUsageGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetProjectDomainQuotaUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetProjectDomainQuotaUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetProjectDomainQuotaUsage(ctx, req.(*admin.ProjectDomainQuotaUsageGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_UpdateProjectAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(admin.ProjectAttributesUpdateRequest)
 	if err := dec(in); err != nil {
@@ -2026,6 +2078,24 @@ func _AdminService_GetExecutionMetrics_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetExecutionAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(admin.WorkflowExecutionGetAnalysisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetExecutionAnalysis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetExecutionAnalysis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetExecutionAnalysis(ctx, req.(*admin.WorkflowExecutionGetAnalysisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2201,6 +2271,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProjectDomainAttributes",
 			Handler:    _AdminService_DeleteProjectDomainAttributes_Handler,
 		},
+		{
+			MethodName: "GetProjectDomainQuotaUsage",
+			Handler:    _AdminService_GetProjectDomainQuotaUsage_Handler,
+		},
 		{
 			MethodName: "UpdateProjectAttributes",
 			Handler:    _AdminService_UpdateProjectAttributes_Handler,
@@ -2257,6 +2331,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetExecutionMetrics",
 			Handler:    _AdminService_GetExecutionMetrics_Handler,
 		},
+		{
+			MethodName: "GetExecutionAnalysis",
+			Handler:    _AdminService_GetExecutionAnalysis_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "flyteidl/service/admin.proto",
@@ -15459,6 +15459,364 @@ export namespace flyteidl {
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
+        /** Properties of a WorkflowExecutionGetAnalysisRequest. */
+        interface IWorkflowExecutionGetAnalysisRequest {
+
+            /** WorkflowExecutionGetAnalysisRequest id */
+            id?: (flyteidl.core.IWorkflowExecutionIdentifier|null);
+
+            /** WorkflowExecutionGetAnalysisRequest depth */
+            depth?: (number|null);
+        }
+
+        /** Represents a WorkflowExecutionGetAnalysisRequest. */
+        class WorkflowExecutionGetAnalysisRequest implements IWorkflowExecutionGetAnalysisRequest {
+
+            /**
+             * Constructs a new WorkflowExecutionGetAnalysisRequest.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IWorkflowExecutionGetAnalysisRequest);
+
+            /** WorkflowExecutionGetAnalysisRequest id. */
+            public id?: (flyteidl.core.IWorkflowExecutionIdentifier|null);
+
+            /** WorkflowExecutionGetAnalysisRequest depth. */
+            public depth: number;
+
+            /**
+             * Creates a new WorkflowExecutionGetAnalysisRequest instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns WorkflowExecutionGetAnalysisRequest instance
+             */
+            public static create(properties?: flyteidl.admin.IWorkflowExecutionGetAnalysisRequest): flyteidl.admin.WorkflowExecutionGetAnalysisRequest;
+
+            /**
+             * Encodes the specified WorkflowExecutionGetAnalysisRequest message. Does not implicitly {@link flyteidl.admin.WorkflowExecutionGetAnalysisRequest.verify|verify} messages.
+             * @param message WorkflowExecutionGetAnalysisRequest message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IWorkflowExecutionGetAnalysisRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a WorkflowExecutionGetAnalysisRequest message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns WorkflowExecutionGetAnalysisRequest
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.WorkflowExecutionGetAnalysisRequest;
+
+            /**
+             * Verifies a WorkflowExecutionGetAnalysisRequest message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of an ActivityDuration. */
+        interface IActivityDuration {
+
+            /** ActivityDuration activity */
+            activity?: (flyteidl.admin.ActivityDuration.Activity|null);
+
+            /** ActivityDuration duration */
+            duration?: (google.protobuf.IDuration|null);
+        }
+
+        /** Represents an ActivityDuration. */
+        class ActivityDuration implements IActivityDuration {
+
+            /**
+             * Constructs a new ActivityDuration.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IActivityDuration);
+
+            /** ActivityDuration activity. */
+            public activity: flyteidl.admin.ActivityDuration.Activity;
+
+            /** ActivityDuration duration. */
+            public duration?: (google.protobuf.IDuration|null);
+
+            /**
+             * Creates a new ActivityDuration instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns ActivityDuration instance
+             */
+            public static create(properties?: flyteidl.admin.IActivityDuration): flyteidl.admin.ActivityDuration;
+
+            /**
+             * Encodes the specified ActivityDuration message. Does not implicitly {@link flyteidl.admin.ActivityDuration.verify|verify} messages.
+             * @param message ActivityDuration message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IActivityDuration, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes an ActivityDuration message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns ActivityDuration
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ActivityDuration;
+
+            /**
+             * Verifies an ActivityDuration message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        namespace ActivityDuration {
+
+            /** Activity enum. */
+            enum Activity {
+                PROPELLER_OVERHEAD = 0,
+                QUEUEING = 1,
+                POD_SCHEDULING = 2,
+                IMAGE_PULL = 3,
+                INITIALIZATION = 4,
+                RUNTIME = 5,
+                IDLE = 6
+            }
+        }
+
+        /** Properties of a CriticalPathSegment. */
+        interface ICriticalPathSegment {
+
+            /** CriticalPathSegment startTime */
+            startTime?: (google.protobuf.ITimestamp|null);
+
+            /** CriticalPathSegment endTime */
+            endTime?: (google.protobuf.ITimestamp|null);
+
+            /** CriticalPathSegment activity */
+            activity?: (flyteidl.admin.ActivityDuration.Activity|null);
+
+            /** CriticalPathSegment operationId */
+            operationId?: (string|null);
+
+            /** CriticalPathSegment nodeId */
+            nodeId?: (string|null);
+
+            /** CriticalPathSegment taskName */
+            taskName?: (string|null);
+        }
+
+        /** Represents a CriticalPathSegment. */
+        class CriticalPathSegment implements ICriticalPathSegment {
+
+            /**
+             * Constructs a new CriticalPathSegment.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.ICriticalPathSegment);
+
+            /** CriticalPathSegment startTime. */
+            public startTime?: (google.protobuf.ITimestamp|null);
+
+            /** CriticalPathSegment endTime. */
+            public endTime?: (google.protobuf.ITimestamp|null);
+
+            /** CriticalPathSegment activity. */
+            public activity: flyteidl.admin.ActivityDuration.Activity;
+
+            /** CriticalPathSegment operationId. */
+            public operationId: string;
+
+            /** CriticalPathSegment nodeId. */
+            public nodeId: string;
+
+            /** CriticalPathSegment taskName. */
+            public taskName: string;
+
+            /**
+             * Creates a new CriticalPathSegment instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns CriticalPathSegment instance
+             */
+            public static create(properties?: flyteidl.admin.ICriticalPathSegment): flyteidl.admin.CriticalPathSegment;
+
+            /**
+             * Encodes the specified CriticalPathSegment message. Does not implicitly {@link flyteidl.admin.CriticalPathSegment.verify|verify} messages.
+             * @param message CriticalPathSegment message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.ICriticalPathSegment, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a CriticalPathSegment message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns CriticalPathSegment
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.CriticalPathSegment;
+
+            /**
+             * Verifies a CriticalPathSegment message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of a CriticalPathNode. */
+        interface ICriticalPathNode {
+
+            /** CriticalPathNode nodeId */
+            nodeId?: (string|null);
+
+            /** CriticalPathNode taskName */
+            taskName?: (string|null);
+
+            /** CriticalPathNode duration */
+            duration?: (google.protobuf.IDuration|null);
+
+            /** CriticalPathNode share */
+            share?: (number|null);
+
+            /** CriticalPathNode breakdown */
+            breakdown?: (flyteidl.admin.IActivityDuration[]|null);
+        }
+
+        /** Represents a CriticalPathNode. */
+        class CriticalPathNode implements ICriticalPathNode {
+
+            /**
+             * Constructs a new CriticalPathNode.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.ICriticalPathNode);
+
+            /** CriticalPathNode nodeId. */
+            public nodeId: string;
+
+            /** CriticalPathNode taskName. */
+            public taskName: string;
+
+            /** CriticalPathNode duration. */
+            public duration?: (google.protobuf.IDuration|null);
+
+            /** CriticalPathNode share. */
+            public share: number;
+
+            /** CriticalPathNode breakdown. */
+            public breakdown: flyteidl.admin.IActivityDuration[];
+
+            /**
+             * Creates a new CriticalPathNode instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns CriticalPathNode instance
+             */
+            public static create(properties?: flyteidl.admin.ICriticalPathNode): flyteidl.admin.CriticalPathNode;
+
+            /**
+             * Encodes the specified CriticalPathNode message. Does not implicitly {@link flyteidl.admin.CriticalPathNode.verify|verify} messages.
+             * @param message CriticalPathNode message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.ICriticalPathNode, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a CriticalPathNode message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns CriticalPathNode
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.CriticalPathNode;
+
+            /**
+             * Verifies a CriticalPathNode message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of a WorkflowExecutionGetAnalysisResponse. */
+        interface IWorkflowExecutionGetAnalysisResponse {
+
+            /** WorkflowExecutionGetAnalysisResponse duration */
+            duration?: (google.protobuf.IDuration|null);
+
+            /** WorkflowExecutionGetAnalysisResponse breakdown */
+            breakdown?: (flyteidl.admin.IActivityDuration[]|null);
+
+            /** WorkflowExecutionGetAnalysisResponse criticalPath */
+            criticalPath?: (flyteidl.admin.ICriticalPathSegment[]|null);
+
+            /** WorkflowExecutionGetAnalysisResponse bottlenecks */
+            bottlenecks?: (flyteidl.admin.ICriticalPathNode[]|null);
+        }
+
+        /** Represents a WorkflowExecutionGetAnalysisResponse. */
+        class WorkflowExecutionGetAnalysisResponse implements IWorkflowExecutionGetAnalysisResponse {
+
+            /**
+             * Constructs a new WorkflowExecutionGetAnalysisResponse.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IWorkflowExecutionGetAnalysisResponse);
+
+            /** WorkflowExecutionGetAnalysisResponse duration. */
+            public duration?: (google.protobuf.IDuration|null);
+
+            /** WorkflowExecutionGetAnalysisResponse breakdown. */
+            public breakdown: flyteidl.admin.IActivityDuration[];
+
+            /** WorkflowExecutionGetAnalysisResponse criticalPath. */
+            public criticalPath: flyteidl.admin.ICriticalPathSegment[];
+
+            /** WorkflowExecutionGetAnalysisResponse bottlenecks. */
+            public bottlenecks: flyteidl.admin.ICriticalPathNode[];
+
+            /**
+             * Creates a new WorkflowExecutionGetAnalysisResponse instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns WorkflowExecutionGetAnalysisResponse instance
+             */
+            public static create(properties?: flyteidl.admin.IWorkflowExecutionGetAnalysisResponse): flyteidl.admin.WorkflowExecutionGetAnalysisResponse;
+
+            /**
+             * Encodes the specified WorkflowExecutionGetAnalysisResponse message. Does not implicitly {@link flyteidl.admin.WorkflowExecutionGetAnalysisResponse.verify|verify} messages.
+             * @param message WorkflowExecutionGetAnalysisResponse message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IWorkflowExecutionGetAnalysisResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a WorkflowExecutionGetAnalysisResponse message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns WorkflowExecutionGetAnalysisResponse
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.WorkflowExecutionGetAnalysisResponse;
+
+            /**
+             * Verifies a WorkflowExecutionGetAnalysisResponse message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
         /** MatchableResource enum. */
         enum MatchableResource {
             TASK_RESOURCE = 0,
@@ -15468,7 +15826,8 @@ export namespace flyteidl {
             QUALITY_OF_SERVICE_SPECIFICATION = 4,
             PLUGIN_OVERRIDE = 5,
             WORKFLOW_EXECUTION_CONFIG = 6,
-            CLUSTER_ASSIGNMENT = 7
+            CLUSTER_ASSIGNMENT = 7,
+            PROJECT_QUOTA = 8
         }
 
         /** Properties of a TaskResourceSpec. */
@@ -15986,6 +16345,91 @@ export namespace flyteidl {
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
+        /** Properties of a ProjectQuotaAttributes. */
+        interface IProjectQuotaAttributes {
+
+            /** ProjectQuotaAttributes maxConcurrentExecutions */
+            maxConcurrentExecutions?: (number|null);
+
+            /** ProjectQuotaAttributes maxRunningTasks */
+            maxRunningTasks?: (number|null);
+
+            /** ProjectQuotaAttributes maxCpu */
+            maxCpu?: (string|null);
+
+            /** ProjectQuotaAttributes maxGpu */
+            maxGpu?: (string|null);
+
+            /** ProjectQuotaAttributes overflow */
+            overflow?: (flyteidl.admin.ProjectQuotaAttributes.OverflowBehavior|null);
+        }
+
+        /** Represents a ProjectQuotaAttributes. */
+        class ProjectQuotaAttributes implements IProjectQuotaAttributes {
+
+            /**
+             * Constructs a new ProjectQuotaAttributes.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IProjectQuotaAttributes);
+
+            /** ProjectQuotaAttributes maxConcurrentExecutions. */
+            public maxConcurrentExecutions: number;
+
+            /** ProjectQuotaAttributes maxRunningTasks. */
+            public maxRunningTasks: number;
+
+            /** ProjectQuotaAttributes maxCpu. */
+            public maxCpu: string;
+
+            /** ProjectQuotaAttributes maxGpu. */
+            public maxGpu: string;
+
+            /** ProjectQuotaAttributes overflow. */
+            public overflow: flyteidl.admin.ProjectQuotaAttributes.OverflowBehavior;
+
+            /**
+             * Creates a new ProjectQuotaAttributes instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns ProjectQuotaAttributes instance
+             */
+            public static create(properties?: flyteidl.admin.IProjectQuotaAttributes): flyteidl.admin.ProjectQuotaAttributes;
+
+            /**
+             * Encodes the specified ProjectQuotaAttributes message. Does not implicitly {@link flyteidl.admin.ProjectQuotaAttributes.verify|verify} messages.
+             * @param message ProjectQuotaAttributes message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IProjectQuotaAttributes, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a ProjectQuotaAttributes message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns ProjectQuotaAttributes
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ProjectQuotaAttributes;
+
+            /**
+             * Verifies a ProjectQuotaAttributes message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        namespace ProjectQuotaAttributes {
+
+            /** OverflowBehavior enum. */
+            enum OverflowBehavior {
+                REJECT = 0,
+                QUEUE = 1
+            }
+        }
+
         /** Properties of a MatchingAttributes. */
         interface IMatchingAttributes {
 
@@ -16012,6 +16456,9 @@ export namespace flyteidl {
 
             /** MatchingAttributes clusterAssignment */
             clusterAssignment?: (flyteidl.admin.IClusterAssignment|null);
+
+            /** MatchingAttributes projectQuotaAttributes */
+            projectQuotaAttributes?: (flyteidl.admin.IProjectQuotaAttributes|null);
         }
 
         /** Represents a MatchingAttributes. */
@@ -16047,8 +16494,11 @@ export namespace flyteidl {
             /** MatchingAttributes clusterAssignment. */
             public clusterAssignment?: (flyteidl.admin.IClusterAssignment|null);
 
+            /** MatchingAttributes projectQuotaAttributes. */
+            public projectQuotaAttributes?: (flyteidl.admin.IProjectQuotaAttributes|null);
+
             /** MatchingAttributes target. */
-            public target?: ("taskResourceAttributes"|"clusterResourceAttributes"|"executionQueueAttributes"|"executionClusterLabel"|"qualityOfService"|"pluginOverrides"|"workflowExecutionConfig"|"clusterAssignment");
+            public target?: ("taskResourceAttributes"|"clusterResourceAttributes"|"executionQueueAttributes"|"executionClusterLabel"|"qualityOfService"|"pluginOverrides"|"workflowExecutionConfig"|"clusterAssignment"|"projectQuotaAttributes");
 
             /**
              * Creates a new MatchingAttributes instance using the specified properties.
@@ -19768,6 +20218,140 @@ export namespace flyteidl {
             public static verify(message: { [k: string]: any }): (string|null);
         }
 
+        /** Properties of a ProjectDomainQuotaUsageGetRequest. */
+        interface IProjectDomainQuotaUsageGetRequest {
+
+            /** ProjectDomainQuotaUsageGetRequest project */
+            project?: (string|null);
+
+            /** ProjectDomainQuotaUsageGetRequest domain */
+            domain?: (string|null);
+
+            /** ProjectDomainQuotaUsageGetRequest org */
+            org?: (string|null);
+        }
+
+        /** Represents a ProjectDomainQuotaUsageGetRequest. */
+        class ProjectDomainQuotaUsageGetRequest implements IProjectDomainQuotaUsageGetRequest {
+
+            /**
+             * Constructs a new ProjectDomainQuotaUsageGetRequest.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IProjectDomainQuotaUsageGetRequest);
+
+            /** ProjectDomainQuotaUsageGetRequest project. */
+            public project: string;
+
+            /** ProjectDomainQuotaUsageGetRequest domain. */
+            public domain: string;
+
+            /** ProjectDomainQuotaUsageGetRequest org. */
+            public org: string;
+
+            /**
+             * Creates a new ProjectDomainQuotaUsageGetRequest instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns ProjectDomainQuotaUsageGetRequest instance
+             */
+            public static create(properties?: flyteidl.admin.IProjectDomainQuotaUsageGetRequest): flyteidl.admin.ProjectDomainQuotaUsageGetRequest;
+
+            /**
+             * Encodes the specified ProjectDomainQuotaUsageGetRequest message. Does not implicitly {@link flyteidl.admin.ProjectDomainQuotaUsageGetRequest.verify|verify} messages.
+             * @param message ProjectDomainQuotaUsageGetRequest message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IProjectDomainQuotaUsageGetRequest, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a ProjectDomainQuotaUsageGetRequest message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns ProjectDomainQuotaUsageGetRequest
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ProjectDomainQuotaUsageGetRequest;
+
+            /**
+             * Verifies a ProjectDomainQuotaUsageGetRequest message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
+        /** Properties of a ProjectDomainQuotaUsageGetResponse. */
+        interface IProjectDomainQuotaUsageGetResponse {
+
+            /** ProjectDomainQuotaUsageGetResponse quota */
+            quota?: (flyteidl.admin.IProjectQuotaAttributes|null);
+
+            /** ProjectDomainQuotaUsageGetResponse activeExecutions */
+            activeExecutions?: (Long|null);
+
+            /** ProjectDomainQuotaUsageGetResponse queuedExecutions */
+            queuedExecutions?: (Long|null);
+
+            /** ProjectDomainQuotaUsageGetResponse runningTasks */
+            runningTasks?: (Long|null);
+        }
+
+        /** Represents a ProjectDomainQuotaUsageGetResponse. */
+        class ProjectDomainQuotaUsageGetResponse implements IProjectDomainQuotaUsageGetResponse {
+
+            /**
+             * Constructs a new ProjectDomainQuotaUsageGetResponse.
+             * @param [properties] Properties to set
+             */
+            constructor(properties?: flyteidl.admin.IProjectDomainQuotaUsageGetResponse);
+
+            /** ProjectDomainQuotaUsageGetResponse quota. */
+            public quota?: (flyteidl.admin.IProjectQuotaAttributes|null);
+
+            /** ProjectDomainQuotaUsageGetResponse activeExecutions. */
+            public activeExecutions: Long;
+
+            /** ProjectDomainQuotaUsageGetResponse queuedExecutions. */
+            public queuedExecutions: Long;
+
+            /** ProjectDomainQuotaUsageGetResponse runningTasks. */
+            public runningTasks: Long;
+
+            /**
+             * Creates a new ProjectDomainQuotaUsageGetResponse instance using the specified properties.
+             * @param [properties] Properties to set
+             * @returns ProjectDomainQuotaUsageGetResponse instance
+             */
+            public static create(properties?: flyteidl.admin.IProjectDomainQuotaUsageGetResponse): flyteidl.admin.ProjectDomainQuotaUsageGetResponse;
+
+            /**
+             * Encodes the specified ProjectDomainQuotaUsageGetResponse message. Does not implicitly {@link flyteidl.admin.ProjectDomainQuotaUsageGetResponse.verify|verify} messages.
+             * @param message ProjectDomainQuotaUsageGetResponse message or plain object to encode
+             * @param [writer] Writer to encode to
+             * @returns Writer
+             */
+            public static encode(message: flyteidl.admin.IProjectDomainQuotaUsageGetResponse, writer?: $protobuf.Writer): $protobuf.Writer;
+
+            /**
+             * Decodes a ProjectDomainQuotaUsageGetResponse message from the specified reader or buffer.
+             * @param reader Reader or buffer to decode from
+             * @param [length] Message length if known beforehand
+             * @returns ProjectDomainQuotaUsageGetResponse
+             * @throws {Error} If the payload is not a reader or valid buffer
+             * @throws {$protobuf.util.ProtocolError} If required fields are missing
+             */
+            public static decode(reader: ($protobuf.Reader|Uint8Array), length?: number): flyteidl.admin.ProjectDomainQuotaUsageGetResponse;
+
+            /**
+             * Verifies a ProjectDomainQuotaUsageGetResponse message.
+             * @param message Plain object to verify
+             * @returns `null` if valid, otherwise the reason why it is not
+             */
+            public static verify(message: { [k: string]: any }): (string|null);
+        }
+
         /** Properties of a SignalGetOrCreateRequest. */
         interface ISignalGetOrCreateRequest {
 
@@ -22761,6 +23345,13 @@ export namespace flyteidl {
              */
             public deleteProjectDomainAttributes(request: flyteidl.admin.IProjectDomainAttributesDeleteRequest, callback: flyteidl.service.AdminService.DeleteProjectDomainAttributesCallback): void;
 
+            /**
+             * Calls GetProjectDomainQuotaUsage.
+             * @param request ProjectDomainQuotaUsageGetRequest message or plain object
+             * @param callback Node-style callback called with the error, if any, and ProjectDomainQuotaUsageGetResponse
+             */
+            public getProjectDomainQuotaUsage(request: flyteidl.admin.IProjectDomainQuotaUsageGetRequest, callback: flyteidl.service.AdminService.GetProjectDomainQuotaUsageCallback): void;
+
             /**
              * Calls DeleteProjectDomainAttributes.
              * @param request ProjectDomainAttributesDeleteRequest message or plain object
@@ -22963,6 +23554,20 @@ export namespace flyteidl {
              * @returns Promise
              */
             public getExecutionMetrics(request: flyteidl.admin.IWorkflowExecutionGetMetricsRequest): Promise<flyteidl.admin.WorkflowExecutionGetMetricsResponse>;
+
+            /**
+             * Calls GetExecutionAnalysis.
+             * @param request WorkflowExecutionGetAnalysisRequest message or plain object
+             * @param callback Node-style callback called with the error, if any, and WorkflowExecutionGetAnalysisResponse
+             */
+            public getExecutionAnalysis(request: flyteidl.admin.IWorkflowExecutionGetAnalysisRequest, callback: flyteidl.service.AdminService.GetExecutionAnalysisCallback): void;
+
+            /**
+             * Calls GetExecutionAnalysis.
+             * @param request WorkflowExecutionGetAnalysisRequest message or plain object
+             * @returns Promise
+             */
+            public getExecutionAnalysis(request: flyteidl.admin.IWorkflowExecutionGetAnalysisRequest): Promise<flyteidl.admin.WorkflowExecutionGetAnalysisResponse>;
         }
 
         namespace AdminService {
@@ -23261,6 +23866,13 @@ export namespace flyteidl {
              */
             type DeleteProjectDomainAttributesCallback = (error: (Error|null), response?: flyteidl.admin.ProjectDomainAttributesDeleteResponse) => void;
 
+            /**
+             * Callback as used by {@link flyteidl.service.AdminService#getProjectDomainQuotaUsage}.
+             * @param error Error, if any
+             * @param [response] ProjectDomainQuotaUsageGetResponse
+             */
+            type GetProjectDomainQuotaUsageCallback = (error: (Error|null), response?: flyteidl.admin.ProjectDomainQuotaUsageGetResponse) => void;
+
             /**
              * Callback as used by {@link flyteidl.service.AdminService#updateProjectAttributes}.
              * @param error Error, if any
@@ -23358,6 +23970,13 @@ export namespace flyteidl {
              * @param [response] WorkflowExecutionGetMetricsResponse
              */
             type GetExecutionMetricsCallback = (error: (Error|null), response?: flyteidl.admin.WorkflowExecutionGetMetricsResponse) => void;
+
+            /**
+             * Callback as used by {@link flyteidl.service.AdminService#getExecutionAnalysis}.
+             * @param error Error, if any
+             * @param [response] WorkflowExecutionGetAnalysisResponse
+             */
+            type GetExecutionAnalysisCallback = (error: (Error|null), response?: flyteidl.admin.WorkflowExecutionGetAnalysisResponse) => void;
         }
 
         /** Represents a SyncAgentService */
@@ -37614,6 +37614,890 @@
                 return WorkflowExecutionGetMetricsResponse;
             })();
     
+            admin.WorkflowExecutionGetAnalysisRequest = (function() {
+    
+                /**
+                 * Properties of a WorkflowExecutionGetAnalysisRequest.
+                 * @memberof flyteidl.admin
+                 * @interface IWorkflowExecutionGetAnalysisRequest
+                 * @property {flyteidl.core.IWorkflowExecutionIdentifier|null} [id] WorkflowExecutionGetAnalysisRequest id
+                 * @property {number|null} [depth] WorkflowExecutionGetAnalysisRequest depth
+                 */
+    
+                /**
+                 * Constructs a new WorkflowExecutionGetAnalysisRequest.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a WorkflowExecutionGetAnalysisRequest.
+                 * @implements IWorkflowExecutionGetAnalysisRequest
+                 * @constructor
+                 * @param {flyteidl.admin.IWorkflowExecutionGetAnalysisRequest=} [properties] Properties to set
+                 */
+                function WorkflowExecutionGetAnalysisRequest(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * WorkflowExecutionGetAnalysisRequest id.
+                 * @member {flyteidl.core.IWorkflowExecutionIdentifier|null|undefined} id
+                 * @memberof flyteidl.admin.WorkflowExecutionGetAnalysisRequest
+                 * @instance
+                 */
+                WorkflowExecutionGetAnalysisRequest.prototype.id = null;
+    
+                /**
+                 * WorkflowExecutionGetAnalysisRequest depth.
+                 * @member {number} depth
+                 * @memberof flyteidl.admin.WorkflowExecutionGetAnalysisRequest
+                 * @instance
+                 */
+                WorkflowExecutionGetAnalysisRequest.prototype.depth = 0;
+    
+                /**
+                 * Creates a new WorkflowExecutionGetAnalysisRequest instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.WorkflowExecutionGetAnalysisRequest
+                 * @static
+                 * @param {flyteidl.admin.IWorkflowExecutionGetAnalysisRequest=} [properties] Properties to set
+                 * @returns {flyteidl.admin.WorkflowExecutionGetAnalysisRequest} WorkflowExecutionGetAnalysisRequest instance
+                 */
+                WorkflowExecutionGetAnalysisRequest.create = function create(properties) {
+                    return new WorkflowExecutionGetAnalysisRequest(properties);
+                };
+    
+                /**
+                 * Encodes the specified WorkflowExecutionGetAnalysisRequest message. Does not implicitly {@link flyteidl.admin.WorkflowExecutionGetAnalysisRequest.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.WorkflowExecutionGetAnalysisRequest
+                 * @static
+                 * @param {flyteidl.admin.IWorkflowExecutionGetAnalysisRequest} message WorkflowExecutionGetAnalysisRequest message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                WorkflowExecutionGetAnalysisRequest.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.id != null && message.hasOwnProperty("id"))
+                        $root.flyteidl.core.WorkflowExecutionIdentifier.encode(message.id, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.depth != null && message.hasOwnProperty("depth"))
+                        writer.uint32(/* id 2, wireType 0 =*/16).int32(message.depth);
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a WorkflowExecutionGetAnalysisRequest message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.WorkflowExecutionGetAnalysisRequest
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.WorkflowExecutionGetAnalysisRequest} WorkflowExecutionGetAnalysisRequest
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                WorkflowExecutionGetAnalysisRequest.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.WorkflowExecutionGetAnalysisRequest();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.id = $root.flyteidl.core.WorkflowExecutionIdentifier.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            message.depth = reader.int32();
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a WorkflowExecutionGetAnalysisRequest message.
+                 * @function verify
+                 * @memberof flyteidl.admin.WorkflowExecutionGetAnalysisRequest
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                WorkflowExecutionGetAnalysisRequest.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.id != null && message.hasOwnProperty("id")) {
+                        var error = $root.flyteidl.core.WorkflowExecutionIdentifier.verify(message.id);
+                        if (error)
+                            return "id." + error;
+                    }
+                    if (message.depth != null && message.hasOwnProperty("depth"))
+                        if (!$util.isInteger(message.depth))
+                            return "depth: integer expected";
+                    return null;
+                };
+    
+                return WorkflowExecutionGetAnalysisRequest;
+            })();
+    
+            admin.ActivityDuration = (function() {
+    
+                /**
+                 * Properties of an ActivityDuration.
+                 * @memberof flyteidl.admin
+                 * @interface IActivityDuration
+                 * @property {flyteidl.admin.ActivityDuration.Activity|null} [activity] ActivityDuration activity
+                 * @property {google.protobuf.IDuration|null} [duration] ActivityDuration duration
+                 */
+    
+                /**
+                 * Constructs a new ActivityDuration.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents an ActivityDuration.
+                 * @implements IActivityDuration
+                 * @constructor
+                 * @param {flyteidl.admin.IActivityDuration=} [properties] Properties to set
+                 */
+                function ActivityDuration(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * ActivityDuration activity.
+                 * @member {flyteidl.admin.ActivityDuration.Activity} activity
+                 * @memberof flyteidl.admin.ActivityDuration
+                 * @instance
+                 */
+                ActivityDuration.prototype.activity = 0;
+    
+                /**
+                 * ActivityDuration duration.
+                 * @member {google.protobuf.IDuration|null|undefined} duration
+                 * @memberof flyteidl.admin.ActivityDuration
+                 * @instance
+                 */
+                ActivityDuration.prototype.duration = null;
+    
+                /**
+                 * Creates a new ActivityDuration instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.ActivityDuration
+                 * @static
+                 * @param {flyteidl.admin.IActivityDuration=} [properties] Properties to set
+                 * @returns {flyteidl.admin.ActivityDuration} ActivityDuration instance
+                 */
+                ActivityDuration.create = function create(properties) {
+                    return new ActivityDuration(properties);
+                };
+    
+                /**
+                 * Encodes the specified ActivityDuration message. Does not implicitly {@link flyteidl.admin.ActivityDuration.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.ActivityDuration
+                 * @static
+                 * @param {flyteidl.admin.IActivityDuration} message ActivityDuration message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                ActivityDuration.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.activity != null && message.hasOwnProperty("activity"))
+                        writer.uint32(/* id 1, wireType 0 =*/8).int32(message.activity);
+                    if (message.duration != null && message.hasOwnProperty("duration"))
+                        $root.google.protobuf.Duration.encode(message.duration, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes an ActivityDuration message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.ActivityDuration
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.ActivityDuration} ActivityDuration
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                ActivityDuration.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ActivityDuration();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.activity = reader.int32();
+                            break;
+                        case 2:
+                            message.duration = $root.google.protobuf.Duration.decode(reader, reader.uint32());
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies an ActivityDuration message.
+                 * @function verify
+                 * @memberof flyteidl.admin.ActivityDuration
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                ActivityDuration.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.activity != null && message.hasOwnProperty("activity"))
+                        switch (message.activity) {
+                        default:
+                            return "activity: enum value expected";
+                        case 0:
+                        case 1:
+                        case 2:
+                        case 3:
+                        case 4:
+                        case 5:
+                        case 6:
+                            break;
+                        }
+                    if (message.duration != null && message.hasOwnProperty("duration")) {
+                        var error = $root.google.protobuf.Duration.verify(message.duration);
+                        if (error)
+                            return "duration." + error;
+                    }
+                    return null;
+                };
+    
+                /**
+                 * Activity enum.
+                 * @name flyteidl.admin.ActivityDuration.Activity
+                 * @enum {string}
+                 * @property {number} PROPELLER_OVERHEAD=0 PROPELLER_OVERHEAD value
+                 * @property {number} QUEUEING=1 QUEUEING value
+                 * @property {number} POD_SCHEDULING=2 POD_SCHEDULING value
+                 * @property {number} IMAGE_PULL=3 IMAGE_PULL value
+                 * @property {number} INITIALIZATION=4 INITIALIZATION value
+                 * @property {number} RUNTIME=5 RUNTIME value
+                 * @property {number} IDLE=6 IDLE value
+                 */
+                ActivityDuration.Activity = (function() {
+                    var valuesById = {}, values = Object.create(valuesById);
+                    values[valuesById[0] = "PROPELLER_OVERHEAD"] = 0;
+                    values[valuesById[1] = "QUEUEING"] = 1;
+                    values[valuesById[2] = "POD_SCHEDULING"] = 2;
+                    values[valuesById[3] = "IMAGE_PULL"] = 3;
+                    values[valuesById[4] = "INITIALIZATION"] = 4;
+                    values[valuesById[5] = "RUNTIME"] = 5;
+                    values[valuesById[6] = "IDLE"] = 6;
+                    return values;
+                })();
+    
+                return ActivityDuration;
+            })();
+    
+            admin.CriticalPathSegment = (function() {
+    
+                /**
+                 * Properties of a CriticalPathSegment.
+                 * @memberof flyteidl.admin
+                 * @interface ICriticalPathSegment
+                 * @property {google.protobuf.ITimestamp|null} [startTime] CriticalPathSegment startTime
+                 * @property {google.protobuf.ITimestamp|null} [endTime] CriticalPathSegment endTime
+                 * @property {flyteidl.admin.ActivityDuration.Activity|null} [activity] CriticalPathSegment activity
+                 * @property {string|null} [operationId] CriticalPathSegment operationId
+                 * @property {string|null} [nodeId] CriticalPathSegment nodeId
+                 * @property {string|null} [taskName] CriticalPathSegment taskName
+                 */
+    
+                /**
+                 * Constructs a new CriticalPathSegment.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a CriticalPathSegment.
+                 * @implements ICriticalPathSegment
+                 * @constructor
+                 * @param {flyteidl.admin.ICriticalPathSegment=} [properties] Properties to set
+                 */
+                function CriticalPathSegment(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * CriticalPathSegment startTime.
+                 * @member {google.protobuf.ITimestamp|null|undefined} startTime
+                 * @memberof flyteidl.admin.CriticalPathSegment
+                 * @instance
+                 */
+                CriticalPathSegment.prototype.startTime = null;
+    
+                /**
+                 * CriticalPathSegment endTime.
+                 * @member {google.protobuf.ITimestamp|null|undefined} endTime
+                 * @memberof flyteidl.admin.CriticalPathSegment
+                 * @instance
+                 */
+                CriticalPathSegment.prototype.endTime = null;
+    
+                /**
+                 * CriticalPathSegment activity.
+                 * @member {flyteidl.admin.ActivityDuration.Activity} activity
+                 * @memberof flyteidl.admin.CriticalPathSegment
+                 * @instance
+                 */
+                CriticalPathSegment.prototype.activity = 0;
+    
+                /**
+                 * CriticalPathSegment operationId.
+                 * @member {string} operationId
+                 * @memberof flyteidl.admin.CriticalPathSegment
+                 * @instance
+                 */
+                CriticalPathSegment.prototype.operationId = "";
+    
+                /**
+                 * CriticalPathSegment nodeId.
+                 * @member {string} nodeId
+                 * @memberof flyteidl.admin.CriticalPathSegment
+                 * @instance
+                 */
+                CriticalPathSegment.prototype.nodeId = "";
+    
+                /**
+                 * CriticalPathSegment taskName.
+                 * @member {string} taskName
+                 * @memberof flyteidl.admin.CriticalPathSegment
+                 * @instance
+                 */
+                CriticalPathSegment.prototype.taskName = "";
+    
+                /**
+                 * Creates a new CriticalPathSegment instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.CriticalPathSegment
+                 * @static
+                 * @param {flyteidl.admin.ICriticalPathSegment=} [properties] Properties to set
+                 * @returns {flyteidl.admin.CriticalPathSegment} CriticalPathSegment instance
+                 */
+                CriticalPathSegment.create = function create(properties) {
+                    return new CriticalPathSegment(properties);
+                };
+    
+                /**
+                 * Encodes the specified CriticalPathSegment message. Does not implicitly {@link flyteidl.admin.CriticalPathSegment.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.CriticalPathSegment
+                 * @static
+                 * @param {flyteidl.admin.ICriticalPathSegment} message CriticalPathSegment message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                CriticalPathSegment.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.startTime != null && message.hasOwnProperty("startTime"))
+                        $root.google.protobuf.Timestamp.encode(message.startTime, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.endTime != null && message.hasOwnProperty("endTime"))
+                        $root.google.protobuf.Timestamp.encode(message.endTime, writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.activity != null && message.hasOwnProperty("activity"))
+                        writer.uint32(/* id 3, wireType 0 =*/24).int32(message.activity);
+                    if (message.operationId != null && message.hasOwnProperty("operationId"))
+                        writer.uint32(/* id 4, wireType 2 =*/34).string(message.operationId);
+                    if (message.nodeId != null && message.hasOwnProperty("nodeId"))
+                        writer.uint32(/* id 5, wireType 2 =*/42).string(message.nodeId);
+                    if (message.taskName != null && message.hasOwnProperty("taskName"))
+                        writer.uint32(/* id 6, wireType 2 =*/50).string(message.taskName);
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a CriticalPathSegment message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.CriticalPathSegment
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.CriticalPathSegment} CriticalPathSegment
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                CriticalPathSegment.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.CriticalPathSegment();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.startTime = $root.google.protobuf.Timestamp.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            message.endTime = $root.google.protobuf.Timestamp.decode(reader, reader.uint32());
+                            break;
+                        case 3:
+                            message.activity = reader.int32();
+                            break;
+                        case 4:
+                            message.operationId = reader.string();
+                            break;
+                        case 5:
+                            message.nodeId = reader.string();
+                            break;
+                        case 6:
+                            message.taskName = reader.string();
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a CriticalPathSegment message.
+                 * @function verify
+                 * @memberof flyteidl.admin.CriticalPathSegment
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                CriticalPathSegment.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.startTime != null && message.hasOwnProperty("startTime")) {
+                        var error = $root.google.protobuf.Timestamp.verify(message.startTime);
+                        if (error)
+                            return "startTime." + error;
+                    }
+                    if (message.endTime != null && message.hasOwnProperty("endTime")) {
+                        var error = $root.google.protobuf.Timestamp.verify(message.endTime);
+                        if (error)
+                            return "endTime." + error;
+                    }
+                    if (message.activity != null && message.hasOwnProperty("activity"))
+                        switch (message.activity) {
+                        default:
+                            return "activity: enum value expected";
+                        case 0:
+                        case 1:
+                        case 2:
+                        case 3:
+                        case 4:
+                        case 5:
+                        case 6:
+                            break;
+                        }
+                    if (message.operationId != null && message.hasOwnProperty("operationId"))
+                        if (!$util.isString(message.operationId))
+                            return "operationId: string expected";
+                    if (message.nodeId != null && message.hasOwnProperty("nodeId"))
+                        if (!$util.isString(message.nodeId))
+                            return "nodeId: string expected";
+                    if (message.taskName != null && message.hasOwnProperty("taskName"))
+                        if (!$util.isString(message.taskName))
+                            return "taskName: string expected";
+                    return null;
+                };
+    
+                return CriticalPathSegment;
+            })();
+    
+            admin.CriticalPathNode = (function() {
+    
+                /**
+                 * Properties of a CriticalPathNode.
+                 * @memberof flyteidl.admin
+                 * @interface ICriticalPathNode
+                 * @property {string|null} [nodeId] CriticalPathNode nodeId
+                 * @property {string|null} [taskName] CriticalPathNode taskName
+                 * @property {google.protobuf.IDuration|null} [duration] CriticalPathNode duration
+                 * @property {number|null} [share] CriticalPathNode share
+                 * @property {Array.<flyteidl.admin.IActivityDuration>|null} [breakdown] CriticalPathNode breakdown
+                 */
+    
+                /**
+                 * Constructs a new CriticalPathNode.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a CriticalPathNode.
+                 * @implements ICriticalPathNode
+                 * @constructor
+                 * @param {flyteidl.admin.ICriticalPathNode=} [properties] Properties to set
+                 */
+                function CriticalPathNode(properties) {
+                    this.breakdown = [];
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * CriticalPathNode nodeId.
+                 * @member {string} nodeId
+                 * @memberof flyteidl.admin.CriticalPathNode
+                 * @instance
+                 */
+                CriticalPathNode.prototype.nodeId = "";
+    
+                /**
+                 * CriticalPathNode taskName.
+                 * @member {string} taskName
+                 * @memberof flyteidl.admin.CriticalPathNode
+                 * @instance
+                 */
+                CriticalPathNode.prototype.taskName = "";
+    
+                /**
+                 * CriticalPathNode duration.
+                 * @member {google.protobuf.IDuration|null|undefined} duration
+                 * @memberof flyteidl.admin.CriticalPathNode
+                 * @instance
+                 */
+                CriticalPathNode.prototype.duration = null;
+    
+                /**
+                 * CriticalPathNode share.
+                 * @member {number} share
+                 * @memberof flyteidl.admin.CriticalPathNode
+                 * @instance
+                 */
+                CriticalPathNode.prototype.share = 0;
+    
+                /**
+                 * CriticalPathNode breakdown.
+                 * @member {Array.<flyteidl.admin.IActivityDuration>} breakdown
+                 * @memberof flyteidl.admin.CriticalPathNode
+                 * @instance
+                 */
+                CriticalPathNode.prototype.breakdown = $util.emptyArray;
+    
+                /**
+                 * Creates a new CriticalPathNode instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.CriticalPathNode
+                 * @static
+                 * @param {flyteidl.admin.ICriticalPathNode=} [properties] Properties to set
+                 * @returns {flyteidl.admin.CriticalPathNode} CriticalPathNode instance
+                 */
+                CriticalPathNode.create = function create(properties) {
+                    return new CriticalPathNode(properties);
+                };
+    
+                /**
+                 * Encodes the specified CriticalPathNode message. Does not implicitly {@link flyteidl.admin.CriticalPathNode.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.CriticalPathNode
+                 * @static
+                 * @param {flyteidl.admin.ICriticalPathNode} message CriticalPathNode message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                CriticalPathNode.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.nodeId != null && message.hasOwnProperty("nodeId"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.nodeId);
+                    if (message.taskName != null && message.hasOwnProperty("taskName"))
+                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.taskName);
+                    if (message.duration != null && message.hasOwnProperty("duration"))
+                        $root.google.protobuf.Duration.encode(message.duration, writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
+                    if (message.share != null && message.hasOwnProperty("share"))
+                        writer.uint32(/* id 4, wireType 1 =*/33).double(message.share);
+                    if (message.breakdown != null && message.breakdown.length)
+                        for (var i = 0; i < message.breakdown.length; ++i)
+                            $root.flyteidl.admin.ActivityDuration.encode(message.breakdown[i], writer.uint32(/* id 5, wireType 2 =*/42).fork()).ldelim();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a CriticalPathNode message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.CriticalPathNode
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.CriticalPathNode} CriticalPathNode
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                CriticalPathNode.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.CriticalPathNode();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.nodeId = reader.string();
+                            break;
+                        case 2:
+                            message.taskName = reader.string();
+                            break;
+                        case 3:
+                            message.duration = $root.google.protobuf.Duration.decode(reader, reader.uint32());
+                            break;
+                        case 4:
+                            message.share = reader.double();
+                            break;
+                        case 5:
+                            if (!(message.breakdown && message.breakdown.length))
+                                message.breakdown = [];
+                            message.breakdown.push($root.flyteidl.admin.ActivityDuration.decode(reader, reader.uint32()));
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a CriticalPathNode message.
+                 * @function verify
+                 * @memberof flyteidl.admin.CriticalPathNode
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                CriticalPathNode.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.nodeId != null && message.hasOwnProperty("nodeId"))
+                        if (!$util.isString(message.nodeId))
+                            return "nodeId: string expected";
+                    if (message.taskName != null && message.hasOwnProperty("taskName"))
+                        if (!$util.isString(message.taskName))
+                            return "taskName: string expected";
+                    if (message.duration != null && message.hasOwnProperty("duration")) {
+                        var error = $root.google.protobuf.Duration.verify(message.duration);
+                        if (error)
+                            return "duration." + error;
+                    }
+                    if (message.share != null && message.hasOwnProperty("share"))
+                        if (typeof message.share !== "number")
+                            return "share: number expected";
+                    if (message.breakdown != null && message.hasOwnProperty("breakdown")) {
+                        if (!Array.isArray(message.breakdown))
+                            return "breakdown: array expected";
+                        for (var i = 0; i < message.breakdown.length; ++i) {
+                            var error = $root.flyteidl.admin.ActivityDuration.verify(message.breakdown[i]);
+                            if (error)
+                                return "breakdown." + error;
+                        }
+                    }
+                    return null;
+                };
+    
+                return CriticalPathNode;
+            })();
+    
+            admin.WorkflowExecutionGetAnalysisResponse = (function() {
+    
+                /**
+                 * Properties of a WorkflowExecutionGetAnalysisResponse.
+                 * @memberof flyteidl.admin
+                 * @interface IWorkflowExecutionGetAnalysisResponse
+                 * @property {google.protobuf.IDuration|null} [duration] WorkflowExecutionGetAnalysisResponse duration
+                 * @property {Array.<flyteidl.admin.IActivityDuration>|null} [breakdown] WorkflowExecutionGetAnalysisResponse breakdown
+                 * @property {Array.<flyteidl.admin.ICriticalPathSegment>|null} [criticalPath] WorkflowExecutionGetAnalysisResponse criticalPath
+                 * @property {Array.<flyteidl.admin.ICriticalPathNode>|null} [bottlenecks] WorkflowExecutionGetAnalysisResponse bottlenecks
+                 */
+    
+                /**
+                 * Constructs a new WorkflowExecutionGetAnalysisResponse.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a WorkflowExecutionGetAnalysisResponse.
+                 * @implements IWorkflowExecutionGetAnalysisResponse
+                 * @constructor
+                 * @param {flyteidl.admin.IWorkflowExecutionGetAnalysisResponse=} [properties] Properties to set
+                 */
+                function WorkflowExecutionGetAnalysisResponse(properties) {
+                    this.breakdown = [];
+                    this.criticalPath = [];
+                    this.bottlenecks = [];
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * WorkflowExecutionGetAnalysisResponse duration.
+                 * @member {google.protobuf.IDuration|null|undefined} duration
+                 * @memberof flyteidl.admin.WorkflowExecutionGetAnalysisResponse
+                 * @instance
+                 */
+                WorkflowExecutionGetAnalysisResponse.prototype.duration = null;
+    
+                /**
+                 * WorkflowExecutionGetAnalysisResponse breakdown.
+                 * @member {Array.<flyteidl.admin.IActivityDuration>} breakdown
+                 * @memberof flyteidl.admin.WorkflowExecutionGetAnalysisResponse
+                 * @instance
+                 */
+                WorkflowExecutionGetAnalysisResponse.prototype.breakdown = $util.emptyArray;
+    
+                /**
+                 * WorkflowExecutionGetAnalysisResponse criticalPath.
+                 * @member {Array.<flyteidl.admin.ICriticalPathSegment>} criticalPath
+                 * @memberof flyteidl.admin.WorkflowExecutionGetAnalysisResponse
+                 * @instance
+                 */
+                WorkflowExecutionGetAnalysisResponse.prototype.criticalPath = $util.emptyArray;
+    
+                /**
+                 * WorkflowExecutionGetAnalysisResponse bottlenecks.
+                 * @member {Array.<flyteidl.admin.ICriticalPathNode>} bottlenecks
+                 * @memberof flyteidl.admin.WorkflowExecutionGetAnalysisResponse
+                 * @instance
+                 */
+                WorkflowExecutionGetAnalysisResponse.prototype.bottlenecks = $util.emptyArray;
+    
+                /**
+                 * Creates a new WorkflowExecutionGetAnalysisResponse instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.WorkflowExecutionGetAnalysisResponse
+                 * @static
+                 * @param {flyteidl.admin.IWorkflowExecutionGetAnalysisResponse=} [properties] Properties to set
+                 * @returns {flyteidl.admin.WorkflowExecutionGetAnalysisResponse} WorkflowExecutionGetAnalysisResponse instance
+                 */
+                WorkflowExecutionGetAnalysisResponse.create = function create(properties) {
+                    return new WorkflowExecutionGetAnalysisResponse(properties);
+                };
+    
+                /**
+                 * Encodes the specified WorkflowExecutionGetAnalysisResponse message. Does not implicitly {@link flyteidl.admin.WorkflowExecutionGetAnalysisResponse.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.WorkflowExecutionGetAnalysisResponse
+                 * @static
+                 * @param {flyteidl.admin.IWorkflowExecutionGetAnalysisResponse} message WorkflowExecutionGetAnalysisResponse message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                WorkflowExecutionGetAnalysisResponse.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.duration != null && message.hasOwnProperty("duration"))
+                        $root.google.protobuf.Duration.encode(message.duration, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.breakdown != null && message.breakdown.length)
+                        for (var i = 0; i < message.breakdown.length; ++i)
+                            $root.flyteidl.admin.ActivityDuration.encode(message.breakdown[i], writer.uint32(/* id 2, wireType 2 =*/18).fork()).ldelim();
+                    if (message.criticalPath != null && message.criticalPath.length)
+                        for (var i = 0; i < message.criticalPath.length; ++i)
+                            $root.flyteidl.admin.CriticalPathSegment.encode(message.criticalPath[i], writer.uint32(/* id 3, wireType 2 =*/26).fork()).ldelim();
+                    if (message.bottlenecks != null && message.bottlenecks.length)
+                        for (var i = 0; i < message.bottlenecks.length; ++i)
+                            $root.flyteidl.admin.CriticalPathNode.encode(message.bottlenecks[i], writer.uint32(/* id 4, wireType 2 =*/34).fork()).ldelim();
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a WorkflowExecutionGetAnalysisResponse message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.WorkflowExecutionGetAnalysisResponse
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.WorkflowExecutionGetAnalysisResponse} WorkflowExecutionGetAnalysisResponse
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                WorkflowExecutionGetAnalysisResponse.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.WorkflowExecutionGetAnalysisResponse();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.duration = $root.google.protobuf.Duration.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            if (!(message.breakdown && message.breakdown.length))
+                                message.breakdown = [];
+                            message.breakdown.push($root.flyteidl.admin.ActivityDuration.decode(reader, reader.uint32()));
+                            break;
+                        case 3:
+                            if (!(message.criticalPath && message.criticalPath.length))
+                                message.criticalPath = [];
+                            message.criticalPath.push($root.flyteidl.admin.CriticalPathSegment.decode(reader, reader.uint32()));
+                            break;
+                        case 4:
+                            if (!(message.bottlenecks && message.bottlenecks.length))
+                                message.bottlenecks = [];
+                            message.bottlenecks.push($root.flyteidl.admin.CriticalPathNode.decode(reader, reader.uint32()));
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a WorkflowExecutionGetAnalysisResponse message.
+                 * @function verify
+                 * @memberof flyteidl.admin.WorkflowExecutionGetAnalysisResponse
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                WorkflowExecutionGetAnalysisResponse.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.duration != null && message.hasOwnProperty("duration")) {
+                        var error = $root.google.protobuf.Duration.verify(message.duration);
+                        if (error)
+                            return "duration." + error;
+                    }
+                    if (message.breakdown != null && message.hasOwnProperty("breakdown")) {
+                        if (!Array.isArray(message.breakdown))
+                            return "breakdown: array expected";
+                        for (var i = 0; i < message.breakdown.length; ++i) {
+                            var error = $root.flyteidl.admin.ActivityDuration.verify(message.breakdown[i]);
+                            if (error)
+                                return "breakdown." + error;
+                        }
+                    }
+                    if (message.criticalPath != null && message.hasOwnProperty("criticalPath")) {
+                        if (!Array.isArray(message.criticalPath))
+                            return "criticalPath: array expected";
+                        for (var i = 0; i < message.criticalPath.length; ++i) {
+                            var error = $root.flyteidl.admin.CriticalPathSegment.verify(message.criticalPath[i]);
+                            if (error)
+                                return "criticalPath." + error;
+                        }
+                    }
+                    if (message.bottlenecks != null && message.hasOwnProperty("bottlenecks")) {
+                        if (!Array.isArray(message.bottlenecks))
+                            return "bottlenecks: array expected";
+                        for (var i = 0; i < message.bottlenecks.length; ++i) {
+                            var error = $root.flyteidl.admin.CriticalPathNode.verify(message.bottlenecks[i]);
+                            if (error)
+                                return "bottlenecks." + error;
+                        }
+                    }
+                    return null;
+                };
+    
+                return WorkflowExecutionGetAnalysisResponse;
+            })();
+    
             /**
              * MatchableResource enum.
              * @name flyteidl.admin.MatchableResource
@@ -37626,6 +38510,7 @@
              * @property {number} PLUGIN_OVERRIDE=5 PLUGIN_OVERRIDE value
              * @property {number} WORKFLOW_EXECUTION_CONFIG=6 WORKFLOW_EXECUTION_CONFIG value
              * @property {number} CLUSTER_ASSIGNMENT=7 CLUSTER_ASSIGNMENT value
+             * @property {number} PROJECT_QUOTA=8 PROJECT_QUOTA value
              */
             admin.MatchableResource = (function() {
                 var valuesById = {}, values = Object.create(valuesById);
@@ -37637,6 +38522,7 @@
                 values[valuesById[5] = "PLUGIN_OVERRIDE"] = 5;
                 values[valuesById[6] = "WORKFLOW_EXECUTION_CONFIG"] = 6;
                 values[valuesById[7] = "CLUSTER_ASSIGNMENT"] = 7;
+                values[valuesById[8] = "PROJECT_QUOTA"] = 8;
                 return values;
             })();
     
@@ -38855,7 +39741,204 @@
                     return null;
                 };
     
-                return WorkflowExecutionConfig;
+                return WorkflowExecutionConfig;
+            })();
+    
+            admin.ProjectQuotaAttributes = (function() {
+    
+                /**
+                 * Properties of a ProjectQuotaAttributes.
+                 * @memberof flyteidl.admin
+                 * @interface IProjectQuotaAttributes
+                 * @property {number|null} [maxConcurrentExecutions] ProjectQuotaAttributes maxConcurrentExecutions
+                 * @property {number|null} [maxRunningTasks] ProjectQuotaAttributes maxRunningTasks
+                 * @property {string|null} [maxCpu] ProjectQuotaAttributes maxCpu
+                 * @property {string|null} [maxGpu] ProjectQuotaAttributes maxGpu
+                 * @property {flyteidl.admin.ProjectQuotaAttributes.OverflowBehavior|null} [overflow] ProjectQuotaAttributes overflow
+                 */
+    
+                /**
+                 * Constructs a new ProjectQuotaAttributes.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a ProjectQuotaAttributes.
+                 * @implements IProjectQuotaAttributes
+                 * @constructor
+                 * @param {flyteidl.admin.IProjectQuotaAttributes=} [properties] Properties to set
+                 */
+                function ProjectQuotaAttributes(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * ProjectQuotaAttributes maxConcurrentExecutions.
+                 * @member {number} maxConcurrentExecutions
+                 * @memberof flyteidl.admin.ProjectQuotaAttributes
+                 * @instance
+                 */
+                ProjectQuotaAttributes.prototype.maxConcurrentExecutions = 0;
+    
+                /**
+                 * ProjectQuotaAttributes maxRunningTasks.
+                 * @member {number} maxRunningTasks
+                 * @memberof flyteidl.admin.ProjectQuotaAttributes
+                 * @instance
+                 */
+                ProjectQuotaAttributes.prototype.maxRunningTasks = 0;
+    
+                /**
+                 * ProjectQuotaAttributes maxCpu.
+                 * @member {string} maxCpu
+                 * @memberof flyteidl.admin.ProjectQuotaAttributes
+                 * @instance
+                 */
+                ProjectQuotaAttributes.prototype.maxCpu = "";
+    
+                /**
+                 * ProjectQuotaAttributes maxGpu.
+                 * @member {string} maxGpu
+                 * @memberof flyteidl.admin.ProjectQuotaAttributes
+                 * @instance
+                 */
+                ProjectQuotaAttributes.prototype.maxGpu = "";
+    
+                /**
+                 * ProjectQuotaAttributes overflow.
+                 * @member {flyteidl.admin.ProjectQuotaAttributes.OverflowBehavior} overflow
+                 * @memberof flyteidl.admin.ProjectQuotaAttributes
+                 * @instance
+                 */
+                ProjectQuotaAttributes.prototype.overflow = 0;
+    
+                /**
+                 * Creates a new ProjectQuotaAttributes instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.ProjectQuotaAttributes
+                 * @static
+                 * @param {flyteidl.admin.IProjectQuotaAttributes=} [properties] Properties to set
+                 * @returns {flyteidl.admin.ProjectQuotaAttributes} ProjectQuotaAttributes instance
+                 */
+                ProjectQuotaAttributes.create = function create(properties) {
+                    return new ProjectQuotaAttributes(properties);
+                };
+    
+                /**
+                 * Encodes the specified ProjectQuotaAttributes message. Does not implicitly {@link flyteidl.admin.ProjectQuotaAttributes.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.ProjectQuotaAttributes
+                 * @static
+                 * @param {flyteidl.admin.IProjectQuotaAttributes} message ProjectQuotaAttributes message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                ProjectQuotaAttributes.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.maxConcurrentExecutions != null && message.hasOwnProperty("maxConcurrentExecutions"))
+                        writer.uint32(/* id 1, wireType 0 =*/8).int32(message.maxConcurrentExecutions);
+                    if (message.maxRunningTasks != null && message.hasOwnProperty("maxRunningTasks"))
+                        writer.uint32(/* id 2, wireType 0 =*/16).int32(message.maxRunningTasks);
+                    if (message.maxCpu != null && message.hasOwnProperty("maxCpu"))
+                        writer.uint32(/* id 3, wireType 2 =*/26).string(message.maxCpu);
+                    if (message.maxGpu != null && message.hasOwnProperty("maxGpu"))
+                        writer.uint32(/* id 4, wireType 2 =*/34).string(message.maxGpu);
+                    if (message.overflow != null && message.hasOwnProperty("overflow"))
+                        writer.uint32(/* id 5, wireType 0 =*/40).int32(message.overflow);
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a ProjectQuotaAttributes message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.ProjectQuotaAttributes
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.ProjectQuotaAttributes} ProjectQuotaAttributes
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                ProjectQuotaAttributes.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ProjectQuotaAttributes();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.maxConcurrentExecutions = reader.int32();
+                            break;
+                        case 2:
+                            message.maxRunningTasks = reader.int32();
+                            break;
+                        case 3:
+                            message.maxCpu = reader.string();
+                            break;
+                        case 4:
+                            message.maxGpu = reader.string();
+                            break;
+                        case 5:
+                            message.overflow = reader.int32();
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a ProjectQuotaAttributes message.
+                 * @function verify
+                 * @memberof flyteidl.admin.ProjectQuotaAttributes
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                ProjectQuotaAttributes.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.maxConcurrentExecutions != null && message.hasOwnProperty("maxConcurrentExecutions"))
+                        if (!$util.isInteger(message.maxConcurrentExecutions))
+                            return "maxConcurrentExecutions: integer expected";
+                    if (message.maxRunningTasks != null && message.hasOwnProperty("maxRunningTasks"))
+                        if (!$util.isInteger(message.maxRunningTasks))
+                            return "maxRunningTasks: integer expected";
+                    if (message.maxCpu != null && message.hasOwnProperty("maxCpu"))
+                        if (!$util.isString(message.maxCpu))
+                            return "maxCpu: string expected";
+                    if (message.maxGpu != null && message.hasOwnProperty("maxGpu"))
+                        if (!$util.isString(message.maxGpu))
+                            return "maxGpu: string expected";
+                    if (message.overflow != null && message.hasOwnProperty("overflow"))
+                        switch (message.overflow) {
+                        default:
+                            return "overflow: enum value expected";
+                        case 0:
+                        case 1:
+                            break;
+                        }
+                    return null;
+                };
+    
+                /**
+                 * OverflowBehavior enum.
+                 * @name flyteidl.admin.ProjectQuotaAttributes.OverflowBehavior
+                 * @enum {string}
+                 * @property {number} REJECT=0 REJECT value
+                 * @property {number} QUEUE=1 QUEUE value
+                 */
+                ProjectQuotaAttributes.OverflowBehavior = (function() {
+                    var valuesById = {}, values = Object.create(valuesById);
+                    values[valuesById[0] = "REJECT"] = 0;
+                    values[valuesById[1] = "QUEUE"] = 1;
+                    return values;
+                })();
+    
+                return ProjectQuotaAttributes;
             })();
     
             admin.MatchingAttributes = (function() {
@@ -38872,6 +39955,7 @@
                  * @property {flyteidl.admin.IPluginOverrides|null} [pluginOverrides] MatchingAttributes pluginOverrides
                  * @property {flyteidl.admin.IWorkflowExecutionConfig|null} [workflowExecutionConfig] MatchingAttributes workflowExecutionConfig
                  * @property {flyteidl.admin.IClusterAssignment|null} [clusterAssignment] MatchingAttributes clusterAssignment
+                 * @property {flyteidl.admin.IProjectQuotaAttributes|null} [projectQuotaAttributes] MatchingAttributes projectQuotaAttributes
                  */
     
                 /**
@@ -38953,17 +40037,25 @@
                  */
                 MatchingAttributes.prototype.clusterAssignment = null;
     
+                /**
+                 * MatchingAttributes projectQuotaAttributes.
+                 * @member {flyteidl.admin.IProjectQuotaAttributes|null|undefined} projectQuotaAttributes
+                 * @memberof flyteidl.admin.MatchingAttributes
+                 * @instance
+                 */
+                MatchingAttributes.prototype.projectQuotaAttributes = null;
+    
                 // OneOf field names bound to virtual getters and setters
                 var $oneOfFields;
     
                 /**
                  * MatchingAttributes target.
-                 * @member {"taskResourceAttributes"|"clusterResourceAttributes"|"executionQueueAttributes"|"executionClusterLabel"|"qualityOfService"|"pluginOverrides"|"workflowExecutionConfig"|"clusterAssignment"|undefined} target
+                 * @member {"taskResourceAttributes"|"clusterResourceAttributes"|"executionQueueAttributes"|"executionClusterLabel"|"qualityOfService"|"pluginOverrides"|"workflowExecutionConfig"|"clusterAssignment"|"projectQuotaAttributes"|undefined} target
                  * @memberof flyteidl.admin.MatchingAttributes
                  * @instance
                  */
                 Object.defineProperty(MatchingAttributes.prototype, "target", {
-                    get: $util.oneOfGetter($oneOfFields = ["taskResourceAttributes", "clusterResourceAttributes", "executionQueueAttributes", "executionClusterLabel", "qualityOfService", "pluginOverrides", "workflowExecutionConfig", "clusterAssignment"]),
+                    get: $util.oneOfGetter($oneOfFields = ["taskResourceAttributes", "clusterResourceAttributes", "executionQueueAttributes", "executionClusterLabel", "qualityOfService", "pluginOverrides", "workflowExecutionConfig", "clusterAssignment", "projectQuotaAttributes"]),
                     set: $util.oneOfSetter($oneOfFields)
                 });
     
@@ -39007,6 +40099,8 @@
                         $root.flyteidl.admin.WorkflowExecutionConfig.encode(message.workflowExecutionConfig, writer.uint32(/* id 7, wireType 2 =*/58).fork()).ldelim();
                     if (message.clusterAssignment != null && message.hasOwnProperty("clusterAssignment"))
                         $root.flyteidl.admin.ClusterAssignment.encode(message.clusterAssignment, writer.uint32(/* id 8, wireType 2 =*/66).fork()).ldelim();
+                    if (message.projectQuotaAttributes != null && message.hasOwnProperty("projectQuotaAttributes"))
+                        $root.flyteidl.admin.ProjectQuotaAttributes.encode(message.projectQuotaAttributes, writer.uint32(/* id 9, wireType 2 =*/74).fork()).ldelim();
                     return writer;
                 };
     
@@ -39052,6 +40146,9 @@
                         case 8:
                             message.clusterAssignment = $root.flyteidl.admin.ClusterAssignment.decode(reader, reader.uint32());
                             break;
+                        case 9:
+                            message.projectQuotaAttributes = $root.flyteidl.admin.ProjectQuotaAttributes.decode(reader, reader.uint32());
+                            break;
                         default:
                             reader.skipType(tag & 7);
                             break;
@@ -39150,6 +40247,16 @@
                                 return "clusterAssignment." + error;
                         }
                     }
+                    if (message.projectQuotaAttributes != null && message.hasOwnProperty("projectQuotaAttributes")) {
+                        if (properties.target === 1)
+                            return "target: multiple values";
+                        properties.target = 1;
+                        {
+                            var error = $root.flyteidl.admin.ProjectQuotaAttributes.verify(message.projectQuotaAttributes);
+                            if (error)
+                                return "projectQuotaAttributes." + error;
+                        }
+                    }
                     return null;
                 };
     
@@ -47788,6 +48895,313 @@
                 return ProjectDomainAttributesDeleteResponse;
             })();
     
+            admin.ProjectDomainQuotaUsageGetRequest = (function() {
+    
+                /**
+                 * Properties of a ProjectDomainQuotaUsageGetRequest.
+                 * @memberof flyteidl.admin
+                 * @interface IProjectDomainQuotaUsageGetRequest
+                 * @property {string|null} [project] ProjectDomainQuotaUsageGetRequest project
+                 * @property {string|null} [domain] ProjectDomainQuotaUsageGetRequest domain
+                 * @property {string|null} [org] ProjectDomainQuotaUsageGetRequest org
+                 */
+    
+                /**
+                 * Constructs a new ProjectDomainQuotaUsageGetRequest.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a ProjectDomainQuotaUsageGetRequest.
+                 * @implements IProjectDomainQuotaUsageGetRequest
+                 * @constructor
+                 * @param {flyteidl.admin.IProjectDomainQuotaUsageGetRequest=} [properties] Properties to set
+                 */
+                function ProjectDomainQuotaUsageGetRequest(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * ProjectDomainQuotaUsageGetRequest project.
+                 * @member {string} project
+                 * @memberof flyteidl.admin.ProjectDomainQuotaUsageGetRequest
+                 * @instance
+                 */
+                ProjectDomainQuotaUsageGetRequest.prototype.project = "";
+    
+                /**
+                 * ProjectDomainQuotaUsageGetRequest domain.
+                 * @member {string} domain
+                 * @memberof flyteidl.admin.ProjectDomainQuotaUsageGetRequest
+                 * @instance
+                 */
+                ProjectDomainQuotaUsageGetRequest.prototype.domain = "";
+    
+                /**
+                 * ProjectDomainQuotaUsageGetRequest org.
+                 * @member {string} org
+                 * @memberof flyteidl.admin.ProjectDomainQuotaUsageGetRequest
+                 * @instance
+                 */
+                ProjectDomainQuotaUsageGetRequest.prototype.org = "";
+    
+                /**
+                 * Creates a new ProjectDomainQuotaUsageGetRequest instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.ProjectDomainQuotaUsageGetRequest
+                 * @static
+                 * @param {flyteidl.admin.IProjectDomainQuotaUsageGetRequest=} [properties] Properties to set
+                 * @returns {flyteidl.admin.ProjectDomainQuotaUsageGetRequest} ProjectDomainQuotaUsageGetRequest instance
+                 */
+                ProjectDomainQuotaUsageGetRequest.create = function create(properties) {
+                    return new ProjectDomainQuotaUsageGetRequest(properties);
+                };
+    
+                /**
+                 * Encodes the specified ProjectDomainQuotaUsageGetRequest message. Does not implicitly {@link flyteidl.admin.ProjectDomainQuotaUsageGetRequest.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.ProjectDomainQuotaUsageGetRequest
+                 * @static
+                 * @param {flyteidl.admin.IProjectDomainQuotaUsageGetRequest} message ProjectDomainQuotaUsageGetRequest message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                ProjectDomainQuotaUsageGetRequest.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.project != null && message.hasOwnProperty("project"))
+                        writer.uint32(/* id 1, wireType 2 =*/10).string(message.project);
+                    if (message.domain != null && message.hasOwnProperty("domain"))
+                        writer.uint32(/* id 2, wireType 2 =*/18).string(message.domain);
+                    if (message.org != null && message.hasOwnProperty("org"))
+                        writer.uint32(/* id 3, wireType 2 =*/26).string(message.org);
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a ProjectDomainQuotaUsageGetRequest message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.ProjectDomainQuotaUsageGetRequest
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.ProjectDomainQuotaUsageGetRequest} ProjectDomainQuotaUsageGetRequest
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                ProjectDomainQuotaUsageGetRequest.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ProjectDomainQuotaUsageGetRequest();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.project = reader.string();
+                            break;
+                        case 2:
+                            message.domain = reader.string();
+                            break;
+                        case 3:
+                            message.org = reader.string();
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a ProjectDomainQuotaUsageGetRequest message.
+                 * @function verify
+                 * @memberof flyteidl.admin.ProjectDomainQuotaUsageGetRequest
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                ProjectDomainQuotaUsageGetRequest.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.project != null && message.hasOwnProperty("project"))
+                        if (!$util.isString(message.project))
+                            return "project: string expected";
+                    if (message.domain != null && message.hasOwnProperty("domain"))
+                        if (!$util.isString(message.domain))
+                            return "domain: string expected";
+                    if (message.org != null && message.hasOwnProperty("org"))
+                        if (!$util.isString(message.org))
+                            return "org: string expected";
+                    return null;
+                };
+    
+                return ProjectDomainQuotaUsageGetRequest;
+            })();
+    
+            admin.ProjectDomainQuotaUsageGetResponse = (function() {
+    
+                /**
+                 * Properties of a ProjectDomainQuotaUsageGetResponse.
+                 * @memberof flyteidl.admin
+                 * @interface IProjectDomainQuotaUsageGetResponse
+                 * @property {flyteidl.admin.IProjectQuotaAttributes|null} [quota] ProjectDomainQuotaUsageGetResponse quota
+                 * @property {Long|null} [activeExecutions] ProjectDomainQuotaUsageGetResponse activeExecutions
+                 * @property {Long|null} [queuedExecutions] ProjectDomainQuotaUsageGetResponse queuedExecutions
+                 * @property {Long|null} [runningTasks] ProjectDomainQuotaUsageGetResponse runningTasks
+                 */
+    
+                /**
+                 * Constructs a new ProjectDomainQuotaUsageGetResponse.
+                 * @memberof flyteidl.admin
+                 * @classdesc Represents a ProjectDomainQuotaUsageGetResponse.
+                 * @implements IProjectDomainQuotaUsageGetResponse
+                 * @constructor
+                 * @param {flyteidl.admin.IProjectDomainQuotaUsageGetResponse=} [properties] Properties to set
+                 */
+                function ProjectDomainQuotaUsageGetResponse(properties) {
+                    if (properties)
+                        for (var keys = Object.keys(properties), i = 0; i < keys.length; ++i)
+                            if (properties[keys[i]] != null)
+                                this[keys[i]] = properties[keys[i]];
+                }
+    
+                /**
+                 * ProjectDomainQuotaUsageGetResponse quota.
+                 * @member {flyteidl.admin.IProjectQuotaAttributes|null|undefined} quota
+                 * @memberof flyteidl.admin.ProjectDomainQuotaUsageGetResponse
+                 * @instance
+                 */
+                ProjectDomainQuotaUsageGetResponse.prototype.quota = null;
+    
+                /**
+                 * ProjectDomainQuotaUsageGetResponse activeExecutions.
+                 * @member {Long} activeExecutions
+                 * @memberof flyteidl.admin.ProjectDomainQuotaUsageGetResponse
+                 * @instance
+                 */
+                ProjectDomainQuotaUsageGetResponse.prototype.activeExecutions = $util.Long ? $util.Long.fromBits(0,0,false) : 0;
+    
+                /**
+                 * ProjectDomainQuotaUsageGetResponse queuedExecutions.
+                 * @member {Long} queuedExecutions
+                 * @memberof flyteidl.admin.ProjectDomainQuotaUsageGetResponse
+                 * @instance
+                 */
+                ProjectDomainQuotaUsageGetResponse.prototype.queuedExecutions = $util.Long ? $util.Long.fromBits(0,0,false) : 0;
+    
+                /**
+                 * ProjectDomainQuotaUsageGetResponse runningTasks.
+                 * @member {Long} runningTasks
+                 * @memberof flyteidl.admin.ProjectDomainQuotaUsageGetResponse
+                 * @instance
+                 */
+                ProjectDomainQuotaUsageGetResponse.prototype.runningTasks = $util.Long ? $util.Long.fromBits(0,0,false) : 0;
+    
+                /**
+                 * Creates a new ProjectDomainQuotaUsageGetResponse instance using the specified properties.
+                 * @function create
+                 * @memberof flyteidl.admin.ProjectDomainQuotaUsageGetResponse
+                 * @static
+                 * @param {flyteidl.admin.IProjectDomainQuotaUsageGetResponse=} [properties] Properties to set
+                 * @returns {flyteidl.admin.ProjectDomainQuotaUsageGetResponse} ProjectDomainQuotaUsageGetResponse instance
+                 */
+                ProjectDomainQuotaUsageGetResponse.create = function create(properties) {
+                    return new ProjectDomainQuotaUsageGetResponse(properties);
+                };
+    
+                /**
+                 * Encodes the specified ProjectDomainQuotaUsageGetResponse message. Does not implicitly {@link flyteidl.admin.ProjectDomainQuotaUsageGetResponse.verify|verify} messages.
+                 * @function encode
+                 * @memberof flyteidl.admin.ProjectDomainQuotaUsageGetResponse
+                 * @static
+                 * @param {flyteidl.admin.IProjectDomainQuotaUsageGetResponse} message ProjectDomainQuotaUsageGetResponse message or plain object to encode
+                 * @param {$protobuf.Writer} [writer] Writer to encode to
+                 * @returns {$protobuf.Writer} Writer
+                 */
+                ProjectDomainQuotaUsageGetResponse.encode = function encode(message, writer) {
+                    if (!writer)
+                        writer = $Writer.create();
+                    if (message.quota != null && message.hasOwnProperty("quota"))
+                        $root.flyteidl.admin.ProjectQuotaAttributes.encode(message.quota, writer.uint32(/* id 1, wireType 2 =*/10).fork()).ldelim();
+                    if (message.activeExecutions != null && message.hasOwnProperty("activeExecutions"))
+                        writer.uint32(/* id 2, wireType 0 =*/16).int64(message.activeExecutions);
+                    if (message.queuedExecutions != null && message.hasOwnProperty("queuedExecutions"))
+                        writer.uint32(/* id 3, wireType 0 =*/24).int64(message.queuedExecutions);
+                    if (message.runningTasks != null && message.hasOwnProperty("runningTasks"))
+                        writer.uint32(/* id 4, wireType 0 =*/32).int64(message.runningTasks);
+                    return writer;
+                };
+    
+                /**
+                 * Decodes a ProjectDomainQuotaUsageGetResponse message from the specified reader or buffer.
+                 * @function decode
+                 * @memberof flyteidl.admin.ProjectDomainQuotaUsageGetResponse
+                 * @static
+                 * @param {$protobuf.Reader|Uint8Array} reader Reader or buffer to decode from
+                 * @param {number} [length] Message length if known beforehand
+                 * @returns {flyteidl.admin.ProjectDomainQuotaUsageGetResponse} ProjectDomainQuotaUsageGetResponse
+                 * @throws {Error} If the payload is not a reader or valid buffer
+                 * @throws {$protobuf.util.ProtocolError} If required fields are missing
+                 */
+                ProjectDomainQuotaUsageGetResponse.decode = function decode(reader, length) {
+                    if (!(reader instanceof $Reader))
+                        reader = $Reader.create(reader);
+                    var end = length === undefined ? reader.len : reader.pos + length, message = new $root.flyteidl.admin.ProjectDomainQuotaUsageGetResponse();
+                    while (reader.pos < end) {
+                        var tag = reader.uint32();
+                        switch (tag >>> 3) {
+                        case 1:
+                            message.quota = $root.flyteidl.admin.ProjectQuotaAttributes.decode(reader, reader.uint32());
+                            break;
+                        case 2:
+                            message.activeExecutions = reader.int64();
+                            break;
+                        case 3:
+                            message.queuedExecutions = reader.int64();
+                            break;
+                        case 4:
+                            message.runningTasks = reader.int64();
+                            break;
+                        default:
+                            reader.skipType(tag & 7);
+                            break;
+                        }
+                    }
+                    return message;
+                };
+    
+                /**
+                 * Verifies a ProjectDomainQuotaUsageGetResponse message.
+                 * @function verify
+                 * @memberof flyteidl.admin.ProjectDomainQuotaUsageGetResponse
+                 * @static
+                 * @param {Object.<string,*>} message Plain object to verify
+                 * @returns {string|null} `null` if valid, otherwise the reason why it is not
+                 */
+                ProjectDomainQuotaUsageGetResponse.verify = function verify(message) {
+                    if (typeof message !== "object" || message === null)
+                        return "object expected";
+                    if (message.quota != null && message.hasOwnProperty("quota")) {
+                        var error = $root.flyteidl.admin.ProjectQuotaAttributes.verify(message.quota);
+                        if (error)
+                            return "quota." + error;
+                    }
+                    if (message.activeExecutions != null && message.hasOwnProperty("activeExecutions"))
+                        if (!$util.isInteger(message.activeExecutions) && !(message.activeExecutions && $util.isInteger(message.activeExecutions.low) && $util.isInteger(message.activeExecutions.high)))
+                            return "activeExecutions: integer|Long expected";
+                    if (message.queuedExecutions != null && message.hasOwnProperty("queuedExecutions"))
+                        if (!$util.isInteger(message.queuedExecutions) && !(message.queuedExecutions && $util.isInteger(message.queuedExecutions.low) && $util.isInteger(message.queuedExecutions.high)))
+                            return "queuedExecutions: integer|Long expected";
+                    if (message.runningTasks != null && message.hasOwnProperty("runningTasks"))
+                        if (!$util.isInteger(message.runningTasks) && !(message.runningTasks && $util.isInteger(message.runningTasks.low) && $util.isInteger(message.runningTasks.high)))
+                            return "runningTasks: integer|Long expected";
+                    return null;
+                };
+    
+                return ProjectDomainQuotaUsageGetResponse;
+            })();
+    
             admin.SignalGetOrCreateRequest = (function() {
     
                 /**
@@ -54772,6 +56186,39 @@
                  * @variation 2
                  */
     
+                /**
+                 * Callback as used by {@link flyteidl.service.AdminService#getProjectDomainQuotaUsage}.
+                 * @memberof flyteidl.service.AdminService
+                 * @typedef GetProjectDomainQuotaUsageCallback
+                 * @type {function}
+                 * @param {Error|null} error Error, if any
+                 * @param {flyteidl.admin.ProjectDomainQuotaUsageGetResponse} [response] ProjectDomainQuotaUsageGetResponse
+                 */
+    
+                /**
+                 * Calls GetProjectDomainQuotaUsage.
+                 * @function getProjectDomainQuotaUsage
+                 * @memberof flyteidl.service.AdminService
+                 * @instance
+                 * @param {flyteidl.admin.IProjectDomainQuotaUsageGetRequest} request ProjectDomainQuotaUsageGetRequest message or plain object
+                 * @param {flyteidl.service.AdminService.GetProjectDomainQuotaUsageCallback} callback Node-style callback called with the error, if any, and ProjectDomainQuotaUsageGetResponse
+                 * @returns {undefined}
+                 * @variation 1
+                 */
+                Object.defineProperty(AdminService.prototype.getProjectDomainQuotaUsage = function getProjectDomainQuotaUsage(request, callback) {
+                    return this.rpcCall(getProjectDomainQuotaUsage, $root.flyteidl.admin.ProjectDomainQuotaUsageGetRequest, $root.flyteidl.admin.ProjectDomainQuotaUsageGetResponse, request, callback);
+                }, "name", { value: "GetProjectDomainQuotaUsage" });
+    
+                /**
+                 * Calls GetProjectDomainQuotaUsage.
+                 * @function getProjectDomainQuotaUsage
+                 * @memberof flyteidl.service.AdminService
+                 * @instance
+                 * @param {flyteidl.admin.IProjectDomainQuotaUsageGetRequest} request ProjectDomainQuotaUsageGetRequest message or plain object
+                 * @returns {Promise<flyteidl.admin.ProjectDomainQuotaUsageGetResponse>} Promise
+                 * @variation 2
+                 */
+    
                 /**
                  * Callback as used by {@link flyteidl.service.AdminService#updateProjectAttributes}.
                  * @memberof flyteidl.service.AdminService
@@ -55234,6 +56681,39 @@
                  * @variation 2
                  */
     
+                /**
+                 * Callback as used by {@link flyteidl.service.AdminService#getExecutionAnalysis}.
+                 * @memberof flyteidl.service.AdminService
+                 * @typedef GetExecutionAnalysisCallback
+                 * @type {function}
+                 * @param {Error|null} error Error, if any
+                 * @param {flyteidl.admin.WorkflowExecutionGetAnalysisResponse} [response] WorkflowExecutionGetAnalysisResponse
+                 */
+    
+                /**
+                 * Calls GetExecutionAnalysis.
+                 * @function getExecutionAnalysis
+                 * @memberof flyteidl.service.AdminService
+                 * @instance
+                 * @param {flyteidl.admin.IWorkflowExecutionGetAnalysisRequest} request WorkflowExecutionGetAnalysisRequest message or plain object
+                 * @param {flyteidl.service.AdminService.GetExecutionAnalysisCallback} callback Node-style callback called with the error, if any, and WorkflowExecutionGetAnalysisResponse
+                 * @returns {undefined}
+                 * @variation 1
+                 */
+                Object.defineProperty(AdminService.prototype.getExecutionAnalysis = function getExecutionAnalysis(request, callback) {
+                    return this.rpcCall(getExecutionAnalysis, $root.flyteidl.admin.WorkflowExecutionGetAnalysisRequest, $root.flyteidl.admin.WorkflowExecutionGetAnalysisResponse, request, callback);
+                }, "name", { value: "GetExecutionAnalysis" });
+    
+                /**
+                 * Calls GetExecutionAnalysis.
+                 * @function getExecutionAnalysis
+                 * @memberof flyteidl.service.AdminService
+                 * @instance
+                 * @param {flyteidl.admin.IWorkflowExecutionGetAnalysisRequest} request WorkflowExecutionGetAnalysisRequest message or plain object
+                 * @returns {Promise<flyteidl.admin.WorkflowExecutionGetAnalysisResponse>} Promise
+                 * @variation 2
+                 */
+    
                 return AdminService;
             })();
     
@@ -297,3 +297,77 @@ class WorkflowExecutionGetMetricsResponse(_message.Message):
     SPAN_FIELD_NUMBER: _ClassVar[int]
     span: _metrics_pb2.Span
     def __init__(self, span: _Optional[_Union[_metrics_pb2.Span, _Mapping]] = ...) -> None: ...
+
+class WorkflowExecutionGetAnalysisRequest(_message.Message):
+    __slots__ = ["id", "depth"]
+    ID_FIELD_NUMBER: _ClassVar[int]
+    DEPTH_FIELD_NUMBER: _ClassVar[int]
+    id: _identifier_pb2.WorkflowExecutionIdentifier
+    depth: int
+    def __init__(self, id: _Optional[_Union[_identifier_pb2.WorkflowExecutionIdentifier, _Mapping]] = ..., depth: _Optional[int] = ...) -> None: ...
+
+class ActivityDuration(_message.Message):
+    __slots__ = ["activity", "duration"]
+    class Activity(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+        __slots__ = []
+        PROPELLER_OVERHEAD: _ClassVar[ActivityDuration.Activity]
+        QUEUEING: _ClassVar[ActivityDuration.Activity]
+        POD_SCHEDULING: _ClassVar[ActivityDuration.Activity]
+        IMAGE_PULL: _ClassVar[ActivityDuration.Activity]
+        INITIALIZATION: _ClassVar[ActivityDuration.Activity]
+        RUNTIME: _ClassVar[ActivityDuration.Activity]
+        IDLE: _ClassVar[ActivityDuration.Activity]
+    PROPELLER_OVERHEAD: ActivityDuration.Activity
+    QUEUEING: ActivityDuration.Activity
+    POD_SCHEDULING: ActivityDuration.Activity
+    IMAGE_PULL: ActivityDuration.Activity
+    INITIALIZATION: ActivityDuration.Activity
+    RUNTIME: ActivityDuration.Activity
+    IDLE: ActivityDuration.Activity
+    ACTIVITY_FIELD_NUMBER: _ClassVar[int]
+    DURATION_FIELD_NUMBER: _ClassVar[int]
+    activity: ActivityDuration.Activity
+    duration: _duration_pb2.Duration
+    def __init__(self, activity: _Optional[_Union[ActivityDuration.Activity, str]] = ..., duration: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ...) -> None: ...
+
+class CriticalPathSegment(_message.Message):
+    __slots__ = ["start_time", "end_time", "activity", "operation_id", "node_id", "task_name"]
+    START_TIME_FIELD_NUMBER: _ClassVar[int]
+    END_TIME_FIELD_NUMBER: _ClassVar[int]
+    ACTIVITY_FIELD_NUMBER: _ClassVar[int]
+    OPERATION_ID_FIELD_NUMBER: _ClassVar[int]
+    NODE_ID_FIELD_NUMBER: _ClassVar[int]
+    TASK_NAME_FIELD_NUMBER: _ClassVar[int]
+    start_time: _timestamp_pb2.Timestamp
+    end_time: _timestamp_pb2.Timestamp
+    activity: ActivityDuration.Activity
+    operation_id: str
+    node_id: str
+    task_name: str
+    def __init__(self, start_time: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., end_time: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., activity: _Optional[_Union[ActivityDuration.Activity, str]] = ..., operation_id: _Optional[str] = ..., node_id: _Optional[str] = ..., task_name: _Optional[str] = ...) -> None: ...
+
+class CriticalPathNode(_message.Message):
+    __slots__ = ["node_id", "task_name", "duration", "share", "breakdown"]
+    NODE_ID_FIELD_NUMBER: _ClassVar[int]
+    TASK_NAME_FIELD_NUMBER: _ClassVar[int]
+    DURATION_FIELD_NUMBER: _ClassVar[int]
+    SHARE_FIELD_NUMBER: _ClassVar[int]
+    BREAKDOWN_FIELD_NUMBER: _ClassVar[int]
+    node_id: str
+    task_name: str
+    duration: _duration_pb2.Duration
+    share: float
+    breakdown: _containers.RepeatedCompositeFieldContainer[ActivityDuration]
+    def __init__(self, node_id: _Optional[str] = ..., task_name: _Optional[str] = ..., duration: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., share: _Optional[float] = ..., breakdown: _Optional[_Iterable[_Union[ActivityDuration, _Mapping]]] = ...) -> None: ...
+
+class WorkflowExecutionGetAnalysisResponse(_message.Message):
+    __slots__ = ["duration", "breakdown", "critical_path", "bottlenecks"]
+    DURATION_FIELD_NUMBER: _ClassVar[int]
+    BREAKDOWN_FIELD_NUMBER: _ClassVar[int]
+    CRITICAL_PATH_FIELD_NUMBER: _ClassVar[int]
+    BOTTLENECKS_FIELD_NUMBER: _ClassVar[int]
+    duration: _duration_pb2.Duration
+    breakdown: _containers.RepeatedCompositeFieldContainer[ActivityDuration]
+    critical_path: _containers.RepeatedCompositeFieldContainer[CriticalPathSegment]
+    bottlenecks: _containers.RepeatedCompositeFieldContainer[CriticalPathNode]
+    def __init__(self, duration: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., breakdown: _Optional[_Iterable[_Union[ActivityDuration, _Mapping]]] = ..., critical_path: _Optional[_Iterable[_Union[CriticalPathSegment, _Mapping]]] = ..., bottlenecks: _Optional[_Iterable[_Union[CriticalPathNode, _Mapping]]] = ...) -> None: ...
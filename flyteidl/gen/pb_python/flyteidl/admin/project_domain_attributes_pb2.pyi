@@ -60,3 +60,25 @@ class ProjectDomainAttributesDeleteRequest(_message.Message):
 class ProjectDomainAttributesDeleteResponse(_message.Message):
     __slots__ = []
     def __init__(self) -> None: ...
+
+class ProjectDomainQuotaUsageGetRequest(_message.Message):
+    __slots__ = ["project", "domain", "org"]
+    PROJECT_FIELD_NUMBER: _ClassVar[int]
+    DOMAIN_FIELD_NUMBER: _ClassVar[int]
+    ORG_FIELD_NUMBER: _ClassVar[int]
+    project: str
+    domain: str
+    org: str
+    def __init__(self, project: _Optional[str] = ..., domain: _Optional[str] = ..., org: _Optional[str] = ...) -> None: ...
+
+class ProjectDomainQuotaUsageGetResponse(_message.Message):
+    __slots__ = ["quota", "active_executions", "queued_executions", "running_tasks"]
+    QUOTA_FIELD_NUMBER: _ClassVar[int]
+    ACTIVE_EXECUTIONS_FIELD_NUMBER: _ClassVar[int]
+    QUEUED_EXECUTIONS_FIELD_NUMBER: _ClassVar[int]
+    RUNNING_TASKS_FIELD_NUMBER: _ClassVar[int]
+    quota: _matchable_resource_pb2.ProjectQuotaAttributes
+    active_executions: int
+    queued_executions: int
+    running_tasks: int
+    def __init__(self, quota: _Optional[_Union[_matchable_resource_pb2.ProjectQuotaAttributes, _Mapping]] = ..., active_executions: _Optional[int] = ..., queued_executions: _Optional[int] = ..., running_tasks: _Optional[int] = ...) -> None: ...
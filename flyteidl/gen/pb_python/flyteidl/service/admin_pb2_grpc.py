@@ -240,6 +240,11 @@ class AdminServiceStub(object):
                 request_serializer=flyteidl_dot_admin_dot_project__domain__attributes__pb2.ProjectDomainAttributesDeleteRequest.SerializeToString,
                 response_deserializer=flyteidl_dot_admin_dot_project__domain__attributes__pb2.ProjectDomainAttributesDeleteResponse.FromString,
                 )
+        self.GetProjectDomainQuotaUsage = channel.unary_unary(
+                '/flyteidl.service.AdminService/GetProjectDomainQuotaUsage',
+                request_serializer=flyteidl_dot_admin_dot_project__domain__attributes__pb2.ProjectDomainQuotaUsageGetRequest.SerializeToString,
+                response_deserializer=flyteidl_dot_admin_dot_project__domain__attributes__pb2.ProjectDomainQuotaUsageGetResponse.FromString,
+                )
         self.UpdateProjectAttributes = channel.unary_unary(
                 '/flyteidl.service.AdminService/UpdateProjectAttributes',
                 request_serializer=flyteidl_dot_admin_dot_project__attributes__pb2.ProjectAttributesUpdateRequest.SerializeToString,
@@ -310,6 +315,11 @@ class AdminServiceStub(object):
                 request_serializer=flyteidl_dot_admin_dot_execution__pb2.WorkflowExecutionGetMetricsRequest.SerializeToString,
                 response_deserializer=flyteidl_dot_admin_dot_execution__pb2.WorkflowExecutionGetMetricsResponse.FromString,
                 )
+        self.GetExecutionAnalysis = channel.unary_unary(
+                '/flyteidl.service.AdminService/GetExecutionAnalysis',
+                request_serializer=flyteidl_dot_admin_dot_execution__pb2.WorkflowExecutionGetAnalysisRequest.SerializeToString,
+                response_deserializer=flyteidl_dot_admin_dot_execution__pb2.WorkflowExecutionGetAnalysisResponse.FromString,
+                )
 
 
 class AdminServiceServicer(object):
@@ -616,6 +626,13 @@ class AdminServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetProjectDomainQuotaUsage(self, request, context):
+        """Fetches the usage of a project and domain along with the :ref:`ref_flyteidl.admin.ProjectQuotaAttributes` in effect for it.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def UpdateProjectAttributes(self, request, context):
         """Creates or updates custom :ref:`ref_flyteidl.admin.MatchableAttributesConfiguration` at the project level
         """
@@ -713,6 +730,13 @@ class AdminServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetExecutionAnalysis(self, request, context):
+        """Analyzes the critical path of a :ref:`ref_flyteidl.admin.Execution`.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_AdminServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -926,6 +950,11 @@ def add_AdminServiceServicer_to_server(servicer, server):
                     request_deserializer=flyteidl_dot_admin_dot_project__domain__attributes__pb2.ProjectDomainAttributesDeleteRequest.FromString,
                     response_serializer=flyteidl_dot_admin_dot_project__domain__attributes__pb2.ProjectDomainAttributesDeleteResponse.SerializeToString,
             ),
+            'GetProjectDomainQuotaUsage': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetProjectDomainQuotaUsage,
+                    request_deserializer=flyteidl_dot_admin_dot_project__domain__attributes__pb2.ProjectDomainQuotaUsageGetRequest.FromString,
+                    response_serializer=flyteidl_dot_admin_dot_project__domain__attributes__pb2.ProjectDomainQuotaUsageGetResponse.SerializeToString,
+            ),
             'UpdateProjectAttributes': grpc.unary_unary_rpc_method_handler(
                     servicer.UpdateProjectAttributes,
                     request_deserializer=flyteidl_dot_admin_dot_project__attributes__pb2.ProjectAttributesUpdateRequest.FromString,
@@ -996,6 +1025,11 @@ def add_AdminServiceServicer_to_server(servicer, server):
                     request_deserializer=flyteidl_dot_admin_dot_execution__pb2.WorkflowExecutionGetMetricsRequest.FromString,
                     response_serializer=flyteidl_dot_admin_dot_execution__pb2.WorkflowExecutionGetMetricsResponse.SerializeToString,
             ),
+            'GetExecutionAnalysis': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetExecutionAnalysis,
+                    request_deserializer=flyteidl_dot_admin_dot_execution__pb2.WorkflowExecutionGetAnalysisRequest.FromString,
+                    response_serializer=flyteidl_dot_admin_dot_execution__pb2.WorkflowExecutionGetAnalysisResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'flyteidl.service.AdminService', rpc_method_handlers)
@@ -1722,6 +1756,23 @@ class AdminService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def GetProjectDomainQuotaUsage(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/flyteidl.service.AdminService/GetProjectDomainQuotaUsage',
+            flyteidl_dot_admin_dot_project__domain__attributes__pb2.ProjectDomainQuotaUsageGetRequest.SerializeToString,
+            flyteidl_dot_admin_dot_project__domain__attributes__pb2.ProjectDomainQuotaUsageGetResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def UpdateProjectAttributes(request,
             target,
@@ -1959,3 +2010,20 @@ class AdminService(object):
             flyteidl_dot_admin_dot_execution__pb2.WorkflowExecutionGetMetricsResponse.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetExecutionAnalysis(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/flyteidl.service.AdminService/GetExecutionAnalysis',
+            flyteidl_dot_admin_dot_execution__pb2.WorkflowExecutionGetAnalysisRequest.SerializeToString,
+            flyteidl_dot_admin_dot_execution__pb2.WorkflowExecutionGetAnalysisResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
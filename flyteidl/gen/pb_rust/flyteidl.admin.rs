@@ -1290,11 +1290,64 @@ pub struct WorkflowExecutionConfig {
     #[prost(message, repeated, tag="9")]
     pub execution_env_assignments: ::prost::alloc::vec::Vec<super::core::ExecutionEnvAssignment>,
 }
+/// Limits the executions and task pods of a project and domain. Zero and empty limits are not enforced.
+/// Limits are enforced on a best-effort basis, executions and tasks created concurrently may briefly exceed them.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ProjectQuotaAttributes {
+    /// The maximum number of executions of the project and domain which have not terminated.
+    #[prost(int32, tag="1")]
+    pub max_concurrent_executions: i32,
+    /// The maximum number of task pods of the project and domain running at the same time.
+    #[prost(int32, tag="2")]
+    pub max_running_tasks: i32,
+    /// The maximum total CPU requested by the running task pods, as a Kubernetes quantity, e.g. "64" or "500m".
+    #[prost(string, tag="3")]
+    pub max_cpu: ::prost::alloc::string::String,
+    /// The maximum total GPU requested by the running task pods, as a Kubernetes quantity.
+    #[prost(string, tag="4")]
+    pub max_gpu: ::prost::alloc::string::String,
+    /// Defines what happens to executions created while at the execution limit.
+    #[prost(enumeration="project_quota_attributes::OverflowBehavior", tag="5")]
+    pub overflow: i32,
+}
+/// Nested message and enum types in `ProjectQuotaAttributes`.
+pub mod project_quota_attributes {
+    #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+    #[repr(i32)]
+    pub enum OverflowBehavior {
+        /// Executions created while at the execution limit are rejected.
+        Reject = 0,
+        /// Executions created while at the execution limit are queued until the project and domain has capacity.
+        /// Single task executions are always rejected.
+        Queue = 1,
+    }
+    impl OverflowBehavior {
+        /// String value of the enum field names used in the ProtoBuf definition.
+        ///
+        /// The values are not transformed in any way and thus are considered stable
+        /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+        pub fn as_str_name(&self) -> &'static str {
+            match self {
+                OverflowBehavior::Reject => "REJECT",
+                OverflowBehavior::Queue => "QUEUE",
+            }
+        }
+        /// Creates an enum from field names used in the ProtoBuf definition.
+        pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+            match value {
+                "REJECT" => Some(Self::Reject),
+                "QUEUE" => Some(Self::Queue),
+                _ => None,
+            }
+        }
+    }
+}
 /// Generic container for encapsulating all types of the above attributes messages.
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MatchingAttributes {
-    #[prost(oneof="matching_attributes::Target", tags="1, 2, 3, 4, 5, 6, 7, 8")]
+    #[prost(oneof="matching_attributes::Target", tags="1, 2, 3, 4, 5, 6, 7, 8, 9")]
     pub target: ::core::option::Option<matching_attributes::Target>,
 }
 /// Nested message and enum types in `MatchingAttributes`.
@@ -1318,6 +1371,8 @@ pub mod matching_attributes {
         WorkflowExecutionConfig(super::WorkflowExecutionConfig),
         #[prost(message, tag="8")]
         ClusterAssignment(super::ClusterAssignment),
+        #[prost(message, tag="9")]
+        ProjectQuotaAttributes(super::ProjectQuotaAttributes),
     }
 }
 /// Represents a custom set of attributes applied for either a domain (and optional org); a domain and project (and optional org);
@@ -1382,6 +1437,8 @@ pub enum MatchableResource {
     WorkflowExecutionConfig = 6,
     /// Controls how to select an available cluster on which this execution should run.
     ClusterAssignment = 7,
+    /// Limits the concurrent executions and running task pods of a project and domain.
+    ProjectQuota = 8,
 }
 impl MatchableResource {
     /// String value of the enum field names used in the ProtoBuf definition.
@@ -1398,6 +1455,7 @@ impl MatchableResource {
             MatchableResource::PluginOverride => "PLUGIN_OVERRIDE",
             MatchableResource::WorkflowExecutionConfig => "WORKFLOW_EXECUTION_CONFIG",
             MatchableResource::ClusterAssignment => "CLUSTER_ASSIGNMENT",
+            MatchableResource::ProjectQuota => "PROJECT_QUOTA",
         }
     }
     /// Creates an enum from field names used in the ProtoBuf definition.
@@ -1411,6 +1469,7 @@ impl MatchableResource {
             "PLUGIN_OVERRIDE" => Some(Self::PluginOverride),
             "WORKFLOW_EXECUTION_CONFIG" => Some(Self::WorkflowExecutionConfig),
             "CLUSTER_ASSIGNMENT" => Some(Self::ClusterAssignment),
+            "PROJECT_QUOTA" => Some(Self::ProjectQuota),
             _ => None,
         }
     }
@@ -1912,6 +1971,134 @@ pub struct WorkflowExecutionGetMetricsResponse {
     #[prost(message, optional, tag="1")]
     pub span: ::core::option::Option<super::core::Span>,
 }
+/// WorkflowExecutionGetAnalysisRequest represents a request to analyze the critical path of the specified workflow
+/// execution.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct WorkflowExecutionGetAnalysisRequest {
+    /// id defines the workflow execution to analyze.
+    #[prost(message, optional, tag="1")]
+    pub id: ::core::option::Option<super::core::WorkflowExecutionIdentifier>,
+    /// depth defines the number of Flyte entity levels to traverse when breaking down execution details.
+    #[prost(int32, tag="2")]
+    pub depth: i32,
+}
+/// ActivityDuration is the time spent on a single kind of activity.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ActivityDuration {
+    #[prost(enumeration="activity_duration::Activity", tag="1")]
+    pub activity: i32,
+    #[prost(message, optional, tag="2")]
+    pub duration: ::core::option::Option<::prost_types::Duration>,
+}
+/// Nested message and enum types in `ActivityDuration`.
+pub mod activity_duration {
+    /// Activity is a kind of activity the time spent on the critical path of an execution is attributed to.
+    #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+    #[repr(i32)]
+    pub enum Activity {
+        /// Time spent by propeller evaluating the workflow and transitioning between nodes and attempts.
+        PropellerOverhead = 0,
+        /// Time a task waited for resources or quotas before it was submitted or scheduled.
+        Queueing = 1,
+        /// Time a pod waited to be scheduled on a node.
+        PodScheduling = 2,
+        /// Time spent pulling images and creating the containers of a pod.
+        ImagePull = 3,
+        /// Time the init containers of a pod ran before its containers started.
+        Initialization = 4,
+        /// Time a task was running.
+        Runtime = 5,
+        /// Time a gate node waited for a signal, an approval or a sleep to elapse.
+        Idle = 6,
+    }
+    impl Activity {
+        /// String value of the enum field names used in the ProtoBuf definition.
+        ///
+        /// The values are not transformed in any way and thus are considered stable
+        /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+        pub fn as_str_name(&self) -> &'static str {
+            match self {
+                Activity::PropellerOverhead => "PROPELLER_OVERHEAD",
+                Activity::Queueing => "QUEUEING",
+                Activity::PodScheduling => "POD_SCHEDULING",
+                Activity::ImagePull => "IMAGE_PULL",
+                Activity::Initialization => "INITIALIZATION",
+                Activity::Runtime => "RUNTIME",
+                Activity::Idle => "IDLE",
+            }
+        }
+        /// Creates an enum from field names used in the ProtoBuf definition.
+        pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+            match value {
+                "PROPELLER_OVERHEAD" => Some(Self::PropellerOverhead),
+                "QUEUEING" => Some(Self::Queueing),
+                "POD_SCHEDULING" => Some(Self::PodScheduling),
+                "IMAGE_PULL" => Some(Self::ImagePull),
+                "INITIALIZATION" => Some(Self::Initialization),
+                "RUNTIME" => Some(Self::Runtime),
+                "IDLE" => Some(Self::Idle),
+                _ => None,
+            }
+        }
+    }
+}
+/// CriticalPathSegment is a contiguous part of the critical path of an execution spent on a single activity.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CriticalPathSegment {
+    #[prost(message, optional, tag="1")]
+    pub start_time: ::core::option::Option<::prost_types::Timestamp>,
+    #[prost(message, optional, tag="2")]
+    pub end_time: ::core::option::Option<::prost_types::Timestamp>,
+    #[prost(enumeration="activity_duration::Activity", tag="3")]
+    pub activity: i32,
+    /// operation_id is the operation of the span the segment was taken from, empty if the time is not covered by any
+    /// operation of a node or workflow.
+    #[prost(string, tag="4")]
+    pub operation_id: ::prost::alloc::string::String,
+    /// node_id is the id of the innermost node the segment belongs to, empty for time spent on the workflow itself.
+    #[prost(string, tag="5")]
+    pub node_id: ::prost::alloc::string::String,
+    #[prost(string, tag="6")]
+    pub task_name: ::prost::alloc::string::String,
+}
+/// CriticalPathNode is a node on the critical path of an execution.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct CriticalPathNode {
+    #[prost(string, tag="1")]
+    pub node_id: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub task_name: ::prost::alloc::string::String,
+    /// duration is the time the node contributes to the critical path, which is the most that speeding up the node
+    /// could shorten the execution by.
+    #[prost(message, optional, tag="3")]
+    pub duration: ::core::option::Option<::prost_types::Duration>,
+    /// share is the fraction of the duration of the execution the node contributes to the critical path.
+    #[prost(double, tag="4")]
+    pub share: f64,
+    /// breakdown attributes the duration to activities.
+    #[prost(message, repeated, tag="5")]
+    pub breakdown: ::prost::alloc::vec::Vec<ActivityDuration>,
+}
+/// WorkflowExecutionGetAnalysisResponse represents the critical path analysis of the specified workflow execution.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct WorkflowExecutionGetAnalysisResponse {
+    #[prost(message, optional, tag="1")]
+    pub duration: ::core::option::Option<::prost_types::Duration>,
+    /// breakdown attributes the duration of the execution to activities.
+    #[prost(message, repeated, tag="2")]
+    pub breakdown: ::prost::alloc::vec::Vec<ActivityDuration>,
+    /// critical_path lists the segments of the critical path in chronological order.
+    #[prost(message, repeated, tag="3")]
+    pub critical_path: ::prost::alloc::vec::Vec<CriticalPathSegment>,
+    /// bottlenecks lists the nodes on the critical path, those contributing the most time first.
+    #[prost(message, repeated, tag="4")]
+    pub bottlenecks: ::prost::alloc::vec::Vec<CriticalPathNode>,
+}
 /// The state of the execution is used to control its visibility in the UI/CLI.
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
@@ -2910,6 +3097,39 @@ pub struct ProjectDomainAttributesDeleteRequest {
 #[derive(Clone, Copy, PartialEq, ::prost::Message)]
 pub struct ProjectDomainAttributesDeleteResponse {
 }
+/// Request to get the quota usage of a project and domain.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ProjectDomainQuotaUsageGetRequest {
+    /// Unique project id for which to get the quota usage.
+    /// +required
+    #[prost(string, tag="1")]
+    pub project: ::prost::alloc::string::String,
+    /// Unique domain id for which to get the quota usage.
+    /// +required
+    #[prost(string, tag="2")]
+    pub domain: ::prost::alloc::string::String,
+    /// Optional, org key applied to the project.
+    #[prost(string, tag="3")]
+    pub org: ::prost::alloc::string::String,
+}
+/// Usage of a project and domain along with the quota in effect for it, if any.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ProjectDomainQuotaUsageGetResponse {
+    /// The :ref:`ref_flyteidl.admin.ProjectQuotaAttributes` matching the project and domain, if any.
+    #[prost(message, optional, tag="1")]
+    pub quota: ::core::option::Option<ProjectQuotaAttributes>,
+    /// Number of launched executions which have not terminated.
+    #[prost(int64, tag="2")]
+    pub active_executions: i64,
+    /// Number of executions queued until the project and domain has capacity.
+    #[prost(int64, tag="3")]
+    pub queued_executions: i64,
+    /// Number of task executions which have not terminated.
+    #[prost(int64, tag="4")]
+    pub running_tasks: i64,
+}
 /// SignalGetOrCreateRequest represents a request structure to retrieve or create a signal.
 /// See :ref:`ref_flyteidl.admin.Signal` for more details
 #[allow(clippy::derive_partial_eq_without_eq)]
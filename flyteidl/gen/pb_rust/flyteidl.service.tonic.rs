@@ -1387,6 +1387,40 @@ pub mod admin_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
+        /** Fetches the usage of a project and domain along with the :ref:`ref_flyteidl.admin.ProjectQuotaAttributes` in effect for it.
+*/
+        pub async fn get_project_domain_quota_usage(
+            &mut self,
+            request: impl tonic::IntoRequest<
+                super::super::admin::ProjectDomainQuotaUsageGetRequest,
+            >,
+        ) -> std::result::Result<
+            tonic::Response<super::super::admin::ProjectDomainQuotaUsageGetResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/flyteidl.service.AdminService/GetProjectDomainQuotaUsage",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "flyteidl.service.AdminService",
+                        "GetProjectDomainQuotaUsage",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
         /** Creates or updates custom :ref:`ref_flyteidl.admin.MatchableAttributesConfiguration` at the project level
 */
         pub async fn update_project_attributes(
@@ -1840,6 +1874,40 @@ pub mod admin_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
+        /** Analyzes the critical path of a :ref:`ref_flyteidl.admin.Execution`.
+*/
+        pub async fn get_execution_analysis(
+            &mut self,
+            request: impl tonic::IntoRequest<
+                super::super::admin::WorkflowExecutionGetAnalysisRequest,
+            >,
+        ) -> std::result::Result<
+            tonic::Response<super::super::admin::WorkflowExecutionGetAnalysisResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/flyteidl.service.AdminService/GetExecutionAnalysis",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "flyteidl.service.AdminService",
+                        "GetExecutionAnalysis",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
     }
 }
 /// Generated server implementations.
@@ -2244,6 +2312,17 @@ pub mod admin_service_server {
             tonic::Response<super::super::admin::ProjectDomainAttributesDeleteResponse>,
             tonic::Status,
         >;
+        /** Fetches the usage of a project and domain along with the :ref:`ref_flyteidl.admin.ProjectQuotaAttributes` in effect for it.
+*/
+        async fn get_project_domain_quota_usage(
+            &self,
+            request: tonic::Request<
+                super::super::admin::ProjectDomainQuotaUsageGetRequest,
+            >,
+        ) -> std::result::Result<
+            tonic::Response<super::super::admin::ProjectDomainQuotaUsageGetResponse>,
+            tonic::Status,
+        >;
         /** Creates or updates custom :ref:`ref_flyteidl.admin.MatchableAttributesConfiguration` at the project level
 */
         async fn update_project_attributes(
@@ -2371,6 +2450,17 @@ pub mod admin_service_server {
             tonic::Response<super::super::admin::WorkflowExecutionGetMetricsResponse>,
             tonic::Status,
         >;
+        /** Analyzes the critical path of a :ref:`ref_flyteidl.admin.Execution`.
+*/
+        async fn get_execution_analysis(
+            &self,
+            request: tonic::Request<
+                super::super::admin::WorkflowExecutionGetAnalysisRequest,
+            >,
+        ) -> std::result::Result<
+            tonic::Response<super::super::admin::WorkflowExecutionGetAnalysisResponse>,
+            tonic::Status,
+        >;
     }
     /** The following defines an RPC service that is also served over HTTP via grpc-gateway.
  Standard response codes for both are defined here: https://github.com/grpc-ecosystem/grpc-gateway/blob/master/runtime/errors.go
@@ -4552,6 +4642,59 @@ pub mod admin_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/flyteidl.service.AdminService/GetProjectDomainQuotaUsage" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetProjectDomainQuotaUsageSvc<T: AdminService>(pub Arc<T>);
+                    impl<
+                        T: AdminService,
+                    > tonic::server::UnaryService<
+                        super::super::admin::ProjectDomainQuotaUsageGetRequest,
+                    > for GetProjectDomainQuotaUsageSvc<T> {
+                        type Response = super::super::admin::ProjectDomainQuotaUsageGetResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                super::super::admin::ProjectDomainQuotaUsageGetRequest,
+                            >,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as AdminService>::get_project_domain_quota_usage(
+                                        &inner,
+                                        request,
+                                    )
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetProjectDomainQuotaUsageSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/flyteidl.service.AdminService/UpdateProjectAttributes" => {
                     #[allow(non_camel_case_types)]
                     struct UpdateProjectAttributesSvc<T: AdminService>(pub Arc<T>);
@@ -5269,6 +5412,56 @@ pub mod admin_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/flyteidl.service.AdminService/GetExecutionAnalysis" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetExecutionAnalysisSvc<T: AdminService>(pub Arc<T>);
+                    impl<
+                        T: AdminService,
+                    > tonic::server::UnaryService<
+                        super::super::admin::WorkflowExecutionGetAnalysisRequest,
+                    > for GetExecutionAnalysisSvc<T> {
+                        type Response = super::super::admin::WorkflowExecutionGetAnalysisResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                super::super::admin::WorkflowExecutionGetAnalysisRequest,
+                            >,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                <T as AdminService>::get_execution_analysis(&inner, request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetExecutionAnalysisSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 _ => {
                     Box::pin(async move {
                         Ok(
@@ -1,8 +1,12 @@
 package logs
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/config"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/tasklog"
+	stdConfig "github.com/flyteorg/flyte/flytestdlib/config"
 )
 
 //go:generate pflags LogConfig --default-var=DefaultConfig
@@ -42,6 +46,77 @@ var (
 	logConfigSection = config.MustRegisterSubSection("logs", &DefaultConfig)
 )
 
+func init() {
+	// Log links are built from the current config for every task, so updates apply live once they pass validation.
+	stdConfig.AddTypedValidator(logConfigSection, ValidateLogConfig)
+}
+
+// ValidateLogConfig checks that all configured log links can be built.
+func ValidateLogConfig(cfg *LogConfig) error {
+	errs := stdConfig.FieldErrors{}
+	for field, uri := range map[string]tasklog.TemplateURI{
+		"cloudwatch-template-uri":  cfg.CloudwatchTemplateURI,
+		"kubernetes-template-uri":  cfg.KubernetesTemplateURI,
+		"stackdriver-template-uri": cfg.StackDriverTemplateURI,
+	} {
+		if err := validateTemplateURI(uri); err != nil {
+			errs.Addf(field, "%v", err)
+		}
+	}
+
+	for name, link := range cfg.DynamicLogLinks {
+		validateTemplateLogPlugin(fmt.Sprintf("dynamic-log-links[%v]", name), link, &errs)
+	}
+
+	for i, template := range cfg.Templates {
+		validateTemplateLogPlugin(fmt.Sprintf("templates[%v]", i), template, &errs)
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func validateTemplateLogPlugin(field string, plugin tasklog.TemplateLogPlugin, errs *stdConfig.FieldErrors) {
+	if len(plugin.TemplateURIs) == 0 && len(plugin.DynamicTemplateURIs) == 0 {
+		errs.Addf(field, "at least one template uri is required")
+	}
+
+	for i, uri := range plugin.TemplateURIs {
+		if err := validateTemplateURI(uri); err != nil {
+			errs.Addf(fmt.Sprintf("%v.templateUris[%v]", field, i), "%v", err)
+		}
+	}
+
+	for i, uri := range plugin.DynamicTemplateURIs {
+		if err := validateTemplateURI(uri); err != nil {
+			errs.Addf(fmt.Sprintf("%v.dynamicTemplateUris[%v]", field, i), "%v", err)
+		}
+	}
+}
+
+// validateTemplateURI checks that every template variable in the uri is closed.
+func validateTemplateURI(uri tasklog.TemplateURI) error {
+	rest := uri
+	for {
+		start := strings.Index(rest, "{{")
+		if start < 0 {
+			break
+		}
+
+		end := strings.Index(rest[start:], "}}")
+		if end < 0 {
+			return fmt.Errorf("unclosed template variable in uri [%v]", uri)
+		}
+
+		if strings.Contains(rest[start+2:start+end], "{{") {
+			return fmt.Errorf("nested template variable in uri [%v]", uri)
+		}
+
+		rest = rest[start+end+2:]
+	}
+
+	return nil
+}
+
 func GetLogConfig() *LogConfig {
 	return logConfigSection.GetConfig().(*LogConfig)
 }
@@ -0,0 +1,59 @@
+package logs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/tasklog"
+	"github.com/flyteorg/flyte/flytestdlib/config"
+)
+
+func TestValidateLogConfig(t *testing.T) {
+	assert.NoError(t, ValidateLogConfig(&DefaultConfig))
+
+	assert.NoError(t, ValidateLogConfig(&LogConfig{
+		Templates: []tasklog.TemplateLogPlugin{
+			{DisplayName: "Internal", TemplateURIs: []tasklog.TemplateURI{"https://logs/{{ .podName }}?ns={{.namespace}}"}},
+		},
+		DynamicLogLinks: map[string]tasklog.TemplateLogPlugin{
+			"vscode": {DisplayName: "VS Code", TemplateURIs: []tasklog.TemplateURI{"https://{{ .taskConfig.port }}"}},
+		},
+	}))
+
+	t.Run("Unclosed variable", func(t *testing.T) {
+		assert.Error(t, ValidateLogConfig(&LogConfig{KubernetesTemplateURI: "http://logs/{{ .podName"}))
+		assert.Error(t, ValidateLogConfig(&LogConfig{KubernetesTemplateURI: "http://logs/{{ .podName {{ .namespace }}"}))
+	})
+
+	t.Run("Missing uri", func(t *testing.T) {
+		assert.Error(t, ValidateLogConfig(&LogConfig{
+			Templates: []tasklog.TemplateLogPlugin{{DisplayName: "Internal"}},
+		}))
+	})
+
+	t.Run("All invalid fields", func(t *testing.T) {
+		err := ValidateLogConfig(&LogConfig{
+			KubernetesTemplateURI: "http://logs/{{ .podName",
+			Templates: []tasklog.TemplateLogPlugin{
+				{DisplayName: "Internal", TemplateURIs: []tasklog.TemplateURI{"https://logs", "https://logs/{{ .podName"}},
+			},
+		})
+
+		var fieldErrs config.FieldErrors
+		if assert.ErrorAs(t, err, &fieldErrs) {
+			var fields []string
+			for _, fieldErr := range fieldErrs {
+				fields = append(fields, fieldErr.Field)
+			}
+			assert.ElementsMatch(t, []string{"kubernetes-template-uri", "templates[0].templateUris[1]"}, fields)
+		}
+	})
+
+	t.Run("Rejected update", func(t *testing.T) {
+		current := GetLogConfig()
+		err := SetLogConfig(&LogConfig{Templates: []tasklog.TemplateLogPlugin{{DisplayName: "Internal"}}})
+		assert.ErrorIs(t, err, config.ErrSectionValidation)
+		assert.Equal(t, current, GetLogConfig())
+	})
+}
@@ -57,4 +57,7 @@ type TaskExecutionMetadata interface {
 	GetInterruptibleFailureThreshold() int32
 	GetEnvironmentVariables() map[string]string
 	GetConsoleURL() string
+	// GetPreviousAttemptError returns the error the previous attempt of this task failed with, or nil for the first
+	// attempt
+	GetPreviousAttemptError() *core.ExecutionError
 }
@@ -0,0 +1,74 @@
+package core
+
+import (
+	structpb "github.com/golang/protobuf/ptypes/struct"
+)
+
+// FailureCategory is a stable classification of task failures. Unlike error codes, which are free-form and plugin
+// specific, the set of categories is fixed so that operators can reliably alert on them.
+type FailureCategory string
+
+const (
+	// FailureCategoryUser indicates that the failure was caused by user code or the task specification.
+	FailureCategoryUser FailureCategory = "user"
+	// FailureCategoryInfra indicates that the failure was caused by the underlying infrastructure.
+	FailureCategoryInfra FailureCategory = "infra"
+	// FailureCategoryQuota indicates that the task could not acquire the resources it asked for.
+	FailureCategoryQuota FailureCategory = "quota"
+	// FailureCategoryPreemption indicates that the task was interrupted by preemption or node shutdown.
+	FailureCategoryPreemption FailureCategory = "preemption"
+	// FailureCategoryImage indicates that the container image could not be pulled or run.
+	FailureCategoryImage FailureCategory = "image"
+	// FailureCategoryOOM indicates that the task ran out of memory.
+	FailureCategoryOOM FailureCategory = "oom"
+)
+
+// FailureCategoryCustomInfoKey is the key under which the failure category is recorded in the custom info of task
+// execution events.
+const FailureCategoryCustomInfoKey = "failureCategory"
+
+// FailureCategories lists all known failure categories.
+var FailureCategories = []FailureCategory{
+	FailureCategoryUser,
+	FailureCategoryInfra,
+	FailureCategoryQuota,
+	FailureCategoryPreemption,
+	FailureCategoryImage,
+	FailureCategoryOOM,
+}
+
+// IsValid returns true if the category is one of the known failure categories.
+func (c FailureCategory) IsValid() bool {
+	for _, category := range FailureCategories {
+		if c == category {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetFailureCategory records the failure category in the custom info of the task info, preserving any other custom
+// info.
+func SetFailureCategory(info *TaskInfo, category FailureCategory) {
+	if info == nil {
+		return
+	}
+
+	if info.CustomInfo == nil {
+		info.CustomInfo = &structpb.Struct{}
+	}
+
+	if info.CustomInfo.Fields == nil {
+		info.CustomInfo.Fields = map[string]*structpb.Value{}
+	}
+
+	info.CustomInfo.Fields[FailureCategoryCustomInfoKey] = &structpb.Value{
+		Kind: &structpb.Value_StringValue{StringValue: string(category)},
+	}
+}
+
+// GetFailureCategory returns the failure category recorded in the custom info of a task execution event, if any.
+func GetFailureCategory(customInfo *structpb.Struct) FailureCategory {
+	return FailureCategory(customInfo.GetFields()[FailureCategoryCustomInfoKey].GetStringValue())
+}
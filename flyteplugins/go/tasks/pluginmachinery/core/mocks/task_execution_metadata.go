@@ -578,6 +578,53 @@ func (_c *TaskExecutionMetadata_GetPlatformResources_Call) RunAndReturn(run func
 	return _c
 }
 
+// GetPreviousAttemptError provides a mock function with given fields:
+func (_m *TaskExecutionMetadata) GetPreviousAttemptError() *flyteidlcore.ExecutionError {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreviousAttemptError")
+	}
+
+	var r0 *flyteidlcore.ExecutionError
+	if rf, ok := ret.Get(0).(func() *flyteidlcore.ExecutionError); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flyteidlcore.ExecutionError)
+		}
+	}
+
+	return r0
+}
+
+// TaskExecutionMetadata_GetPreviousAttemptError_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreviousAttemptError'
+type TaskExecutionMetadata_GetPreviousAttemptError_Call struct {
+	*mock.Call
+}
+
+// GetPreviousAttemptError is a helper method to define mock.On call
+func (_e *TaskExecutionMetadata_Expecter) GetPreviousAttemptError() *TaskExecutionMetadata_GetPreviousAttemptError_Call {
+	return &TaskExecutionMetadata_GetPreviousAttemptError_Call{Call: _e.mock.On("GetPreviousAttemptError")}
+}
+
+func (_c *TaskExecutionMetadata_GetPreviousAttemptError_Call) Run(run func()) *TaskExecutionMetadata_GetPreviousAttemptError_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TaskExecutionMetadata_GetPreviousAttemptError_Call) Return(_a0 *flyteidlcore.ExecutionError) *TaskExecutionMetadata_GetPreviousAttemptError_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TaskExecutionMetadata_GetPreviousAttemptError_Call) RunAndReturn(run func() *flyteidlcore.ExecutionError) *TaskExecutionMetadata_GetPreviousAttemptError_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSecurityContext provides a mock function with given fields:
 func (_m *TaskExecutionMetadata) GetSecurityContext() flyteidlcore.SecurityContext {
 	ret := _m.Called()
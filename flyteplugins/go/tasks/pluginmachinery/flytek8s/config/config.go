@@ -67,6 +67,14 @@ var (
 		UpdateBaseBackoffDuration:          10,
 		UpdateBackoffRetries:               5,
 		AddTolerationsForExtendedResources: []string{},
+		ResourceUsage: ResourceUsageConfig{
+			SampleInterval: config2.Duration{
+				Duration: 30 * time.Second,
+			},
+		},
+		RetryResourceEscalation: RetryResourceEscalationConfig{
+			Factor: 2,
+		},
 	}
 
 	// K8sPluginConfigSection provides a singular top level config section for all plugins.
@@ -220,6 +228,60 @@ type K8sPluginConfig struct {
 	AddTolerationsForExtendedResources []string `json:"add-tolerations-for-extended-resources" pflag:",Name of the extended resources for which tolerations should be added."`
 
 	EnableDistributedErrorAggregation bool `json:"enable-distributed-error-aggregation" pflag:",If true, will aggregate errors of different worker pods for distributed tasks."`
+
+	// ResourceUsage configures sampling of the actual resource usage of running task pods.
+	ResourceUsage ResourceUsageConfig `json:"resource-usage"`
+
+	// RetryResourceEscalation configures increasing resource requests of retries of tasks that ran out of memory or
+	// ephemeral storage.
+	RetryResourceEscalation RetryResourceEscalationConfig `json:"retry-resource-escalation"`
+
+	// FailureClassificationRules are evaluated in order before the built-in rules to classify pod failures. The first
+	// matching rule determines the failure category.
+	FailureClassificationRules []FailureClassificationRule `json:"failure-classification-rules" pflag:"-,Rules to classify pod failures, evaluated before the built-in rules."`
+}
+
+// FailureClassificationRule maps observations about a failed pod to a failure category. A rule matches if any of its
+// criteria matches.
+type FailureClassificationRule struct {
+	// Category is one of user, infra, quota, preemption, image or oom.
+	Category string `json:"category"`
+
+	// Reasons are matched against the failure code, container termination reasons, pod condition reasons and the
+	// reasons of k8s events recorded for the pod.
+	Reasons []string `json:"reasons"`
+
+	// ExitCodes are matched against the exit codes of terminated containers.
+	ExitCodes []int32 `json:"exitCodes"`
+
+	// MessagePatterns are regular expressions matched against the failure message and container termination messages.
+	MessagePatterns []string `json:"messagePatterns"`
+}
+
+// RetryResourceEscalationConfig configures how the primary container requests of a retry are increased when the previous
+// attempt was OOMKilled or evicted for exceeding its ephemeral storage.
+type RetryResourceEscalationConfig struct {
+	// Enabled turns on resource escalation on retries.
+	Enabled bool `json:"enabled" pflag:",If true, will increase the memory or ephemeral storage request of retries of tasks whose previous attempt was OOMKilled or evicted for exceeding its ephemeral storage."`
+
+	// Factor is the factor the request is multiplied by for every retry attempt.
+	Factor float64 `json:"factor" pflag:"-,Factor the memory or ephemeral storage request is multiplied by for every retry attempt."`
+
+	// MaxMemory is the platform cap for escalated memory requests. Requests are never escalated past the container limit.
+	MaxMemory resource.Quantity `json:"max-memory" pflag:",Maximum memory request escalated retries may use. Unbounded if unset."`
+
+	// MaxEphemeralStorage is the platform cap for escalated ephemeral storage requests.
+	MaxEphemeralStorage resource.Quantity `json:"max-ephemeral-storage" pflag:",Maximum ephemeral storage request escalated retries may use. Unbounded if unset."`
+}
+
+// ResourceUsageConfig configures sampling of actual CPU and memory usage of running task pods through the k8s metrics
+// API. Samples are summarized against the pod requests and attached to task execution events.
+type ResourceUsageConfig struct {
+	// Enabled turns on resource usage sampling.
+	Enabled bool `json:"enabled" pflag:",If true, will sample the resource usage of running task pods and attach a usage summary to TaskExecutionEvents."`
+
+	// SampleInterval is the minimum duration between two samples of the same pod.
+	SampleInterval config2.Duration `json:"sample-interval" pflag:",Minimum duration between two resource usage samples of the same pod."`
 }
 
 // FlyteCoPilotConfig specifies configuration for the Flyte CoPilot system. FlyteCoPilot, allows running flytekit-less containers
@@ -71,5 +71,10 @@ func (cfg K8sPluginConfig) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "update-backoff-retries"), defaultK8sConfig.UpdateBackoffRetries, "Number of retries for exponential backoff when updating a resource.")
 	cmdFlags.StringSlice(fmt.Sprintf("%v%v", prefix, "add-tolerations-for-extended-resources"), defaultK8sConfig.AddTolerationsForExtendedResources, "Name of the extended resources for which tolerations should be added.")
 	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "enable-distributed-error-aggregation"), defaultK8sConfig.EnableDistributedErrorAggregation, "If true,  will aggregate errors of different worker pods for distributed tasks.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "resource-usage.enabled"), defaultK8sConfig.ResourceUsage.Enabled, "If true,  will sample the resource usage of running task pods and attach a usage summary to TaskExecutionEvents.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "resource-usage.sample-interval"), defaultK8sConfig.ResourceUsage.SampleInterval.String(), "Minimum duration between two resource usage samples of the same pod.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "retry-resource-escalation.enabled"), defaultK8sConfig.RetryResourceEscalation.Enabled, "If true,  will increase the memory or ephemeral storage request of retries of tasks whose previous attempt was OOMKilled or evicted for exceeding its ephemeral storage.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "retry-resource-escalation.max-memory"), defaultK8sConfig.RetryResourceEscalation.MaxMemory.String(), "Maximum memory request escalated retries may use. Unbounded if unset.")
+	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "retry-resource-escalation.max-ephemeral-storage"), defaultK8sConfig.RetryResourceEscalation.MaxEphemeralStorage.String(), "Maximum ephemeral storage request escalated retries may use. Unbounded if unset.")
 	return cmdFlags
 }
@@ -393,4 +393,74 @@ func TestK8sPluginConfig_SetFlags(t *testing.T) {
 			}
 		})
 	})
+	t.Run("Test_resource-usage.enabled", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("resource-usage.enabled", testValue)
+			if vBool, err := cmdFlags.GetBool("resource-usage.enabled"); err == nil {
+				testDecodeJson_K8sPluginConfig(t, fmt.Sprintf("%v", vBool), &actual.ResourceUsage.Enabled)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_resource-usage.sample-interval", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := defaultK8sConfig.ResourceUsage.SampleInterval.String()
+
+			cmdFlags.Set("resource-usage.sample-interval", testValue)
+			if vString, err := cmdFlags.GetString("resource-usage.sample-interval"); err == nil {
+				testDecodeJson_K8sPluginConfig(t, fmt.Sprintf("%v", vString), &actual.ResourceUsage.SampleInterval)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_retry-resource-escalation.enabled", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := "1"
+
+			cmdFlags.Set("retry-resource-escalation.enabled", testValue)
+			if vBool, err := cmdFlags.GetBool("retry-resource-escalation.enabled"); err == nil {
+				testDecodeJson_K8sPluginConfig(t, fmt.Sprintf("%v", vBool), &actual.RetryResourceEscalation.Enabled)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_retry-resource-escalation.max-memory", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := defaultK8sConfig.RetryResourceEscalation.MaxMemory.String()
+
+			cmdFlags.Set("retry-resource-escalation.max-memory", testValue)
+			if vString, err := cmdFlags.GetString("retry-resource-escalation.max-memory"); err == nil {
+				testDecodeJson_K8sPluginConfig(t, fmt.Sprintf("%v", vString), &actual.RetryResourceEscalation.MaxMemory)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
+	t.Run("Test_retry-resource-escalation.max-ephemeral-storage", func(t *testing.T) {
+
+		t.Run("Override", func(t *testing.T) {
+			testValue := defaultK8sConfig.RetryResourceEscalation.MaxEphemeralStorage.String()
+
+			cmdFlags.Set("retry-resource-escalation.max-ephemeral-storage", testValue)
+			if vString, err := cmdFlags.GetString("retry-resource-escalation.max-ephemeral-storage"); err == nil {
+				testDecodeJson_K8sPluginConfig(t, fmt.Sprintf("%v", vString), &actual.RetryResourceEscalation.MaxEphemeralStorage)
+
+			} else {
+				assert.FailNow(t, err.Error())
+			}
+		})
+	})
 }
@@ -0,0 +1,255 @@
+package flytek8s
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync/atomic"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s/config"
+	stdConfig "github.com/flyteorg/flyte/flytestdlib/config"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+// FailureSignals are the observations about a failed pod that a FailureClassifier uses to categorize the failure.
+type FailureSignals struct {
+	// Code is the failure code reported for the task, e.g. OOMKilled or ErrImagePull.
+	Code string
+	// Kind is the kind of the execution error reported for the task.
+	Kind core.ExecutionError_ErrorKind
+	// Message is the failure message reported for the task.
+	Message string
+	// Reasons holds the pod status reason, the container termination and waiting reasons, the pod condition reasons and
+	// the reasons of k8s events recorded for the pod.
+	Reasons []string
+	// ExitCodes holds the exit codes of terminated containers.
+	ExitCodes []int32
+	// TerminationMessages holds the termination messages of terminated containers.
+	TerminationMessages []string
+}
+
+// FailureClassifier maps the observations about a failed pod to a stable failure category.
+type FailureClassifier interface {
+	Classify(ctx context.Context, signals FailureSignals) pluginsCore.FailureCategory
+}
+
+type failureRule struct {
+	category        pluginsCore.FailureCategory
+	reasons         sets.String
+	exitCodes       sets.Int32
+	messagePatterns []*regexp.Regexp
+}
+
+func (r failureRule) matches(signals FailureSignals) bool {
+	if r.reasons.Has(signals.Code) || r.reasons.HasAny(signals.Reasons...) || r.exitCodes.HasAny(signals.ExitCodes...) {
+		return true
+	}
+
+	for _, pattern := range r.messagePatterns {
+		if pattern.MatchString(signals.Message) {
+			return true
+		}
+
+		for _, message := range signals.TerminationMessages {
+			if pattern.MatchString(message) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ruleBasedFailureClassifier evaluates rules in order and returns the category of the first matching rule. Failures that
+// match no rule are classified by the kind of their execution error.
+type ruleBasedFailureClassifier struct {
+	rules []failureRule
+}
+
+func (c ruleBasedFailureClassifier) Classify(_ context.Context, signals FailureSignals) pluginsCore.FailureCategory {
+	for _, rule := range c.rules {
+		if rule.matches(signals) {
+			return rule.category
+		}
+	}
+
+	if signals.Kind == core.ExecutionError_SYSTEM {
+		return pluginsCore.FailureCategoryInfra
+	}
+
+	return pluginsCore.FailureCategoryUser
+}
+
+// defaultFailureClassificationRules are evaluated after the configured rules.
+var defaultFailureClassificationRules = []config.FailureClassificationRule{
+	{
+		Category: string(pluginsCore.FailureCategoryOOM),
+		Reasons:  []string{OOMKilled},
+	},
+	{
+		Category: string(pluginsCore.FailureCategoryImage),
+		Reasons: []string{"ErrImagePull", "ImagePullBackOff", "InvalidImageName", "ErrImageNeverPull",
+			"RegistryUnavailable", "ImageInspectError"},
+	},
+	{
+		Category: string(pluginsCore.FailureCategoryPreemption),
+		Reasons: []string{Interrupted, "Shutdown", "Terminated", "NodeShutdown", "Preempting", "PreemptionByScheduler",
+			"PreemptionByKubeScheduler", "DeletionByTaintManager", "TerminationByKubelet"},
+	},
+	{
+		Category:        string(pluginsCore.FailureCategoryQuota),
+		Reasons:         []string{"OutOfcpu", "OutOfmemory", "OutOfpods", "ExceededQuota"},
+		MessagePatterns: []string{`exceeded quota`, `Insufficient [a-z./-]+`},
+	},
+	{
+		Category: string(pluginsCore.FailureCategoryInfra),
+		Reasons:  []string{"Evicted", "NodeAffinity", "NodeLost", "UnknownError", "ContainerCannotRun"},
+	},
+}
+
+// NewFailureClassifier creates a FailureClassifier that evaluates the given rules followed by the built-in rules.
+func NewFailureClassifier(rules []config.FailureClassificationRule) (FailureClassifier, error) {
+	classifier := ruleBasedFailureClassifier{}
+	for i, rule := range append(append([]config.FailureClassificationRule{}, rules...), defaultFailureClassificationRules...) {
+		category := pluginsCore.FailureCategory(rule.Category)
+		if !category.IsValid() {
+			return nil, fmt.Errorf("failure classification rule [%d] has invalid category [%s], expected one of %v",
+				i, rule.Category, pluginsCore.FailureCategories)
+		}
+
+		compiled := failureRule{
+			category:  category,
+			reasons:   sets.NewString(rule.Reasons...),
+			exitCodes: sets.NewInt32(rule.ExitCodes...),
+		}
+
+		for _, pattern := range rule.MessagePatterns {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("failure classification rule [%d] has invalid message pattern [%s]: %w", i, pattern, err)
+			}
+
+			compiled.messagePatterns = append(compiled.messagePatterns, re)
+		}
+
+		classifier.rules = append(classifier.rules, compiled)
+	}
+
+	return classifier, nil
+}
+
+// compiledFailureClassifier is the classifier compiled from the rules of a version of the k8s plugin config.
+type compiledFailureClassifier struct {
+	configVersion uint64
+	classifier    FailureClassifier
+	err           error
+}
+
+type failureClassifierOverride struct {
+	classifier FailureClassifier
+}
+
+var (
+	configuredFailureClassifier atomic.Pointer[compiledFailureClassifier]
+	overriddenFailureClassifier atomic.Pointer[failureClassifierOverride]
+)
+
+func init() {
+	// Invalid rules are rejected when the config is loaded or updated, keeping the rules that were in place.
+	stdConfig.AddTypedValidator(config.K8sPluginConfigSection, func(cfg *config.K8sPluginConfig) error {
+		_, err := NewFailureClassifier(cfg.FailureClassificationRules)
+		return err
+	})
+}
+
+// SetFailureClassifier replaces the classifier used to categorize pod failures. Passing nil restores the configured
+// rule based classifier.
+func SetFailureClassifier(classifier FailureClassifier) {
+	if classifier == nil {
+		overriddenFailureClassifier.Store(nil)
+		return
+	}
+
+	overriddenFailureClassifier.Store(&failureClassifierOverride{classifier: classifier})
+}
+
+// getFailureClassifier returns the overridden classifier if one is set. Otherwise it returns the classifier compiled
+// from the configured rules, which is only compiled again once the k8s plugin config changes.
+func getFailureClassifier() (FailureClassifier, error) {
+	if override := overriddenFailureClassifier.Load(); override != nil {
+		return override.classifier, nil
+	}
+
+	version := config.K8sPluginConfigSection.GetVersion()
+	if compiled := configuredFailureClassifier.Load(); compiled != nil && compiled.configVersion == version {
+		return compiled.classifier, compiled.err
+	}
+
+	classifier, err := NewFailureClassifier(config.GetK8sPluginConfig().FailureClassificationRules)
+	configuredFailureClassifier.Store(&compiledFailureClassifier{configVersion: version, classifier: classifier, err: err})
+	return classifier, err
+}
+
+// GetFailureSignals collects the observations about a failed pod that are used to classify the failure.
+func GetFailureSignals(pod *v1.Pod, phaseInfo pluginsCore.PhaseInfo) FailureSignals {
+	signals := FailureSignals{
+		Code:    phaseInfo.Err().GetCode(),
+		Kind:    phaseInfo.Err().GetKind(),
+		Message: phaseInfo.Err().GetMessage(),
+	}
+
+	if len(pod.Status.Reason) > 0 {
+		signals.Reasons = append(signals.Reasons, pod.Status.Reason)
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if len(condition.Reason) > 0 && condition.Status == v1.ConditionTrue {
+			signals.Reasons = append(signals.Reasons, condition.Reason)
+		}
+	}
+
+	for _, status := range append(append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...),
+		pod.Status.EphemeralContainerStatuses...) {
+		if status.State.Waiting != nil && len(status.State.Waiting.Reason) > 0 {
+			signals.Reasons = append(signals.Reasons, status.State.Waiting.Reason)
+		}
+
+		for _, terminated := range []*v1.ContainerStateTerminated{status.State.Terminated, status.LastTerminationState.Terminated} {
+			if terminated == nil || terminated.ExitCode == 0 {
+				continue
+			}
+
+			signals.ExitCodes = append(signals.ExitCodes, terminated.ExitCode)
+			if len(terminated.Reason) > 0 {
+				signals.Reasons = append(signals.Reasons, terminated.Reason)
+			}
+
+			if len(terminated.Message) > 0 {
+				signals.TerminationMessages = append(signals.TerminationMessages, terminated.Message)
+			}
+		}
+	}
+
+	return signals
+}
+
+// ClassifyFailure records the failure category of a failed phase in the custom info of its task info. Phases that are
+// not failures are left untouched.
+func ClassifyFailure(ctx context.Context, phaseInfo pluginsCore.PhaseInfo, signals FailureSignals) {
+	if !phaseInfo.Phase().IsFailure() || phaseInfo.Info() == nil {
+		return
+	}
+
+	classifier, err := getFailureClassifier()
+	if err != nil {
+		logger.Errorf(ctx, "Failed to create failure classifier. Error: %v", err)
+		return
+	}
+
+	pluginsCore.SetFailureCategory(phaseInfo.Info(), classifier.Classify(ctx, signals))
+}
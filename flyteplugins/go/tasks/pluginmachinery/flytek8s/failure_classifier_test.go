@@ -0,0 +1,142 @@
+package flytek8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s/config"
+)
+
+func TestRuleBasedFailureClassifier_Classify(t *testing.T) {
+	classifier, err := NewFailureClassifier([]config.FailureClassificationRule{
+		{Category: string(pluginsCore.FailureCategoryInfra), ExitCodes: []int32{137}, MessagePatterns: []string{`connection reset`}},
+	})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		signals  FailureSignals
+		expected pluginsCore.FailureCategory
+	}{
+		{"oom code", FailureSignals{Code: OOMKilled}, pluginsCore.FailureCategoryOOM},
+		{"image pull", FailureSignals{Code: "ContainersNotReady", Reasons: []string{"ImagePullBackOff"}}, pluginsCore.FailureCategoryImage},
+		{"preemption", FailureSignals{Code: Interrupted, Kind: core.ExecutionError_SYSTEM}, pluginsCore.FailureCategoryPreemption},
+		{"quota message", FailureSignals{Code: "Unschedulable", Message: "0/3 nodes are available: 3 Insufficient nvidia.com/gpu."}, pluginsCore.FailureCategoryQuota},
+		{"configured exit code", FailureSignals{Code: OOMKilled, ExitCodes: []int32{137}}, pluginsCore.FailureCategoryInfra},
+		{"configured termination message", FailureSignals{TerminationMessages: []string{"read: connection reset by peer"}}, pluginsCore.FailureCategoryInfra},
+		{"unmatched user error", FailureSignals{Code: "Error", Kind: core.ExecutionError_USER}, pluginsCore.FailureCategoryUser},
+		{"unmatched system error", FailureSignals{Code: "Error", Kind: core.ExecutionError_SYSTEM}, pluginsCore.FailureCategoryInfra},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, classifier.Classify(context.TODO(), tt.signals))
+		})
+	}
+}
+
+func TestNewFailureClassifier_InvalidRules(t *testing.T) {
+	_, err := NewFailureClassifier([]config.FailureClassificationRule{{Category: "flaky"}})
+	assert.Error(t, err)
+
+	_, err = NewFailureClassifier([]config.FailureClassificationRule{
+		{Category: string(pluginsCore.FailureCategoryUser), MessagePatterns: []string{"("}},
+	})
+	assert.Error(t, err)
+}
+
+func TestGetFailureClassifier(t *testing.T) {
+	assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{}))
+
+	classifier, err := getFailureClassifier()
+	assert.NoError(t, err)
+	assert.Equal(t, pluginsCore.FailureCategoryUser, classifier.Classify(context.TODO(), FailureSignals{ExitCodes: []int32{137}}))
+
+	t.Run("compiled once per config version", func(t *testing.T) {
+		first := configuredFailureClassifier.Load()
+		_, err := getFailureClassifier()
+		assert.NoError(t, err)
+		assert.Same(t, first, configuredFailureClassifier.Load())
+	})
+
+	t.Run("recompiled after the config changes", func(t *testing.T) {
+		assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{
+			FailureClassificationRules: []config.FailureClassificationRule{
+				{Category: string(pluginsCore.FailureCategoryInfra), ExitCodes: []int32{137}},
+			},
+		}))
+
+		classifier, err := getFailureClassifier()
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.FailureCategoryInfra, classifier.Classify(context.TODO(), FailureSignals{ExitCodes: []int32{137}}))
+	})
+
+	t.Run("invalid rules are rejected", func(t *testing.T) {
+		assert.Error(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{
+			FailureClassificationRules: []config.FailureClassificationRule{{Category: "flaky"}},
+		}))
+
+		classifier, err := getFailureClassifier()
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.FailureCategoryInfra, classifier.Classify(context.TODO(), FailureSignals{ExitCodes: []int32{137}}))
+	})
+}
+
+func TestGetFailureSignals(t *testing.T) {
+	pod := &v1.Pod{Status: v1.PodStatus{
+		Reason: "Evicted",
+		Conditions: []v1.PodCondition{
+			{Type: v1.DisruptionTarget, Status: v1.ConditionTrue, Reason: "TerminationByKubelet"},
+			{Type: v1.PodReady, Status: v1.ConditionFalse, Reason: "ContainersNotReady"},
+		},
+		ContainerStatuses: []v1.ContainerStatus{
+			{State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 137, Reason: OOMKilled, Message: "killed"}}},
+			{State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ErrImagePull"}}},
+			{State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"}}},
+		},
+	}}
+
+	phaseInfo := pluginsCore.PhaseInfoSystemRetryableFailure("Evicted", "The node was low on resource: memory.", &pluginsCore.TaskInfo{})
+	signals := GetFailureSignals(pod, phaseInfo)
+	assert.Equal(t, "Evicted", signals.Code)
+	assert.Equal(t, core.ExecutionError_SYSTEM, signals.Kind)
+	assert.Equal(t, []string{"Evicted", "TerminationByKubelet", OOMKilled, "ErrImagePull"}, signals.Reasons)
+	assert.Equal(t, []int32{137}, signals.ExitCodes)
+	assert.Equal(t, []string{"killed"}, signals.TerminationMessages)
+}
+
+type staticFailureClassifier pluginsCore.FailureCategory
+
+func (c staticFailureClassifier) Classify(context.Context, FailureSignals) pluginsCore.FailureCategory {
+	return pluginsCore.FailureCategory(c)
+}
+
+func TestClassifyFailure(t *testing.T) {
+	assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{}))
+
+	t.Run("failure", func(t *testing.T) {
+		phaseInfo := pluginsCore.PhaseInfoRetryableFailure(OOMKilled, "oom", &pluginsCore.TaskInfo{})
+		ClassifyFailure(context.TODO(), phaseInfo, FailureSignals{Code: OOMKilled})
+		assert.Equal(t, pluginsCore.FailureCategoryOOM, pluginsCore.GetFailureCategory(phaseInfo.Info().CustomInfo))
+	})
+
+	t.Run("not a failure", func(t *testing.T) {
+		phaseInfo := pluginsCore.PhaseInfoRunning(1, &pluginsCore.TaskInfo{})
+		ClassifyFailure(context.TODO(), phaseInfo, FailureSignals{Code: OOMKilled})
+		assert.Nil(t, phaseInfo.Info().CustomInfo)
+	})
+
+	t.Run("custom classifier", func(t *testing.T) {
+		SetFailureClassifier(staticFailureClassifier(pluginsCore.FailureCategoryQuota))
+		defer SetFailureClassifier(nil)
+
+		phaseInfo := pluginsCore.PhaseInfoRetryableFailure(OOMKilled, "oom", &pluginsCore.TaskInfo{})
+		ClassifyFailure(context.TODO(), phaseInfo, FailureSignals{Code: OOMKilled})
+		assert.Equal(t, pluginsCore.FailureCategoryQuota, pluginsCore.GetFailureCategory(phaseInfo.Info().CustomInfo))
+	})
+}
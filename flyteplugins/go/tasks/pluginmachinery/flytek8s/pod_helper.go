@@ -468,10 +468,12 @@ func ApplyFlytePodConfiguration(ctx context.Context, tCtx pluginsCore.TaskExecut
 			return nil, nil, err
 		}
 
-		resourceRequests = append(resourceRequests, podSpec.Containers[index].Resources)
 		if container.Name == primaryContainerName {
+			EscalateResourcesOnRetry(ctx, tCtx.TaskExecutionMetadata(), &podSpec.Containers[index], objectMeta)
 			primaryContainer = &podSpec.Containers[index]
 		}
+
+		resourceRequests = append(resourceRequests, podSpec.Containers[index].Resources)
 	}
 
 	if primaryContainer == nil {
@@ -1211,6 +1213,12 @@ func DemystifyFailure(ctx context.Context, status v1.PodStatus, info pluginsCore
 	// }
 	//
 
+	// Evictions caused by ephemeral storage usage only get their own code when retries escalate the ephemeral storage
+	// request, so users and retry policies keep seeing "Evicted" otherwise.
+	if config.GetK8sPluginConfig().RetryResourceEscalation.Enabled && isEphemeralStorageEviction(status) {
+		code = EphemeralStorageEvicted
+	}
+
 	var isSystemError bool
 	// In some versions of GKE the reason can also be "Terminated" or "NodeShutdown"
 	if retryableStatusReasons.Has(code) {
@@ -0,0 +1,137 @@
+package flytek8s
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strings"
+
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s/config"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+// EphemeralStorageEvicted is the failure code of pods that were evicted for exceeding their ephemeral storage.
+const EphemeralStorageEvicted = "EphemeralStorageEvicted"
+
+// ResourceEscalationKey is the annotation under which the requests that were escalated for a retry are recorded on the
+// pod.
+const ResourceEscalationKey = "flyte.org/resource-escalation"
+
+// ResourceEscalationCustomInfoKey is the key under which escalated requests are reported in the custom info of task
+// execution events.
+const ResourceEscalationCustomInfoKey = "resourceEscalation"
+
+const podEvictedReason = "Evicted"
+
+// isEphemeralStorageEviction returns true if the pod status reports an eviction caused by ephemeral storage usage, e.g.
+// "The node was low on resource: ephemeral-storage." or "Pod ephemeral local storage usage exceeds the total limit of
+// containers 1Gi."
+func isEphemeralStorageEviction(status v1.PodStatus) bool {
+	return status.Reason == podEvictedReason && (strings.Contains(status.Message, string(v1.ResourceEphemeralStorage)) ||
+		strings.Contains(status.Message, "ephemeral local storage"))
+}
+
+// escalatedResourceName returns the resource whose request should be increased after an attempt failed with the
+// given error.
+func escalatedResourceName(err *core.ExecutionError) (v1.ResourceName, bool) {
+	switch err.GetCode() {
+	case OOMKilled:
+		return v1.ResourceMemory, true
+	case EphemeralStorageEvicted:
+		return v1.ResourceEphemeralStorage, true
+	}
+
+	return "", false
+}
+
+// EscalateResourcesOnRetry increases the request of the resource that caused the previous attempt to fail by the
+// configured factor for every retry attempt. Requests are never raised past the container limit or the platform cap.
+// The escalated requests are recorded on the pod annotations so that they are reported in task execution events.
+func EscalateResourcesOnRetry(ctx context.Context, taskExecMetadata pluginsCore.TaskExecutionMetadata,
+	container *v1.Container, objectMeta *metav1.ObjectMeta) {
+
+	cfg := config.GetK8sPluginConfig().RetryResourceEscalation
+	if !cfg.Enabled || cfg.Factor <= 1 {
+		return
+	}
+
+	attempt := taskExecMetadata.GetTaskExecutionID().GetID().RetryAttempt
+	if attempt == 0 {
+		return
+	}
+
+	resourceName, ok := escalatedResourceName(taskExecMetadata.GetPreviousAttemptError())
+	if !ok {
+		return
+	}
+
+	request, found := container.Resources.Requests[resourceName]
+	if !found || request.IsZero() {
+		return
+	}
+
+	escalated := *resource.NewQuantity(int64(float64(request.Value())*math.Pow(cfg.Factor, float64(attempt))), request.Format)
+	upperBounds := []resource.Quantity{container.Resources.Limits[resourceName]}
+	switch resourceName {
+	case v1.ResourceMemory:
+		upperBounds = append(upperBounds, cfg.MaxMemory)
+	case v1.ResourceEphemeralStorage:
+		upperBounds = append(upperBounds, cfg.MaxEphemeralStorage)
+	}
+
+	for _, upperBound := range upperBounds {
+		if !upperBound.IsZero() && escalated.Cmp(upperBound) > 0 {
+			escalated = upperBound.DeepCopy()
+		}
+	}
+
+	if escalated.Cmp(request) <= 0 {
+		return
+	}
+
+	logger.Infof(ctx, "Escalating [%v] request of container [%s] from [%v] to [%v] for attempt [%d]", resourceName,
+		container.Name, request.String(), escalated.String(), attempt)
+	container.Resources.Requests[resourceName] = escalated
+
+	raw, err := json.Marshal(map[v1.ResourceName]string{resourceName: escalated.String()})
+	if err != nil {
+		logger.Warnf(ctx, "Failed to record escalated resources. Error: %v", err)
+		return
+	}
+
+	if objectMeta.Annotations == nil {
+		objectMeta.Annotations = map[string]string{}
+	}
+
+	objectMeta.Annotations[ResourceEscalationKey] = string(raw)
+}
+
+// GetResourceEscalationInfo returns the requests that were escalated for the attempt that created the pod in the form
+// they are attached to the custom info of task execution events, or nil if none were escalated.
+func GetResourceEscalationInfo(pod *v1.Pod) *structpb.Struct {
+	raw, found := pod.GetAnnotations()[ResourceEscalationKey]
+	if !found {
+		return nil
+	}
+
+	escalated := map[string]string{}
+	if err := json.Unmarshal([]byte(raw), &escalated); err != nil {
+		return nil
+	}
+
+	fields := make(map[string]*structpb.Value, len(escalated))
+	for name, value := range escalated {
+		fields[name] = &structpb.Value{Kind: &structpb.Value_StringValue{StringValue: value}}
+	}
+
+	return &structpb.Struct{Fields: map[string]*structpb.Value{
+		ResourceEscalationCustomInfoKey: {Kind: &structpb.Value_StructValue{StructValue: &structpb.Struct{Fields: fields}}},
+	}}
+}
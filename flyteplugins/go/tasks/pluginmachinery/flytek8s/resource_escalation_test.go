@@ -0,0 +1,147 @@
+package flytek8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	pluginsCoreMock "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core/mocks"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s/config"
+)
+
+func escalationTaskExecutionMetadata(attempt uint32, previousErr *core.ExecutionError) pluginsCore.TaskExecutionMetadata {
+	tID := &pluginsCoreMock.TaskExecutionID{}
+	tID.EXPECT().GetID().Return(core.TaskExecutionIdentifier{RetryAttempt: attempt})
+
+	taskExecutionMetadata := &pluginsCoreMock.TaskExecutionMetadata{}
+	taskExecutionMetadata.EXPECT().GetTaskExecutionID().Return(tID)
+	taskExecutionMetadata.EXPECT().GetPreviousAttemptError().Return(previousErr)
+	return taskExecutionMetadata
+}
+
+func TestEscalateResourcesOnRetry(t *testing.T) {
+	assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{
+		RetryResourceEscalation: config.RetryResourceEscalationConfig{
+			Enabled:             true,
+			Factor:              2,
+			MaxMemory:           resource.MustParse("3Gi"),
+			MaxEphemeralStorage: resource.MustParse("10Gi"),
+		},
+	}))
+
+	oomKilled := &core.ExecutionError{Code: OOMKilled}
+	newContainer := func(limits v1.ResourceList) *v1.Container {
+		return &v1.Container{
+			Name: "primary",
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceMemory:           resource.MustParse("512Mi"),
+					v1.ResourceEphemeralStorage: resource.MustParse("1Gi"),
+				},
+				Limits: limits,
+			},
+		}
+	}
+
+	t.Run("first attempt", func(t *testing.T) {
+		container, objectMeta := newContainer(nil), &metav1.ObjectMeta{}
+		EscalateResourcesOnRetry(context.TODO(), escalationTaskExecutionMetadata(0, oomKilled), container, objectMeta)
+		assert.Equal(t, "512Mi", container.Resources.Requests.Memory().String())
+		assert.Empty(t, objectMeta.Annotations)
+	})
+
+	t.Run("unrelated failure", func(t *testing.T) {
+		container, objectMeta := newContainer(nil), &metav1.ObjectMeta{}
+		EscalateResourcesOnRetry(context.TODO(), escalationTaskExecutionMetadata(1, &core.ExecutionError{Code: "USER:Error"}),
+			container, objectMeta)
+		assert.Equal(t, "512Mi", container.Resources.Requests.Memory().String())
+		assert.Empty(t, objectMeta.Annotations)
+	})
+
+	t.Run("oom killed", func(t *testing.T) {
+		container, objectMeta := newContainer(nil), &metav1.ObjectMeta{}
+		EscalateResourcesOnRetry(context.TODO(), escalationTaskExecutionMetadata(2, oomKilled), container, objectMeta)
+		assert.Equal(t, "2Gi", container.Resources.Requests.Memory().String())
+		assert.Equal(t, "1Gi", container.Resources.Requests.StorageEphemeral().String())
+		assert.Equal(t, `{"memory":"2Gi"}`, objectMeta.Annotations[ResourceEscalationKey])
+
+		info := GetResourceEscalationInfo(&v1.Pod{ObjectMeta: *objectMeta})
+		assert.Equal(t, "2Gi", info.GetFields()[ResourceEscalationCustomInfoKey].GetStructValue().GetFields()["memory"].GetStringValue())
+	})
+
+	t.Run("bounded by limit", func(t *testing.T) {
+		container, objectMeta := newContainer(v1.ResourceList{v1.ResourceMemory: resource.MustParse("1Gi")}), &metav1.ObjectMeta{}
+		EscalateResourcesOnRetry(context.TODO(), escalationTaskExecutionMetadata(2, oomKilled), container, objectMeta)
+		assert.Equal(t, "1Gi", container.Resources.Requests.Memory().String())
+	})
+
+	t.Run("bounded by platform cap", func(t *testing.T) {
+		container, objectMeta := newContainer(nil), &metav1.ObjectMeta{}
+		EscalateResourcesOnRetry(context.TODO(), escalationTaskExecutionMetadata(4, oomKilled), container, objectMeta)
+		assert.Equal(t, "3Gi", container.Resources.Requests.Memory().String())
+	})
+
+	t.Run("ephemeral storage eviction", func(t *testing.T) {
+		container, objectMeta := newContainer(nil), &metav1.ObjectMeta{}
+		EscalateResourcesOnRetry(context.TODO(), escalationTaskExecutionMetadata(1, &core.ExecutionError{Code: EphemeralStorageEvicted}),
+			container, objectMeta)
+		assert.Equal(t, "512Mi", container.Resources.Requests.Memory().String())
+		assert.Equal(t, "2Gi", container.Resources.Requests.StorageEphemeral().String())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{}))
+		container, objectMeta := newContainer(nil), &metav1.ObjectMeta{}
+		EscalateResourcesOnRetry(context.TODO(), &pluginsCoreMock.TaskExecutionMetadata{}, container, objectMeta)
+		assert.Equal(t, "512Mi", container.Resources.Requests.Memory().String())
+	})
+}
+
+func TestGetResourceEscalationInfo(t *testing.T) {
+	assert.Nil(t, GetResourceEscalationInfo(&v1.Pod{}))
+	assert.Nil(t, GetResourceEscalationInfo(&v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Annotations: map[string]string{ResourceEscalationKey: "not-json"},
+	}}))
+}
+
+func TestDemystifyFailure_EphemeralStorageEviction(t *testing.T) {
+	storageEvicted := v1.PodStatus{
+		Phase:   v1.PodFailed,
+		Reason:  "Evicted",
+		Message: "Pod ephemeral local storage usage exceeds the total limit of containers 1Gi.",
+	}
+
+	t.Run("escalation enabled", func(t *testing.T) {
+		assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{
+			RetryResourceEscalation: config.RetryResourceEscalationConfig{Enabled: true},
+		}))
+
+		phaseInfo, err := DemystifyFailure(context.TODO(), storageEvicted, pluginsCore.TaskInfo{}, "")
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.PhaseRetryableFailure, phaseInfo.Phase())
+		assert.Equal(t, EphemeralStorageEvicted, phaseInfo.Err().GetCode())
+
+		phaseInfo, err = DemystifyFailure(context.TODO(), v1.PodStatus{
+			Phase:   v1.PodFailed,
+			Reason:  "Evicted",
+			Message: "The node was low on resource: memory.",
+		}, pluginsCore.TaskInfo{}, "")
+		assert.NoError(t, err)
+		assert.Equal(t, "Evicted", phaseInfo.Err().GetCode())
+	})
+
+	t.Run("escalation disabled", func(t *testing.T) {
+		assert.NoError(t, config.SetK8sPluginConfig(&config.K8sPluginConfig{}))
+
+		phaseInfo, err := DemystifyFailure(context.TODO(), storageEvicted, pluginsCore.TaskInfo{}, "")
+		assert.NoError(t, err)
+		assert.Equal(t, pluginsCore.PhaseRetryableFailure, phaseInfo.Phase())
+		assert.Equal(t, "Evicted", phaseInfo.Err().GetCode())
+	})
+}
@@ -3,8 +3,10 @@
 package mocks
 
 import (
-	promutils "github.com/flyteorg/flyte/flytestdlib/promutils"
+	core "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
 	mock "github.com/stretchr/testify/mock"
+
+	promutils "github.com/flyteorg/flyte/flytestdlib/promutils"
 )
 
 // PluginSetupContext is an autogenerated mock type for the PluginSetupContext type
@@ -67,6 +69,53 @@ func (_c *PluginSetupContext_MetricsScope_Call) RunAndReturn(run func() promutil
 	return _c
 }
 
+// SecretManager provides a mock function with given fields:
+func (_m *PluginSetupContext) SecretManager() core.SecretManager {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SecretManager")
+	}
+
+	var r0 core.SecretManager
+	if rf, ok := ret.Get(0).(func() core.SecretManager); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.SecretManager)
+		}
+	}
+
+	return r0
+}
+
+// PluginSetupContext_SecretManager_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecretManager'
+type PluginSetupContext_SecretManager_Call struct {
+	*mock.Call
+}
+
+// SecretManager is a helper method to define mock.On call
+func (_e *PluginSetupContext_Expecter) SecretManager() *PluginSetupContext_SecretManager_Call {
+	return &PluginSetupContext_SecretManager_Call{Call: _e.mock.On("SecretManager")}
+}
+
+func (_c *PluginSetupContext_SecretManager_Call) Run(run func()) *PluginSetupContext_SecretManager_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PluginSetupContext_SecretManager_Call) Return(_a0 core.SecretManager) *PluginSetupContext_SecretManager_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PluginSetupContext_SecretManager_Call) RunAndReturn(run func() core.SecretManager) *PluginSetupContext_SecretManager_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPluginSetupContext creates a new instance of PluginSetupContext. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPluginSetupContext(t interface {
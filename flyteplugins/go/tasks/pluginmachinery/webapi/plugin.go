@@ -48,6 +48,9 @@ type PluginEntry struct {
 type PluginSetupContext interface {
 	// a metrics scope to publish stats under
 	MetricsScope() promutils.Scope
+
+	// Returns a secret manager that can retrieve configured secrets for this plugin
+	SecretManager() pluginsCore.SecretManager
 }
 
 type TaskExecutionContextReader interface {
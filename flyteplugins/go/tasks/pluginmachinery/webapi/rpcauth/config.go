@@ -0,0 +1,52 @@
+// Package rpcauth attaches per-request credentials to the gRPC calls web API plugins send to remote services, so the
+// services can authenticate propeller and tell which task execution a request is sent on behalf of.
+package rpcauth
+
+import (
+	"github.com/flyteorg/flyte/flytestdlib/config"
+)
+
+type Type string
+
+const (
+	// TypeNone sends no credentials.
+	TypeNone Type = ""
+	// TypeStaticToken sends a bearer token read from the secret manager.
+	TypeStaticToken Type = "StaticToken"
+	// TypeClientCredentials sends a bearer token obtained through the OAuth2 client credentials flow.
+	TypeClientCredentials Type = "ClientCredentials"
+	// TypeSignedJWT sends a JWT signed by propeller which carries the identity of the task execution.
+	TypeSignedJWT Type = "SignedJWT"
+)
+
+type Config struct {
+	// Type of the credentials to send
+	Type Type `json:"type"`
+
+	// TokenSecretName is the secret holding the static token
+	TokenSecretName string `json:"tokenSecretName"`
+
+	// TokenURL is the OAuth2 token endpoint used by the client credentials flow
+	TokenURL string `json:"tokenUrl"`
+
+	// ClientID is the OAuth2 client used by the client credentials flow
+	ClientID string `json:"clientId"`
+
+	// ClientSecretName is the secret holding the secret of the OAuth2 client
+	ClientSecretName string `json:"clientSecretName"`
+
+	// Scopes requested by the client credentials flow
+	Scopes []string `json:"scopes"`
+
+	// SigningKeySecretName is the secret holding the PEM encoded RSA or EC private key signed JWTs are signed with
+	SigningKeySecretName string `json:"signingKeySecretName"`
+
+	// Issuer of signed JWTs
+	Issuer string `json:"issuer"`
+
+	// Audience of OAuth2 and signed tokens; defaults to the endpoint of the remote service for signed JWTs
+	Audience string `json:"audience"`
+
+	// TokenLifetime is how long signed JWTs are valid for
+	TokenLifetime config.Duration `json:"tokenLifetime"`
+}
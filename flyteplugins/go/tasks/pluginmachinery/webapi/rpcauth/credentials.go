@@ -0,0 +1,241 @@
+package rpcauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"google.golang.org/grpc"
+
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+)
+
+const (
+	defaultIssuer        = "flytepropeller"
+	defaultTokenLifetime = 5 * time.Minute
+	authorizationHeader  = "authorization"
+)
+
+// Identity is the identity of the task execution a request is sent on behalf of.
+type Identity struct {
+	Project       string
+	Domain        string
+	ExecutionName string
+	// TaskExecution uniquely identifies the task execution attempt.
+	TaskExecution string
+	// Principal is the subject who launched the execution, if known.
+	Principal string
+}
+
+// NewIdentity returns the identity of a task execution.
+func NewIdentity(metadata core.TaskExecutionMetadata) Identity {
+	taskExecutionID := metadata.GetTaskExecutionID()
+	id := taskExecutionID.GetID()
+	executionID := id.GetNodeExecutionId().GetExecutionId()
+	securityContext := metadata.GetSecurityContext()
+	return Identity{
+		Project:       executionID.GetProject(),
+		Domain:        executionID.GetDomain(),
+		ExecutionName: executionID.GetName(),
+		TaskExecution: taskExecutionID.GetGeneratedName(),
+		Principal:     securityContext.GetRunAs().GetExecutionIdentity(),
+	}
+}
+
+// Claims are the claims of the JWTs signed by propeller. The subject is the task execution.
+type Claims struct {
+	Project   string `json:"project"`
+	Domain    string `json:"domain"`
+	Execution string `json:"execution"`
+	Principal string `json:"principal,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Provider creates the credentials attached to the requests sent to a single remote service. A nil Provider attaches
+// no credentials.
+type Provider struct {
+	cfg           Config
+	audience      string
+	insecure      bool
+	secretManager core.SecretManager
+
+	mu            sync.Mutex
+	tokenSource   oauth2.TokenSource
+	signingKey    crypto.Signer
+	signingMethod jwt.SigningMethod
+}
+
+// NewProvider returns the provider of credentials for the service at the endpoint. Credentials are sent over insecure
+// channels only when insecure is set.
+func NewProvider(cfg Config, endpoint string, insecure bool, secretManager core.SecretManager) *Provider {
+	audience := cfg.Audience
+	if len(audience) == 0 {
+		audience = endpoint
+	}
+	return &Provider{
+		cfg:           cfg,
+		audience:      audience,
+		insecure:      insecure,
+		secretManager: secretManager,
+	}
+}
+
+// CallOptions returns the call options which attach the credentials to a request sent on behalf of the identity.
+func (p *Provider) CallOptions(ctx context.Context, identity Identity) ([]grpc.CallOption, error) {
+	if p == nil || p.cfg.Type == TypeNone {
+		return nil, nil
+	}
+	token, err := p.getToken(ctx, identity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get [%v] credentials with error: %w", p.cfg.Type, err)
+	}
+	return []grpc.CallOption{grpc.PerRPCCredentials(bearerToken{
+		token:                    token,
+		requireTransportSecurity: !p.insecure,
+	})}, nil
+}
+
+func (p *Provider) getToken(ctx context.Context, identity Identity) (string, error) {
+	switch p.cfg.Type {
+	case TypeStaticToken:
+		return p.getSecret(ctx, p.cfg.TokenSecretName)
+	case TypeClientCredentials:
+		tokenSource, err := p.getTokenSource(ctx)
+		if err != nil {
+			return "", err
+		}
+		token, err := tokenSource.Token()
+		if err != nil {
+			return "", err
+		}
+		return token.AccessToken, nil
+	case TypeSignedJWT:
+		return p.signToken(ctx, identity)
+	}
+	return "", fmt.Errorf("unsupported credentials type [%v]", p.cfg.Type)
+}
+
+func (p *Provider) getSecret(ctx context.Context, name string) (string, error) {
+	if p.secretManager == nil {
+		return "", fmt.Errorf("no secret manager to read secret [%v] from", name)
+	}
+	return p.secretManager.Get(ctx, name)
+}
+
+// getTokenSource returns the source of client credentials tokens, which caches tokens until they expire.
+func (p *Provider) getTokenSource(ctx context.Context) (oauth2.TokenSource, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.tokenSource != nil {
+		return p.tokenSource, nil
+	}
+
+	clientSecret, err := p.getSecret(ctx, p.cfg.ClientSecretName)
+	if err != nil {
+		return nil, err
+	}
+	clientCredentials := clientcredentials.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: clientSecret,
+		TokenURL:     p.cfg.TokenURL,
+		Scopes:       p.cfg.Scopes,
+	}
+	if len(p.cfg.Audience) > 0 {
+		clientCredentials.EndpointParams = url.Values{"audience": {p.cfg.Audience}}
+	}
+	// The token source outlives the request it is created for.
+	p.tokenSource = clientCredentials.TokenSource(context.Background())
+	return p.tokenSource, nil
+}
+
+func (p *Provider) getSigningKey(ctx context.Context) (crypto.Signer, jwt.SigningMethod, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.signingKey != nil {
+		return p.signingKey, p.signingMethod, nil
+	}
+
+	pemKey, err := p.getSecret(ctx, p.cfg.SigningKeySecretName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rsaKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(pemKey)); err == nil {
+		p.signingKey, p.signingMethod = rsaKey, jwt.SigningMethodRS256
+		return p.signingKey, p.signingMethod, nil
+	}
+	ecKey, err := jwt.ParseECPrivateKeyFromPEM([]byte(pemKey))
+	if err != nil {
+		return nil, nil, fmt.Errorf("signing key is neither an RSA nor an EC private key")
+	}
+	signingMethod, err := getECSigningMethod(ecKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	p.signingKey, p.signingMethod = ecKey, signingMethod
+	return p.signingKey, p.signingMethod, nil
+}
+
+func getECSigningMethod(key *ecdsa.PrivateKey) (jwt.SigningMethod, error) {
+	switch key.Curve.Params().BitSize {
+	case 256:
+		return jwt.SigningMethodES256, nil
+	case 384:
+		return jwt.SigningMethodES384, nil
+	case 521:
+		return jwt.SigningMethodES512, nil
+	}
+	return nil, fmt.Errorf("unsupported EC curve [%v]", key.Curve.Params().Name)
+}
+
+func (p *Provider) signToken(ctx context.Context, identity Identity) (string, error) {
+	signingKey, signingMethod, err := p.getSigningKey(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	issuer := p.cfg.Issuer
+	if len(issuer) == 0 {
+		issuer = defaultIssuer
+	}
+	lifetime := p.cfg.TokenLifetime.Duration
+	if lifetime == 0 {
+		lifetime = defaultTokenLifetime
+	}
+	now := time.Now()
+	claims := Claims{
+		Project:   identity.Project,
+		Domain:    identity.Domain,
+		Execution: identity.ExecutionName,
+		Principal: identity.Principal,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    issuer,
+			Subject:   identity.TaskExecution,
+			Audience:  jwt.ClaimStrings{p.audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(lifetime)),
+		},
+	}
+	return jwt.NewWithClaims(signingMethod, claims).SignedString(signingKey)
+}
+
+// bearerToken attaches a token to every request of a call as an authorization header.
+type bearerToken struct {
+	token                    string
+	requireTransportSecurity bool
+}
+
+func (b bearerToken) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{authorizationHeader: "Bearer " + b.token}, nil
+}
+
+func (b bearerToken) RequireTransportSecurity() bool {
+	return b.requireTransportSecurity
+}
@@ -0,0 +1,183 @@
+package rpcauth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core/mocks"
+)
+
+var testIdentity = Identity{
+	Project:       "flytesnacks",
+	Domain:        "development",
+	ExecutionName: "f8a8c3e2b1",
+	TaskExecution: "f8a8c3e2b1-n0-0",
+	Principal:     "alice",
+}
+
+// startTestServer starts a health server which verifies the bearer token of every request before serving it.
+func startTestServer(t *testing.T, verify func(token string) error) grpc_health_v1.HealthClient {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{},
+		_ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		authorization := md.Get(authorizationHeader)
+		if len(authorization) != 1 || !strings.HasPrefix(authorization[0], "Bearer ") {
+			return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+		}
+		if err := verify(strings.TrimPrefix(authorization[0], "Bearer ")); err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return handler(ctx, req)
+	}))
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return grpc_health_v1.NewHealthClient(conn)
+}
+
+func callWithCredentials(ctx context.Context, client grpc_health_v1.HealthClient, provider *Provider) error {
+	opts, err := provider.CallOptions(ctx, testIdentity)
+	if err != nil {
+		return err
+	}
+	_, err = client.Check(ctx, &grpc_health_v1.HealthCheckRequest{}, opts...)
+	return err
+}
+
+func newSecretManager(secrets map[string]string) *mocks.SecretManager {
+	secretManager := &mocks.SecretManager{}
+	for name, value := range secrets {
+		secretManager.EXPECT().Get(mock.Anything, name).Return(value, nil)
+	}
+	return secretManager
+}
+
+func TestNoCredentials(t *testing.T) {
+	client := startTestServer(t, func(token string) error { return nil })
+	err := callWithCredentials(context.Background(), client, NewProvider(Config{}, "connector:8000", true, nil))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	var provider *Provider
+	opts, err := provider.CallOptions(context.Background(), testIdentity)
+	assert.NoError(t, err)
+	assert.Empty(t, opts)
+}
+
+func TestStaticToken(t *testing.T) {
+	client := startTestServer(t, func(token string) error {
+		if token != "s3cr3t" {
+			return status.Error(codes.Unauthenticated, "wrong token")
+		}
+		return nil
+	})
+	provider := NewProvider(Config{Type: TypeStaticToken, TokenSecretName: "connector-token"}, "connector:8000", true,
+		newSecretManager(map[string]string{"connector-token": "s3cr3t"}))
+	assert.NoError(t, callWithCredentials(context.Background(), client, provider))
+
+	t.Run("requires transport security", func(t *testing.T) {
+		provider := NewProvider(Config{Type: TypeStaticToken, TokenSecretName: "connector-token"}, "connector:8000", false,
+			newSecretManager(map[string]string{"connector-token": "s3cr3t"}))
+		assert.Error(t, callWithCredentials(context.Background(), client, provider))
+	})
+}
+
+func TestClientCredentials(t *testing.T) {
+	requests := 0
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		clientID, clientSecret, _ := r.BasicAuth()
+		if clientID != "propeller" || clientSecret != "client-s3cr3t" || r.FormValue("audience") != "connectors" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"oauth-token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer tokenServer.Close()
+
+	client := startTestServer(t, func(token string) error {
+		if token != "oauth-token" {
+			return status.Error(codes.Unauthenticated, "wrong token")
+		}
+		return nil
+	})
+	provider := NewProvider(Config{
+		Type:             TypeClientCredentials,
+		TokenURL:         tokenServer.URL,
+		ClientID:         "propeller",
+		ClientSecretName: "client-secret",
+		Audience:         "connectors",
+	}, "connector:8000", true, newSecretManager(map[string]string{"client-secret": "client-s3cr3t"}))
+	assert.NoError(t, callWithCredentials(context.Background(), client, provider))
+	assert.NoError(t, callWithCredentials(context.Background(), client, provider))
+	assert.Equal(t, 1, requests, "tokens are reused until they expire")
+}
+
+func TestSignedJWT(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+
+	var claims *Claims
+	client := startTestServer(t, func(token string) error {
+		claims = &Claims{}
+		_, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+			return &key.PublicKey, nil
+		}, jwt.WithValidMethods([]string{"ES256"}), jwt.WithAudience("connector:8000"), jwt.WithIssuer(defaultIssuer))
+		return err
+	})
+	provider := NewProvider(Config{Type: TypeSignedJWT, SigningKeySecretName: "signing-key"}, "connector:8000", true,
+		newSecretManager(map[string]string{"signing-key": string(pemKey)}))
+	require.NoError(t, callWithCredentials(context.Background(), client, provider))
+
+	assert.Equal(t, testIdentity.Project, claims.Project)
+	assert.Equal(t, testIdentity.Domain, claims.Domain)
+	assert.Equal(t, testIdentity.ExecutionName, claims.Execution)
+	assert.Equal(t, testIdentity.Principal, claims.Principal)
+	assert.Equal(t, testIdentity.TaskExecution, claims.Subject)
+	assert.Equal(t, defaultTokenLifetime, claims.ExpiresAt.Sub(claims.IssuedAt.Time))
+
+	t.Run("wrong key", func(t *testing.T) {
+		otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		der, err := x509.MarshalECPrivateKey(otherKey)
+		require.NoError(t, err)
+		provider := NewProvider(Config{Type: TypeSignedJWT, SigningKeySecretName: "signing-key"}, "connector:8000", true,
+			newSecretManager(map[string]string{"signing-key": string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))}))
+		err = callWithCredentials(context.Background(), client, provider)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
@@ -245,6 +245,12 @@ func (s SubTaskExecutionMetadata) IsInterruptible() bool {
 	return s.interruptible
 }
 
+// GetPreviousAttemptError overrides the base TaskExecutionMetadata because the error of the previous attempt of the
+// array node does not describe the previous attempt of an individual subtask
+func (s SubTaskExecutionMetadata) GetPreviousAttemptError() *core.ExecutionError {
+	return nil
+}
+
 // NewSubtaskExecutionMetadata constructs a SubTaskExecutionMetadata using the provided parameters
 func NewSubTaskExecutionMetadata(taskExecutionMetadata pluginsCore.TaskExecutionMetadata, taskTemplate *core.TaskTemplate,
 	executionIndex int, retryAttempt uint64, systemFailures uint64) (SubTaskExecutionMetadata, error) {
@@ -169,6 +169,7 @@ func (plugin) GetTaskPhaseWithLogs(ctx context.Context, pluginContext k8s.Plugin
 	info := pluginsCore.TaskInfo{
 		OccurredAt: &transitionOccurredAt,
 		ReportedAt: &reportedAt,
+		CustomInfo: flytek8s.GetResourceEscalationInfo(pod),
 	}
 
 	taskExecID := pluginContext.TaskExecutionMetadata().GetTaskExecutionID()
@@ -251,6 +252,7 @@ func DemystifyPodStatus(ctx context.Context, pod *v1.Pod, info pluginsCore.TaskI
 		return pluginsCore.PhaseInfoUndefined, err
 	}
 
+	flytek8s.ClassifyFailure(ctx, phaseInfo, flytek8s.GetFailureSignals(pod, phaseInfo))
 	k8s.MaybeUpdatePhaseVersion(&phaseInfo, &pluginState)
 	return phaseInfo, err
 }
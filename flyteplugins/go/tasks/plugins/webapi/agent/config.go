@@ -6,6 +6,7 @@ import (
 	pluginsConfig "github.com/flyteorg/flyte/flyteplugins/go/tasks/config"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/webapi"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/webapi/rpcauth"
 	"github.com/flyteorg/flyte/flytestdlib/config"
 )
 
@@ -93,6 +94,9 @@ type Deployment struct {
 
 	// DefaultTimeout gives the default RPC timeout if a more specific one is not defined in Timeouts; if neither DefaultTimeout nor Timeouts is defined for an operation, RPC timeout will not be enforced
 	DefaultTimeout config.Duration `json:"defaultTimeout"`
+
+	// Auth configures the credentials sent with every request to the agent
+	Auth rpcauth.Config `json:"auth"`
 }
 
 func GetConfig() *Config {
@@ -348,6 +348,7 @@ func newFakeSetupContext(name string) *pluginCoreMocks.SetupContext {
 	fakeSetupContext := pluginCoreMocks.SetupContext{}
 	fakeSetupContext.EXPECT().MetricsScope().Return(promutils.NewScope(name))
 	fakeSetupContext.EXPECT().ResourceRegistrar().Return(&fakeResourceRegistrar)
+	fakeSetupContext.EXPECT().SecretManager().Return(&pluginCoreMocks.SecretManager{}).Maybe()
 
 	return &fakeSetupContext
 }
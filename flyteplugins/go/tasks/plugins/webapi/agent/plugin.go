@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"golang.org/x/exp/maps"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/structpb"
 	"k8s.io/apimachinery/pkg/util/wait"
 
@@ -22,6 +23,7 @@ import (
 	flyteIO "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/io"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/ioutils"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/webapi"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/webapi/rpcauth"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 	"github.com/flyteorg/flyte/flytestdlib/promutils"
 )
@@ -55,7 +57,10 @@ type Plugin struct {
 	cfg         *Config
 	cs          *ClientSet
 	registry    Registry
-	mu          sync.RWMutex
+	// secretManager reads the secrets the credentials sent to agents are made of.
+	secretManager core.SecretManager
+	credentials   map[string]*rpcauth.Provider // map[endpoint] => Provider
+	mu            sync.RWMutex
 }
 
 type ResourceWrapper struct {
@@ -78,6 +83,9 @@ type ResourceMetaWrapper struct {
 	OutputPrefix      string
 	AgentResourceMeta []byte
 	TaskCategory      admin.TaskCategory
+	// Identity is the identity of the task execution the resource is created for, which the credentials sent with
+	// later requests about the resource carry.
+	Identity rpcauth.Identity
 }
 
 func (p *Plugin) setRegistry(r Registry) {
@@ -133,6 +141,11 @@ func (p *Plugin) Create(ctx context.Context, taskCtx webapi.TaskExecutionContext
 	agent, isSync := p.getFinalAgent(&taskCategory, p.cfg)
 
 	taskExecutionMetadata := buildTaskExecutionMetadata(taskCtx.TaskExecutionMetadata())
+	identity := rpcauth.NewIdentity(taskCtx.TaskExecutionMetadata())
+	opts, err := p.getCallOptions(ctx, agent, identity)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	if isSync {
 		finalCtx, cancel := getFinalContext(ctx, "ExecuteTaskSync", agent)
@@ -142,7 +155,7 @@ func (p *Plugin) Create(ctx context.Context, taskCtx webapi.TaskExecutionContext
 			return nil, nil, err
 		}
 		header := &admin.CreateRequestHeader{Template: taskTemplate, OutputPrefix: outputPrefix, TaskExecutionMetadata: &taskExecutionMetadata}
-		return p.ExecuteTaskSync(finalCtx, client, header, inputs)
+		return p.ExecuteTaskSync(finalCtx, client, header, inputs, opts...)
 	}
 
 	finalCtx, cancel := getFinalContext(ctx, "CreateTask", agent)
@@ -154,7 +167,7 @@ func (p *Plugin) Create(ctx context.Context, taskCtx webapi.TaskExecutionContext
 		return nil, nil, err
 	}
 	request := &admin.CreateTaskRequest{Inputs: inputs, Template: taskTemplate, OutputPrefix: outputPrefix, TaskExecutionMetadata: &taskExecutionMetadata}
-	res, err := client.CreateTask(finalCtx, request)
+	res, err := client.CreateTask(finalCtx, request, opts...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create task from agent with %v", err)
 	}
@@ -163,6 +176,7 @@ func (p *Plugin) Create(ctx context.Context, taskCtx webapi.TaskExecutionContext
 		OutputPrefix:      outputPrefix,
 		AgentResourceMeta: res.GetResourceMeta(),
 		TaskCategory:      taskCategory,
+		Identity:          identity,
 	}, nil, nil
 }
 
@@ -171,8 +185,9 @@ func (p *Plugin) ExecuteTaskSync(
 	client service.SyncAgentServiceClient,
 	header *admin.CreateRequestHeader,
 	inputs *flyteIdl.LiteralMap,
+	opts ...grpc.CallOption,
 ) (webapi.ResourceMeta, webapi.Resource, error) {
-	stream, err := client.ExecuteTaskSync(ctx)
+	stream, err := client.ExecuteTaskSync(ctx, opts...)
 	if err != nil {
 		logger.Errorf(ctx, "failed to execute task from agent with %v", err)
 		return nil, nil, fmt.Errorf("failed to execute task from agent with %v", err)
@@ -235,6 +250,10 @@ func (p *Plugin) Get(ctx context.Context, taskCtx webapi.GetContext) (latest web
 	if err != nil {
 		return nil, err
 	}
+	opts, err := p.getCallOptions(ctx, agent, metadata.Identity)
+	if err != nil {
+		return nil, err
+	}
 	finalCtx, cancel := getFinalContext(ctx, "GetTask", agent)
 	defer cancel()
 
@@ -244,7 +263,7 @@ func (p *Plugin) Get(ctx context.Context, taskCtx webapi.GetContext) (latest web
 		ResourceMeta: metadata.AgentResourceMeta,
 		OutputPrefix: metadata.OutputPrefix,
 	}
-	res, err := client.GetTask(finalCtx, request)
+	res, err := client.GetTask(finalCtx, request, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get task from agent with %v", err)
 	}
@@ -270,6 +289,10 @@ func (p *Plugin) Delete(ctx context.Context, taskCtx webapi.DeleteContext) error
 	if err != nil {
 		return err
 	}
+	opts, err := p.getCallOptions(ctx, agent, metadata.Identity)
+	if err != nil {
+		return err
+	}
 	finalCtx, cancel := getFinalContext(ctx, "DeleteTask", agent)
 	defer cancel()
 
@@ -278,7 +301,7 @@ func (p *Plugin) Delete(ctx context.Context, taskCtx webapi.DeleteContext) error
 		TaskCategory: &metadata.TaskCategory,
 		ResourceMeta: metadata.AgentResourceMeta,
 	}
-	_, err = client.DeleteTask(finalCtx, request)
+	_, err = client.DeleteTask(finalCtx, request, opts...)
 	if err != nil {
 		return fmt.Errorf("failed to delete task from agent with %v", err)
 	}
@@ -366,6 +389,31 @@ func (p *Plugin) getAsyncAgentClient(ctx context.Context, agent *Deployment) (se
 	return client, nil
 }
 
+// getCallOptions returns the call options which attach the credentials configured for the agent to a request sent on
+// behalf of the task execution identity.
+func (p *Plugin) getCallOptions(ctx context.Context, agent *Deployment, identity rpcauth.Identity) ([]grpc.CallOption, error) {
+	if agent.Auth.Type == rpcauth.TypeNone {
+		return nil, nil
+	}
+
+	p.mu.Lock()
+	provider, ok := p.credentials[agent.Endpoint]
+	if !ok {
+		provider = rpcauth.NewProvider(agent.Auth, agent.Endpoint, agent.Insecure, p.secretManager)
+		if p.credentials == nil {
+			p.credentials = make(map[string]*rpcauth.Provider)
+		}
+		p.credentials[agent.Endpoint] = provider
+	}
+	p.mu.Unlock()
+
+	opts, err := provider.CallOptions(ctx, identity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate to agent [%v] with error: %w", agent.Endpoint, err)
+	}
+	return opts, nil
+}
+
 func (p *Plugin) watchAgents(ctx context.Context, agentService *AgentService) {
 	go wait.Until(func() {
 		childCtx, cancel := context.WithCancel(ctx)
@@ -435,10 +483,11 @@ func newAgentPlugin(agentService *AgentService) webapi.PluginEntry {
 		SupportedTaskTypes: supportedTaskTypes,
 		PluginLoader: func(ctx context.Context, iCtx webapi.PluginSetupContext) (webapi.AsyncPlugin, error) {
 			plugin := &Plugin{
-				metricScope: iCtx.MetricsScope(),
-				cfg:         cfg,
-				cs:          clientSet,
-				registry:    agentRegistry,
+				metricScope:   iCtx.MetricsScope(),
+				cfg:           cfg,
+				cs:            clientSet,
+				registry:      agentRegistry,
+				secretManager: iCtx.SecretManager(),
 			}
 			plugin.watchAgents(ctx, agentService)
 			return plugin, nil
@@ -3,6 +3,7 @@ package connector
 import (
 	"context"
 	"crypto/x509"
+	"slices"
 	"strings"
 
 	"golang.org/x/exp/maps"
@@ -11,6 +12,7 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
@@ -30,6 +32,30 @@ type Connector struct {
 	IsSync bool
 	// ConnectorDeployment is the connector deployment where this connector is running.
 	ConnectorDeployment *Deployment
+	// Pool holds every deployment this connector is running on, including ConnectorDeployment, when there is more than
+	// one. Tasks are balanced across the available deployments of the pool.
+	Pool []*Deployment
+}
+
+// getPool returns the deployments this connector is running on.
+func (c *Connector) getPool() []*Deployment {
+	if len(c.Pool) == 0 {
+		return []*Deployment{c.ConnectorDeployment}
+	}
+	return c.Pool
+}
+
+// registerConnector adds a connector deployment to the registry, pooling it with the other deployments which support
+// the same task category.
+func registerConnector(registry Registry, taskCategory string, version int32, deployment *Deployment, isSync bool) {
+	if connector, exists := registry[taskCategory][version]; exists {
+		pool := connector.getPool()
+		if !slices.Contains(pool, deployment) {
+			connector.Pool = append(slices.Clone(pool), deployment)
+		}
+		return
+	}
+	registry[taskCategory] = map[int32]*Connector{version: {ConnectorDeployment: deployment, IsSync: isSync}}
 }
 
 // ClientSet contains the clients exposed to communicate with various connector services.
@@ -37,6 +63,7 @@ type ClientSet struct {
 	asyncConnectorClients    map[string]service.AsyncAgentServiceClient    // map[endpoint] => AsyncConnectorServiceClient
 	syncConnectorClients     map[string]service.SyncAgentServiceClient     // map[endpoint] => SyncConnectorServiceClient
 	connectorMetadataClients map[string]service.AgentMetadataServiceClient // map[endpoint] => ConnectorMetadataServiceClient
+	healthClients            map[string]grpc_health_v1.HealthClient        // map[endpoint] => HealthClient
 }
 
 func getGrpcConnection(ctx context.Context, connector *Deployment) (*grpc.ClientConn, error) {
@@ -132,16 +159,14 @@ func getConnectorRegistry(ctx context.Context, cs *ClientSet) Registry {
 		for _, connector := range res.GetAgents() {
 			deprecatedSupportedTaskTypes := connector.GetSupportedTaskTypes()
 			for _, supportedTaskType := range deprecatedSupportedTaskTypes {
-				connector := &Connector{ConnectorDeployment: connectorDeployment, IsSync: connector.GetIsSync()}
-				newConnectorRegistry[supportedTaskType] = map[int32]*Connector{defaultTaskTypeVersion: connector}
+				registerConnector(newConnectorRegistry, supportedTaskType, defaultTaskTypeVersion, connectorDeployment, connector.GetIsSync())
 				connectorSupportedTaskCategories[supportedTaskType] = struct{}{}
 			}
 
 			supportedTaskCategories := connector.GetSupportedTaskCategories()
 			for _, supportedCategory := range supportedTaskCategories {
-				connector := &Connector{ConnectorDeployment: connectorDeployment, IsSync: connector.GetIsSync()}
 				supportedCategoryName := supportedCategory.GetName()
-				newConnectorRegistry[supportedCategoryName] = map[int32]*Connector{supportedCategory.GetVersion(): connector}
+				registerConnector(newConnectorRegistry, supportedCategoryName, supportedCategory.GetVersion(), connectorDeployment, connector.GetIsSync())
 				connectorSupportedTaskCategories[supportedCategoryName] = struct{}{}
 			}
 		}
@@ -159,6 +184,30 @@ func getConnectorRegistry(ctx context.Context, cs *ClientSet) Registry {
 		}
 	}
 
+	// Explicitly configured pools replace the deployments discovered for a task type
+	for taskType, connectorDeploymentIDs := range cfg.ConnectorPoolsForTaskTypes {
+		pool := make([]*Deployment, 0, len(connectorDeploymentIDs))
+		for _, connectorDeploymentID := range connectorDeploymentIDs {
+			connectorDeployment, ok := cfg.ConnectorDeployments[connectorDeploymentID]
+			if !ok {
+				logger.Warningf(ctx, "Connector [%v] in the pool of task type [%v] is not configured", connectorDeploymentID, taskType)
+				continue
+			}
+			pool = append(pool, connectorDeployment)
+		}
+		if len(pool) == 0 {
+			continue
+		}
+
+		if _, ok := newConnectorRegistry[taskType]; !ok {
+			newConnectorRegistry[taskType] = map[int32]*Connector{defaultTaskTypeVersion: {IsSync: false}}
+		}
+		for _, connector := range newConnectorRegistry[taskType] {
+			connector.ConnectorDeployment = pool[0]
+			connector.Pool = pool
+		}
+	}
+
 	// Ensure that the old configuration is backward compatible
 	for _, taskType := range cfg.SupportedTaskTypes {
 		if _, ok := newConnectorRegistry[taskType]; !ok {
@@ -176,6 +225,7 @@ func getConnectorClientSets(ctx context.Context) *ClientSet {
 		asyncConnectorClients:    make(map[string]service.AsyncAgentServiceClient),
 		syncConnectorClients:     make(map[string]service.SyncAgentServiceClient),
 		connectorMetadataClients: make(map[string]service.AgentMetadataServiceClient),
+		healthClients:            make(map[string]grpc_health_v1.HealthClient),
 	}
 
 	var connectorDeployments []*Deployment
@@ -198,6 +248,7 @@ func getConnectorClientSets(ctx context.Context) *ClientSet {
 		clientSet.syncConnectorClients[connectorDeployment.Endpoint] = service.NewSyncAgentServiceClient(conn)
 		clientSet.asyncConnectorClients[connectorDeployment.Endpoint] = service.NewAsyncAgentServiceClient(conn)
 		clientSet.connectorMetadataClients[connectorDeployment.Endpoint] = service.NewAgentMetadataServiceClient(conn)
+		clientSet.healthClients[connectorDeployment.Endpoint] = grpc_health_v1.NewHealthClient(conn)
 	}
 	return clientSet
 }
@@ -6,6 +6,7 @@ import (
 	pluginsConfig "github.com/flyteorg/flyte/flyteplugins/go/tasks/config"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/webapi"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/webapi/rpcauth"
 	"github.com/flyteorg/flyte/flytestdlib/config"
 )
 
@@ -45,10 +46,23 @@ var (
 			DefaultTimeout:       config.Duration{Duration: 10 * time.Second},
 			DefaultServiceConfig: `{"loadBalancingConfig": [{"round_robin":{}}]}`,
 		},
-		ConnectorDeployments:  map[string]*Deployment{},
-		ConnectorForTaskTypes: map[string]string{},
-		SupportedTaskTypes:    []string{"task_type_3", "task_type_4"},
-		PollInterval:          config.Duration{Duration: 10 * time.Second},
+		ConnectorDeployments:       map[string]*Deployment{},
+		ConnectorForTaskTypes:      map[string]string{},
+		ConnectorPoolsForTaskTypes: map[string][]string{},
+		SupportedTaskTypes:         []string{"task_type_3", "task_type_4"},
+		PollInterval:               config.Duration{Duration: 10 * time.Second},
+		HealthCheck: HealthCheckConfig{
+			Enabled:            true,
+			Interval:           config.Duration{Duration: 10 * time.Second},
+			Timeout:            config.Duration{Duration: 2 * time.Second},
+			UnhealthyThreshold: 3,
+			HealthyThreshold:   1,
+		},
+		OutlierEjection: OutlierEjectionConfig{
+			ConsecutiveFailures: 5,
+			BaseEjectionTime:    config.Duration{Duration: 30 * time.Second},
+			MaxEjectionTime:     config.Duration{Duration: 5 * time.Minute},
+		},
 	}
 
 	configSection = pluginsConfig.MustRegisterSubSection("connector-service", &defaultConfig)
@@ -71,11 +85,52 @@ type Config struct {
 	// Maps task types to their connectors. {TaskType: connectorDeploymentID}
 	ConnectorForTaskTypes map[string]string `json:"connectorForTaskTypes" pflag:"-,"`
 
+	// Maps task types to pools of connectors which share their load and fail over to each other. Takes precedence over
+	// ConnectorForTaskTypes and over the connectors discovered through the metadata service. {TaskType: [connectorDeploymentID]}
+	ConnectorPoolsForTaskTypes map[string][]string `json:"connectorPoolsForTaskTypes" pflag:"-,"`
+
 	// SupportedTaskTypes is a list of task types that are supported by this plugin.
 	SupportedTaskTypes []string `json:"supportedTaskTypes" pflag:"-,Defines a list of task types that are supported by this plugin."`
 
 	// PollInterval is the interval at which the plugin should poll the connector for metadata updates
 	PollInterval config.Duration `json:"pollInterval" pflag:",The interval at which the plugin should poll the connector for metadata updates."`
+
+	// HealthCheck configures active gRPC health probing of the connectors
+	HealthCheck HealthCheckConfig `json:"healthCheck" pflag:",Configures active gRPC health probing of the connectors."`
+
+	// OutlierEjection configures taking connectors which keep failing requests out of their pools
+	OutlierEjection OutlierEjectionConfig `json:"outlierEjection" pflag:",Configures taking connectors which keep failing requests out of their pools."`
+}
+
+type HealthCheckConfig struct {
+	// Enabled turns on probing every connector with the standard gRPC health service. Connectors which don't implement
+	// the health service are considered healthy.
+	Enabled bool `json:"enabled"`
+
+	// Interval between two probes of a connector
+	Interval config.Duration `json:"interval"`
+
+	// Timeout of a single probe
+	Timeout config.Duration `json:"timeout"`
+
+	// UnhealthyThreshold is the number of consecutive failed probes after which a connector is taken out of its pools
+	UnhealthyThreshold int `json:"unhealthyThreshold"`
+
+	// HealthyThreshold is the number of consecutive successful probes after which an unhealthy connector is put back
+	HealthyThreshold int `json:"healthyThreshold"`
+}
+
+type OutlierEjectionConfig struct {
+	// ConsecutiveFailures is the number of consecutive requests failing as unavailable after which a connector is
+	// ejected from its pools; 0 disables ejection
+	ConsecutiveFailures int `json:"consecutiveFailures"`
+
+	// BaseEjectionTime is how long a connector is ejected for the first time; every further ejection without a
+	// successful request in between lasts one BaseEjectionTime longer
+	BaseEjectionTime config.Duration `json:"baseEjectionTime"`
+
+	// MaxEjectionTime caps how long a connector is ejected
+	MaxEjectionTime config.Duration `json:"maxEjectionTime"`
 }
 
 type Deployment struct {
@@ -93,6 +148,12 @@ type Deployment struct {
 
 	// DefaultTimeout gives the default RPC timeout if a more specific one is not defined in Timeouts; if neither DefaultTimeout nor Timeouts is defined for an operation, RPC timeout will not be enforced
 	DefaultTimeout config.Duration `json:"defaultTimeout"`
+
+	// Weight is the share of the tasks routed to this connector relative to the other connectors of its pools; defaults to 1
+	Weight int `json:"weight"`
+
+	// Auth configures the credentials sent with every request to the connector
+	Auth rpcauth.Config `json:"auth"`
 }
 
 func GetConfig() *Config {
@@ -0,0 +1,200 @@
+package connector
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
+
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+)
+
+// deploymentHealth is the availability of a single connector deployment.
+type deploymentHealth struct {
+	// unhealthy is set once active probing failed UnhealthyThreshold times in a row.
+	unhealthy           bool
+	consecutiveProbes   int
+	consecutiveFailures int
+	ejections           int
+	ejectedUntil        time.Time
+}
+
+// healthTracker combines active health probing with passive outlier ejection to decide which connector deployments
+// can receive new tasks. A nil healthTracker considers every deployment available.
+type healthTracker struct {
+	mu              sync.Mutex
+	clock           clock.Clock
+	healthCheck     HealthCheckConfig
+	outlierEjection OutlierEjectionConfig
+	deployments     map[string]*deploymentHealth // map[endpoint] => deploymentHealth
+}
+
+func newHealthTracker(cfg *Config, clock clock.Clock) *healthTracker {
+	return &healthTracker{
+		clock:           clock,
+		healthCheck:     cfg.HealthCheck,
+		outlierEjection: cfg.OutlierEjection,
+		deployments:     make(map[string]*deploymentHealth),
+	}
+}
+
+// isUnavailableError returns true for errors which indicate the connector could not serve the request at all, as
+// opposed to errors returned by a connector which is up.
+func isUnavailableError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+// shouldFailOver returns true when a create request can safely be sent to another connector. A request which timed out
+// may still have been accepted by the connector, so failing over could launch the task twice.
+func shouldFailOver(err error) bool {
+	return status.Code(err) == codes.Unavailable
+}
+
+func (h *healthTracker) getDeploymentHealth(endpoint string) *deploymentHealth {
+	health, ok := h.deployments[endpoint]
+	if !ok {
+		health = &deploymentHealth{}
+		h.deployments[endpoint] = health
+	}
+	return health
+}
+
+func (h *healthTracker) isAvailable(endpoint string) bool {
+	if h == nil {
+		return true
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	health, ok := h.deployments[endpoint]
+	if !ok {
+		return true
+	}
+	return !health.unhealthy && !h.clock.Now().Before(health.ejectedUntil)
+}
+
+// reportResult records the outcome of a request sent to a connector and ejects the connector from its pools once
+// too many requests in a row found it unavailable.
+func (h *healthTracker) reportResult(ctx context.Context, endpoint string, err error) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	health := h.getDeploymentHealth(endpoint)
+	if !isUnavailableError(err) {
+		health.consecutiveFailures = 0
+		health.ejections = 0
+		return
+	}
+
+	health.consecutiveFailures++
+	if h.outlierEjection.ConsecutiveFailures <= 0 || health.consecutiveFailures < h.outlierEjection.ConsecutiveFailures {
+		return
+	}
+	health.consecutiveFailures = 0
+	health.ejections++
+	ejectionTime := time.Duration(health.ejections) * h.outlierEjection.BaseEjectionTime.Duration
+	if maxEjectionTime := h.outlierEjection.MaxEjectionTime.Duration; maxEjectionTime > 0 && ejectionTime > maxEjectionTime {
+		ejectionTime = maxEjectionTime
+	}
+	health.ejectedUntil = h.clock.Now().Add(ejectionTime)
+	logger.Warningf(ctx, "Ejecting connector [%v] for [%v] after [%v] consecutive failures, last error: [%v]",
+		endpoint, ejectionTime, h.outlierEjection.ConsecutiveFailures, err)
+}
+
+// reportProbe records the outcome of an active health probe of a connector.
+func (h *healthTracker) reportProbe(ctx context.Context, endpoint string, serving bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	health := h.getDeploymentHealth(endpoint)
+	if serving == !health.unhealthy {
+		health.consecutiveProbes = 0
+		return
+	}
+
+	health.consecutiveProbes++
+	threshold := h.healthCheck.UnhealthyThreshold
+	if health.unhealthy {
+		threshold = h.healthCheck.HealthyThreshold
+	}
+	if health.consecutiveProbes < threshold {
+		return
+	}
+	health.consecutiveProbes = 0
+	health.unhealthy = !serving
+	if serving {
+		logger.Infof(ctx, "Connector [%v] is healthy again", endpoint)
+	} else {
+		logger.Warningf(ctx, "Connector [%v] failed [%v] consecutive health checks", endpoint, threshold)
+	}
+}
+
+// selectDeployment picks an available deployment of a pool that hasn't been tried yet at random, weighted by the
+// deployments' weights. It returns nil when no such deployment is left.
+func (h *healthTracker) selectDeployment(pool []*Deployment, tried map[string]bool) *Deployment {
+	candidates := make([]*Deployment, 0, len(pool))
+	totalWeight := 0
+	for _, deployment := range pool {
+		if tried[deployment.Endpoint] || !h.isAvailable(deployment.Endpoint) {
+			continue
+		}
+		candidates = append(candidates, deployment)
+		totalWeight += getWeight(deployment)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	// #nosec G404
+	pick := rand.Intn(totalWeight)
+	for _, deployment := range candidates {
+		pick -= getWeight(deployment)
+		if pick < 0 {
+			return deployment
+		}
+	}
+	return candidates[len(candidates)-1]
+}
+
+func getWeight(deployment *Deployment) int {
+	if deployment.Weight <= 0 {
+		return 1
+	}
+	return deployment.Weight
+}
+
+// probe checks the health of every connector once. Connectors which don't implement the gRPC health service are
+// considered healthy.
+func (h *healthTracker) probe(ctx context.Context, cs *ClientSet) {
+	for endpoint, client := range cs.healthClients {
+		probeCtx, cancel := context.WithTimeout(ctx, h.healthCheck.Timeout.Duration)
+		res, err := client.Check(probeCtx, &grpc_health_v1.HealthCheckRequest{})
+		cancel()
+		serving := err == nil && res.GetStatus() == grpc_health_v1.HealthCheckResponse_SERVING
+		if status.Code(err) == codes.Unimplemented {
+			serving = true
+		} else if err != nil {
+			logger.Debugf(ctx, "Health check of connector [%v] failed with error: [%v]", endpoint, err)
+		}
+		h.reportProbe(ctx, endpoint, serving)
+	}
+}
+
+func (h *healthTracker) watchHealth(ctx context.Context, cs *ClientSet) {
+	if !h.healthCheck.Enabled || len(cs.healthClients) == 0 {
+		return
+	}
+	go wait.Until(func() {
+		h.probe(ctx, cs)
+	}, h.healthCheck.Interval.Duration, ctx.Done())
+}
@@ -0,0 +1,96 @@
+package connector
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"github.com/flyteorg/flyte/flytestdlib/config"
+)
+
+func newTestHealthTracker() (*healthTracker, *testingclock.FakeClock) {
+	cfg := defaultConfig
+	cfg.OutlierEjection = OutlierEjectionConfig{
+		ConsecutiveFailures: 2,
+		BaseEjectionTime:    config.Duration{Duration: time.Minute},
+		MaxEjectionTime:     config.Duration{Duration: 90 * time.Second},
+	}
+	cfg.HealthCheck.UnhealthyThreshold = 2
+	cfg.HealthCheck.HealthyThreshold = 1
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	return newHealthTracker(&cfg, fakeClock), fakeClock
+}
+
+func TestOutlierEjection(t *testing.T) {
+	ctx := context.Background()
+	health, fakeClock := newTestHealthTracker()
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+
+	health.reportResult(ctx, "a", unavailable)
+	health.reportResult(ctx, "a", status.Error(codes.InvalidArgument, "bad request"))
+	health.reportResult(ctx, "a", unavailable)
+	assert.True(t, health.isAvailable("a"), "failures in between successes don't eject")
+
+	health.reportResult(ctx, "a", unavailable)
+	assert.False(t, health.isAvailable("a"))
+	fakeClock.Step(time.Minute)
+	assert.True(t, health.isAvailable("a"))
+
+	// Repeated ejections last longer, up to the max ejection time.
+	health.reportResult(ctx, "a", unavailable)
+	health.reportResult(ctx, "a", unavailable)
+	fakeClock.Step(time.Minute)
+	assert.False(t, health.isAvailable("a"))
+	fakeClock.Step(30 * time.Second)
+	assert.True(t, health.isAvailable("a"))
+
+	var nilTracker *healthTracker
+	nilTracker.reportResult(ctx, "a", unavailable)
+	assert.True(t, nilTracker.isAvailable("a"))
+}
+
+func TestShouldFailOver(t *testing.T) {
+	assert.True(t, shouldFailOver(fmt.Errorf("failed to create task from connector with %w",
+		status.Error(codes.Unavailable, "connection refused"))))
+	assert.False(t, shouldFailOver(status.Error(codes.DeadlineExceeded, "deadline exceeded")))
+	assert.False(t, shouldFailOver(status.Error(codes.InvalidArgument, "bad request")))
+}
+
+func TestHealthProbes(t *testing.T) {
+	ctx := context.Background()
+	health, _ := newTestHealthTracker()
+
+	health.reportProbe(ctx, "a", false)
+	assert.True(t, health.isAvailable("a"))
+	health.reportProbe(ctx, "a", false)
+	assert.False(t, health.isAvailable("a"))
+	health.reportProbe(ctx, "a", true)
+	assert.True(t, health.isAvailable("a"))
+}
+
+func TestSelectDeployment(t *testing.T) {
+	ctx := context.Background()
+	health, _ := newTestHealthTracker()
+	a := &Deployment{Endpoint: "a"}
+	b := &Deployment{Endpoint: "b", Weight: 3}
+	pool := []*Deployment{a, b}
+
+	selected := map[string]int{}
+	for i := 0; i < 400; i++ {
+		selected[health.selectDeployment(pool, nil).Endpoint]++
+	}
+	assert.Greater(t, selected["b"], selected["a"])
+
+	assert.Equal(t, b, health.selectDeployment(pool, map[string]bool{"a": true}))
+
+	health.reportProbe(ctx, "b", false)
+	health.reportProbe(ctx, "b", false)
+	assert.Equal(t, a, health.selectDeployment(pool, nil))
+	assert.Nil(t, health.selectDeployment(pool, map[string]bool{"a": true}))
+}
@@ -9,8 +9,10 @@ import (
 	"time"
 
 	"golang.org/x/exp/maps"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/structpb"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
 
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/admin"
 	flyteIdl "github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
@@ -22,12 +24,17 @@ import (
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/io"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/ioutils"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/webapi"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/webapi/rpcauth"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 	"github.com/flyteorg/flyte/flytestdlib/promutils"
 )
 
 const ID = "connector-service"
 
+// ConnectorUnavailable is the error code of tasks which could not be created because none of their connectors were
+// available.
+const ConnectorUnavailable = "ConnectorUnavailable"
+
 type ConnectorService struct {
 	mu                 sync.RWMutex
 	supportedTaskTypes []string
@@ -55,7 +62,11 @@ type Plugin struct {
 	cfg         *Config
 	cs          *ClientSet
 	registry    Registry
-	mu          sync.RWMutex
+	health      *healthTracker
+	// secretManager reads the secrets the credentials sent to connectors are made of.
+	secretManager core.SecretManager
+	credentials   map[string]*rpcauth.Provider // map[endpoint] => Provider
+	mu            sync.RWMutex
 }
 
 type ResourceWrapper struct {
@@ -67,6 +78,8 @@ type ResourceWrapper struct {
 	LogLinks       []*flyteIdl.TaskLog
 	CustomInfo     *structpb.Struct
 	ConnectorError *admin.AgentError
+	// ConnectorUnavailable is set when the task could not be created because none of its connectors were available.
+	ConnectorUnavailable bool
 }
 
 // IsTerminal is used to avoid making network calls to the connector service if the resource is already in a terminal state.
@@ -78,6 +91,12 @@ type ResourceMetaWrapper struct {
 	OutputPrefix          string
 	ConnectorResourceMeta []byte
 	TaskCategory          admin.TaskCategory
+	// ConnectorEndpoint is the endpoint of the connector which created the resource. The resource is only known to
+	// that connector, so it serves all later requests about it.
+	ConnectorEndpoint string
+	// Identity is the identity of the task execution the resource is created for, which the credentials sent with
+	// later requests about the resource carry.
+	Identity rpcauth.Identity
 }
 
 func (p *Plugin) setRegistry(r Registry) {
@@ -130,40 +149,85 @@ func (p *Plugin) Create(ctx context.Context, taskCtx webapi.TaskExecutionContext
 	outputPrefix := taskCtx.OutputWriter().GetOutputPrefixPath().String()
 
 	taskCategory := admin.TaskCategory{Name: taskTemplate.GetType(), Version: taskTemplate.GetTaskTypeVersion()}
-	connector, isSync := p.getFinalConnector(&taskCategory, p.cfg)
+	pool, isSync := p.getConnectorPool(&taskCategory, p.cfg)
 
 	taskExecutionMetadata := buildTaskExecutionMetadata(taskCtx.TaskExecutionMetadata())
-
-	if isSync {
-		finalCtx, cancel := getFinalContext(ctx, "ExecuteTaskSync", connector)
-		defer cancel()
-		client, err := p.getSyncConnectorClient(ctx, connector)
-		if err != nil {
-			return nil, nil, err
+	identity := rpcauth.NewIdentity(taskCtx.TaskExecutionMetadata())
+
+	// Fail over to the other connectors of the pool while the chosen one is unavailable
+	var lastErr error
+	tried := make(map[string]bool, len(pool))
+	for {
+		connector := p.health.selectDeployment(pool, tried)
+		if connector == nil {
+			logger.Warningf(ctx, "No connector is available for task type [%v], last error: [%v]", taskCategory.GetName(), lastErr)
+			return nil, ResourceWrapper{
+				ConnectorUnavailable: true,
+				Message: fmt.Sprintf("no connector is available for task type [%v], last error: [%v]",
+					taskCategory.GetName(), lastErr),
+			}, nil
 		}
-		header := &admin.CreateRequestHeader{Template: taskTemplate, OutputPrefix: outputPrefix, TaskExecutionMetadata: &taskExecutionMetadata}
-		return p.ExecuteTaskSync(finalCtx, client, header, inputs)
+		tried[connector.Endpoint] = true
+
+		var resourceMeta webapi.ResourceMeta
+		var resource webapi.Resource
+		if isSync {
+			header := &admin.CreateRequestHeader{Template: taskTemplate, OutputPrefix: outputPrefix, TaskExecutionMetadata: &taskExecutionMetadata}
+			resourceMeta, resource, err = p.executeTaskSync(ctx, connector, identity, header, inputs)
+		} else {
+			request := &admin.CreateTaskRequest{Inputs: inputs, Template: taskTemplate, OutputPrefix: outputPrefix, TaskExecutionMetadata: &taskExecutionMetadata}
+			resourceMeta, err = p.createTask(ctx, connector, identity, request, &taskCategory)
+		}
+		p.health.reportResult(ctx, connector.Endpoint, err)
+		if err == nil || !shouldFailOver(err) {
+			return resourceMeta, resource, err
+		}
+		logger.Warningf(ctx, "Connector [%v] is unavailable, failing over: [%v]", connector.Endpoint, err)
+		lastErr = err
+	}
+}
+
+func (p *Plugin) executeTaskSync(ctx context.Context, connector *Deployment, identity rpcauth.Identity,
+	header *admin.CreateRequestHeader, inputs *flyteIdl.LiteralMap) (webapi.ResourceMeta, webapi.Resource, error) {
+	finalCtx, cancel := getFinalContext(ctx, "ExecuteTaskSync", connector)
+	defer cancel()
+	client, err := p.getSyncConnectorClient(ctx, connector)
+	if err != nil {
+		return nil, nil, err
+	}
+	opts, err := p.getCallOptions(ctx, connector, identity)
+	if err != nil {
+		return nil, nil, err
 	}
+	return p.ExecuteTaskSync(finalCtx, client, header, inputs, opts...)
+}
 
+func (p *Plugin) createTask(ctx context.Context, connector *Deployment, identity rpcauth.Identity,
+	request *admin.CreateTaskRequest, taskCategory *admin.TaskCategory) (webapi.ResourceMeta, error) {
 	finalCtx, cancel := getFinalContext(ctx, "CreateTask", connector)
 	defer cancel()
 
 	// Use async connector client
 	client, err := p.getAsyncConnectorClient(ctx, connector)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+	opts, err := p.getCallOptions(ctx, connector, identity)
+	if err != nil {
+		return nil, err
 	}
-	request := &admin.CreateTaskRequest{Inputs: inputs, Template: taskTemplate, OutputPrefix: outputPrefix, TaskExecutionMetadata: &taskExecutionMetadata}
-	res, err := client.CreateTask(finalCtx, request)
+	res, err := client.CreateTask(finalCtx, request, opts...)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create task from connector with %v", err)
+		return nil, fmt.Errorf("failed to create task from connector with %w", err)
 	}
 
 	return ResourceMetaWrapper{
-		OutputPrefix:          outputPrefix,
+		OutputPrefix:          request.GetOutputPrefix(),
 		ConnectorResourceMeta: res.GetResourceMeta(),
-		TaskCategory:          taskCategory,
-	}, nil, nil
+		TaskCategory:          admin.TaskCategory{Name: taskCategory.GetName(), Version: taskCategory.GetVersion()},
+		ConnectorEndpoint:     connector.Endpoint,
+		Identity:              identity,
+	}, nil
 }
 
 func (p *Plugin) ExecuteTaskSync(
@@ -171,11 +235,12 @@ func (p *Plugin) ExecuteTaskSync(
 	client service.SyncAgentServiceClient,
 	header *admin.CreateRequestHeader,
 	inputs *flyteIdl.LiteralMap,
+	opts ...grpc.CallOption,
 ) (webapi.ResourceMeta, webapi.Resource, error) {
-	stream, err := client.ExecuteTaskSync(ctx)
+	stream, err := client.ExecuteTaskSync(ctx, opts...)
 	if err != nil {
 		logger.Errorf(ctx, "failed to execute task from connector with %v", err)
-		return nil, nil, fmt.Errorf("failed to execute task from connector with %v", err)
+		return nil, nil, fmt.Errorf("failed to execute task from connector with %w", err)
 	}
 
 	headerProto := &admin.ExecuteTaskSyncRequest{
@@ -229,12 +294,16 @@ func (p *Plugin) ExecuteTaskSync(
 
 func (p *Plugin) Get(ctx context.Context, taskCtx webapi.GetContext) (latest webapi.Resource, err error) {
 	metadata := taskCtx.ResourceMeta().(ResourceMetaWrapper)
-	connector, _ := p.getFinalConnector(&metadata.TaskCategory, p.cfg)
+	connector := p.getResourceConnector(ctx, &metadata)
 
 	client, err := p.getAsyncConnectorClient(ctx, connector)
 	if err != nil {
 		return nil, err
 	}
+	opts, err := p.getCallOptions(ctx, connector, metadata.Identity)
+	if err != nil {
+		return nil, err
+	}
 	finalCtx, cancel := getFinalContext(ctx, "GetTask", connector)
 	defer cancel()
 
@@ -244,9 +313,10 @@ func (p *Plugin) Get(ctx context.Context, taskCtx webapi.GetContext) (latest web
 		ResourceMeta: metadata.ConnectorResourceMeta,
 		OutputPrefix: metadata.OutputPrefix,
 	}
-	res, err := client.GetTask(finalCtx, request)
+	res, err := client.GetTask(finalCtx, request, opts...)
+	p.health.reportResult(ctx, connector.Endpoint, err)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get task from connector with %v", err)
+		return nil, fmt.Errorf("failed to get task from connector with %w", err)
 	}
 
 	return ResourceWrapper{
@@ -264,12 +334,16 @@ func (p *Plugin) Delete(ctx context.Context, taskCtx webapi.DeleteContext) error
 		return nil
 	}
 	metadata := taskCtx.ResourceMeta().(ResourceMetaWrapper)
-	connector, _ := p.getFinalConnector(&metadata.TaskCategory, p.cfg)
+	connector := p.getResourceConnector(ctx, &metadata)
 
 	client, err := p.getAsyncConnectorClient(ctx, connector)
 	if err != nil {
 		return err
 	}
+	opts, err := p.getCallOptions(ctx, connector, metadata.Identity)
+	if err != nil {
+		return err
+	}
 	finalCtx, cancel := getFinalContext(ctx, "DeleteTask", connector)
 	defer cancel()
 
@@ -278,9 +352,10 @@ func (p *Plugin) Delete(ctx context.Context, taskCtx webapi.DeleteContext) error
 		TaskCategory: &metadata.TaskCategory,
 		ResourceMeta: metadata.ConnectorResourceMeta,
 	}
-	_, err = client.DeleteTask(finalCtx, request)
+	_, err = client.DeleteTask(finalCtx, request, opts...)
+	p.health.reportResult(ctx, connector.Endpoint, err)
 	if err != nil {
-		return fmt.Errorf("failed to delete task from connector with %v", err)
+		return fmt.Errorf("failed to delete task from connector with %w", err)
 	}
 	return nil
 }
@@ -292,6 +367,9 @@ func (p *Plugin) Status(ctx context.Context, taskCtx webapi.StatusContext) (phas
 	if resource.ConnectorError != nil && resource.ConnectorError.GetCode() != "" {
 		errorCode = resource.ConnectorError.GetCode()
 	}
+	if resource.ConnectorUnavailable {
+		return core.PhaseInfoSystemRetryableFailure(ConnectorUnavailable, resource.Message, taskInfo), nil
+	}
 
 	switch resource.Phase {
 	case flyteIdl.TaskExecution_QUEUED:
@@ -366,6 +444,31 @@ func (p *Plugin) getAsyncConnectorClient(ctx context.Context, connector *Deploym
 	return client, nil
 }
 
+// getCallOptions returns the call options which attach the credentials configured for the connector to a request
+// sent on behalf of the task execution identity.
+func (p *Plugin) getCallOptions(ctx context.Context, connector *Deployment, identity rpcauth.Identity) ([]grpc.CallOption, error) {
+	if connector.Auth.Type == rpcauth.TypeNone {
+		return nil, nil
+	}
+
+	p.mu.Lock()
+	provider, ok := p.credentials[connector.Endpoint]
+	if !ok {
+		provider = rpcauth.NewProvider(connector.Auth, connector.Endpoint, connector.Insecure, p.secretManager)
+		if p.credentials == nil {
+			p.credentials = make(map[string]*rpcauth.Provider)
+		}
+		p.credentials[connector.Endpoint] = provider
+	}
+	p.mu.Unlock()
+
+	opts, err := provider.CallOptions(ctx, identity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate to connector [%v] with error: %w", connector.Endpoint, err)
+	}
+	return opts, nil
+}
+
 func (p *Plugin) watchConnectors(ctx context.Context, connectorService *ConnectorService) {
 	go wait.Until(func() {
 		childCtx, cancel := context.WithCancel(ctx)
@@ -387,6 +490,35 @@ func (p *Plugin) getFinalConnector(taskCategory *admin.TaskCategory, cfg *Config
 	return &cfg.DefaultConnector, false
 }
 
+// getConnectorPool returns the connectors serving a task category and whether they are synchronous.
+func (p *Plugin) getConnectorPool(taskCategory *admin.TaskCategory, cfg *Config) ([]*Deployment, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if connector, exists := p.registry[taskCategory.GetName()][taskCategory.GetVersion()]; exists {
+		return connector.getPool(), connector.IsSync
+	}
+	return []*Deployment{&cfg.DefaultConnector}, false
+}
+
+// getResourceConnector returns the connector which created a resource. Resources created before connectors were
+// recorded are routed like new tasks of their task category.
+func (p *Plugin) getResourceConnector(ctx context.Context, metadata *ResourceMetaWrapper) *Deployment {
+	if len(metadata.ConnectorEndpoint) > 0 {
+		if p.cfg.DefaultConnector.Endpoint == metadata.ConnectorEndpoint {
+			return &p.cfg.DefaultConnector
+		}
+		for _, connectorDeployment := range p.cfg.ConnectorDeployments {
+			if connectorDeployment.Endpoint == metadata.ConnectorEndpoint {
+				return connectorDeployment
+			}
+		}
+		logger.Warningf(ctx, "Connector [%v] which created the resource is no longer configured", metadata.ConnectorEndpoint)
+	}
+	connector, _ := p.getFinalConnector(&metadata.TaskCategory, p.cfg)
+	return connector
+}
+
 func writeOutput(ctx context.Context, taskCtx webapi.StatusContext, outputs *flyteIdl.LiteralMap) error {
 	taskTemplate, err := taskCtx.TaskReader().Read(ctx)
 	if err != nil {
@@ -433,18 +565,24 @@ func newConnectorPlugin(connectorService *ConnectorService) webapi.PluginEntry {
 	supportedTaskTypes := maps.Keys(connectorRegistry)
 	connectorService.SetSupportedTaskType(supportedTaskTypes)
 
+	cfg := GetConfig()
 	plugin := &Plugin{
 		metricScope: promutils.NewScope("connector_plugin"),
-		cfg:         GetConfig(),
+		cfg:         cfg,
 		cs:          clientSet,
 		registry:    connectorRegistry,
+		health:      newHealthTracker(cfg, clock.RealClock{}),
 	}
 	plugin.watchConnectors(ctx, connectorService)
+	plugin.health.watchHealth(ctx, clientSet)
 
 	return webapi.PluginEntry{
 		ID:                 ID,
 		SupportedTaskTypes: supportedTaskTypes,
 		PluginLoader: func(ctx context.Context, iCtx webapi.PluginSetupContext) (webapi.AsyncPlugin, error) {
+			plugin.mu.Lock()
+			plugin.secretManager = iCtx.SecretManager()
+			plugin.mu.Unlock()
 			return plugin, nil
 		},
 	}
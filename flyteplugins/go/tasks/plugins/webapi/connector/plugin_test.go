@@ -355,3 +355,80 @@ func TestInitializeConnectorRegistry(t *testing.T) {
 		assert.Contains(t, connectorRegistryKeys, key)
 	}
 }
+
+func TestConnectorPools(t *testing.T) {
+	connectorMetadataClients := map[string]service.AgentMetadataServiceClient{
+		"connector-a:80": getMockMetadataServiceClient(),
+		"connector-b:80": getMockMetadataServiceClient(),
+	}
+	cs := &ClientSet{
+		asyncConnectorClients:    map[string]service.AsyncAgentServiceClient{},
+		connectorMetadataClients: connectorMetadataClients,
+	}
+
+	cfg := defaultConfig
+	cfg.ConnectorDeployments = map[string]*Deployment{
+		"connector_a": {Endpoint: "connector-a:80"},
+		"connector_b": {Endpoint: "connector-b:80"},
+		"connector_c": {Endpoint: "connector-c:80"},
+	}
+	cfg.ConnectorPoolsForTaskTypes = map[string][]string{"task3": {"connector_c", "connector_b"}}
+	previous := GetConfig()
+	err := SetConfig(&cfg)
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, SetConfig(previous)) }()
+
+	connectorRegistry := getConnectorRegistry(context.Background(), cs)
+	discoveredPool := connectorRegistry["task1"][defaultTaskTypeVersion].getPool()
+	assert.ElementsMatch(t, []*Deployment{cfg.ConnectorDeployments["connector_a"], cfg.ConnectorDeployments["connector_b"]}, discoveredPool)
+	configuredPool := connectorRegistry["task3"][defaultTaskTypeVersion].getPool()
+	assert.Equal(t, []*Deployment{cfg.ConnectorDeployments["connector_c"], cfg.ConnectorDeployments["connector_b"]}, configuredPool)
+}
+
+func TestGetFromCreatingConnector(t *testing.T) {
+	cfg := defaultConfig
+	cfg.ConnectorDeployments = map[string]*Deployment{
+		"connector_a": {Endpoint: "connector-a:80"},
+		"connector_b": {Endpoint: "connector-b:80"},
+	}
+	taskCategory := admin.TaskCategory{Name: "spark", Version: defaultTaskTypeVersion}
+	connectorB := &agentMocks.AsyncAgentServiceClient{}
+	connectorB.On("GetTask", mock.Anything, mock.Anything).Return(&admin.GetTaskResponse{
+		Resource: &admin.Resource{Phase: flyteIdlCore.TaskExecution_RUNNING},
+	}, nil)
+	plugin := Plugin{
+		cfg: &cfg,
+		cs: &ClientSet{
+			asyncConnectorClients: map[string]service.AsyncAgentServiceClient{"connector-b:80": connectorB},
+		},
+		registry: Registry{"spark": {defaultTaskTypeVersion: {
+			ConnectorDeployment: cfg.ConnectorDeployments["connector_a"],
+			Pool:                []*Deployment{cfg.ConnectorDeployments["connector_a"], cfg.ConnectorDeployments["connector_b"]},
+		}}},
+	}
+
+	getContext := &webapiPlugin.GetContext{}
+	getContext.EXPECT().ResourceMeta().Return(ResourceMetaWrapper{
+		TaskCategory:      admin.TaskCategory{Name: taskCategory.GetName(), Version: taskCategory.GetVersion()},
+		ConnectorEndpoint: "connector-b:80",
+	})
+	resource, err := plugin.Get(context.Background(), getContext)
+	assert.NoError(t, err)
+	assert.Equal(t, flyteIdlCore.TaskExecution_RUNNING, resource.(ResourceWrapper).Phase)
+	connectorB.AssertExpectations(t)
+}
+
+func TestStatusConnectorUnavailable(t *testing.T) {
+	plugin := Plugin{}
+	taskContext := new(webapiPlugin.StatusContext)
+	taskContext.On("Resource").Return(ResourceWrapper{
+		ConnectorUnavailable: true,
+		Message:              "no connector is available",
+	})
+
+	phase, err := plugin.Status(context.Background(), taskContext)
+	assert.NoError(t, err)
+	assert.Equal(t, pluginsCore.PhaseRetryableFailure, phase.Phase())
+	assert.Equal(t, ConnectorUnavailable, phase.Err().GetCode())
+	assert.Equal(t, flyteIdlCore.ExecutionError_SYSTEM, phase.Err().GetKind())
+}
@@ -0,0 +1,420 @@
+// Package lint provides an advisory static analysis pass for compiled flyte workflows. Unlike the compiler, which
+// rejects workflows that cannot execute, the linter reports Warnings about constructs that are valid but are likely
+// to be unintended or expensive at runtime. Lint should only be run on closures that compiled successfully.
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	// #noSA1019
+	"github.com/golang/protobuf/proto"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/plugins"
+	c "github.com/flyteorg/flyte/flytepropeller/pkg/compiler/common"
+	"github.com/flyteorg/flyte/flytestdlib/utils"
+)
+
+type WarningCode string
+
+const (
+	// A node produces one or more outputs that no other node nor the workflow outputs consume.
+	UnusedOutput WarningCode = "UnusedOutput"
+
+	// A cacheable node consumes outputs of a node that is not cached, so its cache key changes whenever the upstream
+	// node is recomputed.
+	CacheableNodeWithNonDeterministicInput WarningCode = "CacheableNodeWithNonDeterministicInput"
+
+	// A branch case can never be selected because of the conditions that precede it or its own condition.
+	UnreachableBranchCase WarningCode = "UnreachableBranchCase"
+
+	// A map task runs all of its sub tasks at once because it has no parallelism limit.
+	UnboundedMapTask WarningCode = "UnboundedMapTask"
+
+	// An interruptible node has no retries, so a single preemption fails the node.
+	InterruptibleWithoutRetries WarningCode = "InterruptibleWithoutRetries"
+)
+
+const legacyMapTaskType = "container_array"
+
+// Warning represents a single lint finding on a node of a workflow.
+type Warning struct {
+	Code        WarningCode `json:"code"`
+	WorkflowID  string      `json:"workflowId"`
+	NodeID      string      `json:"nodeId"`
+	Description string      `json:"description"`
+}
+
+// Gets a readable/formatted string explaining the warning as well as at which node it occurred.
+func (w Warning) String() string {
+	return fmt.Sprintf("Code: %s, Workflow: %s, Node Id: %s, Description: %s", w.Code, w.WorkflowID, w.NodeID,
+		w.Description)
+}
+
+// idKey builds a stable key for an identifier. The protobuf text format returned by String() is not guaranteed to be
+// stable, so it is not used for lookups or reported ids. The resource type and org are part of the key so a task and a
+// workflow, or the same entity in two orgs, never collide.
+func idKey(id *core.Identifier) string {
+	return fmt.Sprintf("%s:%s:%s:%s:%s:%s", id.GetResourceType(), id.GetOrg(), id.GetProject(), id.GetDomain(),
+		id.GetName(), id.GetVersion())
+}
+
+type linter struct {
+	tasks    map[string]*core.TaskTemplate
+	warnings []Warning
+}
+
+// Lint runs all lint rules against the primary workflow and all subworkflows of a compiled workflow closure and returns
+// the collected warnings sorted by workflow, node and code.
+func Lint(closure *core.CompiledWorkflowClosure) []Warning {
+	l := &linter{tasks: make(map[string]*core.TaskTemplate, len(closure.GetTasks()))}
+	for _, task := range closure.GetTasks() {
+		l.tasks[idKey(task.GetTemplate().GetId())] = task.GetTemplate()
+	}
+
+	if closure.GetPrimary() != nil {
+		l.lintWorkflow(closure.GetPrimary().GetTemplate())
+	}
+
+	for _, subWf := range closure.GetSubWorkflows() {
+		l.lintWorkflow(subWf.GetTemplate())
+	}
+
+	sort.SliceStable(l.warnings, func(i, j int) bool {
+		if l.warnings[i].WorkflowID != l.warnings[j].WorkflowID {
+			return l.warnings[i].WorkflowID < l.warnings[j].WorkflowID
+		}
+
+		if l.warnings[i].NodeID != l.warnings[j].NodeID {
+			return l.warnings[i].NodeID < l.warnings[j].NodeID
+		}
+
+		return l.warnings[i].Code < l.warnings[j].Code
+	})
+
+	return l.warnings
+}
+
+func (l *linter) report(wf *core.WorkflowTemplate, nodeID string, code WarningCode, format string, args ...interface{}) {
+	l.warnings = append(l.warnings, Warning{
+		Code:        code,
+		WorkflowID:  idKey(wf.GetId()),
+		NodeID:      nodeID,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+func (l *linter) lintWorkflow(wf *core.WorkflowTemplate) {
+	if wf == nil {
+		return
+	}
+
+	allNodes := make([]*core.Node, 0, len(wf.GetNodes()))
+	for _, n := range wf.GetNodes() {
+		allNodes = appendWithChildren(allNodes, n)
+	}
+
+	if wf.GetFailureNode() != nil {
+		allNodes = appendWithChildren(allNodes, wf.GetFailureNode())
+	}
+
+	l.checkUnusedOutputs(wf, allNodes)
+	l.checkCacheableInputs(wf, allNodes)
+	for _, n := range allNodes {
+		l.checkBranch(wf, n)
+		l.checkMapTask(wf, n)
+		l.checkInterruptibleRetries(wf, n)
+	}
+}
+
+// Flattens a node and all the nodes nested within it (branch cases and array sub nodes).
+func appendWithChildren(nodes []*core.Node, n *core.Node) []*core.Node {
+	if n == nil {
+		return nodes
+	}
+
+	nodes = append(nodes, n)
+	if ifElse := n.GetBranchNode().GetIfElse(); ifElse != nil {
+		nodes = appendWithChildren(nodes, ifElse.GetCase().GetThenNode())
+		for _, other := range ifElse.GetOther() {
+			nodes = appendWithChildren(nodes, other.GetThenNode())
+		}
+
+		nodes = appendWithChildren(nodes, ifElse.GetElseNode())
+	}
+
+	if arrayNode := n.GetArrayNode(); arrayNode != nil {
+		nodes = appendWithChildren(nodes, arrayNode.GetNode())
+	}
+
+	return nodes
+}
+
+// Gets the task template a node executes, including the task wrapped by an array node.
+func (l *linter) getTask(n *core.Node) (*core.TaskTemplate, bool) {
+	if arrayNode := n.GetArrayNode(); arrayNode != nil {
+		return l.getTask(arrayNode.GetNode())
+	}
+
+	if n.GetTaskNode() == nil {
+		return nil, false
+	}
+
+	task, found := l.tasks[idKey(n.GetTaskNode().GetReferenceId())]
+	return task, found
+}
+
+func (l *linter) isCacheable(n *core.Node) bool {
+	if override, ok := n.GetMetadata().GetCacheableValue().(*core.NodeMetadata_Cacheable); ok {
+		return override.Cacheable
+	}
+
+	task, found := l.getTask(n)
+	return found && task.GetMetadata().GetDiscoverable()
+}
+
+func (l *linter) isInterruptible(n *core.Node) bool {
+	if override, ok := n.GetMetadata().GetInterruptibleValue().(*core.NodeMetadata_Interruptible); ok {
+		return override.Interruptible
+	}
+
+	task, found := l.getTask(n)
+	return found && task.GetMetadata().GetInterruptible()
+}
+
+func (l *linter) getRetries(n *core.Node) uint32 {
+	if n.GetMetadata().GetRetries() != nil {
+		return n.GetMetadata().GetRetries().GetRetries()
+	}
+
+	task, _ := l.getTask(n)
+	return task.GetMetadata().GetRetries().GetRetries()
+}
+
+func collectPromises(data *core.BindingData, promises []*core.OutputReference) []*core.OutputReference {
+	switch v := data.GetValue().(type) {
+	case *core.BindingData_Promise:
+		promises = append(promises, v.Promise)
+	case *core.BindingData_Collection:
+		for _, item := range v.Collection.GetBindings() {
+			promises = collectPromises(item, promises)
+		}
+	case *core.BindingData_Map:
+		for _, item := range v.Map.GetBindings() {
+			promises = collectPromises(item, promises)
+		}
+	}
+
+	return promises
+}
+
+func bindingPromises(bindings []*core.Binding) []*core.OutputReference {
+	var promises []*core.OutputReference
+	for _, b := range bindings {
+		promises = collectPromises(b.GetBinding(), promises)
+	}
+
+	return promises
+}
+
+func (l *linter) checkUnusedOutputs(wf *core.WorkflowTemplate, allNodes []*core.Node) {
+	consumed := map[string]map[string]bool{}
+	markConsumed := func(bindings []*core.Binding) {
+		for _, p := range bindingPromises(bindings) {
+			if _, found := consumed[p.GetNodeId()]; !found {
+				consumed[p.GetNodeId()] = map[string]bool{}
+			}
+
+			consumed[p.GetNodeId()][p.GetVar()] = true
+		}
+	}
+
+	markConsumed(wf.GetOutputs())
+	for _, n := range allNodes {
+		markConsumed(n.GetInputs())
+	}
+
+	// Only top level nodes can be referenced by other nodes. Outputs of nodes nested in branches are exposed through
+	// the branch node itself.
+	for _, n := range wf.GetNodes() {
+		if n.GetId() == c.StartNodeID || n.GetId() == c.EndNodeID {
+			continue
+		}
+
+		task, found := l.getTask(n)
+		if !found {
+			continue
+		}
+
+		unused := make([]string, 0, len(task.GetInterface().GetOutputs().GetVariables()))
+		for name := range task.GetInterface().GetOutputs().GetVariables() {
+			if !consumed[n.GetId()][name] {
+				unused = append(unused, name)
+			}
+		}
+
+		if len(unused) > 0 {
+			sort.Strings(unused)
+			l.report(wf, n.GetId(), UnusedOutput, "Outputs [%v] are never consumed by any node or workflow output.",
+				strings.Join(unused, ","))
+		}
+	}
+}
+
+func (l *linter) checkCacheableInputs(wf *core.WorkflowTemplate, allNodes []*core.Node) {
+	nodesByID := make(map[string]*core.Node, len(allNodes))
+	for _, n := range allNodes {
+		nodesByID[n.GetId()] = n
+	}
+
+	for _, n := range allNodes {
+		if n.GetArrayNode() != nil || !l.isCacheable(n) {
+			continue
+		}
+
+		upstream := map[string]bool{}
+		for _, p := range bindingPromises(n.GetInputs()) {
+			upstreamNode, found := nodesByID[p.GetNodeId()]
+			if !found || p.GetNodeId() == c.StartNodeID {
+				continue
+			}
+
+			if _, isTask := l.getTask(upstreamNode); isTask && !l.isCacheable(upstreamNode) {
+				upstream[p.GetNodeId()] = true
+			}
+		}
+
+		if len(upstream) > 0 {
+			ids := make([]string, 0, len(upstream))
+			for id := range upstream {
+				ids = append(ids, id)
+			}
+
+			sort.Strings(ids)
+			l.report(wf, n.GetId(), CacheableNodeWithNonDeterministicInput,
+				"Node is cacheable but consumes outputs of non-cacheable nodes [%v]. Its cache key changes every time "+
+					"they are recomputed.", strings.Join(ids, ","))
+		}
+	}
+}
+
+type tristate int
+
+const (
+	unknown tristate = iota
+	alwaysTrue
+	alwaysFalse
+)
+
+func operandsEqual(left, right *core.Operand) bool {
+	return left != nil && right != nil && proto.Equal(left, right)
+}
+
+// Statically evaluates a boolean expression where possible. Only comparisons of an operand with itself are folded.
+func evaluate(expr *core.BooleanExpression) tristate {
+	if comparison := expr.GetComparison(); comparison != nil {
+		if !operandsEqual(comparison.GetLeftValue(), comparison.GetRightValue()) {
+			return unknown
+		}
+
+		switch comparison.GetOperator() {
+		case core.ComparisonExpression_EQ, core.ComparisonExpression_GTE, core.ComparisonExpression_LTE:
+			return alwaysTrue
+		default:
+			return alwaysFalse
+		}
+	}
+
+	if conjunction := expr.GetConjunction(); conjunction != nil {
+		left, right := evaluate(conjunction.GetLeftExpression()), evaluate(conjunction.GetRightExpression())
+		switch conjunction.GetOperator() {
+		case core.ConjunctionExpression_AND:
+			if left == alwaysFalse || right == alwaysFalse {
+				return alwaysFalse
+			} else if left == alwaysTrue && right == alwaysTrue {
+				return alwaysTrue
+			}
+		case core.ConjunctionExpression_OR:
+			if left == alwaysTrue || right == alwaysTrue {
+				return alwaysTrue
+			} else if left == alwaysFalse && right == alwaysFalse {
+				return alwaysFalse
+			}
+		}
+	}
+
+	return unknown
+}
+
+func (l *linter) checkBranch(wf *core.WorkflowTemplate, n *core.Node) {
+	ifElse := n.GetBranchNode().GetIfElse()
+	if ifElse == nil {
+		return
+	}
+
+	cases := append([]*core.IfBlock{ifElse.GetCase()}, ifElse.GetOther()...)
+	for i, ifBlock := range cases {
+		caseID := ifBlock.GetThenNode().GetId()
+		switch evaluate(ifBlock.GetCondition()) {
+		case alwaysFalse:
+			l.report(wf, n.GetId(), UnreachableBranchCase, "Case [%v] has a condition that is always false.", caseID)
+			continue
+		case alwaysTrue:
+			for _, shadowed := range cases[i+1:] {
+				l.report(wf, n.GetId(), UnreachableBranchCase,
+					"Case [%v] is unreachable because case [%v] always matches.", shadowed.GetThenNode().GetId(), caseID)
+			}
+
+			if ifElse.GetElseNode() != nil {
+				l.report(wf, n.GetId(), UnreachableBranchCase,
+					"Else case [%v] is unreachable because case [%v] always matches.", ifElse.GetElseNode().GetId(), caseID)
+			}
+
+			return
+		}
+
+		for _, previous := range cases[:i] {
+			if proto.Equal(previous.GetCondition(), ifBlock.GetCondition()) {
+				l.report(wf, n.GetId(), UnreachableBranchCase,
+					"Case [%v] is unreachable because case [%v] has the same condition.", caseID,
+					previous.GetThenNode().GetId())
+				break
+			}
+		}
+	}
+}
+
+func (l *linter) checkMapTask(wf *core.WorkflowTemplate, n *core.Node) {
+	if arrayNode := n.GetArrayNode(); arrayNode != nil {
+		if _, set := arrayNode.GetParallelismOption().(*core.ArrayNode_Parallelism); set && arrayNode.GetParallelism() == 0 {
+			l.report(wf, n.GetId(), UnboundedMapTask,
+				"Map task parallelism is set to 0 and will run all sub tasks concurrently.")
+		}
+
+		return
+	}
+
+	task, found := l.getTask(n)
+	if !found || task.GetType() != legacyMapTaskType || task.GetCustom() == nil {
+		return
+	}
+
+	arrayJob := &plugins.ArrayJob{}
+	if err := utils.UnmarshalStructToPb(task.GetCustom(), arrayJob); err == nil && arrayJob.GetParallelism() == 0 {
+		l.report(wf, n.GetId(), UnboundedMapTask,
+			"Map task [%v] has no parallelism limit and will run all sub tasks concurrently.", task.GetId().GetName())
+	}
+}
+
+func (l *linter) checkInterruptibleRetries(wf *core.WorkflowTemplate, n *core.Node) {
+	if _, isTask := l.getTask(n); !isTask || n.GetArrayNode() != nil {
+		return
+	}
+
+	if l.isInterruptible(n) && l.getRetries(n) == 0 {
+		l.report(wf, n.GetId(), InterruptibleWithoutRetries,
+			"Node is interruptible but has no retries. A single preemption will fail it.")
+	}
+}
@@ -0,0 +1,257 @@
+package lint
+
+import (
+	"testing"
+
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+)
+
+func newTask(name string, outputs []string, metadata *core.TaskMetadata) *core.CompiledTask {
+	variables := map[string]*core.Variable{}
+	for _, o := range outputs {
+		variables[o] = &core.Variable{Type: &core.LiteralType{Type: &core.LiteralType_Simple{Simple: core.SimpleType_INTEGER}}}
+	}
+
+	return &core.CompiledTask{
+		Template: &core.TaskTemplate{
+			Id:       &core.Identifier{ResourceType: core.ResourceType_TASK, Name: name},
+			Type:     "python-task",
+			Metadata: metadata,
+			Interface: &core.TypedInterface{
+				Outputs: &core.VariableMap{Variables: variables},
+			},
+		},
+	}
+}
+
+func newTaskNode(id, taskName string, inputs ...*core.Binding) *core.Node {
+	return &core.Node{
+		Id:     id,
+		Inputs: inputs,
+		Target: &core.Node_TaskNode{
+			TaskNode: &core.TaskNode{
+				Reference: &core.TaskNode_ReferenceId{
+					ReferenceId: &core.Identifier{ResourceType: core.ResourceType_TASK, Name: taskName},
+				},
+			},
+		},
+	}
+}
+
+func newPromiseBinding(varName, nodeID, outputVar string) *core.Binding {
+	return &core.Binding{
+		Var: varName,
+		Binding: &core.BindingData{
+			Value: &core.BindingData_Promise{
+				Promise: &core.OutputReference{NodeId: nodeID, Var: outputVar},
+			},
+		},
+	}
+}
+
+func newClosure(tasks []*core.CompiledTask, nodes []*core.Node, outputs ...*core.Binding) *core.CompiledWorkflowClosure {
+	return &core.CompiledWorkflowClosure{
+		Primary: &core.CompiledWorkflow{
+			Template: &core.WorkflowTemplate{
+				Id:      &core.Identifier{ResourceType: core.ResourceType_WORKFLOW, Name: "wf"},
+				Nodes:   nodes,
+				Outputs: outputs,
+			},
+		},
+		Tasks: tasks,
+	}
+}
+
+func codes(warnings []Warning) []WarningCode {
+	res := make([]WarningCode, 0, len(warnings))
+	for _, w := range warnings {
+		res = append(res, w.Code)
+	}
+
+	return res
+}
+
+func TestLint_Clean(t *testing.T) {
+	closure := newClosure(
+		[]*core.CompiledTask{newTask("t1", []string{"o0"}, nil)},
+		[]*core.Node{newTaskNode("n1", "t1")},
+		newPromiseBinding("wf_out", "n1", "o0"))
+
+	assert.Empty(t, Lint(closure))
+}
+
+func TestLint_UnusedOutput(t *testing.T) {
+	closure := newClosure(
+		[]*core.CompiledTask{newTask("t1", []string{"o0", "o1", "o2"}, nil)},
+		[]*core.Node{
+			newTaskNode("n1", "t1"),
+			newTaskNode("n2", "t1", newPromiseBinding("x", "n1", "o1")),
+		},
+		newPromiseBinding("wf_out", "n2", "o0"))
+
+	warnings := Lint(closure)
+	if assert.Len(t, warnings, 2) {
+		assert.Equal(t, UnusedOutput, warnings[0].Code)
+		assert.Equal(t, "WORKFLOW::::wf:", warnings[0].WorkflowID)
+		assert.Equal(t, "n1", warnings[0].NodeID)
+		assert.Contains(t, warnings[0].Description, "[o0,o2]")
+		assert.Equal(t, "n2", warnings[1].NodeID)
+		assert.Contains(t, warnings[1].Description, "[o1,o2]")
+	}
+}
+
+func TestIDKey(t *testing.T) {
+	task := &core.Identifier{ResourceType: core.ResourceType_TASK, Project: "p", Domain: "d", Name: "n", Version: "v"}
+	workflow := &core.Identifier{ResourceType: core.ResourceType_WORKFLOW, Project: "p", Domain: "d", Name: "n", Version: "v"}
+	otherOrg := &core.Identifier{ResourceType: core.ResourceType_TASK, Org: "o", Project: "p", Domain: "d", Name: "n", Version: "v"}
+
+	assert.Equal(t, "TASK::p:d:n:v", idKey(task))
+	assert.NotEqual(t, idKey(task), idKey(workflow))
+	assert.NotEqual(t, idKey(task), idKey(otherOrg))
+}
+
+func TestLint_TaskInOtherOrg(t *testing.T) {
+	interruptible := &core.TaskMetadata{InterruptibleValue: &core.TaskMetadata_Interruptible{Interruptible: true}}
+	otherOrg := newTask("t1", []string{"o0"}, interruptible)
+	otherOrg.Template.Id.Org = "other"
+	closure := newClosure(
+		[]*core.CompiledTask{newTask("t1", []string{"o0"}, nil), otherOrg},
+		[]*core.Node{newTaskNode("n1", "t1")},
+		newPromiseBinding("wf_out", "n1", "o0"))
+
+	// The node references the task without an org, so the interruptible task of the other org must not be picked up.
+	assert.Empty(t, Lint(closure))
+}
+
+func TestLint_CacheableNodeWithNonDeterministicInput(t *testing.T) {
+	closure := newClosure(
+		[]*core.CompiledTask{
+			newTask("uncached", []string{"o0"}, nil),
+			newTask("cached", []string{"o0"}, &core.TaskMetadata{Discoverable: true}),
+		},
+		[]*core.Node{
+			newTaskNode("n1", "uncached"),
+			newTaskNode("n2", "cached", newPromiseBinding("x", "n1", "o0")),
+			newTaskNode("n3", "cached", newPromiseBinding("x", "n2", "o0")),
+		},
+		newPromiseBinding("wf_out", "n3", "o0"))
+
+	warnings := Lint(closure)
+	if assert.Len(t, warnings, 1) {
+		assert.Equal(t, CacheableNodeWithNonDeterministicInput, warnings[0].Code)
+		assert.Equal(t, "n2", warnings[0].NodeID)
+	}
+}
+
+func TestLint_UnreachableBranchCase(t *testing.T) {
+	varOperand := &core.Operand{Val: &core.Operand_Var{Var: "x"}}
+	otherOperand := &core.Operand{Val: &core.Operand_Var{Var: "y"}}
+	alwaysTrueCond := &core.BooleanExpression{
+		Expr: &core.BooleanExpression_Comparison{
+			Comparison: &core.ComparisonExpression{
+				Operator:   core.ComparisonExpression_EQ,
+				LeftValue:  varOperand,
+				RightValue: varOperand,
+			},
+		},
+	}
+	regularCond := &core.BooleanExpression{
+		Expr: &core.BooleanExpression_Comparison{
+			Comparison: &core.ComparisonExpression{
+				Operator:   core.ComparisonExpression_GT,
+				LeftValue:  varOperand,
+				RightValue: otherOperand,
+			},
+		},
+	}
+
+	t.Run("duplicate condition", func(t *testing.T) {
+		branch := &core.Node{
+			Id: "b",
+			Target: &core.Node_BranchNode{
+				BranchNode: &core.BranchNode{
+					IfElse: &core.IfElseBlock{
+						Case:  &core.IfBlock{Condition: regularCond, ThenNode: newTaskNode("b-n0", "t1")},
+						Other: []*core.IfBlock{{Condition: regularCond, ThenNode: newTaskNode("b-n1", "t1")}},
+					},
+				},
+			},
+		}
+
+		warnings := Lint(newClosure([]*core.CompiledTask{newTask("t1", nil, nil)}, []*core.Node{branch}))
+		if assert.Len(t, warnings, 1) {
+			assert.Equal(t, UnreachableBranchCase, warnings[0].Code)
+			assert.Contains(t, warnings[0].Description, "b-n1")
+		}
+	})
+
+	t.Run("always true", func(t *testing.T) {
+		branch := &core.Node{
+			Id: "b",
+			Target: &core.Node_BranchNode{
+				BranchNode: &core.BranchNode{
+					IfElse: &core.IfElseBlock{
+						Case:  &core.IfBlock{Condition: alwaysTrueCond, ThenNode: newTaskNode("b-n0", "t1")},
+						Other: []*core.IfBlock{{Condition: regularCond, ThenNode: newTaskNode("b-n1", "t1")}},
+						Default: &core.IfElseBlock_ElseNode{
+							ElseNode: newTaskNode("b-n2", "t1"),
+						},
+					},
+				},
+			},
+		}
+
+		warnings := Lint(newClosure([]*core.CompiledTask{newTask("t1", nil, nil)}, []*core.Node{branch}))
+		assert.Equal(t, []WarningCode{UnreachableBranchCase, UnreachableBranchCase}, codes(warnings))
+	})
+}
+
+func TestLint_UnboundedMapTask(t *testing.T) {
+	t.Run("array node", func(t *testing.T) {
+		arrayNode := &core.Node{
+			Id: "n1",
+			Target: &core.Node_ArrayNode{
+				ArrayNode: &core.ArrayNode{
+					Node:              newTaskNode("n1-sub", "t1"),
+					ParallelismOption: &core.ArrayNode_Parallelism{Parallelism: 0},
+				},
+			},
+		}
+
+		warnings := Lint(newClosure([]*core.CompiledTask{newTask("t1", nil, nil)}, []*core.Node{arrayNode}))
+		assert.Equal(t, []WarningCode{UnboundedMapTask}, codes(warnings))
+
+		arrayNode.GetArrayNode().ParallelismOption = &core.ArrayNode_Parallelism{Parallelism: 10}
+		assert.Empty(t, Lint(newClosure([]*core.CompiledTask{newTask("t1", nil, nil)}, []*core.Node{arrayNode})))
+	})
+
+	t.Run("legacy map task", func(t *testing.T) {
+		task := newTask("t1", nil, nil)
+		task.Template.Type = legacyMapTaskType
+		task.Template.Custom = &structpb.Struct{Fields: map[string]*structpb.Value{
+			"size": {Kind: &structpb.Value_NumberValue{NumberValue: 10}},
+		}}
+
+		warnings := Lint(newClosure([]*core.CompiledTask{task}, []*core.Node{newTaskNode("n1", "t1")}))
+		assert.Equal(t, []WarningCode{UnboundedMapTask}, codes(warnings))
+	})
+}
+
+func TestLint_InterruptibleWithoutRetries(t *testing.T) {
+	interruptible := &core.TaskMetadata{InterruptibleValue: &core.TaskMetadata_Interruptible{Interruptible: true}}
+	closure := newClosure(
+		[]*core.CompiledTask{newTask("t1", nil, interruptible)},
+		[]*core.Node{newTaskNode("n1", "t1"), newTaskNode("n2", "t1")})
+	closure.GetPrimary().GetTemplate().GetNodes()[1].Metadata = &core.NodeMetadata{
+		Retries: &core.RetryStrategy{Retries: 3},
+	}
+
+	warnings := Lint(closure)
+	if assert.Len(t, warnings, 1) {
+		assert.Equal(t, InterruptibleWithoutRetries, warnings[0].Code)
+		assert.Equal(t, "n1", warnings[0].NodeID)
+	}
+}
@@ -30,6 +30,15 @@ const (
 	ShardKeyLabel = "shard-key"
 	// The fully qualified FlyteWorkflow name
 	WorkflowNameLabel = "workflow-name"
+
+	// Annotations are set on the FlyteWorkflow CRD by flyteadmin to limit the task pods of the project and domain
+
+	// The maximum number of task pods of the project and domain running at the same time
+	MaxRunningTasksAnnotation = "quota.flyte.org/max-running-tasks"
+	// The maximum total CPU requested by the running task pods of the project and domain, as a Kubernetes quantity
+	MaxCPUAnnotation = "quota.flyte.org/max-cpu"
+	// The maximum total GPU requested by the running task pods of the project and domain, as a Kubernetes quantity
+	MaxGPUAnnotation = "quota.flyte.org/max-gpu"
 )
 
 func requiresInputs(w *core.WorkflowTemplate) bool {
@@ -0,0 +1,297 @@
+package cacheservice
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"time"
+
+	grpcRetry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	grpcPrometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/cacheservice"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/datacatalog"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/catalog"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/io"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/ioutils"
+	catalogTransformer "github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/catalog/datacatalog"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/otelutils"
+)
+
+var (
+	_ catalog.Client = &CacheClient{}
+)
+
+// CacheClient is the client that caches task executions in the key-value CacheService.
+type CacheClient struct {
+	client      cacheservice.CacheServiceClient
+	maxCacheAge time.Duration
+}
+
+// cacheKey identifies a cache entry. It is made of the same dataset ID and input hash tag DataCatalog uses, so the
+// cache is invalidated under the same conditions (task interface, cache version and inputs).
+type cacheKey struct {
+	datasetID *datacatalog.DatasetID
+	tag       string
+}
+
+func (k cacheKey) String() string {
+	return strings.Join([]string{k.datasetID.GetProject(), k.datasetID.GetDomain(), k.datasetID.GetName(), k.datasetID.GetVersion(), k.tag}, ":")
+}
+
+func (k cacheKey) catalogMetadata(source *core.TaskExecutionIdentifier) *core.CatalogMetadata {
+	return catalogTransformer.EventCatalogMetadata(k.datasetID, &datacatalog.Tag{Name: k.tag, ArtifactId: k.String()}, source)
+}
+
+func generateCacheKey(ctx context.Context, key catalog.Key) (cacheKey, error) {
+	datasetID, err := catalogTransformer.GenerateDatasetIDForTask(ctx, key)
+	if err != nil {
+		return cacheKey{}, err
+	}
+
+	inputs := &core.LiteralMap{}
+	if key.TypedInterface.GetInputs() != nil {
+		retInputs, err := key.InputReader.Get(ctx)
+		if err != nil {
+			return cacheKey{}, errors.Wrap(err, "failed to read inputs when trying to query cache service")
+		}
+		inputs = retInputs
+	}
+
+	tag, err := catalogTransformer.GenerateArtifactTagName(ctx, inputs, key.CacheIgnoreInputVars)
+	if err != nil {
+		return cacheKey{}, err
+	}
+
+	return cacheKey{datasetID: datasetID, tag: tag}, nil
+}
+
+// getMetadataForSource records the task execution that populated the cache entry, using the same keys DataCatalog
+// stores in its dataset and artifact metadata.
+func getMetadataForSource(taskExecutionID *core.TaskExecutionIdentifier) *cacheservice.Metadata {
+	keyMap := map[string]string{}
+	for k, v := range catalogTransformer.GetDatasetMetadataForSource(taskExecutionID).GetKeyMap() {
+		keyMap[k] = v
+	}
+	for k, v := range catalogTransformer.GetArtifactMetadataForSource(taskExecutionID).GetKeyMap() {
+		keyMap[k] = v
+	}
+
+	return &cacheservice.Metadata{
+		SourceIdentifier: taskExecutionID.GetTaskId(),
+		KeyMap:           &cacheservice.KeyMapMetadata{Values: keyMap},
+	}
+}
+
+// Get the cached task execution from the CacheService.
+func (c *CacheClient) Get(ctx context.Context, key catalog.Key) (catalog.Entry, error) {
+	k, err := generateCacheKey(ctx, key)
+	if err != nil {
+		logger.Errorf(ctx, "CacheService failed to generate cache key for ID %s, err: %+v", key.Identifier.String(), err)
+		return catalog.Entry{}, err
+	}
+
+	response, err := c.client.Get(ctx, &cacheservice.GetCacheRequest{Key: k.String()})
+	if err != nil {
+		logger.Debugf(ctx, "CacheService failed to get output for key %s, err: %+v", k, err)
+		return catalog.Entry{}, err
+	}
+
+	output := response.GetOutput()
+	if c.maxCacheAge > time.Duration(0) {
+		createdAt := output.GetMetadata().GetCreatedAt()
+		if err := createdAt.CheckValid(); err != nil {
+			logger.Errorf(ctx, "CacheService output for key %s has invalid createdAt %+v, err: %+v", k, createdAt, err)
+			return catalog.Entry{}, err
+		}
+
+		if time.Since(createdAt.AsTime()) > c.maxCacheAge {
+			logger.Warningf(ctx, "Expired cached output for key %s created on %v, older than max age %v",
+				k, createdAt.AsTime().String(), c.maxCacheAge)
+			return catalog.Entry{}, status.Error(codes.NotFound, "Cached output over age limit")
+		}
+	}
+
+	keyMap := &datacatalog.Metadata{KeyMap: output.GetMetadata().GetKeyMap().GetValues()}
+	source, err := catalogTransformer.GetSourceFromMetadata(keyMap, keyMap, key.Identifier)
+	if err != nil {
+		return catalog.Entry{}, fmt.Errorf("failed to get source from metadata. Error: %w", err)
+	}
+	md := k.catalogMetadata(source)
+
+	if output.GetOutputLiterals() == nil {
+		// Propeller always stores literals, an output referenced by URI was written by another client
+		logger.Errorf(ctx, "CacheService output for key %s is stored by reference, which is not supported", k)
+		return catalog.NewCatalogEntry(nil, catalog.NewStatus(core.CatalogCacheStatus_CACHE_MISS, md)),
+			errors.Errorf("cached output for key %s is not stored inline", k)
+	}
+
+	outputs := output.GetOutputLiterals()
+	logger.Infof(ctx, "Retrieved %v outputs from cache service for key %s", len(outputs.GetLiterals()), k)
+	return catalog.NewCatalogEntry(ioutils.NewInMemoryOutputReader(outputs, nil, nil), catalog.NewStatus(core.CatalogCacheStatus_CACHE_HIT, md)), nil
+}
+
+func (c *CacheClient) put(ctx context.Context, key catalog.Key, reader io.OutputReader, metadata catalog.Metadata, overwrite bool) (catalog.Status, error) {
+	k, err := generateCacheKey(ctx, key)
+	if err != nil {
+		logger.Errorf(ctx, "CacheService failed to generate cache key for ID %s, err: %+v", key.Identifier.String(), err)
+		return catalog.NewPutFailureStatus(&key), err
+	}
+
+	outputs := &core.LiteralMap{}
+	if key.TypedInterface.GetOutputs() != nil && len(key.TypedInterface.GetOutputs().GetVariables()) != 0 {
+		retOutputs, retErr, err := reader.Read(ctx)
+		if err != nil {
+			logger.Errorf(ctx, "CacheService failed to read outputs err: %s", err)
+			return catalog.NewPutFailureStatus(&key), err
+		}
+		if retErr != nil {
+			logger.Errorf(ctx, "CacheService failed to read outputs, err :%s", retErr.Message)
+			return catalog.NewPutFailureStatus(&key), errors.Errorf("Failed to read outputs. EC: %s, Msg: %s", retErr.Code, retErr.Message)
+		}
+		outputs = retOutputs
+	}
+
+	request := &cacheservice.PutCacheRequest{
+		Key: k.String(),
+		Output: &cacheservice.CachedOutput{
+			Output:   &cacheservice.CachedOutput_OutputLiterals{OutputLiterals: outputs},
+			Metadata: getMetadataForSource(metadata.TaskExecutionIdentifier),
+		},
+		Overwrite: overwrite,
+	}
+
+	if _, err := c.client.Put(ctx, request); err != nil {
+		if status.Code(err) != codes.AlreadyExists {
+			logger.Errorf(ctx, "Failed to put outputs for key %s, err: %v", k, err)
+			return catalog.NewPutFailureStatus(&key), err
+		}
+		logger.Warnf(ctx, "Cached outputs for key %s already exist (idempotent)", k)
+	}
+
+	logger.Debugf(ctx, "Successfully cached %d outputs for key %s and execution %+v", len(outputs.GetLiterals()), k, metadata)
+	return catalog.NewStatus(core.CatalogCacheStatus_CACHE_POPULATED, k.catalogMetadata(metadata.TaskExecutionIdentifier)), nil
+}
+
+// Put stores the result of a task execution in the cache, keyed by the task's dataset ID and the hash of its input
+// values. An already existing entry is left untouched.
+func (c *CacheClient) Put(ctx context.Context, key catalog.Key, reader io.OutputReader, metadata catalog.Metadata) (catalog.Status, error) {
+	return c.put(ctx, key, reader, metadata, false)
+}
+
+// Update stores the result of a task execution in the cache, overwriting any already stored data from a previous
+// execution.
+func (c *CacheClient) Update(ctx context.Context, key catalog.Key, reader io.OutputReader, metadata catalog.Metadata) (catalog.Status, error) {
+	return c.put(ctx, key, reader, metadata, true)
+}
+
+// GetOrExtendReservation attempts to get a reservation for the cacheable task. If you have
+// previously acquired a reservation it will be extended. If another entity holds the reservation
+// that is returned.
+func (c *CacheClient) GetOrExtendReservation(ctx context.Context, key catalog.Key, ownerID string, heartbeatInterval time.Duration) (*datacatalog.Reservation, error) {
+	k, err := generateCacheKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.client.GetOrExtendReservation(ctx, &cacheservice.GetOrExtendReservationRequest{
+		Key:               k.String(),
+		OwnerId:           ownerID,
+		HeartbeatInterval: durationpb.New(heartbeatInterval),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The task node handler works with DataCatalog reservations, translate the response to one
+	reservation := response.GetReservation()
+	return &datacatalog.Reservation{
+		ReservationId: &datacatalog.ReservationID{
+			DatasetId: k.datasetID,
+			TagName:   k.tag,
+		},
+		OwnerId:           reservation.GetOwnerId(),
+		HeartbeatInterval: reservation.GetHeartbeatInterval(),
+		ExpiresAt:         reservation.GetExpiresAt(),
+	}, nil
+}
+
+// ReleaseReservation attempts to release a reservation for a cacheable task. If the reservation
+// does not exist (e.x. it never existed or has been acquired by another owner) then this call
+// still succeeds.
+func (c *CacheClient) ReleaseReservation(ctx context.Context, key catalog.Key, ownerID string) error {
+	k, err := generateCacheKey(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.ReleaseReservation(ctx, &cacheservice.ReleaseReservationRequest{
+		Key:     k.String(),
+		OwnerId: ownerID,
+	})
+	return err
+}
+
+// NewCacheClient creates a new CacheService client for task execution caching
+func NewCacheClient(ctx context.Context, endpoint string, insecureConnection bool, maxCacheAge time.Duration,
+	useAdminAuth bool, defaultServiceConfig string, maxRetries uint, backoffScalar int, backoffJitter float64, authOpt ...grpc.DialOption) (*CacheClient, error) {
+	var opts []grpc.DialOption
+	if useAdminAuth && authOpt != nil {
+		opts = append(opts, authOpt...)
+	}
+
+	grpcOptions := []grpcRetry.CallOption{
+		grpcRetry.WithBackoff(grpcRetry.BackoffExponentialWithJitter(time.Duration(backoffScalar)*time.Millisecond, backoffJitter)),
+		grpcRetry.WithCodes(codes.DeadlineExceeded, codes.Unavailable, codes.Canceled),
+		grpcRetry.WithMax(maxRetries),
+	}
+
+	if insecureConnection {
+		logger.Debug(ctx, "Establishing insecure connection to CacheService")
+		opts = append(opts, grpc.WithInsecure())
+	} else {
+		logger.Debug(ctx, "Establishing secure connection to CacheService")
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, err
+		}
+
+		creds := credentials.NewClientTLSFromCert(pool, "")
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	}
+
+	if defaultServiceConfig != "" {
+		opts = append(opts, grpc.WithDefaultServiceConfig(defaultServiceConfig))
+	}
+
+	retryInterceptor := grpcRetry.UnaryClientInterceptor(grpcOptions...)
+
+	tracerProvider := otelutils.GetTracerProvider(otelutils.DataCatalogClientTracer)
+	opts = append(opts, grpc.WithChainUnaryInterceptor(
+		grpcPrometheus.UnaryClientInterceptor,
+		otelgrpc.UnaryClientInterceptor(
+			otelgrpc.WithTracerProvider(tracerProvider),
+			otelgrpc.WithPropagators(propagation.TraceContext{}),
+		),
+		retryInterceptor))
+	clientConn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CacheClient{
+		client:      cacheservice.NewCacheServiceClient(clientConn),
+		maxCacheAge: maxCacheAge,
+	}, nil
+}
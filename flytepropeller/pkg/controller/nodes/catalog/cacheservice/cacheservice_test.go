@@ -0,0 +1,227 @@
+package cacheservice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/flyteorg/flyte/flyteidl/clients/go/cacheservice/mocks"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/cacheservice"
+	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/catalog"
+	mocks2 "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/io/mocks"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/ioutils"
+	"github.com/flyteorg/flyte/flytestdlib/contextutils"
+	"github.com/flyteorg/flyte/flytestdlib/promutils/labeled"
+)
+
+func init() {
+	labeled.SetMetricKeys(contextutils.ProjectKey, contextutils.DomainKey, contextutils.WorkflowIDKey, contextutils.TaskIDKey)
+}
+
+func newStringLiteral(value string) *core.Literal {
+	return &core.Literal{
+		Value: &core.Literal_Scalar{
+			Scalar: &core.Scalar{
+				Value: &core.Scalar_Primitive{
+					Primitive: &core.Primitive{
+						Value: &core.Primitive_StringValue{
+							StringValue: value,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var sampleParameters = &core.LiteralMap{Literals: map[string]*core.Literal{
+	"out1": newStringLiteral("output1-stringval"),
+}}
+
+var variableMap = &core.VariableMap{
+	Variables: map[string]*core.Variable{
+		"test": {
+			Type: &core.LiteralType{
+				Type: &core.LiteralType_Simple{
+					Simple: core.SimpleType_STRING,
+				},
+			},
+		},
+	},
+}
+
+var sampleKey = catalog.Key{
+	Identifier:     core.Identifier{ResourceType: core.ResourceType_TASK, Project: "project", Domain: "domain", Name: "name", Version: "version"},
+	TypedInterface: core.TypedInterface{Inputs: variableMap, Outputs: variableMap},
+	CacheVersion:   "1.0.0",
+}
+
+var taskExecutionID = &core.TaskExecutionIdentifier{
+	TaskId: &core.Identifier{
+		ResourceType: core.ResourceType_TASK,
+		Project:      "project",
+		Domain:       "domain",
+		Name:         "name",
+		Version:      "ver",
+	},
+	NodeExecutionId: &core.NodeExecutionIdentifier{
+		ExecutionId: &core.WorkflowExecutionIdentifier{
+			Name:    "wf",
+			Project: "p1",
+			Domain:  "d1",
+		},
+		NodeId: "n",
+	},
+	RetryAttempt: 1,
+}
+
+func newKey() catalog.Key {
+	ir := &mocks2.InputReader{}
+	ir.On("Get", mock.Anything).Return(sampleParameters, nil, nil)
+	key := sampleKey
+	key.InputReader = ir
+	return key
+}
+
+func TestCacheClient_Get(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Not found", func(t *testing.T) {
+		mockClient := &mocks.CacheServiceClient{}
+		cacheClient := &CacheClient{client: mockClient}
+		mockClient.On("Get", ctx, mock.Anything).Return(nil, status.Error(codes.NotFound, "test not found"))
+
+		resp, err := cacheClient.Get(ctx, newKey())
+		assert.Error(t, err)
+		assert.True(t, catalog.IsNotFound(err))
+		assert.Equal(t, core.CatalogCacheStatus_CACHE_DISABLED, resp.GetStatus().GetCacheStatus())
+	})
+
+	t.Run("Found", func(t *testing.T) {
+		mockClient := &mocks.CacheServiceClient{}
+		cacheClient := &CacheClient{client: mockClient}
+
+		var requestedKey string
+		mockClient.On("Get", ctx, mock.MatchedBy(func(o *cacheservice.GetCacheRequest) bool {
+			requestedKey = o.GetKey()
+			return true
+		})).Return(&cacheservice.GetCacheResponse{Output: &cacheservice.CachedOutput{
+			Output:   &cacheservice.CachedOutput_OutputLiterals{OutputLiterals: sampleParameters},
+			Metadata: getMetadataForSource(taskExecutionID),
+		}}, nil)
+
+		resp, err := cacheClient.Get(ctx, newKey())
+		assert.NoError(t, err)
+		assert.Equal(t, core.CatalogCacheStatus_CACHE_HIT, resp.GetStatus().GetCacheStatus())
+		assert.Equal(t, "project:domain:flyte_task-name:1.0.0-ue5g6uuI-ue5g6uuI:flyte_cached-BE6CZsMk6N3ExR_4X9EuwBgj2Jh2UwasXK3a_pM9xlY", requestedKey)
+		assert.Equal(t, requestedKey, resp.GetStatus().GetMetadata().GetArtifactTag().GetArtifactId())
+		assert.True(t, proto.Equal(taskExecutionID, resp.GetStatus().GetMetadata().GetSourceTaskExecution()))
+
+		outputs, executionErr, err := resp.GetOutputs().Read(ctx)
+		assert.NoError(t, err)
+		assert.Nil(t, executionErr)
+		assert.True(t, proto.Equal(sampleParameters, outputs))
+	})
+
+	t.Run("Found expired", func(t *testing.T) {
+		mockClient := &mocks.CacheServiceClient{}
+		cacheClient := &CacheClient{client: mockClient, maxCacheAge: time.Hour}
+		metadata := getMetadataForSource(taskExecutionID)
+		metadata.CreatedAt = timestamppb.New(time.Now().Add(-2 * time.Hour))
+		mockClient.On("Get", ctx, mock.Anything).Return(&cacheservice.GetCacheResponse{Output: &cacheservice.CachedOutput{
+			Output:   &cacheservice.CachedOutput_OutputLiterals{OutputLiterals: sampleParameters},
+			Metadata: metadata,
+		}}, nil)
+
+		_, err := cacheClient.Get(ctx, newKey())
+		assert.Error(t, err)
+		assert.True(t, catalog.IsNotFound(err))
+	})
+}
+
+func TestCacheClient_Put(t *testing.T) {
+	ctx := context.Background()
+
+	for _, overwrite := range []bool{false, true} {
+		mockClient := &mocks.CacheServiceClient{}
+		cacheClient := &CacheClient{client: mockClient}
+		mockClient.On("Put", ctx, mock.MatchedBy(func(o *cacheservice.PutCacheRequest) bool {
+			return o.GetOverwrite() == overwrite &&
+				proto.Equal(sampleParameters, o.GetOutput().GetOutputLiterals()) &&
+				proto.Equal(taskExecutionID.GetTaskId(), o.GetOutput().GetMetadata().GetSourceIdentifier()) &&
+				o.GetOutput().GetMetadata().GetKeyMap().GetValues()["execution-name"] == "wf"
+		})).Return(&cacheservice.PutCacheResponse{}, nil)
+
+		put := cacheClient.Put
+		if overwrite {
+			put = cacheClient.Update
+		}
+		s, err := put(ctx, newKey(), ioutils.NewInMemoryOutputReader(sampleParameters, nil, nil), catalog.Metadata{
+			TaskExecutionIdentifier: taskExecutionID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, core.CatalogCacheStatus_CACHE_POPULATED, s.GetCacheStatus())
+		mockClient.AssertExpectations(t)
+	}
+
+	t.Run("Already exists", func(t *testing.T) {
+		mockClient := &mocks.CacheServiceClient{}
+		cacheClient := &CacheClient{client: mockClient}
+		mockClient.On("Put", ctx, mock.Anything).Return(nil, status.Error(codes.AlreadyExists, "exists"))
+
+		s, err := cacheClient.Put(ctx, newKey(), ioutils.NewInMemoryOutputReader(sampleParameters, nil, nil), catalog.Metadata{})
+		assert.NoError(t, err)
+		assert.Equal(t, core.CatalogCacheStatus_CACHE_POPULATED, s.GetCacheStatus())
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		mockClient := &mocks.CacheServiceClient{}
+		cacheClient := &CacheClient{client: mockClient}
+		mockClient.On("Put", ctx, mock.Anything).Return(nil, status.Error(codes.Internal, "failed"))
+
+		s, err := cacheClient.Put(ctx, newKey(), ioutils.NewInMemoryOutputReader(sampleParameters, nil, nil), catalog.Metadata{})
+		assert.Error(t, err)
+		assert.Equal(t, core.CatalogCacheStatus_CACHE_PUT_FAILURE, s.GetCacheStatus())
+	})
+}
+
+func TestCacheClient_GetOrExtendReservation(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &mocks.CacheServiceClient{}
+	cacheClient := &CacheClient{client: mockClient}
+
+	expiresAt := timestamppb.New(time.Now().Add(time.Minute))
+	mockClient.On("GetOrExtendReservation", ctx, mock.MatchedBy(func(o *cacheservice.GetOrExtendReservationRequest) bool {
+		return o.GetOwnerId() == "owner" && o.GetHeartbeatInterval().AsDuration() == 10*time.Second
+	})).Return(&cacheservice.GetOrExtendReservationResponse{Reservation: &cacheservice.Reservation{
+		OwnerId:           "other-owner",
+		HeartbeatInterval: durationpb.New(10 * time.Second),
+		ExpiresAt:         expiresAt,
+	}}, nil)
+
+	reservation, err := cacheClient.GetOrExtendReservation(ctx, newKey(), "owner", 10*time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, "other-owner", reservation.GetOwnerId())
+	assert.Equal(t, "flyte_task-name", reservation.GetReservationId().GetDatasetId().GetName())
+	assert.True(t, proto.Equal(expiresAt, reservation.GetExpiresAt()))
+}
+
+func TestCacheClient_ReleaseReservation(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &mocks.CacheServiceClient{}
+	cacheClient := &CacheClient{client: mockClient}
+	mockClient.On("ReleaseReservation", ctx, mock.MatchedBy(func(o *cacheservice.ReleaseReservationRequest) bool {
+		return o.GetOwnerId() == "owner" && o.GetKey() != ""
+	})).Return(&cacheservice.ReleaseReservationResponse{}, nil)
+
+	assert.NoError(t, cacheClient.ReleaseReservation(ctx, newKey(), "owner"))
+}
@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/catalog"
+	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/catalog/cacheservice"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/catalog/datacatalog"
 	"github.com/flyteorg/flyte/flytestdlib/config"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
@@ -41,6 +42,7 @@ type DiscoveryType = string
 const (
 	NoOpDiscoveryType DiscoveryType = "noop"
 	DataCatalogType   DiscoveryType = "datacatalog"
+	CacheServiceType  DiscoveryType = "cacheservice"
 )
 
 type Config struct {
@@ -83,6 +85,10 @@ func NewCatalogClient(ctx context.Context, authOpt ...grpc.DialOption) (catalog.
 		return datacatalog.NewDataCatalog(ctx, catalogConfig.Endpoint, catalogConfig.Insecure,
 			catalogConfig.MaxCacheAge.Duration, catalogConfig.UseAdminAuth, catalogConfig.DefaultServiceConfig,
 			uint(catalogConfig.MaxRetries), catalogConfig.BackoffScalar, catalogConfig.GetBackoffJitter(ctx), authOpt...) // #nosec G115
+	case CacheServiceType:
+		return cacheservice.NewCacheClient(ctx, catalogConfig.Endpoint, catalogConfig.Insecure,
+			catalogConfig.MaxCacheAge.Duration, catalogConfig.UseAdminAuth, catalogConfig.DefaultServiceConfig,
+			uint(catalogConfig.MaxRetries), catalogConfig.BackoffScalar, catalogConfig.GetBackoffJitter(ctx), authOpt...) // #nosec G115
 	case NoOpDiscoveryType, "":
 		return NOOPCatalog{}, nil
 	}
@@ -8,6 +8,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	pluginMachinery "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery"
 	"github.com/flyteorg/flyte/flytestdlib/config"
 	"github.com/flyteorg/flyte/flytestdlib/logger"
 )
@@ -29,6 +30,48 @@ var (
 	section = config.MustRegisterSection(SectionKey, defaultConfig)
 )
 
+func init() {
+	config.AddTypedValidator(section, validateConfig)
+}
+
+// validateConfig checks that the plugins referenced by the config are registered. Plugins register themselves when
+// their packages are imported, so the check is skipped if none are (e.g. when the config is used without the plugins).
+func validateConfig(cfg *Config) error {
+	registered := sets.NewString()
+	for _, plugin := range pluginMachinery.PluginRegistry().GetCorePlugins() {
+		registered.Insert(cleanString(plugin.ID))
+	}
+
+	for _, plugin := range pluginMachinery.PluginRegistry().GetK8sPlugins() {
+		registered.Insert(cleanString(plugin.ID))
+	}
+
+	if registered.Len() == 0 {
+		return nil
+	}
+
+	errs := config.FieldErrors{}
+	enabled := sets.NewString()
+	for i, pluginID := range cfg.TaskPlugins.EnabledPlugins {
+		enabled.Insert(cleanString(pluginID))
+		if !registered.Has(cleanString(pluginID)) {
+			errs.Addf(fmt.Sprintf("task-plugins.enabled-plugins[%v]", i), "unknown plugin [%v], registered plugins are %v",
+				pluginID, registered.List())
+		}
+	}
+
+	for taskType, pluginID := range cfg.TaskPlugins.DefaultForTaskTypes {
+		field := fmt.Sprintf("task-plugins.default-for-task-types[%v]", taskType)
+		if !registered.Has(cleanString(pluginID)) {
+			errs.Addf(field, "unknown plugin [%v], registered plugins are %v", pluginID, registered.List())
+		} else if enabled.Len() > 0 && !enabled.Has(cleanString(pluginID)) {
+			errs.Addf(field, "plugin [%v] must be enabled to be the default for task type [%v]", pluginID, taskType)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
 type Config struct {
 	TaskPlugins            TaskPluginConfig `json:"task-plugins" pflag:",Task plugin configuration"`
 	MaxPluginPhaseVersions int32            `json:"max-plugin-phase-versions" pflag:",Maximum number of plugin phase versions allowed for one phase."`
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pluginMachinery "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flytestdlib/config"
+)
+
+func TestValidateConfig(t *testing.T) {
+	t.Run("No registered plugins", func(t *testing.T) {
+		assert.NoError(t, validateConfig(&Config{TaskPlugins: TaskPluginConfig{EnabledPlugins: []string{"unknown"}}}))
+	})
+
+	for _, id := range []string{"container", "spark"} {
+		pluginMachinery.PluginRegistry().RegisterCorePlugin(core.PluginEntry{
+			ID:                  id,
+			RegisteredTaskTypes: []core.TaskType{id},
+			LoadPlugin: func(ctx context.Context, iCtx core.SetupContext) (core.Plugin, error) {
+				return nil, nil
+			},
+		})
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		assert.NoError(t, validateConfig(&Config{TaskPlugins: TaskPluginConfig{
+			EnabledPlugins:      []string{"Container", "spark"},
+			DefaultForTaskTypes: map[string]string{"python-task": "container"},
+		}}))
+	})
+
+	t.Run("Unknown plugins", func(t *testing.T) {
+		err := validateConfig(&Config{TaskPlugins: TaskPluginConfig{
+			EnabledPlugins:      []string{"container", "sprak"},
+			DefaultForTaskTypes: map[string]string{"python-task": "containr", "spark": "spark"},
+		}})
+
+		var fieldErrs config.FieldErrors
+		if assert.ErrorAs(t, err, &fieldErrs) {
+			var fields []string
+			for _, fieldErr := range fieldErrs {
+				fields = append(fields, fieldErr.Field)
+			}
+
+			assert.ElementsMatch(t, []string{
+				"task-plugins.enabled-plugins[1]",
+				"task-plugins.default-for-task-types[python-task]",
+				"task-plugins.default-for-task-types[spark]",
+			}, fields)
+		}
+	})
+}
@@ -490,6 +490,7 @@ func Test_task_Handle_NoCatalog(t *testing.T) {
 		ns := &flyteMocks.ExecutableNodeStatus{}
 		ns.EXPECT().GetDataDir().Return("data-dir")
 		ns.EXPECT().GetOutputDir().Return("data-dir")
+		ns.EXPECT().GetExecutionError().Return(nil)
 
 		res := &v1.ResourceRequirements{}
 		n := &flyteMocks.ExecutableNode{}
@@ -810,6 +811,7 @@ func Test_task_Abort(t *testing.T) {
 		ns := &flyteMocks.ExecutableNodeStatus{}
 		ns.EXPECT().GetDataDir().Return(storage.DataReference("data-dir"))
 		ns.EXPECT().GetOutputDir().Return(storage.DataReference("output-dir"))
+		ns.EXPECT().GetExecutionError().Return(nil)
 
 		res := &v1.ResourceRequirements{}
 		n := &flyteMocks.ExecutableNode{}
@@ -974,6 +976,7 @@ func Test_task_Abort_v1(t *testing.T) {
 		ns := &flyteMocks.ExecutableNodeStatus{}
 		ns.EXPECT().GetDataDir().Return(storage.DataReference("data-dir"))
 		ns.EXPECT().GetOutputDir().Return(storage.DataReference("output-dir"))
+		ns.EXPECT().GetExecutionError().Return(nil)
 
 		res := &v1.ResourceRequirements{}
 		n := &flyteMocks.ExecutableNode{}
@@ -1156,6 +1159,7 @@ func Test_task_Finalize(t *testing.T) {
 		ns := &flyteMocks.ExecutableNodeStatus{}
 		ns.EXPECT().GetDataDir().Return(storage.DataReference("data-dir"))
 		ns.EXPECT().GetOutputDir().Return(storage.DataReference("output-dir"))
+		ns.EXPECT().GetExecutionError().Return(nil)
 
 		res := &v1.ResourceRequirements{}
 		n := &flyteMocks.ExecutableNode{}
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -117,6 +118,9 @@ type ResourceMonitorIndex struct {
 	// rather than one metric per type, but can revisit this down the road.
 	gauges      map[promutils.Scope]*labeled.Gauge
 	stopwatches map[promutils.Scope]*labeled.StopWatch
+
+	// All the plugins which create pods share one tracker, which is fed by a single handler on the pod informer.
+	quotaTracker *ProjectQuotaTracker
 }
 
 func (r *ResourceMonitorIndex) GetOrCreateResourceLevelMonitor(ctx context.Context, scope promutils.Scope, cache cache.Cache,
@@ -159,6 +163,32 @@ func (r *ResourceMonitorIndex) GetOrCreateResourceLevelMonitor(ctx context.Conte
 	return rm
 }
 
+// GetOrCreateProjectQuotaTracker returns the tracker of the usage of project quotas, registering it with the pod informer
+// of the cache the first time it is called.
+func (r *ResourceMonitorIndex) GetOrCreateProjectQuotaTracker(ctx context.Context, scope promutils.Scope,
+	cache cache.Cache) (*ProjectQuotaTracker, error) {
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.quotaTracker != nil {
+		return r.quotaTracker, nil
+	}
+
+	informer, err := cache.GetInformer(ctx, &v1.Pod{})
+	if err != nil {
+		return nil, err
+	}
+	tracker := newProjectQuotaTracker(scope)
+	if _, err := informer.AddEventHandler(tracker); err != nil {
+		return nil, err
+	}
+	logger.Infof(ctx, "Tracking the usage of project quotas from the pod informer")
+	r.quotaTracker = tracker
+
+	return tracker, nil
+}
+
 // This is a global variable to this file. At runtime, the NewResourceMonitorIndex() function should only be called once
 // but can be called multiple times in unit tests.
 var index *ResourceMonitorIndex
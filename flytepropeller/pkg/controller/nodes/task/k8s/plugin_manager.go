@@ -28,6 +28,7 @@ import (
 	"github.com/flyteorg/flyte/flyteidl/gen/pb-go/flyteidl/core"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/errors"
 	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s/config"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/io"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/ioutils"
@@ -64,6 +65,8 @@ type PluginState struct {
 	Phase           PluginPhase
 	K8sPluginState  k8s.PluginState
 	LastEventUpdate time.Time
+	ResourceUsage   ResourceUsageSummary
+	LastUsageSample time.Time
 }
 
 type PluginMetrics struct {
@@ -73,6 +76,11 @@ type PluginMetrics struct {
 	GetAPILatency   labeled.StopWatch
 	ResourceDeleted labeled.Counter
 	TaskPodErrors   *prometheus.CounterVec
+	TaskFailures    *prometheus.CounterVec
+	CPUUtilization  *prometheus.SummaryVec
+	MemUtilization  *prometheus.SummaryVec
+	// Counts how many times task pods were held back by the quota of their project.
+	ProjectQuotaExceeded labeled.Counter
 }
 
 func newPluginMetrics(s promutils.Scope) PluginMetrics {
@@ -88,6 +96,14 @@ func newPluginMetrics(s promutils.Scope) PluginMetrics {
 			" called with a deleted resource.", s),
 		TaskPodErrors: s.MustNewCounterVec("task_pod_errors", "Counts how many times task pods failed in given phase with given code",
 			"phase", "error_code"),
+		TaskFailures: s.MustNewCounterVec("task_failure_categories", "Counts how many times tasks failed with a given failure category",
+			"phase", "category"),
+		CPUUtilization: s.MustNewSummaryVec("task_pod_cpu_utilization",
+			"Ratio of peak CPU usage to CPU requests of completed task pods", "task_type"),
+		MemUtilization: s.MustNewSummaryVec("task_pod_memory_utilization",
+			"Ratio of peak memory usage to memory requests of completed task pods", "task_type"),
+		ProjectQuotaExceeded: labeled.NewCounter("project_quota_exceeded",
+			"Counts how many times task pods were held back by the quota of their project.", s),
 	}
 }
 
@@ -107,6 +123,8 @@ type PluginManager struct {
 	backOffController         *backoff.Controller
 	resourceLevelMonitor      *ResourceLevelMonitor
 	eventWatcher              EventWatcher
+	usageSource               PodUsageSource
+	quotaTracker              *ProjectQuotaTracker
 	updateBaseBackoffDuration int
 	updateBackoffRetries      int
 }
@@ -145,6 +163,20 @@ func (e *PluginManager) GetID() string {
 }
 
 func (e *PluginManager) getPodEffectiveResourceLimits(ctx context.Context, pod *v1.Pod) v1.ResourceList {
+	podRequestedResources := podEffectiveResourceLimits(pod)
+
+	formattedResources := make([]string, 0, len(podRequestedResources))
+	for resourceName, quantity := range podRequestedResources {
+		formattedResources = append(formattedResources, fmt.Sprintf("{[%v]: [%v]}", resourceName, quantity.String()))
+	}
+
+	logger.Infof(ctx, "The resource requirement for creating Pod [%v/%v] is [%+v]\n",
+		pod.Namespace, pod.Name, formattedResources)
+
+	return podRequestedResources
+}
+
+func podEffectiveResourceLimits(pod *v1.Pod) v1.ResourceList {
 	podRequestedResources := make(v1.ResourceList)
 	initContainersRequestedResources := make(v1.ResourceList)
 	containersRequestedResources := make(v1.ResourceList)
@@ -185,14 +217,6 @@ func (e *PluginManager) getPodEffectiveResourceLimits(ctx context.Context, pod *
 		}
 	}
 
-	formattedResources := make([]string, 0, len(podRequestedResources))
-	for resourceName, quantity := range podRequestedResources {
-		formattedResources = append(formattedResources, fmt.Sprintf("{[%v]: [%v]}", resourceName, quantity.String()))
-	}
-
-	logger.Infof(ctx, "The resource requirement for creating Pod [%v/%v] is [%+v]\n",
-		pod.Namespace, pod.Name, formattedResources)
-
 	return podRequestedResources
 }
 
@@ -226,6 +250,20 @@ func (e *PluginManager) launchResource(ctx context.Context, tCtx pluginsCore.Tas
 	key := backoff.ComposeResourceKey(o)
 
 	pod, casted := o.(*v1.Pod)
+	if casted && e.quotaTracker != nil {
+		exceeded, permanent := e.quotaTracker.Check(ctx, pod)
+		if permanent {
+			logger.Infof(ctx, "Failing to create Pod [%v/%v] as %s", pod.Namespace, pod.Name, exceeded)
+			return pluginsCore.DoTransition(pluginsCore.PhaseInfoFailure(ProjectQuotaExceedsLimitCode,
+				fmt.Sprintf("Exceeds project quota: %s", exceeded), nil)), nil
+		}
+		if len(exceeded) > 0 {
+			logger.Infof(ctx, "Waiting to create Pod [%v/%v] as the %s", pod.Namespace, pod.Name, exceeded)
+			e.metrics.ProjectQuotaExceeded.Inc(ctx)
+			return pluginsCore.DoTransition(pluginsCore.PhaseInfoWaitingForResourcesInfo(time.Now(),
+				pluginsCore.DefaultPhaseVersion, fmt.Sprintf("Exceeded project quota: %s", exceeded), nil)), nil
+		}
+	}
 	if e.backOffController != nil && casted {
 		podRequestedResources := e.getPodEffectiveResourceLimits(ctx, pod)
 
@@ -266,6 +304,11 @@ func (e *PluginManager) launchResource(ctx context.Context, tCtx pluginsCore.Tas
 		return pluginsCore.UnknownTransition, errors.Wrapf(stdErrors.ErrorCode(reason), err, "failed to create resource")
 	}
 
+	if casted && e.quotaTracker != nil && err == nil {
+		// Count the pod before the informer observes it, so that tasks launched right after it see its usage.
+		e.quotaTracker.Track(pod)
+	}
+
 	return pluginsCore.DoTransition(pluginsCore.PhaseInfoQueued(time.Now(), pluginsCore.DefaultPhaseVersion, "task submitted to K8s")), nil
 }
 
@@ -345,6 +388,53 @@ func (e *PluginManager) checkResourcePhase(ctx context.Context, tCtx pluginsCore
 	return pluginsCore.DoTransition(p), nil
 }
 
+// recordResourceUsage samples the usage of running task pods at most once per configured interval and attaches the
+// summary collected so far to the task info of the current phase. When the task transitions into a terminal phase the
+// observed utilization is emitted as a metric per task type. A terminal phase can be observed in several rounds, so the
+// metric is only emitted when the previously persisted phase was not terminal yet.
+func (e *PluginManager) recordResourceUsage(ctx context.Context, tCtx pluginsCore.TaskExecutionContext, o client.Object,
+	previousPhase pluginsCore.Phase, phaseInfo pluginsCore.PhaseInfo, summary ResourceUsageSummary,
+	lastSample time.Time) (ResourceUsageSummary, time.Time) {
+
+	pod, isPod := o.(*v1.Pod)
+	if !isPod {
+		return summary, lastSample
+	}
+
+	now := time.Now()
+	if phaseInfo.Phase() == pluginsCore.PhaseRunning &&
+		now.Sub(lastSample) >= config.GetK8sPluginConfig().ResourceUsage.SampleInterval.Duration {
+		usage, err := e.usageSource.GetPodUsage(ctx, pod.GetNamespace(), pod.GetName())
+		if err != nil {
+			logger.Debugf(ctx, "Failed to sample resource usage of pod [%s/%s]. Error: %v", pod.GetNamespace(), pod.GetName(), err)
+		} else {
+			summary = summary.addSample(pod, usage)
+			lastSample = now
+		}
+	}
+
+	if summary.Samples == 0 {
+		return summary, lastSample
+	}
+
+	if taskInfo := phaseInfo.Info(); taskInfo != nil {
+		taskInfo.CustomInfo = summary.attachTo(taskInfo.CustomInfo)
+	}
+
+	if phaseInfo.Phase().IsTerminal() && !previousPhase.IsTerminal() {
+		taskTemplate, err := tCtx.TaskReader().Read(ctx)
+		if err != nil {
+			logger.Warnf(ctx, "Failed to read task template to emit resource usage metrics. Error: %v", err)
+			return summary, lastSample
+		}
+
+		e.metrics.CPUUtilization.WithLabelValues(taskTemplate.GetType()).Observe(summary.CPUUtilization())
+		e.metrics.MemUtilization.WithLabelValues(taskTemplate.GetType()).Observe(summary.MemoryUtilization())
+	}
+
+	return summary, lastSample
+}
+
 func (e PluginManager) Handle(ctx context.Context, tCtx pluginsCore.TaskExecutionContext) (pluginsCore.Transition, error) {
 	// read phase state
 	pluginState := PluginState{}
@@ -404,6 +494,29 @@ func (e PluginManager) Handle(ctx context.Context, tCtx pluginsCore.TaskExecutio
 		}
 	}
 
+	// Refine the failure category of failed pods with the k8s events recorded for them
+	if pod, isPod := o.(*v1.Pod); isPod && e.eventWatcher != nil && phaseInfo.Phase().IsFailure() {
+		signals := flytek8s.GetFailureSignals(pod, phaseInfo)
+		for _, event := range e.eventWatcher.List(k8stypes.NamespacedName{Namespace: pod.GetNamespace(), Name: pod.GetName()}, time.Time{}) {
+			signals.Reasons = append(signals.Reasons, event.Reason)
+		}
+
+		flytek8s.ClassifyFailure(ctx, phaseInfo, signals)
+	}
+
+	if phaseInfo.Phase().IsFailure() && phaseInfo.Info() != nil {
+		if category := pluginsCore.GetFailureCategory(phaseInfo.Info().CustomInfo); len(category) > 0 {
+			e.metrics.TaskFailures.WithLabelValues(phaseInfo.Phase().String(), string(category)).Inc()
+		}
+	}
+
+	// Sample resource usage of running pods
+	usageSummary, lastUsageSample := pluginState.ResourceUsage, pluginState.LastUsageSample
+	if e.usageSource != nil && o != nil {
+		usageSummary, lastUsageSample = e.recordResourceUsage(ctx, tCtx, o, pluginState.K8sPluginState.Phase,
+			phaseInfo, usageSummary, lastUsageSample)
+	}
+
 	// persist any changes in phase state
 	newPluginState := PluginState{
 		Phase: pluginPhase,
@@ -413,6 +526,8 @@ func (e PluginManager) Handle(ctx context.Context, tCtx pluginsCore.TaskExecutio
 			Reason:       phaseInfo.Reason(),
 		},
 		LastEventUpdate: lastEventUpdate,
+		ResourceUsage:   usageSummary,
+		LastUsageSample: lastUsageSample,
 	}
 	if pluginState != newPluginState {
 		if err := tCtx.PluginStateWriter().Put(pluginStateVersion, &newPluginState); err != nil {
@@ -704,12 +819,25 @@ func NewPluginManager(ctx context.Context, iCtx pluginsCore.SetupContext, entry
 		}
 	}
 
+	var usageSource PodUsageSource
+	if k8sConfig.ResourceUsage.Enabled {
+		usageSource = NewMetricsAPIUsageSource(kubeClientset)
+	}
+
 	// Construct the collector that will emit a gauge indicating current levels of the resource that this K8s plugin operates on
 	rm := monitorIndex.GetOrCreateResourceLevelMonitor(ctx, metricsScope, kubeClient.GetCache(), gvk)
 
 	// Start the poller and gauge emitter
 	rm.RunCollectorOnce(ctx)
 
+	var quotaTracker *ProjectQuotaTracker
+	if _, isPod := entry.ResourceToWatch.(*v1.Pod); isPod {
+		quotaTracker, err = monitorIndex.GetOrCreateProjectQuotaTracker(ctx, metricsScope, kubeClient.GetCache())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &PluginManager{
 		id:                        entry.ID,
 		plugin:                    entry.Plugin,
@@ -718,6 +846,8 @@ func NewPluginManager(ctx context.Context, iCtx pluginsCore.SetupContext, entry
 		kubeClient:                kubeClient,
 		resourceLevelMonitor:      rm,
 		eventWatcher:              eventWatcher,
+		usageSource:               usageSource,
+		quotaTracker:              quotaTracker,
 		updateBaseBackoffDuration: k8sConfig.UpdateBaseBackoffDuration,
 		updateBackoffRetries:      k8sConfig.UpdateBackoffRetries,
 	}, nil
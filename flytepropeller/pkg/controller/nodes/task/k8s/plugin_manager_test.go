@@ -28,6 +28,7 @@ import (
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/io"
 	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/k8s"
 	pluginsk8sMock "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/k8s/mocks"
+	compiler "github.com/flyteorg/flyte/flytepropeller/pkg/compiler/transformers/k8s"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/executors/mocks"
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/nodes/task/backoff"
 	"github.com/flyteorg/flyte/flytestdlib/contextutils"
@@ -375,6 +376,70 @@ func TestK8sTaskExecutor_Handle_LaunchResource(t *testing.T) {
 		assert.True(t, k8serrors.IsNotFound(err))
 	})
 
+	t.Run("projectQuotaExceeded", func(t *testing.T) {
+		quotaPod := func(name, project, cpu string, phase v1.PodPhase) *v1.Pod {
+			return &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "ns",
+					Labels:    map[string]string{compiler.ProjectLabel: project, compiler.DomainLabel: "development"},
+				},
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{Resources: v1.ResourceRequirements{
+							Limits: v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu)},
+						}},
+					},
+				},
+				Status: v1.PodStatus{Phase: phase},
+			}
+		}
+		for _, test := range []struct {
+			name        string
+			annotations map[string]string
+			wantPhase   pluginsCore.Phase
+		}{
+			{"below the limits", map[string]string{compiler.MaxRunningTasksAnnotation: "2", compiler.MaxCPUAnnotation: "5"},
+				pluginsCore.PhaseQueued},
+			{"running tasks", map[string]string{compiler.MaxRunningTasksAnnotation: "1"}, pluginsCore.PhaseWaitingForResources},
+			{"cpu", map[string]string{compiler.MaxCPUAnnotation: "4"}, pluginsCore.PhaseWaitingForResources},
+			{"whole quota", map[string]string{compiler.MaxCPUAnnotation: "1"}, pluginsCore.PhasePermanentFailure},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				wantPluginPhase := PluginPhaseNotStarted
+				if test.wantPhase == pluginsCore.PhaseQueued {
+					wantPluginPhase = PluginPhaseStarted
+				}
+				tctx := getMockTaskContext(PluginPhaseNotStarted, wantPluginPhase)
+				// The tracker is shared by all the plugin managers, so each test uses its own project.
+				pod := quotaPod("", test.name, "2", "")
+				pod.Annotations = test.annotations
+				mockResourceHandler := &pluginsk8sMock.Plugin{}
+				mockResourceHandler.EXPECT().GetProperties().Return(k8s.PluginProperties{})
+				mockResourceHandler.EXPECT().BuildResource(mock.Anything, mock.Anything).Return(pod, nil)
+				pluginManager, err := NewPluginManager(ctx, dummySetupContext(fake.NewClientBuilder().Build()), k8s.PluginEntry{
+					ID:              "x",
+					ResourceToWatch: &v1.Pod{},
+					Plugin:          mockResourceHandler,
+				}, NewResourceMonitorIndex(), k8sfake.NewSimpleClientset())
+				assert.NoError(t, err)
+				// Terminated pods do not count towards the quota.
+				pluginManager.quotaTracker.OnAdd(quotaPod("running", test.name, "3", v1.PodRunning), false)
+				pluginManager.quotaTracker.OnAdd(quotaPod("succeeded", test.name, "8", v1.PodSucceeded), false)
+
+				transition, err := pluginManager.Handle(ctx, tctx)
+				assert.NoError(t, err)
+				assert.Equal(t, test.wantPhase, transition.Info().Phase())
+				switch test.wantPhase {
+				case pluginsCore.PhaseWaitingForResources:
+					assert.Contains(t, transition.Info().Reason(), "Exceeded project quota")
+				case pluginsCore.PhasePermanentFailure:
+					assert.Equal(t, ProjectQuotaExceedsLimitCode, transition.Info().Err().GetCode())
+				}
+			})
+		}
+	})
+
 	t.Run("jobForbidden", func(t *testing.T) {
 		// common setup code
 		tctx := getMockTaskContext(PluginPhaseNotStarted, PluginPhaseNotStarted)
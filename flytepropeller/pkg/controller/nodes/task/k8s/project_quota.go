@@ -0,0 +1,235 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/flytek8s/config"
+	compiler "github.com/flyteorg/flyte/flytepropeller/pkg/compiler/transformers/k8s"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+// ProjectQuotaExceedsLimitCode is the error code of tasks whose pod alone requests more than the quota of its project.
+const ProjectQuotaExceedsLimitCode = "ProjectQuotaExceedsLimit"
+
+type projectDomainKey struct {
+	namespace string
+	project   string
+	domain    string
+}
+
+type projectQuotaUsage struct {
+	runningTasks int
+	cpu          resource.Quantity
+	gpu          resource.Quantity
+}
+
+type trackedPod struct {
+	key projectDomainKey
+	cpu resource.Quantity
+	gpu resource.Quantity
+}
+
+// ProjectQuotaTracker keeps a running count of the task pods of each project and domain which have not terminated, along
+// with their effective cpu and gpu limits, so that launching a pod does not need to list the pods of its project. It is
+// fed by the pod informer and emits the usage of each project and domain as gauges.
+type ProjectQuotaTracker struct {
+	lock  sync.Mutex
+	pods  map[k8stypes.NamespacedName]trackedPod
+	usage map[projectDomainKey]*projectQuotaUsage
+
+	runningTasks *prometheus.GaugeVec
+	cpu          *prometheus.GaugeVec
+	gpu          *prometheus.GaugeVec
+}
+
+func (t *ProjectQuotaTracker) OnAdd(obj interface{}, _ bool) {
+	if pod, ok := obj.(*v1.Pod); ok {
+		t.Track(pod)
+	}
+}
+
+func (t *ProjectQuotaTracker) OnUpdate(_, newObj interface{}) {
+	if pod, ok := newObj.(*v1.Pod); ok {
+		t.Track(pod)
+	}
+}
+
+func (t *ProjectQuotaTracker) OnDelete(obj interface{}) {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if pod, ok := obj.(*v1.Pod); ok {
+		t.lock.Lock()
+		defer t.lock.Unlock()
+		t.untrack(k8stypes.NamespacedName{Namespace: pod.GetNamespace(), Name: pod.GetName()})
+	}
+}
+
+// Track counts a pod towards the usage of its project and domain until it terminates or is deleted. Launching a pod
+// tracks it right away, so that pods created moments ago are counted before the informer observes them.
+func (t *ProjectQuotaTracker) Track(pod *v1.Pod) {
+	name := k8stypes.NamespacedName{Namespace: pod.GetNamespace(), Name: pod.GetName()}
+	project, domain := pod.GetLabels()[compiler.ProjectLabel], pod.GetLabels()[compiler.DomainLabel]
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.untrack(name)
+	if len(project) == 0 || pod.GetDeletionTimestamp() != nil || pod.Status.Phase == v1.PodSucceeded ||
+		pod.Status.Phase == v1.PodFailed {
+		return
+	}
+
+	limits := podEffectiveResourceLimits(pod)
+	tracked := trackedPod{
+		key: projectDomainKey{namespace: pod.GetNamespace(), project: project, domain: domain},
+		cpu: limits[v1.ResourceCPU],
+		gpu: limits[config.GetK8sPluginConfig().GpuResourceName],
+	}
+	usage, found := t.usage[tracked.key]
+	if !found {
+		usage = &projectQuotaUsage{}
+		t.usage[tracked.key] = usage
+	}
+	usage.runningTasks++
+	usage.cpu.Add(tracked.cpu)
+	usage.gpu.Add(tracked.gpu)
+	t.pods[name] = tracked
+	t.emit(tracked.key)
+}
+
+// untrack must be called with the lock held.
+func (t *ProjectQuotaTracker) untrack(name k8stypes.NamespacedName) {
+	tracked, found := t.pods[name]
+	if !found {
+		return
+	}
+	delete(t.pods, name)
+	usage := t.usage[tracked.key]
+	usage.runningTasks--
+	usage.cpu.Sub(tracked.cpu)
+	usage.gpu.Sub(tracked.gpu)
+	if usage.runningTasks <= 0 {
+		delete(t.usage, tracked.key)
+	}
+	t.emit(tracked.key)
+}
+
+// emit must be called with the lock held.
+func (t *ProjectQuotaTracker) emit(key projectDomainKey) {
+	labels := []string{key.namespace, key.project, key.domain}
+	usage, found := t.usage[key]
+	if !found {
+		t.runningTasks.DeleteLabelValues(labels...)
+		t.cpu.DeleteLabelValues(labels...)
+		t.gpu.DeleteLabelValues(labels...)
+		return
+	}
+	t.runningTasks.WithLabelValues(labels...).Set(float64(usage.runningTasks))
+	t.cpu.WithLabelValues(labels...).Set(usage.cpu.AsApproximateFloat64())
+	t.gpu.WithLabelValues(labels...).Set(usage.gpu.AsApproximateFloat64())
+}
+
+// Check returns why creating a pod would exceed the task limits flyteadmin set on the workflow from the quota of its
+// project and domain, or an empty string if the pod can be created. The reason is permanent when the pod alone requests
+// more than the quota, as it would never be created.
+func (t *ProjectQuotaTracker) Check(ctx context.Context, pod *v1.Pod) (reason string, permanent bool) {
+	annotations := pod.GetAnnotations()
+	maxRunningTasks, hasMaxRunningTasks := annotations[compiler.MaxRunningTasksAnnotation]
+	maxCPU, hasMaxCPU := annotations[compiler.MaxCPUAnnotation]
+	maxGPU, hasMaxGPU := annotations[compiler.MaxGPUAnnotation]
+	project, domain := pod.GetLabels()[compiler.ProjectLabel], pod.GetLabels()[compiler.DomainLabel]
+	if (!hasMaxRunningTasks && !hasMaxCPU && !hasMaxGPU) || len(project) == 0 {
+		return "", false
+	}
+
+	requested := podEffectiveResourceLimits(pod)
+	podCPU, podGPU := requested[v1.ResourceCPU], requested[config.GetK8sPluginConfig().GpuResourceName]
+
+	var usage projectQuotaUsage
+	key := projectDomainKey{namespace: pod.GetNamespace(), project: project, domain: domain}
+	t.lock.Lock()
+	if current, found := t.usage[key]; found {
+		usage = projectQuotaUsage{runningTasks: current.runningTasks, cpu: current.cpu.DeepCopy(), gpu: current.gpu.DeepCopy()}
+	}
+	if tracked, found := t.pods[k8stypes.NamespacedName{Namespace: pod.GetNamespace(), Name: pod.GetName()}]; found && tracked.key == key {
+		usage.runningTasks--
+		usage.cpu.Sub(tracked.cpu)
+		usage.gpu.Sub(tracked.gpu)
+	}
+	t.lock.Unlock()
+
+	type resourceLimit struct {
+		name      string
+		limit     resource.Quantity
+		requested resource.Quantity
+		used      resource.Quantity
+	}
+	resourceLimits := make([]resourceLimit, 0, 2)
+	for _, quota := range []struct {
+		name      string
+		limit     string
+		hasLimit  bool
+		requested resource.Quantity
+		used      resource.Quantity
+	}{
+		{"cpu", maxCPU, hasMaxCPU, podCPU, usage.cpu},
+		{"gpu", maxGPU, hasMaxGPU, podGPU, usage.gpu},
+	} {
+		if !quota.hasLimit {
+			continue
+		}
+		limit, err := resource.ParseQuantity(quota.limit)
+		if err != nil {
+			logger.Warnf(ctx, "Ignoring invalid %s quota [%s]", quota.name, quota.limit)
+			continue
+		}
+		// A pod which alone requests more than the quota would never be created, however many tasks terminate.
+		if quota.requested.Cmp(limit) > 0 {
+			return fmt.Sprintf("the task requests [%s] %s which is more than the [%s] %s quota of project [%s] domain [%s]",
+				quota.requested.String(), quota.name, limit.String(), quota.name, project, domain), true
+		}
+		resourceLimits = append(resourceLimits, resourceLimit{quota.name, limit, quota.requested, quota.used})
+	}
+
+	if hasMaxRunningTasks {
+		if limit, err := strconv.Atoi(maxRunningTasks); err != nil {
+			logger.Warnf(ctx, "Ignoring invalid [%s] annotation [%s]", compiler.MaxRunningTasksAnnotation, maxRunningTasks)
+		} else if usage.runningTasks+1 > limit {
+			return fmt.Sprintf("project [%s] domain [%s] has [%d] running tasks out of [%d]", project, domain,
+				usage.runningTasks, limit), false
+		}
+	}
+	for _, check := range resourceLimits {
+		total := check.used.DeepCopy()
+		total.Add(check.requested)
+		if total.Cmp(check.limit) > 0 {
+			return fmt.Sprintf("running tasks of project [%s] domain [%s] would use [%s] %s out of [%s]", project,
+				domain, total.String(), check.name, check.limit.String()), false
+		}
+	}
+	return "", false
+}
+
+func newProjectQuotaTracker(scope promutils.Scope) *ProjectQuotaTracker {
+	labels := []string{"namespace", "project", "domain"}
+	return &ProjectQuotaTracker{
+		pods:  make(map[k8stypes.NamespacedName]trackedPod),
+		usage: make(map[projectDomainKey]*projectQuotaUsage),
+		runningTasks: scope.MustNewGaugeVec("project_quota_running_tasks",
+			"Current number of task pods of a project and domain which have not terminated", labels...),
+		cpu: scope.MustNewGaugeVec("project_quota_cpu",
+			"Current cpu limits of the task pods of a project and domain which have not terminated", labels...),
+		gpu: scope.MustNewGaugeVec("project_quota_gpu",
+			"Current gpu limits of the task pods of a project and domain which have not terminated", labels...),
+	}
+}
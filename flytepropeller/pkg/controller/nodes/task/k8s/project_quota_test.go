@@ -0,0 +1,90 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+
+	compiler "github.com/flyteorg/flyte/flytepropeller/pkg/compiler/transformers/k8s"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+func trackedTaskPod(name, cpu string, phase v1.PodPhase) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "flytesnacks-development",
+			Labels:    map[string]string{compiler.ProjectLabel: "flytesnacks", compiler.DomainLabel: "development"},
+			Annotations: map[string]string{
+				compiler.MaxRunningTasksAnnotation: "2",
+				compiler.MaxCPUAnnotation:          "4",
+			},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Resources: v1.ResourceRequirements{
+					Limits: v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu)},
+				}},
+			},
+		},
+		Status: v1.PodStatus{Phase: phase},
+	}
+}
+
+func TestProjectQuotaTracker(t *testing.T) {
+	ctx := context.Background()
+	labels := []string{"flytesnacks-development", "flytesnacks", "development"}
+
+	t.Run("informer events", func(t *testing.T) {
+		tracker := newProjectQuotaTracker(promutils.NewTestScope())
+		tracker.OnAdd(trackedTaskPod("a", "1", v1.PodRunning), true)
+		tracker.OnAdd(trackedTaskPod("b", "2", v1.PodPending), false)
+		// Pods which are not task pods are not tracked.
+		tracker.OnAdd(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "flytesnacks-development"}}, false)
+		assert.Equal(t, float64(2), testutil.ToFloat64(tracker.runningTasks.WithLabelValues(labels...)))
+		assert.Equal(t, float64(3), testutil.ToFloat64(tracker.cpu.WithLabelValues(labels...)))
+
+		// Updates replace the usage of a pod rather than adding to it.
+		tracker.OnUpdate(nil, trackedTaskPod("b", "2", v1.PodRunning))
+		assert.Equal(t, float64(2), testutil.ToFloat64(tracker.runningTasks.WithLabelValues(labels...)))
+
+		tracker.OnUpdate(nil, trackedTaskPod("a", "1", v1.PodSucceeded))
+		assert.Equal(t, float64(1), testutil.ToFloat64(tracker.runningTasks.WithLabelValues(labels...)))
+		assert.Equal(t, float64(2), testutil.ToFloat64(tracker.cpu.WithLabelValues(labels...)))
+
+		tracker.OnDelete(toolscache.DeletedFinalStateUnknown{Key: "flytesnacks-development/b", Obj: trackedTaskPod("b", "2", v1.PodRunning)})
+		assert.Empty(t, tracker.usage)
+		assert.Equal(t, 0, testutil.CollectAndCount(tracker.runningTasks))
+	})
+
+	t.Run("check", func(t *testing.T) {
+		tracker := newProjectQuotaTracker(promutils.NewTestScope())
+		reason, permanent := tracker.Check(ctx, trackedTaskPod("a", "3", ""))
+		assert.Empty(t, reason)
+		assert.False(t, permanent)
+
+		// Launched pods count before the informer observes them, but not towards their own launch.
+		tracker.Track(trackedTaskPod("a", "3", ""))
+		reason, _ = tracker.Check(ctx, trackedTaskPod("a", "3", ""))
+		assert.Empty(t, reason)
+
+		reason, permanent = tracker.Check(ctx, trackedTaskPod("b", "2", ""))
+		assert.Contains(t, reason, "would use [5] cpu out of [4]")
+		assert.False(t, permanent)
+
+		tracker.Track(trackedTaskPod("b", "1", ""))
+		reason, permanent = tracker.Check(ctx, trackedTaskPod("c", "0", ""))
+		assert.Contains(t, reason, "has [2] running tasks out of [2]")
+		assert.False(t, permanent)
+
+		reason, permanent = tracker.Check(ctx, trackedTaskPod("d", "5", ""))
+		assert.Contains(t, reason, "more than the [4] cpu quota")
+		assert.True(t, permanent)
+	})
+}
@@ -0,0 +1,180 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	metricsAPIPath = "/apis/metrics.k8s.io/v1beta1"
+
+	// ResourceUsageCustomInfoKey is the key under which the usage summary is attached to the custom info of task
+	// execution events.
+	ResourceUsageCustomInfoKey = "resourceUsage"
+)
+
+// PodUsageSource retrieves the current resource usage of each container of a pod, keyed by container name.
+type PodUsageSource interface {
+	GetPodUsage(ctx context.Context, namespace, name string) (map[string]v1.ResourceList, error)
+}
+
+// podMetrics mirrors the subset of the metrics.k8s.io/v1beta1 PodMetrics object that is needed to compute usage.
+type podMetrics struct {
+	Containers []struct {
+		Name  string          `json:"name"`
+		Usage v1.ResourceList `json:"usage"`
+	} `json:"containers"`
+}
+
+// metricsAPIUsageSource reads pod usage from the k8s resource metrics API (served by metrics-server).
+type metricsAPIUsageSource struct {
+	restClient rest.Interface
+}
+
+func (m metricsAPIUsageSource) GetPodUsage(ctx context.Context, namespace, name string) (map[string]v1.ResourceList, error) {
+	raw, err := m.restClient.Get().AbsPath(metricsAPIPath, "namespaces", namespace, "pods", name).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := podMetrics{}
+	if err := json.Unmarshal(raw, &metrics); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal pod metrics for [%s/%s]: %w", namespace, name, err)
+	}
+
+	usage := make(map[string]v1.ResourceList, len(metrics.Containers))
+	for _, c := range metrics.Containers {
+		usage[c.Name] = c.Usage
+	}
+
+	return usage, nil
+}
+
+// NewMetricsAPIUsageSource creates a PodUsageSource backed by the k8s resource metrics API.
+func NewMetricsAPIUsageSource(kubeClientset kubernetes.Interface) PodUsageSource {
+	return metricsAPIUsageSource{restClient: kubeClientset.CoreV1().RESTClient()}
+}
+
+// InMemoryPodUsageSource is a PodUsageSource that serves usage that was explicitly set on it. It is meant for tests and
+// local environments without a metrics API.
+type InMemoryPodUsageSource struct {
+	lock  sync.RWMutex
+	usage map[string]map[string]v1.ResourceList
+}
+
+func (s *InMemoryPodUsageSource) SetPodUsage(namespace, name string, usage map[string]v1.ResourceList) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.usage[namespace+"/"+name] = usage
+}
+
+func (s *InMemoryPodUsageSource) GetPodUsage(_ context.Context, namespace, name string) (map[string]v1.ResourceList, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	usage, found := s.usage[namespace+"/"+name]
+	if !found {
+		return nil, fmt.Errorf("no usage found for pod [%s/%s]", namespace, name)
+	}
+
+	return usage, nil
+}
+
+func NewInMemoryPodUsageSource() *InMemoryPodUsageSource {
+	return &InMemoryPodUsageSource{usage: map[string]map[string]v1.ResourceList{}}
+}
+
+// ResourceUsageSummary aggregates the usage samples taken for a task pod against the pod's requests. It is persisted as
+// part of the PluginState and therefore only holds comparable fields.
+type ResourceUsageSummary struct {
+	Samples            int
+	CPURequestMilli    int64
+	CPUPeakMilli       int64
+	MemoryRequestBytes int64
+	MemoryPeakBytes    int64
+}
+
+// addSample folds the usage of all containers of a pod into the summary.
+func (s ResourceUsageSummary) addSample(pod *v1.Pod, usage map[string]v1.ResourceList) ResourceUsageSummary {
+	cpuRequest, memoryRequest := resource.Quantity{}, resource.Quantity{}
+	for _, c := range pod.Spec.Containers {
+		cpuRequest.Add(*c.Resources.Requests.Cpu())
+		memoryRequest.Add(*c.Resources.Requests.Memory())
+	}
+
+	cpuUsage, memoryUsage := resource.Quantity{}, resource.Quantity{}
+	for _, containerUsage := range usage {
+		cpuUsage.Add(*containerUsage.Cpu())
+		memoryUsage.Add(*containerUsage.Memory())
+	}
+
+	s.Samples++
+	s.CPURequestMilli = cpuRequest.MilliValue()
+	s.MemoryRequestBytes = memoryRequest.Value()
+	if cpuUsage.MilliValue() > s.CPUPeakMilli {
+		s.CPUPeakMilli = cpuUsage.MilliValue()
+	}
+
+	if memoryUsage.Value() > s.MemoryPeakBytes {
+		s.MemoryPeakBytes = memoryUsage.Value()
+	}
+
+	return s
+}
+
+func utilization(peak, request int64) float64 {
+	if request == 0 {
+		return 0
+	}
+
+	return float64(peak) / float64(request)
+}
+
+func (s ResourceUsageSummary) CPUUtilization() float64 {
+	return utilization(s.CPUPeakMilli, s.CPURequestMilli)
+}
+
+func (s ResourceUsageSummary) MemoryUtilization() float64 {
+	return utilization(s.MemoryPeakBytes, s.MemoryRequestBytes)
+}
+
+// toStruct renders the summary in the form that is attached to task execution events.
+func (s ResourceUsageSummary) toStruct() *structpb.Struct {
+	number := func(v float64) *structpb.Value {
+		return &structpb.Value{Kind: &structpb.Value_NumberValue{NumberValue: v}}
+	}
+
+	return &structpb.Struct{Fields: map[string]*structpb.Value{
+		"samples":            number(float64(s.Samples)),
+		"cpuRequestMilli":    number(float64(s.CPURequestMilli)),
+		"cpuPeakMilli":       number(float64(s.CPUPeakMilli)),
+		"cpuUtilization":     number(s.CPUUtilization()),
+		"memoryRequestBytes": number(float64(s.MemoryRequestBytes)),
+		"memoryPeakBytes":    number(float64(s.MemoryPeakBytes)),
+		"memoryUtilization":  number(s.MemoryUtilization()),
+	}}
+}
+
+// attachTo adds the summary to the custom info of a task info, preserving any custom info set by the plugin.
+func (s ResourceUsageSummary) attachTo(customInfo *structpb.Struct) *structpb.Struct {
+	if customInfo == nil {
+		customInfo = &structpb.Struct{}
+	}
+
+	if customInfo.Fields == nil {
+		customInfo.Fields = map[string]*structpb.Value{}
+	}
+
+	customInfo.Fields[ResourceUsageCustomInfoKey] = &structpb.Value{
+		Kind: &structpb.Value_StructValue{StructValue: s.toStruct()},
+	}
+
+	return customInfo
+}
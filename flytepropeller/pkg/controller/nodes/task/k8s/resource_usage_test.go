@@ -0,0 +1,160 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pluginsCore "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/core"
+	"github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/k8s"
+	pluginsk8sMock "github.com/flyteorg/flyte/flyteplugins/go/tasks/pluginmachinery/k8s/mocks"
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+func newPodWithRequests(name, namespace string, requests ...v1.ResourceList) *v1.Pod {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	for _, r := range requests {
+		pod.Spec.Containers = append(pod.Spec.Containers, v1.Container{Resources: v1.ResourceRequirements{Requests: r}})
+	}
+
+	return pod
+}
+
+func TestResourceUsageSummary_AddSample(t *testing.T) {
+	pod := newPodWithRequests("p", "ns",
+		v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m"), v1.ResourceMemory: resource.MustParse("1Gi")},
+		v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m"), v1.ResourceMemory: resource.MustParse("1Gi")})
+
+	summary := ResourceUsageSummary{}
+	summary = summary.addSample(pod, map[string]v1.ResourceList{
+		"a": {v1.ResourceCPU: resource.MustParse("200m"), v1.ResourceMemory: resource.MustParse("512Mi")},
+		"b": {v1.ResourceCPU: resource.MustParse("300m"), v1.ResourceMemory: resource.MustParse("512Mi")},
+	})
+	summary = summary.addSample(pod, map[string]v1.ResourceList{
+		"a": {v1.ResourceCPU: resource.MustParse("100m"), v1.ResourceMemory: resource.MustParse("1Gi")},
+	})
+
+	assert.Equal(t, 2, summary.Samples)
+	assert.Equal(t, int64(1000), summary.CPURequestMilli)
+	assert.Equal(t, int64(500), summary.CPUPeakMilli)
+	assert.Equal(t, 0.5, summary.CPUUtilization())
+	assert.Equal(t, int64(2*1024*1024*1024), summary.MemoryRequestBytes)
+	assert.Equal(t, int64(1024*1024*1024), summary.MemoryPeakBytes)
+	assert.Equal(t, 0.5, summary.MemoryUtilization())
+
+	t.Run("no requests", func(t *testing.T) {
+		summary := ResourceUsageSummary{}.addSample(newPodWithRequests("p", "ns", v1.ResourceList{}),
+			map[string]v1.ResourceList{"a": {v1.ResourceCPU: resource.MustParse("1")}})
+		assert.Equal(t, float64(0), summary.CPUUtilization())
+	})
+}
+
+func TestResourceUsageSummary_AttachTo(t *testing.T) {
+	summary := ResourceUsageSummary{Samples: 1, CPURequestMilli: 1000, CPUPeakMilli: 250}
+
+	customInfo := summary.attachTo(nil)
+	usage := customInfo.GetFields()[ResourceUsageCustomInfoKey].GetStructValue()
+	if assert.NotNil(t, usage) {
+		assert.Equal(t, 0.25, usage.GetFields()["cpuUtilization"].GetNumberValue())
+		assert.Equal(t, float64(250), usage.GetFields()["cpuPeakMilli"].GetNumberValue())
+	}
+
+	existing := summary.attachTo(nil)
+	existing.Fields["pluginKey"] = existing.Fields[ResourceUsageCustomInfoKey]
+	customInfo = summary.attachTo(existing)
+	assert.Len(t, customInfo.GetFields(), 2)
+}
+
+func TestPluginManager_Handle_ResourceUsage(t *testing.T) {
+	ctx := context.TODO()
+	tm := getMockTaskExecutionMetadata()
+	res := newPodWithRequests(tm.GetTaskExecutionID().GetGeneratedName(), tm.GetNamespace(),
+		v1.ResourceList{v1.ResourceCPU: resource.MustParse("1"), v1.ResourceMemory: resource.MustParse("1Gi")})
+
+	usageSource := NewInMemoryPodUsageSource()
+	usageSource.SetPodUsage(res.Namespace, res.Name, map[string]v1.ResourceList{
+		"primary": {v1.ResourceCPU: resource.MustParse("750m"), v1.ResourceMemory: resource.MustParse("256Mi")},
+	})
+
+	tests := []struct {
+		name        string
+		phaseInfo   pluginsCore.PhaseInfo
+		usageSource PodUsageSource
+		wantUsage   bool
+	}{
+		{"running", pluginsCore.PhaseInfoRunning(1, &pluginsCore.TaskInfo{}), usageSource, true},
+		{"running-without-metrics", pluginsCore.PhaseInfoRunning(1, &pluginsCore.TaskInfo{}), NewInMemoryPodUsageSource(), false},
+		{"disabled", pluginsCore.PhaseInfoRunning(1, &pluginsCore.TaskInfo{}), nil, false},
+		{"queued", pluginsCore.PhaseInfoQueued(metav1.Now().Time, 1, ""), usageSource, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tctx := getMockTaskContext(PluginPhaseStarted, PluginPhaseStarted)
+			mockResourceHandler := &pluginsk8sMock.Plugin{}
+			mockResourceHandler.EXPECT().GetProperties().Return(k8s.PluginProperties{})
+			mockResourceHandler.On("BuildIdentityResource", mock.Anything, tctx.TaskExecutionMetadata()).Return(&v1.Pod{}, nil)
+			mockResourceHandler.On("GetTaskPhase", mock.Anything, mock.Anything, mock.Anything).Return(tt.phaseInfo, nil)
+			pluginManager, err := NewPluginManager(ctx, dummySetupContext(fake.NewFakeClient(res)), k8s.PluginEntry{
+				ID:              "x",
+				ResourceToWatch: &v1.Pod{},
+				Plugin:          mockResourceHandler,
+			}, NewResourceMonitorIndex(), k8sfake.NewSimpleClientset())
+			assert.NoError(t, err)
+			pluginManager.usageSource = tt.usageSource
+
+			transition, err := pluginManager.Handle(ctx, tctx)
+			assert.NoError(t, err)
+			taskInfo := transition.Info().Info()
+			if taskInfo == nil {
+				assert.False(t, tt.wantUsage)
+				return
+			}
+
+			usage := taskInfo.CustomInfo.GetFields()[ResourceUsageCustomInfoKey].GetStructValue()
+			if tt.wantUsage {
+				if assert.NotNil(t, usage) {
+					assert.Equal(t, 0.75, usage.GetFields()["cpuUtilization"].GetNumberValue())
+					assert.Equal(t, 0.25, usage.GetFields()["memoryUtilization"].GetNumberValue())
+				}
+			} else {
+				assert.Nil(t, usage)
+			}
+		})
+	}
+}
+
+func TestPluginManager_RecordResourceUsage_TerminalTransition(t *testing.T) {
+	ctx := context.TODO()
+	pod := newPodWithRequests("p", "ns", v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")})
+	summary := ResourceUsageSummary{}.addSample(pod, map[string]v1.ResourceList{
+		"primary": {v1.ResourceCPU: resource.MustParse("500m")},
+	})
+	pluginManager := &PluginManager{
+		metrics:     newPluginMetrics(promutils.NewTestScope()),
+		usageSource: NewInMemoryPodUsageSource(),
+	}
+	tctx := getMockTaskContext(PluginPhaseStarted, PluginPhaseStarted)
+	succeeded := pluginsCore.PhaseInfoSuccess(&pluginsCore.TaskInfo{})
+
+	// The first round observing the terminal phase records the utilization, later rounds must not record it again.
+	pluginManager.recordResourceUsage(ctx, tctx, pod, pluginsCore.PhaseRunning, succeeded, summary, time.Now())
+	pluginManager.recordResourceUsage(ctx, tctx, pod, pluginsCore.PhaseSuccess, succeeded, summary, time.Now())
+	pluginManager.recordResourceUsage(ctx, tctx, pod, pluginsCore.PhaseSuccess, succeeded, summary, time.Now())
+
+	assert.Equal(t, 1, testutil.CollectAndCount(pluginManager.metrics.CPUUtilization))
+	metric := &dto.Metric{}
+	assert.NoError(t, pluginManager.metrics.CPUUtilization.WithLabelValues("").(prometheus.Metric).Write(metric))
+	assert.Equal(t, uint64(1), metric.GetSummary().GetSampleCount())
+}
@@ -1,7 +1,10 @@
 package config
 
 import (
+	"context"
+
 	"github.com/flyteorg/flyte/flytepropeller/pkg/controller/config"
+	stdConfig "github.com/flyteorg/flyte/flytestdlib/config"
 )
 
 //go:generate pflags Config --default-var=defaultConfig
@@ -25,6 +28,27 @@ var (
 	configSection = config.MustRegisterSubSection(configSectionKey, &defaultConfig)
 )
 
+func init() {
+	stdConfig.AddTypedValidator(configSection, validateConfig)
+}
+
+func validateConfig(cfg *Config) error {
+	errs := stdConfig.FieldErrors{}
+	if cfg.Type != TypeRedis {
+		return nil
+	}
+
+	if cfg.ResourceMaxQuota <= 0 {
+		errs.Addf("resourceMaxQuota", "must be positive, got [%v]", cfg.ResourceMaxQuota)
+	}
+
+	if len(cfg.RedisConfig.HostPaths) == 0 && len(cfg.RedisConfig.HostPath) == 0 {
+		errs.Addf("redis.hostPaths", "at least one redis host is required")
+	}
+
+	return errs.ErrorOrNil()
+}
+
 // Configs for Resource Manager
 type Config struct {
 	Type             Type        `json:"type" pflag:"noop, Which resource manager to use, redis or noop. Default is noop."`
@@ -51,3 +75,9 @@ func GetConfig() *Config {
 func SetConfig(cfg *Config) error {
 	return configSection.SetConfig(cfg)
 }
+
+// Subscribe registers handler to be called with the new config whenever it's updated at runtime. The returned function
+// removes the subscription.
+func Subscribe(handler func(ctx context.Context, cfg *Config)) (unsubscribe func()) {
+	return stdConfig.SubscribeTyped(configSection, handler)
+}
@@ -2,8 +2,10 @@ package resourcemanager
 
 import (
 	"context"
+	"reflect"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -76,6 +78,21 @@ func (r *RedisResourceManagerBuilder) BuildResourceManager(ctx context.Context)
 		namespacedResourcesMap: map[pluginCore.ResourceNamespace]*Resource{},
 	}
 
+	// The global max quota applies live. Other changes take effect on the next start.
+	initialConfig := *rmConfig.GetConfig()
+	rm.maxQuota.Store(int64(initialConfig.ResourceMaxQuota))
+	unsubscribe := rmConfig.Subscribe(func(ctx context.Context, cfg *rmConfig.Config) {
+		rm.maxQuota.Store(int64(cfg.ResourceMaxQuota))
+		logger.Infof(ctx, "Resource manager max quota updated to [%v]", cfg.ResourceMaxQuota)
+		if cfg.Type != initialConfig.Type || !reflect.DeepEqual(cfg.RedisConfig, initialConfig.RedisConfig) {
+			logger.Warnf(ctx, "Resource manager type and redis config changes require a restart to take effect")
+		}
+	})
+	go func() {
+		<-ctx.Done()
+		unsubscribe()
+	}()
+
 	logger.Infof(ctx, "Building a resource manager: creating metrics and namespacedResourcesMap")
 	// building the resources and insert them into the resource manager
 	for namespace, quota := range r.namespacedResourcesQuotaMap {
@@ -107,6 +124,8 @@ type RedisResourceManager struct {
 	client                 RedisClient
 	MetricsScope           promutils.Scope
 	namespacedResourcesMap map[pluginCore.ResourceNamespace]*Resource
+	// maxQuota caps the quota of every resource. It's kept up to date with the config and no cap applies when zero.
+	maxQuota atomic.Int64
 }
 
 type RedisResourceManagerMetrics struct {
@@ -127,6 +146,15 @@ func (r *RedisResourceManager) getResource(namespace pluginCore.ResourceNamespac
 	return nil, errors.Errorf("Requested resource [%v] not found in namespacedResourceMap", namespace)
 }
 
+// getQuota returns the quota of a resource capped by the max quota.
+func (r *RedisResourceManager) getQuota(resource *Resource) BaseResourceConstraint {
+	if maxQuota := r.maxQuota.Load(); maxQuota > 0 && maxQuota < resource.quota.Value {
+		return BaseResourceConstraint{Value: maxQuota}
+	}
+
+	return resource.quota
+}
+
 func (r *RedisResourceManager) pollRedis(ctx context.Context, namespace pluginCore.ResourceNamespace) {
 	resource, err := r.getResource(namespace)
 	if err != nil {
@@ -237,7 +265,8 @@ func (r *RedisResourceManager) AllocateResource(ctx context.Context, namespace p
 		return pluginCore.AllocationUndefined, err
 	}
 
-	if !namespacedResource.quota.IsAllowed(size) {
+	quota := r.getQuota(namespacedResource)
+	if !quota.IsAllowed(size) {
 		logger.Infof(ctx, "Too many allocations (total [%d]), rejecting [%s:%s]", size, namespace, allocationToken)
 		namespacedResource.rejectedTokens.Store(allocationToken, struct{}{})
 		return pluginCore.AllocationStatusExhausted, nil
@@ -111,6 +111,31 @@ func TestRedisResourceManager_AllocateResource(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, core.AllocationStatusExhausted, got)
 	})
+
+	t.Run("Max quota caps the resource quota. Resource 2 is exhausted by 3 tokens.", func(t *testing.T) {
+		mockScope := promutils.NewTestScope()
+		mockRedisClient := &mocks.RedisClient{}
+		mockContext := context.TODO()
+		r := &RedisResourceManager{
+			client:                 mockRedisClient,
+			MetricsScope:           mockScope,
+			namespacedResourcesMap: createMockNamespacedResourcesMap(mockScope),
+		}
+		r.maxQuota.Store(3)
+		allocatedTokens := []string{"ns1-token1", "ns1-token2", "ns1-token3"}
+		mockRedisClient.EXPECT().SIsMember("test-resource2", mock.Anything).Return(false, nil)
+		mockRedisClient.EXPECT().SCard("test-resource2").Return(int64(len(allocatedTokens)), nil)
+		got, err := r.AllocateResource(mockContext, "test-resource2", "ns1-token4", []FullyQualifiedResourceConstraint{})
+		assert.Nil(t, err)
+		assert.Equal(t, core.AllocationStatusExhausted, got)
+
+		// Raising the max quota above the resource quota restores it.
+		r.maxQuota.Store(10)
+		mockRedisClient.EXPECT().SAdd(mock.Anything, mock.Anything).Return(1, nil)
+		got, err = r.AllocateResource(mockContext, "test-resource2", "ns1-token4", []FullyQualifiedResourceConstraint{})
+		assert.Nil(t, err)
+		assert.Equal(t, core.AllocationStatusGranted, got)
+	})
 }
 
 func TestRedisResourceManager_checkAgainstConstraints(t *testing.T) {
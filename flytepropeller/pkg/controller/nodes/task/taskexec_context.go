@@ -70,6 +70,7 @@ type taskExecutionMetadata struct {
 	maxAttempts          uint32
 	platformResources    *v1.ResourceRequirements
 	environmentVariables map[string]string
+	previousAttemptError *core.ExecutionError
 }
 
 func (t taskExecutionMetadata) GetTaskExecutionID() pluginCore.TaskExecutionID {
@@ -92,6 +93,10 @@ func (t taskExecutionMetadata) GetEnvironmentVariables() map[string]string {
 	return t.environmentVariables
 }
 
+func (t taskExecutionMetadata) GetPreviousAttemptError() *core.ExecutionError {
+	return t.previousAttemptError
+}
+
 type taskExecutionContext struct {
 	interfaces.NodeExecutionContext
 	tm  taskExecutionMetadata
@@ -290,6 +295,12 @@ func (t *Handler) newTaskExecutionContext(ctx context.Context, nCtx interfaces.N
 		return nil, err
 	}
 
+	// The node status retains the error of the last failed attempt until the node reaches a terminal phase
+	var previousAttemptError *core.ExecutionError
+	if nCtx.CurrentAttempt() > 0 {
+		previousAttemptError = nCtx.NodeStatus().GetExecutionError()
+	}
+
 	return &taskExecutionContext{
 		NodeExecutionContext: nCtx,
 		tm: taskExecutionMetadata{
@@ -303,6 +314,7 @@ func (t *Handler) newTaskExecutionContext(ctx context.Context, nCtx interfaces.N
 			maxAttempts:          maxAttempts,
 			platformResources:    convertTaskResourcesToRequirements(nCtx.ExecutionContext().GetExecutionConfig().TaskResources),
 			environmentVariables: nCtx.ExecutionContext().GetExecutionConfig().EnvironmentVariables,
+			previousAttemptError: previousAttemptError,
 		},
 		rm: resourcemanager.GetTaskResourceManager(
 			t.resourceManager, resourceNamespacePrefix, id),
@@ -74,6 +74,7 @@ func dummyNodeExecutionContext(t *testing.T, parentInfo executors.ImmutableParen
 	ns := &flyteMocks.ExecutableNodeStatus{}
 	ns.EXPECT().GetDataDir().Return("data-dir")
 	ns.EXPECT().GetOutputDir().Return("output-dir")
+	ns.EXPECT().GetExecutionError().Return(&core.ExecutionError{Code: "OOMKilled"})
 
 	n := &flyteMocks.ExecutableNode{}
 	n.EXPECT().GetResources().Return(resources)
@@ -172,6 +173,7 @@ func TestHandler_newTaskExecutionContext(t *testing.T) {
 	assert.Equal(t, got.TaskExecutionMetadata().GetTaskExecutionID().GetID().NodeExecutionId.GetNodeId(), nodeID)        //nolint:protogetter
 	assert.Equal(t, got.TaskExecutionMetadata().GetTaskExecutionID().GetID().NodeExecutionId.GetExecutionId(), wfExecID) //nolint:protogetter
 	assert.Equal(t, got.TaskExecutionMetadata().GetTaskExecutionID().GetUniqueNodeID(), nodeID)
+	assert.Equal(t, "OOMKilled", got.TaskExecutionMetadata().GetPreviousAttemptError().GetCode())
 
 	assert.EqualValues(t, got.ResourceManager().(resourcemanager.TaskResourceManager).GetResourcePoolInfo(), make([]*event.ResourcePoolInfo, 0))
 
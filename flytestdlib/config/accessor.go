@@ -17,6 +17,10 @@
 // from that particular config file are parsed. It follows that if there are inter-dependent sections (e.g. changing one
 // MUST be followed by a change in another), then make sure those sections are placed in the same config file.
 //
+// Any number of components can subscribe to updates of a section through Section.Subscribe, and validators added
+// through Section.AddValidator can reject a new config. When a changed config file fails validation, the affected
+// sections keep their previous config while the remaining sections are still updated.
+//
 // A convenience tool is also provided in cli package (pflags) that generates an implementation for PFlagProvider interface
 // based on json names of the fields.
 package config
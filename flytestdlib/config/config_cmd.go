@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"reflect"
@@ -41,14 +42,22 @@ func NewConfigCommand(accessorProvider AccessorProvider) *cobra.Command {
 	validateCmd := &cobra.Command{
 		Use:   "validate",
 		Short: "Validates the loaded config.",
+		Long: `Validates the loaded config and reports every problem found along with its location in the config
+files, e.g. keys that fail to parse, unknown keys in strict mode and values rejected by the rules of each section.`,
+		// The config is loaded by the command itself, skip loading the config of the parent command.
+		PersistentPreRunE: skipParentPreRun,
+		// All problems are printed by the command.
+		SilenceErrors: true,
+		SilenceUsage:  true,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return validate(accessorProvider(opts), cmd)
 		},
 	}
 
 	discoverCmd := &cobra.Command{
-		Use:   "discover",
-		Short: "Searches for a config in one of the default search paths.",
+		Use:               "discover",
+		Short:             "Searches for a config in one of the default search paths.",
+		PersistentPreRunE: skipParentPreRun,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return validate(accessorProvider(opts), cmd)
 		},
@@ -307,11 +316,30 @@ func validate(accessor Accessor, p printer) error {
 	} else {
 		red := color.New(color.FgRed).SprintFunc()
 		p.Println(red("Failed to validate config file."))
+		printProblems(p, err)
 	}
 
 	return err
 }
 
+// skipParentPreRun overrides the PersistentPreRunE of parent commands, which commonly load the config already.
+func skipParentPreRun(*cobra.Command, []string) error {
+	return nil
+}
+
+func printProblems(p printer, err error) {
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		p.Println(err)
+		return
+	}
+
+	p.Printf("Found %v problem(s):\n", len(validationErr.Problems))
+	for _, problem := range validationErr.Problems {
+		p.Println(problem.String())
+	}
+}
+
 func printInfo(p printer, v Accessor) {
 	cfgFile := v.ConfigFilesUsed()
 	if len(cfgFile) != 0 {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 
@@ -18,12 +19,25 @@ type Section interface {
 	// section registered.
 	GetConfig() Config
 
-	// Gets a function pointer to call when the config has been updated.
+	// Gets a function pointer to call when the config has been updated. The function invokes the handler the section
+	// was registered with followed by all subscribers. Returns nil if there are none.
 	GetConfigUpdatedHandler() SectionUpdated
 
 	// Sets the config and sets a bit indicating whether the new config is different when compared to the existing value.
+	// A new config is first checked by all validators added to the section and rejected, keeping the existing value, if
+	// any of them fails.
 	SetConfig(config Config) error
 
+	// Subscribes to updates of this section. The handler is invoked with the new config every time the section is
+	// updated at runtime. The returned function removes the subscription.
+	Subscribe(handler SectionUpdated) (unsubscribe func())
+
+	// Adds a validator that is run against every new config set on this section.
+	AddValidator(validator SectionValidator)
+
+	// Gets the version of the config, which is incremented every time a different config is set.
+	GetVersion() uint64
+
 	// Gets a value indicating whether the config has changed since the last call to GetConfigChangedAndClear and clears
 	// the changed bit. This operation is atomic.
 	GetConfigChangedAndClear() bool
@@ -68,15 +82,24 @@ type PFlagProvider interface {
 
 type SectionUpdated func(ctx context.Context, newValue Config)
 
+// SectionValidator checks a new config before it's set on a section. Returning an error rejects the new config. Validators
+// should return FieldErrors to report every problem along with the field it concerns.
+type SectionValidator func(newValue Config) error
+
 // Global section to use with any root-level config sections registered.
 var rootSection = NewRootSection()
 
 type section struct {
-	config   Config
-	handler  SectionUpdated
-	isDirty  atomic.Bool
-	sections SectionMap
-	lockObj  sync.RWMutex
+	config      Config
+	handler     SectionUpdated
+	subscribers map[uint64]SectionUpdated
+	// The id of the next subscriber. Ids are never reused so that unsubscribing twice is harmless.
+	nextSubscriberID uint64
+	validators       []SectionValidator
+	version          uint64
+	isDirty          atomic.Bool
+	sections         SectionMap
+	lockObj          sync.RWMutex
 }
 
 // Gets the global root section.
@@ -204,7 +227,24 @@ func (r *section) SetConfig(c Config) error {
 	}
 
 	if !DeepEqual(r.config, c) {
+		// Run all validators so every problem is reported at once.
+		var problems FieldErrors
+		for _, validator := range r.validators {
+			err := validator(c)
+			var fieldErrs FieldErrors
+			if errors.As(err, &fieldErrs) {
+				problems = append(problems, fieldErrs...)
+			} else if err != nil {
+				problems = append(problems, FieldError{Message: err.Error()})
+			}
+		}
+
+		if len(problems) > 0 {
+			return fmt.Errorf("%w: %w", ErrSectionValidation, problems)
+		}
+
 		r.config = c
+		r.version++
 		r.isDirty.Store(true)
 	}
 
@@ -212,7 +252,62 @@ func (r *section) SetConfig(c Config) error {
 }
 
 func (r *section) GetConfigUpdatedHandler() SectionUpdated {
-	return r.handler
+	r.lockObj.RLock()
+	defer r.lockObj.RUnlock()
+
+	if len(r.subscribers) == 0 {
+		return r.handler
+	}
+
+	// Subscribers are invoked in the order they subscribed.
+	ids := make([]uint64, 0, len(r.subscribers))
+	for id := range r.subscribers {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	handlers := make([]SectionUpdated, 0, len(ids)+1)
+	if r.handler != nil {
+		handlers = append(handlers, r.handler)
+	}
+	for _, id := range ids {
+		handlers = append(handlers, r.subscribers[id])
+	}
+
+	return func(ctx context.Context, newValue Config) {
+		for _, handler := range handlers {
+			handler(ctx, newValue)
+		}
+	}
+}
+
+func (r *section) Subscribe(handler SectionUpdated) (unsubscribe func()) {
+	r.lockObj.Lock()
+	defer r.lockObj.Unlock()
+
+	id := r.nextSubscriberID
+	r.nextSubscriberID++
+	r.subscribers[id] = handler
+
+	return func() {
+		r.lockObj.Lock()
+		defer r.lockObj.Unlock()
+		delete(r.subscribers, id)
+	}
+}
+
+func (r *section) AddValidator(validator SectionValidator) {
+	r.lockObj.Lock()
+	defer r.lockObj.Unlock()
+
+	r.validators = append(r.validators, validator)
+}
+
+func (r *section) GetVersion() uint64 {
+	r.lockObj.RLock()
+	defer r.lockObj.RUnlock()
+
+	return r.version
 }
 
 func (r *section) GetConfigChangedAndClear() bool {
@@ -221,14 +316,34 @@ func (r *section) GetConfigChangedAndClear() bool {
 
 func NewSection(configSection Config, updatesFn SectionUpdated) Section {
 	return &section{
-		config:   configSection,
-		handler:  updatesFn,
-		isDirty:  atomic.NewBool(false),
-		sections: map[SectionKey]Section{},
-		lockObj:  sync.RWMutex{},
+		config:      configSection,
+		handler:     updatesFn,
+		subscribers: map[uint64]SectionUpdated{},
+		isDirty:     atomic.NewBool(false),
+		sections:    map[SectionKey]Section{},
+		lockObj:     sync.RWMutex{},
 	}
 }
 
+// SubscribeTyped subscribes to updates of a section whose config is of type *T. See Section.Subscribe.
+func SubscribeTyped[T any](s Section, handler func(ctx context.Context, newValue *T)) (unsubscribe func()) {
+	return s.Subscribe(func(ctx context.Context, newValue Config) {
+		handler(ctx, newValue.(*T))
+	})
+}
+
+// AddTypedValidator adds a validator to a section whose config is of type *T. See Section.AddValidator.
+func AddTypedValidator[T any](s Section, validator func(newValue *T) error) {
+	s.AddValidator(func(newValue Config) error {
+		typed, ok := newValue.(*T)
+		if !ok {
+			return fmt.Errorf("expected config of type %T, got %T", typed, newValue)
+		}
+
+		return validator(typed)
+	})
+}
+
 func NewRootSection() Section {
 	return NewSection(nil, nil)
 }
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
@@ -115,3 +117,69 @@ func TestGetSection(t *testing.T) {
 	assert.NotNil(t, actual2)
 	assert.Equal(t, reflect.TypeOf(&TestConfig{}), reflect.TypeOf(actual2.GetConfig()))
 }
+
+func TestSectionSubscribe(t *testing.T) {
+	ctx := context.TODO()
+	s := NewSection(&MyComponentConfig{}, nil)
+	assert.Nil(t, s.GetConfigUpdatedHandler())
+
+	var calls []string
+	unsubscribe := SubscribeTyped(s, func(ctx context.Context, newValue *MyComponentConfig) {
+		calls = append(calls, "first "+newValue.StringValue)
+	})
+	s.Subscribe(func(ctx context.Context, newValue Config) {
+		calls = append(calls, "second "+newValue.(*MyComponentConfig).StringValue)
+	})
+
+	s.GetConfigUpdatedHandler()(ctx, &MyComponentConfig{StringValue: "a"})
+	assert.Equal(t, []string{"first a", "second a"}, calls)
+
+	unsubscribe()
+	unsubscribe()
+	s.GetConfigUpdatedHandler()(ctx, &MyComponentConfig{StringValue: "b"})
+	assert.Equal(t, []string{"first a", "second a", "second b"}, calls)
+}
+
+func TestSectionValidator(t *testing.T) {
+	s := NewSection(&MyComponentConfig{StringValue: "valid"}, nil)
+	AddTypedValidator(s, func(newValue *MyComponentConfig) error {
+		if len(newValue.StringValue) == 0 {
+			return fmt.Errorf("str is required")
+		}
+		return nil
+	})
+
+	assert.NoError(t, s.SetConfig(&MyComponentConfig{StringValue: "valid"}))
+	assert.Equal(t, uint64(0), s.GetVersion())
+	assert.False(t, s.GetConfigChangedAndClear())
+
+	err := s.SetConfig(&MyComponentConfig{})
+	assert.True(t, errors.Is(err, ErrSectionValidation))
+	assert.Equal(t, "valid", s.GetConfig().(*MyComponentConfig).StringValue)
+	assert.Equal(t, uint64(0), s.GetVersion())
+	assert.False(t, s.GetConfigChangedAndClear())
+
+	assert.NoError(t, s.SetConfig(&MyComponentConfig{StringValue: "updated"}))
+	assert.Equal(t, uint64(1), s.GetVersion())
+	assert.True(t, s.GetConfigChangedAndClear())
+
+	assert.Error(t, s.SetConfig(&OtherComponentConfig{}))
+
+	t.Run("All problems are reported", func(t *testing.T) {
+		AddTypedValidator(s, func(newValue *MyComponentConfig) error {
+			errs := FieldErrors{}
+			if len(newValue.StringValue) == 0 {
+				errs.Addf("str", "is required")
+			}
+			return errs.ErrorOrNil()
+		})
+
+		err := s.SetConfig(&MyComponentConfig{})
+		assert.ErrorIs(t, err, ErrSectionValidation)
+
+		var fieldErrs FieldErrors
+		if assert.ErrorAs(t, err, &fieldErrs) {
+			assert.Equal(t, FieldErrors{{Message: "str is required"}, {Field: "str", Message: "is required"}}, fieldErrs)
+		}
+	})
+}
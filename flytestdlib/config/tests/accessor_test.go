@@ -509,6 +509,111 @@ func TestAccessor_UpdateConfig(t *testing.T) {
 			assert.NotEqual(t, firstValue, secondValue)
 		})
 
+		t.Run(fmt.Sprintf("[%v] Rejected update", provider(config.Options{}).ID()), func(t *testing.T) {
+			reg := config.NewRootSection()
+			mySection, err := reg.RegisterSection(MyComponentSectionKey, &MyComponentConfig{})
+			assert.NoError(t, err)
+			otherSection, err := reg.RegisterSection(OtherComponentSectionKey, &OtherComponentConfig{})
+			assert.NoError(t, err)
+
+			// Only accepts the value the section is first loaded with.
+			var firstValue string
+			config.AddTypedValidator(mySection, func(c *MyComponentConfig) error {
+				if len(firstValue) > 0 && c.StringValue != firstValue {
+					return fmt.Errorf("str can't change")
+				}
+				return nil
+			})
+
+			var updates []string
+			config.SubscribeTyped(otherSection, func(ctx context.Context, c *OtherComponentConfig) {
+				updates = append(updates, c.StringValue)
+			})
+			config.SubscribeTyped(mySection, func(ctx context.Context, c *MyComponentConfig) {
+				updates = append(updates, c.StringValue)
+			})
+
+			watchDir, configFile, cleanup := newSymlinkedConfigFile(t)
+			defer cleanup()
+
+			v := provider(config.Options{
+				SearchPaths: []string{configFile},
+				RootSection: reg,
+			})
+			assert.NoError(t, v.UpdateConfig(context.TODO()))
+			firstValue = mySection.GetConfig().(*MyComponentConfig).StringValue
+			assert.Len(t, updates, 2)
+			assert.Equal(t, uint64(1), otherSection.GetVersion())
+
+			dataDir2 := path.Join(watchDir, "data2")
+			assert.NoError(t, os.Mkdir(dataDir2, os.ModePerm))
+			newData, err := populateConfigData(path.Join(dataDir2, "config.yaml"))
+			assert.NoError(t, err)
+			assert.NoError(t, changeSymLink(dataDir2, path.Join(watchDir, "data")))
+
+			time.Sleep(5 * time.Second)
+
+			// The rejected section keeps its config while the other one is updated.
+			assert.Equal(t, firstValue, mySection.GetConfig().(*MyComponentConfig).StringValue)
+			assert.Equal(t, newData.OtherComponentConfig.StringValue,
+				otherSection.GetConfig().(*OtherComponentConfig).StringValue)
+			assert.Equal(t, uint64(1), mySection.GetVersion())
+			assert.Equal(t, uint64(2), otherSection.GetVersion())
+			assert.Equal(t, newData.OtherComponentConfig.StringValue, updates[len(updates)-1])
+			assert.NotContains(t, updates, newData.MyComponentConfig.StringValue)
+		})
+
+		t.Run(fmt.Sprintf("[%v] Located problems", provider(config.Options{}).ID()), func(t *testing.T) {
+			reg := config.NewRootSection()
+			mySection, err := reg.RegisterSection(MyComponentSectionKey, &MyComponentConfig{})
+			assert.NoError(t, err)
+			_, err = reg.RegisterSection(OtherComponentSectionKey, &OtherComponentConfig{})
+			assert.NoError(t, err)
+
+			config.AddTypedValidator(mySection, func(c *MyComponentConfig) error {
+				errs := config.FieldErrors{}
+				if c.StringValue == "invalid" {
+					errs.Addf("str", "must not be [%v]", c.StringValue)
+				}
+				if len(c.StringValue2) == 0 {
+					errs.Addf("str2", "is required")
+				}
+				return errs.ErrorOrNil()
+			})
+
+			configFile := filepath.Join("testdata", "invalid_config.yaml")
+			v := provider(config.Options{
+				SearchPaths: []string{configFile},
+				RootSection: reg,
+				StrictMode:  true,
+			})
+
+			err = v.UpdateConfig(context.TODO())
+			assert.ErrorIs(t, err, config.ErrSectionValidation)
+			assert.ErrorIs(t, err, config.ErrStrictModeValidation)
+
+			var validationErr *config.ValidationError
+			if assert.ErrorAs(t, err, &validationErr) {
+				located := make([]string, 0, len(validationErr.Problems))
+				for _, problem := range validationErr.Problems {
+					located = append(located, fmt.Sprintf("%v:%v:%v %v", problem.File, problem.Line, problem.Column, problem.Key))
+				}
+
+				assert.Equal(t, []string{
+					configFile + ":1:1 my-component.str2",
+					configFile + ":2:3 my-component.str",
+					configFile + ":4:3 other-component.duration-value",
+					configFile + ":5:3 other-component.int-val",
+					configFile + ":6:3 other-component.unknown-field",
+					configFile + ":7:1 unknown-section",
+				}, located)
+				assert.Contains(t, validationErr.Problems[2].Message, `unknown unit " parsecs"`)
+			}
+
+			// Sections that failed keep their previous config.
+			assert.Empty(t, mySection.GetConfig().(*MyComponentConfig).StringValue)
+		})
+
 		t.Run(fmt.Sprintf("[%v] Default variables", provider(config.Options{}).ID()), func(t *testing.T) {
 			reg := config.NewRootSection()
 			_, err := reg.RegisterSection(MyComponentSectionKey, &MyComponentConfig{
@@ -73,6 +73,7 @@ func TestValidateCommand(t *testing.T) {
 			output, err := executeCommand(cmd, config.CommandValidate, "--file=bad_config.yaml", "--strict")
 			assert.Error(t, err)
 			assert.Contains(t, output, "Failed")
+			assert.Contains(t, output, "bad_config.yaml:12:1: unknown-key")
 		})
 
 		t.Run(fmt.Sprintf(testNameFormatter, provider(config.Options{}).ID(), "Valid config file"), func(t *testing.T) {
@@ -0,0 +1,135 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FieldError describes a problem with a single field of a config section. Field is the path of the field relative to
+// the section using the json names of the fields, with slice indexes and map keys in brackets (e.g.
+// "clusterConfigs[0].name" or "labelClusterMap[team1][1].id"). An empty Field refers to the section as a whole.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e FieldError) Error() string {
+	if len(e.Field) == 0 {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%v: %v", e.Field, e.Message)
+}
+
+// FieldErrors collects all problems a SectionValidator finds so they can be reported together and located in the
+// config files they originate from.
+type FieldErrors []FieldError
+
+// Addf adds a problem with the field at the given path.
+func (e *FieldErrors) Addf(field, format string, args ...interface{}) {
+	*e = append(*e, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// ErrorOrNil returns the collected problems as an error or nil if there are none.
+func (e FieldErrors) ErrorOrNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+func (e FieldErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, fieldErr := range e {
+		msgs = append(msgs, fieldErr.Error())
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// Problem is a single problem found while loading config. File, Line and Column point at the key in the config file it
+// originates from, if it could be located.
+type Problem struct {
+	// Full path of the config key, starting with the section key (e.g. "clusters.clusterConfigs[0].name").
+	Key     string
+	Message string
+	File    string
+	Line    int
+	Column  int
+	// The error the problem originates from. Used to match problems with errors.Is (e.g. ErrSectionValidation).
+	Err error
+}
+
+func (p Problem) String() string {
+	location := ""
+	if len(p.File) > 0 {
+		location = fmt.Sprintf("%v:%v:%v: ", p.File, p.Line, p.Column)
+	}
+
+	if len(p.Key) == 0 {
+		return location + p.Message
+	}
+
+	return fmt.Sprintf("%v%v: %v", location, p.Key, p.Message)
+}
+
+// ValidationError is returned by Accessor.UpdateConfig when the loaded config has one or more problems. It reports all
+// of them rather than only the first.
+type ValidationError struct {
+	Problems []Problem
+}
+
+// NewValidationError returns a ValidationError for the problems, sorted by location, or nil if there are none.
+func NewValidationError(problems []Problem) error {
+	if len(problems) == 0 {
+		return nil
+	}
+
+	sorted := make([]Problem, len(problems))
+	copy(sorted, problems)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		// Located problems come first, ordered as they appear in the files.
+		if (len(a.File) == 0) != (len(b.File) == 0) {
+			return len(a.File) > 0
+		}
+
+		if a.File != b.File {
+			return a.File < b.File
+		}
+
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+
+		if a.Column != b.Column {
+			return a.Column < b.Column
+		}
+
+		return a.Key < b.Key
+	})
+
+	return &ValidationError{Problems: sorted}
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Problems))
+	for _, problem := range e.Problems {
+		msgs = append(msgs, problem.String())
+	}
+
+	return fmt.Sprintf("config has %v problem(s):\n%v", len(e.Problems), strings.Join(msgs, "\n"))
+}
+
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Problems))
+	for _, problem := range e.Problems {
+		if problem.Err != nil {
+			errs = append(errs, problem.Err)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,234 @@
+package viper
+
+import (
+	"errors"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"gopkg.in/yaml.v3"
+
+	"github.com/flyteorg/flyte/flytestdlib/config"
+)
+
+var (
+	// mapstructure reports unknown keys of a struct as a single error listing all of them. Test_decodeProblemsMessageFormat
+	// fails if the format of this or the errors below changes.
+	invalidKeysPattern = regexp.MustCompile(`^'([^']*)' has invalid keys: (.*)$`)
+
+	// Patterns of the other errors mapstructure reports, capturing the name of the field and the message.
+	decodeErrorPatterns = []struct {
+		pattern       *regexp.Regexp
+		messagePrefix string
+	}{
+		{pattern: regexp.MustCompile(`^error decoding '([^']*)': (.*)$`)},
+		{pattern: regexp.MustCompile(`^cannot parse '([^']*)' (.*)$`), messagePrefix: "cannot parse value "},
+		{pattern: regexp.MustCompile(`^'([^']*)' (.*)$`)},
+	}
+)
+
+// joinKey joins the key of a section (including its trailing delimiter) and the path of a field within the section.
+func joinKey(sectionKey config.SectionKey, field string) string {
+	if len(field) == 0 {
+		return strings.TrimSuffix(sectionKey, keyDelim)
+	}
+
+	if strings.HasPrefix(field, "[") {
+		return strings.TrimSuffix(sectionKey, keyDelim) + field
+	}
+
+	return sectionKey + field
+}
+
+// decodeProblems converts an error decoding a section into one problem per offending field.
+func decodeProblems(sectionKey config.SectionKey, err error) []config.Problem {
+	var decodeErr *mapstructure.Error
+	if !errors.As(err, &decodeErr) {
+		return []config.Problem{{Key: joinKey(sectionKey, ""), Message: err.Error(), Err: err}}
+	}
+
+	problems := make([]config.Problem, 0, len(decodeErr.Errors))
+	for _, msg := range decodeErr.Errors {
+		if match := invalidKeysPattern.FindStringSubmatch(msg); match != nil {
+			for _, key := range strings.Split(match[2], ", ") {
+				field := key
+				if len(match[1]) > 0 {
+					field = match[1] + keyDelim + key
+				}
+
+				problems = append(problems, config.Problem{
+					Key:     joinKey(sectionKey, field),
+					Message: "unknown key",
+					Err:     config.ErrStrictModeValidation,
+				})
+			}
+
+			continue
+		}
+
+		problem := config.Problem{Key: joinKey(sectionKey, ""), Message: msg, Err: decodeErr}
+		for _, decodeErrorPattern := range decodeErrorPatterns {
+			if match := decodeErrorPattern.pattern.FindStringSubmatch(msg); match != nil {
+				problem.Key = joinKey(sectionKey, match[1])
+				problem.Message = decodeErrorPattern.messagePrefix + match[2]
+				break
+			}
+		}
+
+		problems = append(problems, problem)
+	}
+
+	return problems
+}
+
+// setConfigProblems converts an error setting the config of a section into one problem per field error reported by the
+// section validators.
+func setConfigProblems(sectionKey config.SectionKey, err error) []config.Problem {
+	var fieldErrs config.FieldErrors
+	if !errors.As(err, &fieldErrs) {
+		return []config.Problem{{Key: joinKey(sectionKey, ""), Message: err.Error(), Err: err}}
+	}
+
+	problems := make([]config.Problem, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		problems = append(problems, config.Problem{
+			Key:     joinKey(sectionKey, fieldErr.Field),
+			Message: fieldErr.Message,
+			Err:     config.ErrSectionValidation,
+		})
+	}
+
+	return problems
+}
+
+// splitKey splits a key path such as "clusters.labelClusterMap[team1][0].id" into its segments.
+func splitKey(key string) []string {
+	var segments []string
+	current := strings.Builder{}
+	flush := func() {
+		if current.Len() > 0 {
+			segments = append(segments, current.String())
+			current.Reset()
+		}
+	}
+
+	for i := 0; i < len(key); i++ {
+		switch key[i] {
+		case '.':
+			flush()
+		case '[':
+			flush()
+			end := strings.IndexByte(key[i:], ']')
+			if end < 0 {
+				current.WriteString(key[i+1:])
+				i = len(key)
+				continue
+			}
+
+			segments = append(segments, key[i+1:i+end])
+			i += end
+		default:
+			current.WriteByte(key[i])
+		}
+	}
+
+	flush()
+	return segments
+}
+
+// findKey looks up the segments of a key path in a yaml document. Keys are matched case-insensitively, as viper does.
+// It returns the node of the deepest segment found and how many segments were found.
+func findKey(node *yaml.Node, segments []string) (found *yaml.Node, depth int) {
+	for node != nil && node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+
+	for node != nil && depth < len(segments) {
+		if node.Kind == yaml.AliasNode {
+			node = node.Alias
+		}
+
+		segment := segments[depth]
+		var keyNode, valueNode *yaml.Node
+		switch node.Kind {
+		case yaml.MappingNode:
+			keyNode, valueNode = findMappingKey(node, segment)
+		case yaml.SequenceNode:
+			if index, err := strconv.Atoi(segment); err == nil {
+				if index >= 0 && index < len(node.Content) {
+					keyNode, valueNode = node.Content[index], node.Content[index]
+				}
+			} else {
+				// Maps can be written as a sequence of single-key maps, see sliceToMapHook.
+				for _, item := range node.Content {
+					if keyNode, valueNode = findMappingKey(item, segment); keyNode != nil {
+						break
+					}
+				}
+			}
+		}
+
+		if keyNode == nil {
+			break
+		}
+
+		found, node = keyNode, valueNode
+		depth++
+	}
+
+	return found, depth
+}
+
+func findMappingKey(node *yaml.Node, key string) (keyNode, valueNode *yaml.Node) {
+	if node.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if strings.EqualFold(node.Content[i].Value, key) {
+			return node.Content[i], node.Content[i+1]
+		}
+	}
+
+	return nil, nil
+}
+
+// locateProblems sets the location of every problem to the key it concerns in the config files. If a key isn't set in
+// any file, e.g. a required field that's missing, the problem is located at its closest parent that is. Problems whose
+// section isn't set in any file are left unlocated.
+func locateProblems(problems []config.Problem, files []string) {
+	docs := make(map[string]*yaml.Node, len(files))
+	for _, file := range files {
+		raw, err := os.ReadFile(file)
+		if err != nil {
+			continue
+		}
+
+		doc := &yaml.Node{}
+		if err = yaml.Unmarshal(raw, doc); err != nil {
+			continue
+		}
+
+		docs[file] = doc
+	}
+
+	for i := range problems {
+		segments := splitKey(problems[i].Key)
+		bestDepth := 0
+		for _, file := range files {
+			doc, found := docs[file]
+			if !found {
+				continue
+			}
+
+			if node, depth := findKey(doc, segments); depth > bestDepth {
+				bestDepth = depth
+				problems[i].File = file
+				problems[i].Line = node.Line
+				problems[i].Column = node.Column
+			}
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package viper
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/flyteorg/flyte/flytestdlib/config"
+)
+
+func Test_splitKey(t *testing.T) {
+	assert.Equal(t, []string{"clusters", "labelClusterMap", "team.one", "0", "id"},
+		splitKey("clusters.labelClusterMap[team.one][0].id"))
+	assert.Equal(t, []string{"tasks"}, splitKey("tasks"))
+	assert.Empty(t, splitKey(""))
+}
+
+func Test_decodeProblems(t *testing.T) {
+	err := &mapstructure.Error{Errors: []string{
+		"'' has invalid keys: a, b",
+		"'list[1]' has invalid keys: c",
+		"cannot parse 'int-val' as int: invalid syntax",
+		"error decoding 'duration': invalid duration",
+		"'items[x].name' expected type 'string', got unconvertible type 'map[string]interface {}'",
+	}}
+
+	var keys, msgs []string
+	for _, problem := range decodeProblems("section.", err) {
+		keys = append(keys, problem.Key)
+		msgs = append(msgs, problem.Message)
+	}
+
+	assert.Equal(t, []string{"section.a", "section.b", "section.list[1].c", "section.int-val", "section.duration",
+		"section.items[x].name"}, keys)
+	assert.Equal(t, []string{"unknown key", "unknown key", "unknown key", "cannot parse value as int: invalid syntax",
+		"invalid duration", "expected type 'string', got unconvertible type 'map[string]interface {}'"}, msgs)
+
+	problems := decodeProblems("section.", fmt.Errorf("not a decode error"))
+	assert.Equal(t, []config.Problem{{Key: "section", Message: "not a decode error", Err: fmt.Errorf("not a decode error")}}, problems)
+}
+
+// Test_decodeProblemsMessageFormat decodes a config with mapstructure rather than using canned errors, so that it fails
+// if mapstructure changes the format of the errors decodeProblems parses.
+func Test_decodeProblemsMessageFormat(t *testing.T) {
+	type item struct {
+		ID string `json:"id"`
+	}
+
+	var target struct {
+		IntVal   int             `json:"int-val"`
+		Duration time.Duration   `json:"duration"`
+		List     []item          `json:"list"`
+		Items    map[string]item `json:"items"`
+	}
+
+	err := decode(map[string]interface{}{
+		"unknown":  1,
+		"int-val":  "abc",
+		"duration": "5 parsecs",
+		"list":     []interface{}{map[string]interface{}{"id": "a", "other": 1}},
+		"items":    map[string]interface{}{"x": map[string]interface{}{"id": map[string]interface{}{"a": 1}}},
+	}, defaultDecoderConfig(&target, func(c *mapstructure.DecoderConfig) {
+		c.ErrorUnused = true
+	}))
+
+	problems := map[string]config.Problem{}
+	var keys []string
+	for _, problem := range decodeProblems("section.", err) {
+		problems[problem.Key] = problem
+		keys = append(keys, problem.Key)
+	}
+
+	assert.ElementsMatch(t, []string{"section.unknown", "section.int-val", "section.duration", "section.list[0].other",
+		"section.items[x].id"}, keys)
+	assert.Equal(t, "unknown key", problems["section.unknown"].Message)
+	assert.ErrorIs(t, problems["section.unknown"].Err, config.ErrStrictModeValidation)
+	assert.Equal(t, "unknown key", problems["section.list[0].other"].Message)
+	assert.True(t, strings.HasPrefix(problems["section.int-val"].Message, "cannot parse value as int"))
+	assert.Contains(t, problems["section.duration"].Message, `unknown unit " parsecs"`)
+	assert.True(t, strings.HasPrefix(problems["section.items[x].id"].Message, "expected type 'string'"))
+}
+
+func Test_locateProblems(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NoError(t, os.WriteFile(file, []byte(`clusters:
+  labelClusterMap:
+    Team1:
+      - id: a
+      - id: b
+  items:
+    - first:
+        name: x
+`), os.ModePerm))
+
+	problems := []config.Problem{
+		{Key: "clusters.labelClusterMap[team1][1].id"},
+		{Key: "clusters.items[first].name"},
+		{Key: "clusters.defaultExecutionLabel"},
+		{Key: "tasks.enabled-plugins"},
+	}
+
+	locateProblems(problems, []string{file, filepath.Join(t.TempDir(), "missing.yaml")})
+
+	var locations []string
+	for _, problem := range problems {
+		locations = append(locations, fmt.Sprintf("%v:%v:%v", filepath.Base(problem.File), problem.Line, problem.Column))
+	}
+
+	assert.Equal(t, []string{"config.yaml:5:9", "config.yaml:8:9", "config.yaml:1:1", ".:0:0"}, locations)
+}
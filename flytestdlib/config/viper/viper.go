@@ -5,7 +5,6 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"flag"
-	"fmt"
 	"reflect"
 	"strings"
 	"sync"
@@ -13,6 +12,7 @@ import (
 	"github.com/fsnotify/fsnotify"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	viperLib "github.com/spf13/viper"
@@ -32,8 +32,41 @@ var (
 	dereferencableKinds = map[reflect.Kind]struct{}{
 		reflect.Array: {}, reflect.Chan: {}, reflect.Map: {}, reflect.Ptr: {}, reflect.Slice: {},
 	}
+
+	metricsOnce    sync.Once
+	defaultMetrics *accessorMetrics
 )
 
+// accessorMetrics are shared by all accessors of the process, as they report on the same root section by default.
+type accessorMetrics struct {
+	sectionVersion  *prometheus.GaugeVec
+	rejectedUpdates *prometheus.CounterVec
+}
+
+// getMetrics registers the metrics of accessors with the default prometheus registry the first time an accessor is
+// created, so that importing the package has no side effects.
+func getMetrics() *accessorMetrics {
+	metricsOnce.Do(func() {
+		defaultMetrics = &accessorMetrics{
+			sectionVersion: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Name: "flyte_config_section_version",
+					Help: "Version of the config of a section, incremented every time the config changes",
+				},
+				[]string{"section"}),
+			rejectedUpdates: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Name: "flyte_config_rejected_updates_total",
+					Help: "Number of config updates of a section rejected by its validators",
+				},
+				[]string{"section"}),
+		}
+		prometheus.MustRegister(defaultMetrics.sectionVersion, defaultMetrics.rejectedUpdates)
+	})
+
+	return defaultMetrics
+}
+
 type viperAccessor struct {
 	// Determines whether parsing config should fail if it contains un-registered sections.
 	strictMode bool
@@ -42,6 +75,7 @@ type viperAccessor struct {
 	// Ensures we initialize the file Watcher once.
 	watcherInitializer *sync.Once
 	existingFlagKeys   sets.String
+	metrics            *accessorMetrics
 }
 
 func (viperAccessor) ID() string {
@@ -248,6 +282,17 @@ func jsonUnmarshallerHook(_, to reflect.Type, data interface{}) (interface{}, er
 		res := reflect.New(to).Interface()
 		err = json.Unmarshal(raw, &res)
 		if err != nil {
+			// mapstructure can only decode maps into structs so there is no point in passing on anything else, and the
+			// json error explains the problem far better (e.g. an invalid duration).
+			target := to
+			if target.Kind() == reflect.Ptr {
+				target = target.Elem()
+			}
+
+			if target.Kind() == reflect.Struct && data != nil && reflect.TypeOf(data).Kind() != reflect.Map {
+				return nil, err
+			}
+
 			logger.Errorf(ctx, "Failed to umarshal Data: %v. Error: %v. Skipping jsonUnmarshalHook", data, err)
 			return data, nil
 		}
@@ -259,13 +304,16 @@ func jsonUnmarshallerHook(_, to reflect.Type, data interface{}) (interface{}, er
 }
 
 // Parses RootType config from parsed Viper settings. This should be called after viper has parsed config file/pflags...etc.
+// All problems found in any section are returned together as a config.ValidationError, located in the config files.
 func (v viperAccessor) parseViperConfig(root config.Section) error {
 	// We use AllSettings instead of AllKeys to get the root level keys folded.
-	return v.parseViperConfigRecursive(root, v.viper.AllSettings())
+	problems := v.parseViperConfigRecursive(root, v.viper.AllSettings(), "")
+	locateProblems(problems, v.viper.ConfigFilesUsed())
+	return config.NewValidationError(problems)
 }
 
-func (v viperAccessor) parseViperConfigRecursive(root config.Section, settings interface{}) error {
-	errs := stdLibErrs.ErrorCollection{}
+func (v viperAccessor) parseViperConfigRecursive(root config.Section, settings interface{}, sectionKey config.SectionKey) []config.Problem {
+	var problems []config.Problem
 	var mine interface{}
 	myKeysCount := 0
 	discoveredKeys := sets.NewString()
@@ -273,7 +321,7 @@ func (v viperAccessor) parseViperConfigRecursive(root config.Section, settings i
 		myMap := map[string]interface{}{}
 		for childKey, childValue := range asMap {
 			if childSection, found := root.GetSections()[childKey]; found {
-				errs.Append(v.parseViperConfigRecursive(childSection, childValue))
+				problems = append(problems, v.parseViperConfigRecursive(childSection, childValue, sectionKey+childKey+keyDelim)...)
 			} else {
 				discoveredKeys.Insert(childKey)
 				myMap[childKey] = childValue
@@ -286,7 +334,8 @@ func (v viperAccessor) parseViperConfigRecursive(root config.Section, settings i
 		mine = settings
 		myKeysCount = len(asSlice)
 	} else {
-		discoveredKeys.Insert(fmt.Sprintf("%v", mine))
+		// A value set directly on the section key.
+		discoveredKeys.Insert("")
 		mine = settings
 		if settings != nil {
 			myKeysCount = 1
@@ -295,28 +344,36 @@ func (v viperAccessor) parseViperConfigRecursive(root config.Section, settings i
 
 	if root.GetConfig() != nil {
 		c, err := config.DeepCopyConfig(root.GetConfig())
-		errs.Append(err)
 		if err != nil {
-			return errs.ErrorOrDefault()
+			return append(problems, config.Problem{Key: joinKey(sectionKey, ""), Message: err.Error(), Err: err})
 		}
 
-		errs.Append(decode(mine, defaultDecoderConfig(c, v.decoderConfigs()...)))
-		errs.Append(root.SetConfig(c))
+		// A config that failed to decode is only partially populated and must not replace the current one.
+		if err = decode(mine, defaultDecoderConfig(c, v.decoderConfigs()...)); err != nil {
+			return append(problems, decodeProblems(sectionKey, err)...)
+		}
 
-		return errs.ErrorOrDefault()
+		if err = root.SetConfig(c); err != nil {
+			if errors.Is(err, config.ErrSectionValidation) {
+				v.metrics.rejectedUpdates.WithLabelValues(strings.TrimSuffix(sectionKey, keyDelim)).Inc()
+			}
+
+			problems = append(problems, setConfigProblems(sectionKey, err)...)
+		}
 	} else if myKeysCount > 0 {
 		// There are keys set that are meant to be decoded but no config to receive them. Fail if strict mode is on.
 		if v.strictMode {
-			if newKeys := discoveredKeys.Difference(v.existingFlagKeys); newKeys.Len() > 0 {
-				errs.Append(errors.Wrap(
-					config.ErrStrictModeValidation,
-					fmt.Sprintf("strict mode is on but received keys [%+v] to decode with no config assigned to"+
-						" receive them", newKeys)))
+			for _, key := range discoveredKeys.Difference(v.existingFlagKeys).List() {
+				problems = append(problems, config.Problem{
+					Key:     joinKey(sectionKey, key),
+					Message: "strict mode is on but no config is registered to receive this key",
+					Err:     config.ErrStrictModeValidation,
+				})
 			}
 		}
 	}
 
-	return errs.ErrorOrDefault()
+	return problems
 }
 
 // Adds any specific configs controlled by this viper accessor instance.
@@ -365,10 +422,11 @@ func decode(input interface{}, config *mapstructure.DecoderConfig) error {
 
 func (v viperAccessor) configChangeHandler() {
 	ctx := context.Background()
-	err := v.RefreshFromConfig(ctx, v.rootConfig, false)
+	err := v.parseViperConfig(v.rootConfig)
+	// Sections that failed to parse or validate keep their previous config, the rest are still updated.
+	v.sendUpdatedEvents(ctx, v.rootConfig, false, "")
 	if err != nil {
-		// TODO: Retry? panic?
-		logger.Errorf(ctx, "Failed to update config. Error: %v", err)
+		logger.Errorf(ctx, "Failed to update config. Sections that failed kept their previous config. Error: %v", err)
 	} else {
 		logger.Infof(ctx, "Refreshed config in response to file(s) change.")
 	}
@@ -387,6 +445,7 @@ func (v viperAccessor) RefreshFromConfig(ctx context.Context, r config.Section,
 
 func (v viperAccessor) sendUpdatedEvents(ctx context.Context, root config.Section, forceSend bool, sectionKey config.SectionKey) {
 	for key, section := range root.GetSections() {
+		v.metrics.sectionVersion.WithLabelValues(sectionKey + key).Set(float64(section.GetVersion()))
 		if !section.GetConfigChangedAndClear() && !forceSend {
 			logger.Debugf(ctx, "Config section [%v] hasn't changed.", sectionKey+key)
 		} else if section.GetConfigUpdatedHandler() == nil {
@@ -435,6 +494,7 @@ func newAccessor(opts config.Options) *viperAccessor {
 		rootConfig:         r,
 		viper:              &CollectionProxy{underlying: vipers},
 		watcherInitializer: &sync.Once{},
+		metrics:            getMetrics(),
 	}
 }
 
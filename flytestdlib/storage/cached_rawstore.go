@@ -88,6 +88,11 @@ func (s *cachedRawStore) ReadRaw(ctx context.Context, reference DataReference) (
 	return ioutils.NewBytesReadCloser(b), err
 }
 
+// ReadRawRange retrieves a byte range of the referenced data. Ranges are read from the underlying store and not cached.
+func (s *cachedRawStore) ReadRawRange(ctx context.Context, reference DataReference, offset, length int64) (io.ReadCloser, error) {
+	return ReadRawRange(ctx, s.RawStore, reference, offset, length)
+}
+
 // WriteRaw stores a raw byte array.
 func (s *cachedRawStore) WriteRaw(ctx context.Context, reference DataReference, size int64, opts Options, raw io.Reader) error {
 	ctx, span := otelutils.NewSpan(ctx, otelutils.BlobstoreClientTracer, "flytestdlib.storage.cachedRawStore/WriteRaw")
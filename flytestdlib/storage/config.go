@@ -40,6 +40,10 @@ var (
 			AuthType: "iam",
 		},
 		MultiContainerEnabled: false,
+		Native: NativeConfig{
+			PartSizeMegabytes: 16,
+			Concurrency:       8,
+		},
 	}
 )
 
@@ -63,6 +67,17 @@ type Config struct {
 	Limits            LimitsConfig     `json:"limits" pflag:",Sets limits for stores."`
 	DefaultHTTPClient HTTPClientConfig `json:"defaultHttpClient" pflag:",Sets the default http client config."`
 	SignedURL         SignedURLConfig  `json:"signedUrl" pflag:",Sets config for SignedURL."`
+	Native            NativeConfig     `json:"native" pflag:",Sets config for the native local and s3 raw stores."`
+}
+
+// NativeConfig configures the raw stores which access the local filesystem and S3 compatible endpoints directly
+// instead of through stow. Besides plain reads and writes, they support ranged reads, parallel multipart uploads and
+// server side copies.
+type NativeConfig struct {
+	// Enabled only applies to the local and s3 stow kinds, other kinds are always accessed through stow.
+	Enabled           bool  `json:"enabled" pflag:",Use the native raw store instead of stow for local and s3 storage."`
+	PartSizeMegabytes int64 `json:"partSizeMBs" pflag:",Size of the parts (in MBs) of multipart uploads and copies."`
+	Concurrency       int   `json:"concurrency" pflag:",Maximum number of parts transferred in parallel by a single upload or copy."`
 }
 
 // SignedURLConfig encapsulates configs specifically used for SignedURL behavior.
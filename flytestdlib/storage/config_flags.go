@@ -65,5 +65,8 @@ func (cfg Config) GetPFlagSet(prefix string) *pflag.FlagSet {
 	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "cache.target_gc_percent"), defaultConfig.Cache.TargetGCPercent, "Sets the garbage collection target percentage.")
 	cmdFlags.Int64(fmt.Sprintf("%v%v", prefix, "limits.maxDownloadMBs"), defaultConfig.Limits.GetLimitMegabytes, "Maximum allowed download size (in MBs) per call.")
 	cmdFlags.String(fmt.Sprintf("%v%v", prefix, "defaultHttpClient.timeout"), defaultConfig.DefaultHTTPClient.Timeout.String(), "Sets time out on the http client.")
+	cmdFlags.Bool(fmt.Sprintf("%v%v", prefix, "native.enabled"), defaultConfig.Native.Enabled, "Use the native raw store instead of stow for local and s3 storage.")
+	cmdFlags.Int64(fmt.Sprintf("%v%v", prefix, "native.partSizeMBs"), defaultConfig.Native.PartSizeMegabytes, "Size of the parts (in MBs) of multipart uploads and copies.")
+	cmdFlags.Int(fmt.Sprintf("%v%v", prefix, "native.concurrency"), defaultConfig.Native.Concurrency, "Maximum number of parts transferred in parallel by a single upload or copy.")
 	return cmdFlags
 }
@@ -0,0 +1,366 @@
+package storage
+
+import (
+	"context"
+	"crypto/md5" // #nosec
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	errs "github.com/pkg/errors"
+
+	"github.com/flyteorg/stow"
+	"github.com/flyteorg/stow/local"
+)
+
+// tempFilePrefix prefixes the temporary files data is written to before being moved in place.
+const tempFilePrefix = ".flyte-write-"
+
+// FileStore is a raw store backed by a local directory with a subdirectory per container, the same layout as the
+// local stow kind. Writes are atomic: data is written to a temporary file which is renamed once complete.
+type FileStore struct {
+	root                          string
+	baseContainer                 string
+	baseContainerFQN              DataReference
+	enableDynamicContainerLoading bool
+	limits                        LimitsConfig
+	metrics                       *stowMetrics
+}
+
+func newFileRawStore(_ context.Context, cfg *Config, cfgMap stow.ConfigMap, metrics *dataStoreMetrics) (RawStore, error) {
+	root, ok := cfgMap[local.ConfigKeyPath]
+	if !ok || root == "" {
+		return nil, fmt.Errorf("stow config [%v] is required for the local kind", local.ConfigKeyPath)
+	}
+
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	// Like stow, create the base container if it doesn't exist.
+	if err := os.MkdirAll(filepath.Join(root, cfg.InitContainer), 0755); err != nil {
+		return nil, fmt.Errorf("unable to initialize container [%v]. Error: %v", cfg.InitContainer, err)
+	}
+
+	return &FileStore{
+		root:                          root,
+		baseContainer:                 cfg.InitContainer,
+		baseContainerFQN:              fQNFn[local.Kind](cfg.InitContainer),
+		enableDynamicContainerLoading: cfg.MultiContainerEnabled,
+		limits:                        cfg.Limits,
+		metrics:                       metrics.stowMetrics,
+	}, nil
+}
+
+// path returns the directory of the container and the path of the referenced data.
+func (s *FileStore) path(ctx context.Context, reference DataReference) (containerDir, path string, err error) {
+	_, c, k, err := reference.Split()
+	if err != nil {
+		s.metrics.BadReference.Inc(ctx)
+		return "", "", err
+	}
+
+	if err := checkContainer(ctx, s.metrics, s.baseContainer, c, s.enableDynamicContainerLoading); err != nil {
+		return "", "", err
+	}
+
+	containerDir = filepath.Join(s.root, c)
+	path = filepath.Join(containerDir, filepath.FromSlash(k))
+	if filepath.Dir(containerDir) != s.root ||
+		(path != containerDir && !strings.HasPrefix(path, containerDir+string(filepath.Separator))) {
+		s.metrics.BadReference.Inc(ctx)
+		return "", "", fmt.Errorf("reference [%v] points outside of its container", reference)
+	}
+
+	return containerDir, path, nil
+}
+
+func (s *FileStore) Head(ctx context.Context, reference DataReference) (Metadata, error) {
+	_, p, err := s.path(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	t1 := s.metrics.HeadLatency.Start(ctx)
+	t2 := s.metrics.HeadLatencyHist.Start(ctx)
+	info, err := os.Stat(p)
+	t1.Stop()
+	t2.Stop()
+
+	if err == nil && !info.IsDir() {
+		return StowMetadata{
+			exists: true,
+			size:   info.Size(),
+			etag:   info.ModTime().String(),
+		}, nil
+	}
+
+	if err == nil || os.IsNotExist(err) {
+		return StowMetadata{exists: false}, nil
+	}
+
+	incFailureCounterForError(ctx, s.metrics.HeadFailure, err)
+	return StowMetadata{exists: false}, errs.Wrapf(err, "path:%v", p)
+}
+
+// List lists the data whose key starts with the key of the reference, in lexical order.
+func (s *FileStore) List(ctx context.Context, reference DataReference, maxItems int, cursor Cursor) ([]DataReference, Cursor, error) {
+	if cursor.cursorState == AtEndCursorState {
+		return nil, NewCursorAtEnd(), fmt.Errorf("Cursor cannot be at end for the List call")
+	}
+
+	containerDir, _, err := s.path(ctx, reference)
+	if err != nil {
+		return nil, NewCursorAtEnd(), err
+	}
+
+	scheme, container, prefix, err := reference.Split()
+	if err != nil {
+		return nil, NewCursorAtEnd(), err
+	}
+
+	// Only walk the deepest directory all matching keys are in.
+	dir := containerDir
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		dir = filepath.Join(containerDir, filepath.FromSlash(prefix[:i]))
+	}
+
+	t1 := s.metrics.ListLatency.Start(ctx)
+	t2 := s.metrics.ListLatencyHist.Start(ctx)
+	var keys []string
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if p == dir && os.IsNotExist(err) {
+				return filepath.SkipAll
+			}
+			return err
+		}
+
+		if d.IsDir() || strings.HasPrefix(d.Name(), tempFilePrefix) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(containerDir, p)
+		if err != nil {
+			return err
+		}
+
+		key := filepath.ToSlash(rel)
+		if strings.HasPrefix(key, prefix) && (cursor.cursorState == AtStartCursorState || key > cursor.customPosition) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	t1.Stop()
+	t2.Stop()
+
+	if err != nil {
+		incFailureCounterForError(ctx, s.metrics.ListFailure, err)
+		return nil, NewCursorAtEnd(), errs.Wrapf(err, "path:%v", dir)
+	}
+
+	// Keys are listed in lexical order like in S3, which differs from the walk order for keys such as a.txt and a/b.
+	sort.Strings(keys)
+	next := NewCursorAtEnd()
+	if maxItems > 0 && len(keys) > maxItems {
+		keys = keys[:maxItems]
+		next = NewCursorFromCustomPosition(keys[maxItems-1])
+	}
+
+	results := make([]DataReference, 0, len(keys))
+	for _, key := range keys {
+		results = append(results, NewDataReference(scheme, container, key))
+	}
+	return results, next, nil
+}
+
+func (s *FileStore) ReadRaw(ctx context.Context, reference DataReference) (io.ReadCloser, error) {
+	return s.ReadRawRange(ctx, reference, 0, -1)
+}
+
+// ReadRawRange retrieves length bytes of the referenced data starting at offset. A negative length reads up to the
+// end of the data.
+func (s *FileStore) ReadRawRange(ctx context.Context, reference DataReference, offset, length int64) (io.ReadCloser, error) {
+	_, p, err := s.path(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	t1 := s.metrics.ReadOpenLatency.Start(ctx)
+	t2 := s.metrics.ReadOpenLatencyHist.Start(ctx)
+	f, err := os.Open(p)
+	t1.Stop()
+	t2.Stop()
+
+	if err != nil {
+		incFailureCounterForError(ctx, s.metrics.ReadFailure, err)
+		return nil, errs.Wrapf(err, "path:%v", p)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	remaining := info.Size() - offset
+	if remaining < 0 {
+		remaining = 0
+	}
+	if length >= 0 && length < remaining {
+		remaining = length
+	}
+
+	if err := checkGetLimit(s.limits, remaining); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	if offset == 0 && length < 0 {
+		// The file is returned as is for the copy implementations which check for an io.Seeker.
+		return f, nil
+	}
+
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return limitReadCloser(f, length), nil
+}
+
+// WriteRaw stores a raw byte array. The md5 digest of the data is computed while writing and checked against the
+// FlyteContentMD5 metadata, if set, before the data is moved in place.
+func (s *FileStore) WriteRaw(ctx context.Context, reference DataReference, size int64, opts Options, raw io.Reader) error {
+	_, p, err := s.path(ctx, reference)
+	if err != nil {
+		return err
+	}
+
+	t1 := s.metrics.WriteLatency.Start(ctx)
+	t2 := s.metrics.WriteLatencyHist.Start(ctx)
+	err = writeFileAtomically(p, func(f *os.File) error {
+		digest := md5.New() // #nosec
+		n, err := io.Copy(io.MultiWriter(f, digest), raw)
+		if err != nil {
+			return err
+		}
+
+		if size > 0 && n != size {
+			return fmt.Errorf("wrote [%vb] instead of the expected [%vb]", n, size)
+		}
+
+		return verifyContentMD5(opts, encodeMD5(digest))
+	})
+	t1.Stop()
+	t2.Stop()
+
+	if err != nil {
+		incFailureCounterForError(ctx, s.metrics.WriteFailure, err)
+		return errs.Wrapf(err, "Failed to write data [%vb] to path [%v].", size, p)
+	}
+
+	return nil
+}
+
+// CopyRaw copies from source to destination. The copy is left to the kernel where the filesystem supports it.
+func (s *FileStore) CopyRaw(ctx context.Context, source, destination DataReference, _ Options) error {
+	_, src, err := s.path(ctx, source)
+	if err != nil {
+		return err
+	}
+
+	_, dst, err := s.path(ctx, destination)
+	if err != nil {
+		return err
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		incFailureCounterForError(ctx, s.metrics.ReadFailure, err)
+		return errs.Wrapf(err, "path:%v", src)
+	}
+	defer in.Close()
+
+	t1 := s.metrics.WriteLatency.Start(ctx)
+	t2 := s.metrics.WriteLatencyHist.Start(ctx)
+	err = writeFileAtomically(dst, func(f *os.File) error {
+		_, err := io.Copy(f, in)
+		return err
+	})
+	t1.Stop()
+	t2.Stop()
+
+	if err != nil {
+		incFailureCounterForError(ctx, s.metrics.WriteFailure, err)
+		return errs.Wrapf(err, "failed to copy [%v] to [%v]", src, dst)
+	}
+
+	return nil
+}
+
+// Delete removes the referenced data from the blob store.
+func (s *FileStore) Delete(ctx context.Context, reference DataReference) error {
+	_, p, err := s.path(ctx, reference)
+	if err != nil {
+		return err
+	}
+
+	defer s.metrics.DeleteLatency.Start(ctx).Stop()
+	defer s.metrics.DeleteLatencyHist.Start(ctx).Stop()
+
+	if err := os.Remove(p); err != nil {
+		incFailureCounterForError(ctx, s.metrics.DeleteFailure, err)
+		return errs.Wrapf(err, "failed to remove item at path %q", p)
+	}
+
+	return nil
+}
+
+func (s *FileStore) GetBaseContainerFQN(ctx context.Context) DataReference {
+	return s.baseContainerFQN
+}
+
+// CreateSignedURL is not supported for local files.
+func (s *FileStore) CreateSignedURL(ctx context.Context, reference DataReference, properties SignedURLProperties) (SignedURLResponse, error) {
+	return SignedURLResponse{}, fmt.Errorf("signed urls are not supported for local storage")
+}
+
+// writeFileAtomically writes a file through a temporary file in the same directory, which is renamed to the path once
+// written successfully and removed otherwise.
+func writeFileAtomically(path string, write func(f *os.File) error) (err error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), tempFilePrefix+"*")
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
+
+	if err = write(f); err != nil {
+		return err
+	}
+
+	// Temporary files are only readable by their owner.
+	if err = f.Chmod(0644); err != nil {
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
@@ -0,0 +1,188 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" // #nosec
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flyteorg/flyte/flytestdlib/promutils"
+)
+
+func newTestFileStore(t *testing.T, multiContainer bool) (*FileStore, string) {
+	root := t.TempDir()
+	store, err := newFileRawStore(context.Background(), &Config{
+		InitContainer:         "container",
+		MultiContainerEnabled: multiContainer,
+		Limits:                LimitsConfig{GetLimitMegabytes: 1},
+	}, map[string]string{"path": root}, metrics)
+	require.NoError(t, err)
+	return store.(*FileStore), root
+}
+
+// contents returns a function which reads the data returned by a read of a raw store.
+func contents(t *testing.T) func(rc io.ReadCloser, err error) string {
+	return func(rc io.ReadCloser, err error) string {
+		require.NoError(t, err)
+		defer rc.Close()
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		return string(data)
+	}
+}
+
+func base64MD5(data string) string {
+	sum := md5.Sum([]byte(data)) // #nosec
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	store, root := newTestFileStore(t, false)
+	read := contents(t)
+	assert.Equal(t, DataReference("file://container"), store.GetBaseContainerFQN(ctx))
+	assert.DirExists(t, filepath.Join(root, "container"))
+
+	ref := DataReference("file://container/a/b.txt")
+	t.Run("Write and read", func(t *testing.T) {
+		assert.NoError(t, store.WriteRaw(ctx, ref, 10, Options{}, strings.NewReader("0123456789")))
+		assert.FileExists(t, filepath.Join(root, "container", "a", "b.txt"))
+
+		assert.Equal(t, "0123456789", read(store.ReadRaw(ctx, ref)))
+		assert.Equal(t, "234", read(store.ReadRawRange(ctx, ref, 2, 3)))
+		assert.Equal(t, "789", read(store.ReadRawRange(ctx, ref, 7, -1)))
+		assert.Equal(t, "", read(store.ReadRawRange(ctx, ref, 20, 3)))
+
+		metadata, err := store.Head(ctx, ref)
+		assert.NoError(t, err)
+		assert.True(t, metadata.Exists())
+		assert.Equal(t, int64(10), metadata.Size())
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		metadata, err := store.Head(ctx, "file://container/missing")
+		assert.NoError(t, err)
+		assert.False(t, metadata.Exists())
+
+		_, err = store.ReadRaw(ctx, "file://container/missing")
+		assert.True(t, IsNotFound(err))
+	})
+
+	t.Run("Checksum", func(t *testing.T) {
+		checked := DataReference("file://container/checked")
+		opts := Options{Metadata: map[string]interface{}{FlyteContentMD5: base64MD5("data")}}
+		assert.NoError(t, store.WriteRaw(ctx, checked, 4, opts, strings.NewReader("data")))
+
+		err := store.WriteRaw(ctx, checked, 5, opts, strings.NewReader("other"))
+		assert.True(t, IsChecksumMismatch(err))
+		// The data written before is left in place.
+		assert.Equal(t, "data", read(store.ReadRaw(ctx, checked)))
+		entries, err := os.ReadDir(filepath.Join(root, "container"))
+		assert.NoError(t, err)
+		for _, entry := range entries {
+			assert.False(t, strings.HasPrefix(entry.Name(), tempFilePrefix))
+		}
+	})
+
+	t.Run("Size mismatch", func(t *testing.T) {
+		err := store.WriteRaw(ctx, "file://container/short", 10, Options{}, strings.NewReader("short"))
+		assert.Error(t, err)
+		assert.NoFileExists(t, filepath.Join(root, "container", "short"))
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		large := DataReference("file://container/large")
+		data := bytes.Repeat([]byte("x"), int(MiB)+1)
+		assert.NoError(t, store.WriteRaw(ctx, large, int64(len(data)), Options{}, bytes.NewReader(data)))
+
+		_, err := store.ReadRaw(ctx, large)
+		assert.True(t, IsExceedsLimit(err))
+		assert.Equal(t, "xx", read(store.ReadRawRange(ctx, large, 0, 2)))
+	})
+
+	t.Run("Copy", func(t *testing.T) {
+		dst := DataReference("file://container/copies/b.txt")
+		assert.NoError(t, store.CopyRaw(ctx, ref, dst, Options{}))
+		assert.Equal(t, "0123456789", read(store.ReadRaw(ctx, dst)))
+
+		err := store.CopyRaw(ctx, "file://container/missing", dst, Options{})
+		assert.True(t, IsNotFound(err))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		deleted := DataReference("file://container/deleted")
+		assert.NoError(t, store.WriteRaw(ctx, deleted, 1, Options{}, strings.NewReader("x")))
+		assert.NoError(t, store.Delete(ctx, deleted))
+		assert.NoFileExists(t, filepath.Join(root, "container", "deleted"))
+	})
+
+	t.Run("Containers", func(t *testing.T) {
+		_, err := store.ReadRaw(ctx, "file://other/a")
+		assert.True(t, IsNotFound(err))
+
+		_, err = store.ReadRaw(ctx, "file://container/../../etc/passwd")
+		assert.Error(t, err)
+
+		multi, _ := newTestFileStore(t, true)
+		assert.NoError(t, multi.WriteRaw(ctx, "file://other/a", 1, Options{}, strings.NewReader("x")))
+		assert.Equal(t, "x", read(multi.ReadRaw(ctx, "file://other/a")))
+	})
+}
+
+func TestFileStoreList(t *testing.T) {
+	ctx := context.Background()
+	store, _ := newTestFileStore(t, false)
+	for _, key := range []string{"a/b", "a.txt", "a/c/d", "b/e", "ab"} {
+		assert.NoError(t, store.WriteRaw(ctx, NewDataReference("file", "container", key), 1, Options{},
+			strings.NewReader("x")))
+	}
+
+	items, cursor, err := store.List(ctx, "file://container/a", 2, NewCursorAtStart())
+	assert.NoError(t, err)
+	assert.Equal(t, []DataReference{"file://container/a.txt", "file://container/a/b"}, items)
+	assert.False(t, IsCursorEnd(cursor))
+
+	items, cursor, err = store.List(ctx, "file://container/a", 2, cursor)
+	assert.NoError(t, err)
+	assert.Equal(t, []DataReference{"file://container/a/c/d", "file://container/ab"}, items)
+	assert.True(t, IsCursorEnd(cursor))
+
+	items, _, err = store.List(ctx, "file://container/a/c", 10, NewCursorAtStart())
+	assert.NoError(t, err)
+	assert.Equal(t, []DataReference{"file://container/a/c/d"}, items)
+
+	items, cursor, err = store.List(ctx, "file://container/missing/", 10, NewCursorAtStart())
+	assert.NoError(t, err)
+	assert.Empty(t, items)
+	assert.True(t, IsCursorEnd(cursor))
+}
+
+func TestNewDataStoreNative(t *testing.T) {
+	root := t.TempDir()
+	cfg := &Config{
+		Type:          TypeLocal,
+		InitContainer: "container",
+		Stow:          StowConfig{Kind: "local", Config: map[string]string{"path": root}},
+		Native:        NativeConfig{Enabled: true, PartSizeMegabytes: 1, Concurrency: 2},
+	}
+
+	store, err := NewDataStore(cfg, promutils.NewTestScope())
+	assert.NoError(t, err)
+	read := contents(t)
+	ctx := context.Background()
+	ref := DataReference("file://container/key")
+	assert.NoError(t, store.WriteRaw(ctx, ref, 5, Options{}, strings.NewReader("hello")))
+	assert.Equal(t, "ell", read(store.ReadRawRange(ctx, ref, 1, 3)))
+
+	cfg.Native.Concurrency = 0
+	_, err = NewDataStore(cfg, promutils.NewTestScope())
+	assert.Error(t, err)
+}
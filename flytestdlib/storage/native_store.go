@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"hash"
+
+	errs "github.com/pkg/errors"
+
+	"github.com/flyteorg/flyte/flytestdlib/errors"
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/stow"
+	"github.com/flyteorg/stow/local"
+	"github.com/flyteorg/stow/s3"
+)
+
+// newStowOrNativeRawStore creates a native raw store for the local and s3 stow kinds when enabled in the config, and a
+// stow raw store otherwise.
+func newStowOrNativeRawStore(ctx context.Context, cfg *Config, metrics *dataStoreMetrics) (RawStore, error) {
+	if !cfg.Native.Enabled {
+		return newStowRawStore(ctx, cfg, metrics)
+	}
+
+	if cfg.InitContainer == "" {
+		return nil, fmt.Errorf("initContainer is required even with `enable-multicontainer`")
+	}
+
+	if cfg.Native.PartSizeMegabytes <= 0 || cfg.Native.Concurrency <= 0 {
+		return nil, fmt.Errorf("native.partSizeMBs [%v] and native.concurrency [%v] must be positive",
+			cfg.Native.PartSizeMegabytes, cfg.Native.Concurrency)
+	}
+
+	kind, cfgMap := stowKindAndConfig(cfg)
+	switch kind {
+	case local.Kind:
+		return newFileRawStore(ctx, cfg, cfgMap, metrics)
+	case s3.Kind:
+		return newS3RawStore(ctx, cfg, cfgMap, metrics)
+	default:
+		logger.Infof(ctx, "No native raw store for stow kind [%s], using stow", kind)
+		return newStowRawStore(ctx, cfg, metrics)
+	}
+}
+
+// checkContainer fails for containers other than the base container unless dynamic container loading is enabled, the
+// same way the stow raw store does.
+func checkContainer(ctx context.Context, metrics *stowMetrics, baseContainer, container string,
+	enableDynamicContainerLoading bool) error {
+	if container == baseContainer || enableDynamicContainerLoading {
+		return nil
+	}
+
+	metrics.BadContainer.Inc(ctx)
+	return errs.Wrapf(stow.ErrNotFound, "Conf container:%v != Passed Container:%v. Dynamic loading is disabled",
+		baseContainer, container)
+}
+
+// checkGetLimit fails reads of more than the configured download limit.
+func checkGetLimit(limits LimitsConfig, sizeBytes int64) error {
+	if limits.GetLimitMegabytes != 0 && sizeBytes > limits.GetLimitMegabytes*MiB {
+		return errors.Errorf(ErrExceedsLimit, "limit exceeded. %.6fmb > %vmb. You can increase the limit by setting maxDownloadMBs.",
+			float64(sizeBytes)/float64(MiB), limits.GetLimitMegabytes)
+	}
+
+	return nil
+}
+
+// encodeMD5 encodes an md5 digest the way FlyteContentMD5 metadata and the Content-MD5 header hold it.
+func encodeMD5(digest hash.Hash) string {
+	return base64.StdEncoding.EncodeToString(digest.Sum(nil))
+}
+
+// verifyContentMD5 checks the digest of written data against the FlyteContentMD5 metadata, if set in the options.
+func verifyContentMD5(opts Options, contentMD5 string) error {
+	expected, ok := opts.Metadata[FlyteContentMD5].(string)
+	if !ok || expected == contentMD5 {
+		return nil
+	}
+
+	return errors.Errorf(ErrChecksumMismatch, "content md5 [%v] does not match the expected [%v]", contentMD5, expected)
+}
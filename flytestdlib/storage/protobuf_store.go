@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -32,6 +33,11 @@ type DefaultProtobufStore struct {
 	metrics *protoMetrics
 }
 
+// ReadRawRange retrieves a byte range of the referenced data from the underlying raw store.
+func (s DefaultProtobufStore) ReadRawRange(ctx context.Context, reference DataReference, offset, length int64) (io.ReadCloser, error) {
+	return ReadRawRange(ctx, s.RawStore, reference, offset, length)
+}
+
 func (s DefaultProtobufStore) ReadProtobuf(ctx context.Context, reference DataReference, msg proto.Message) error {
 	ctx, span := otelutils.NewSpan(ctx, otelutils.BlobstoreClientTracer, "flytestdlib.storage.DefaultProtobufStore/ReadProtobuf")
 	defer span.End()
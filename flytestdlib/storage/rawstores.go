@@ -12,10 +12,10 @@ type dataStoreCreateFn func(ctx context.Context, cfg *Config, metrics *dataStore
 
 var stores = map[string]dataStoreCreateFn{
 	TypeMemory: NewInMemoryRawStore,
-	TypeLocal:  newStowRawStore,
-	TypeMinio:  newStowRawStore,
-	TypeS3:     newStowRawStore,
-	TypeStow:   newStowRawStore,
+	TypeLocal:  newStowOrNativeRawStore,
+	TypeMinio:  newStowOrNativeRawStore,
+	TypeS3:     newStowOrNativeRawStore,
+	TypeStow:   newStowOrNativeRawStore,
 }
 
 type proxyTransport struct {
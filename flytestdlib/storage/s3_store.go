@@ -0,0 +1,727 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" // #nosec
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	s32 "github.com/aws/aws-sdk-go/service/s3"
+	errs "github.com/pkg/errors"
+
+	"github.com/flyteorg/flyte/flytestdlib/logger"
+	"github.com/flyteorg/stow"
+	"github.com/flyteorg/stow/s3"
+)
+
+const (
+	// maxUploadParts is the maximum number of parts of an S3 multipart upload.
+	maxUploadParts = 10000
+	// maxCopyObjectSize is the size of the largest object S3 copies in a single request.
+	maxCopyObjectSize = 5 * 1024 * MiB
+)
+
+// S3Store is a raw store which talks to S3 compatible endpoints directly. Data larger than a part is uploaded in
+// parallel parts, each checked against its md5 digest by the server, and the digest of the whole data is checked
+// against the FlyteContentMD5 metadata, if set, before the upload is completed. Like with the stow store, the
+// FlyteContentMD5 metadata is only stored when set in the options. Copies are done server side.
+type S3Store struct {
+	client                        *s32.S3
+	baseContainer                 string
+	baseContainerFQN              DataReference
+	enableDynamicContainerLoading bool
+	limits                        LimitsConfig
+	partSize                      int64
+	concurrency                   int
+	maxCopyObjectSize             int64
+	metrics                       *stowMetrics
+	copyMetrics                   *copyMetrics
+	// signedURLClient signs urls, with the signed url overrides of the stow config applied.
+	signedURLClient *s32.S3
+}
+
+func newS3RawStore(ctx context.Context, cfg *Config, cfgMap stow.ConfigMap, metrics *dataStoreMetrics) (RawStore, error) {
+	client, err := newS3Client(cfgMap)
+	if err != nil {
+		return nil, fmt.Errorf("unable to configure the storage for %s. Error: %v", s3.Kind, err)
+	}
+
+	signedURLClient := client
+	if len(cfg.SignedURL.StowConfigOverride) > 0 {
+		signedURLCfgMap := make(stow.ConfigMap, len(cfgMap))
+		MergeMaps(signedURLCfgMap, cfgMap, cfg.SignedURL.StowConfigOverride)
+		if signedURLClient, err = newS3Client(signedURLCfgMap); err != nil {
+			return nil, fmt.Errorf("unable to configure the storage for %s. Error: %v", s3.Kind, err)
+		}
+	}
+
+	store := &S3Store{
+		client:                        client,
+		signedURLClient:               signedURLClient,
+		baseContainer:                 cfg.InitContainer,
+		baseContainerFQN:              fQNFn[s3.Kind](cfg.InitContainer),
+		enableDynamicContainerLoading: cfg.MultiContainerEnabled,
+		limits:                        cfg.Limits,
+		partSize:                      cfg.Native.PartSizeMegabytes * MiB,
+		concurrency:                   cfg.Native.Concurrency,
+		maxCopyObjectSize:             maxCopyObjectSize,
+		metrics:                       metrics.stowMetrics,
+		copyMetrics:                   metrics.copyMetrics,
+	}
+
+	if err := store.ensureBucket(ctx, cfg.InitContainer); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// newS3Client creates a client from the config of the s3 stow kind, the same way stow does.
+func newS3Client(cfgMap stow.ConfigMap) (*s32.S3, error) {
+	awsConfig := aws.NewConfig().
+		WithHTTPClient(http.DefaultClient).
+		WithMaxRetries(aws.UseServiceDefaultRetries)
+
+	if region := cfgMap[s3.ConfigRegion]; region != "" {
+		awsConfig.WithRegion(region)
+	} else {
+		awsConfig.WithRegion("us-east-1")
+	}
+
+	if authType := cfgMap[s3.ConfigAuthType]; authType == "" || authType == "accesskey" {
+		awsConfig.WithCredentials(credentials.NewStaticCredentials(cfgMap[s3.ConfigAccessKeyID],
+			cfgMap[s3.ConfigSecretKey], cfgMap[s3.ConfigToken]))
+	}
+
+	if endpoint := cfgMap[s3.ConfigEndpoint]; endpoint != "" {
+		awsConfig.WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+	}
+
+	if strings.EqualFold(cfgMap[s3.ConfigDisableSSL], "true") {
+		awsConfig.WithDisableSSL(true)
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return s32.New(sess), nil
+}
+
+// ensureBucket creates the bucket if it doesn't exist.
+func (s *S3Store) ensureBucket(ctx context.Context, bucket string) error {
+	_, err := s.client.HeadBucketWithContext(ctx, &s32.HeadBucketInput{Bucket: aws.String(bucket)})
+	if err == nil {
+		return nil
+	}
+
+	if !awsIsNotFound(err) {
+		logger.Errorf(ctx, "Container [%s] lookup failed. Error %s", bucket, err)
+		return err
+	}
+
+	logger.Infof(ctx, "Attempting to create container [%s]", bucket)
+	_, err = s.client.CreateBucketWithContext(ctx, &s32.CreateBucketInput{Bucket: aws.String(bucket)})
+	if err != nil && !awsBucketAlreadyExists(err) {
+		return fmt.Errorf("unable to initialize container [%v]. Error: %v", bucket, err)
+	}
+
+	return nil
+}
+
+// split returns the bucket and key of the reference.
+func (s *S3Store) split(ctx context.Context, reference DataReference) (bucket, key string, err error) {
+	_, bucket, key, err = reference.Split()
+	if err != nil {
+		s.metrics.BadReference.Inc(ctx)
+		return "", "", err
+	}
+
+	return bucket, key, checkContainer(ctx, s.metrics, s.baseContainer, bucket, s.enableDynamicContainerLoading)
+}
+
+func (s *S3Store) Head(ctx context.Context, reference DataReference) (Metadata, error) {
+	bucket, key, err := s.split(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	t1 := s.metrics.HeadLatency.Start(ctx)
+	t2 := s.metrics.HeadLatencyHist.Start(ctx)
+	out, err := s.client.HeadObjectWithContext(ctx, &s32.HeadObjectInput{Bucket: aws.String(bucket), Key: aws.String(key)})
+	t1.Stop()
+	t2.Stop()
+
+	if err == nil {
+		return StowMetadata{
+			exists:     true,
+			size:       aws.Int64Value(out.ContentLength),
+			etag:       aws.StringValue(out.ETag),
+			contentMD5: metadataValue(out.Metadata, FlyteContentMD5),
+		}, nil
+	}
+
+	if awsIsNotFound(err) {
+		return StowMetadata{exists: false}, nil
+	}
+
+	incFailureCounterForError(ctx, s.metrics.HeadFailure, err)
+	return StowMetadata{exists: false}, errs.Wrapf(err, "path:%v", key)
+}
+
+func (s *S3Store) List(ctx context.Context, reference DataReference, maxItems int, cursor Cursor) ([]DataReference, Cursor, error) {
+	if cursor.cursorState == AtEndCursorState {
+		return nil, NewCursorAtEnd(), fmt.Errorf("Cursor cannot be at end for the List call")
+	}
+
+	scheme, bucket, prefix, err := reference.Split()
+	if err != nil {
+		s.metrics.BadReference.Inc(ctx)
+		return nil, NewCursorAtEnd(), err
+	}
+
+	if err := checkContainer(ctx, s.metrics, s.baseContainer, bucket, s.enableDynamicContainerLoading); err != nil {
+		return nil, NewCursorAtEnd(), err
+	}
+
+	input := &s32.ListObjectsV2Input{Bucket: aws.String(bucket), Prefix: aws.String(prefix)}
+	if maxItems > 0 {
+		input.MaxKeys = aws.Int64(int64(maxItems))
+	}
+	if cursor.cursorState == AtCustomPosCursorState {
+		input.ContinuationToken = aws.String(cursor.customPosition)
+	}
+
+	t1 := s.metrics.ListLatency.Start(ctx)
+	t2 := s.metrics.ListLatencyHist.Start(ctx)
+	out, err := s.client.ListObjectsV2WithContext(ctx, input)
+	t1.Stop()
+	t2.Stop()
+
+	if err != nil {
+		incFailureCounterForError(ctx, s.metrics.ListFailure, err)
+		return nil, NewCursorAtEnd(), errs.Wrapf(err, "path:%v", prefix)
+	}
+
+	results := make([]DataReference, 0, len(out.Contents))
+	for _, object := range out.Contents {
+		results = append(results, NewDataReference(scheme, bucket, aws.StringValue(object.Key)))
+	}
+
+	if aws.BoolValue(out.IsTruncated) {
+		return results, NewCursorFromCustomPosition(aws.StringValue(out.NextContinuationToken)), nil
+	}
+	return results, NewCursorAtEnd(), nil
+}
+
+func (s *S3Store) ReadRaw(ctx context.Context, reference DataReference) (io.ReadCloser, error) {
+	return s.ReadRawRange(ctx, reference, 0, -1)
+}
+
+// ReadRawRange retrieves length bytes of the referenced data starting at offset. A negative length reads up to the
+// end of the data.
+func (s *S3Store) ReadRawRange(ctx context.Context, reference DataReference, offset, length int64) (io.ReadCloser, error) {
+	bucket, key, err := s.split(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	if length == 0 {
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	}
+
+	input := &s32.GetObjectInput{Bucket: aws.String(bucket), Key: aws.String(key)}
+	if length > 0 {
+		input.Range = aws.String(fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	} else if offset > 0 {
+		input.Range = aws.String(fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	t1 := s.metrics.ReadOpenLatency.Start(ctx)
+	t2 := s.metrics.ReadOpenLatencyHist.Start(ctx)
+	out, err := s.client.GetObjectWithContext(ctx, input)
+	t1.Stop()
+	t2.Stop()
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "InvalidRange" {
+			// The range starts after the end of the data.
+			return io.NopCloser(bytes.NewReader(nil)), nil
+		}
+
+		incFailureCounterForError(ctx, s.metrics.ReadFailure, err)
+		if awsIsNotFound(err) {
+			return nil, errs.Wrapf(stow.ErrNotFound, "path:%v, %v", key, err)
+		}
+		return nil, errs.Wrapf(err, "path:%v", key)
+	}
+
+	if err := checkGetLimit(s.limits, aws.Int64Value(out.ContentLength)); err != nil {
+		_ = out.Body.Close()
+		return nil, err
+	}
+
+	return out.Body, nil
+}
+
+// WriteRaw stores a raw byte array. Data which fits in a part is uploaded in a single request, larger data in parallel
+// parts. The size, when known, is only used to grow the parts to fit the data in the maximum number of parts.
+func (s *S3Store) WriteRaw(ctx context.Context, reference DataReference, size int64, opts Options, raw io.Reader) error {
+	bucket, key, err := s.split(ctx, reference)
+	if err != nil {
+		return err
+	}
+
+	t1 := s.metrics.WriteLatency.Start(ctx)
+	t2 := s.metrics.WriteLatencyHist.Start(ctx)
+	err = s.write(ctx, bucket, key, size, opts, raw)
+	t1.Stop()
+	t2.Stop()
+
+	if err != nil {
+		incFailureCounterForError(ctx, s.metrics.WriteFailure, err)
+		return errs.Wrapf(err, "Failed to write data [%vb] to path [%v].", size, key)
+	}
+
+	return nil
+}
+
+func (s *S3Store) write(ctx context.Context, bucket, key string, size int64, opts Options, raw io.Reader) error {
+	metadata, err := s3Metadata(opts.Metadata)
+	if err != nil {
+		return err
+	}
+
+	partSize := s.partSizeFor(size)
+	digest := md5.New() // #nosec
+	reader := io.TeeReader(raw, digest)
+	first, err := readPart(reader, partSize)
+	if err != nil {
+		return err
+	}
+
+	if int64(len(first)) < partSize {
+		contentMD5 := encodeMD5(digest)
+		if err := verifyContentMD5(opts, contentMD5); err != nil {
+			return err
+		}
+
+		_, err := s.client.PutObjectWithContext(ctx, &s32.PutObjectInput{
+			Bucket:     aws.String(bucket),
+			Key:        aws.String(key),
+			Body:       bytes.NewReader(first),
+			ContentMD5: aws.String(contentMD5),
+			Metadata:   metadata,
+		})
+		return err
+	}
+
+	return s.multipartUpload(ctx, bucket, key, opts, metadata, first, reader, digest, partSize)
+}
+
+// multipartUpload uploads the data in parallel parts starting with the already read first part. The digest is
+// computed from the data as it is read.
+func (s *S3Store) multipartUpload(ctx context.Context, bucket, key string, opts Options, metadata map[string]*string,
+	first []byte, reader io.Reader, digest hash.Hash, partSize int64) error {
+	upload, err := s.client.CreateMultipartUploadWithContext(ctx, &s32.CreateMultipartUploadInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		Metadata: metadata,
+	})
+	if err != nil {
+		return err
+	}
+
+	transfer := newPartTransfer(ctx, s.concurrency)
+	part := first
+	for number := int64(1); len(part) > 0; number++ {
+		if number > maxUploadParts {
+			transfer.fail(fmt.Errorf("data exceeds %v parts of [%vb]", maxUploadParts, partSize))
+			break
+		}
+
+		data := part
+		started := transfer.start(number, func(ctx context.Context) (*string, error) {
+			partMD5 := md5.New() // #nosec
+			partMD5.Write(data)
+			out, err := s.client.UploadPartWithContext(ctx, &s32.UploadPartInput{
+				Bucket:     aws.String(bucket),
+				Key:        aws.String(key),
+				UploadId:   upload.UploadId,
+				PartNumber: aws.Int64(number),
+				Body:       bytes.NewReader(data),
+				ContentMD5: aws.String(encodeMD5(partMD5)),
+			})
+			if err != nil {
+				return nil, err
+			}
+			return out.ETag, nil
+		})
+		if !started {
+			break
+		}
+
+		if part, err = readPart(reader, partSize); err != nil {
+			transfer.fail(err)
+			break
+		}
+	}
+
+	parts, err := transfer.wait()
+	if err == nil {
+		err = verifyContentMD5(opts, encodeMD5(digest))
+	}
+
+	return s.completeUpload(ctx, bucket, key, upload.UploadId, parts, err)
+}
+
+// CopyRaw copies from source to destination server side. Objects larger than S3 copies in a single request are copied
+// in parallel parts. The metadata of the source is kept unless the options set metadata.
+func (s *S3Store) CopyRaw(ctx context.Context, source, destination DataReference, opts Options) error {
+	srcBucket, srcKey, err := s.split(ctx, source)
+	if err != nil {
+		return err
+	}
+
+	dstBucket, dstKey, err := s.split(ctx, destination)
+	if err != nil {
+		return err
+	}
+
+	t := s.copyMetrics.CopyLatency.Start(ctx)
+	err = s.copy(ctx, srcBucket, srcKey, dstBucket, dstKey, opts)
+	t.Stop()
+
+	if err != nil {
+		incFailureCounterForError(ctx, s.metrics.WriteFailure, err)
+		if awsIsNotFound(err) {
+			return errs.Wrapf(stow.ErrNotFound, "failed to copy [%v] to [%v], %v", source, destination, err)
+		}
+		return errs.Wrapf(err, "failed to copy [%v] to [%v]", source, destination)
+	}
+
+	return nil
+}
+
+func (s *S3Store) copy(ctx context.Context, srcBucket, srcKey, dstBucket, dstKey string, opts Options) error {
+	metadata, err := s3Metadata(opts.Metadata)
+	if err != nil {
+		return err
+	}
+
+	head, err := s.client.HeadObjectWithContext(ctx, &s32.HeadObjectInput{
+		Bucket: aws.String(srcBucket),
+		Key:    aws.String(srcKey),
+	})
+	if err != nil {
+		return err
+	}
+
+	copySource := (&url.URL{Path: srcBucket + "/" + srcKey}).EscapedPath()
+	size := aws.Int64Value(head.ContentLength)
+	if size <= s.maxCopyObjectSize {
+		input := &s32.CopyObjectInput{
+			Bucket:     aws.String(dstBucket),
+			Key:        aws.String(dstKey),
+			CopySource: aws.String(copySource),
+		}
+		if len(metadata) > 0 {
+			input.MetadataDirective = aws.String(s32.MetadataDirectiveReplace)
+			input.Metadata = metadata
+		}
+
+		_, err := s.client.CopyObjectWithContext(ctx, input)
+		return err
+	}
+
+	// Unlike single request copies, multipart copies don't carry the metadata of the source over.
+	if len(metadata) == 0 {
+		metadata = head.Metadata
+	}
+
+	upload, err := s.client.CreateMultipartUploadWithContext(ctx, &s32.CreateMultipartUploadInput{
+		Bucket:   aws.String(dstBucket),
+		Key:      aws.String(dstKey),
+		Metadata: metadata,
+	})
+	if err != nil {
+		return err
+	}
+
+	partSize := s.partSizeFor(size)
+	transfer := newPartTransfer(ctx, s.concurrency)
+	for number, offset := int64(1), int64(0); offset < size; number, offset = number+1, offset+partSize {
+		copyRange := fmt.Sprintf("bytes=%d-%d", offset, min(offset+partSize, size)-1)
+		started := transfer.start(number, func(ctx context.Context) (*string, error) {
+			out, err := s.client.UploadPartCopyWithContext(ctx, &s32.UploadPartCopyInput{
+				Bucket:          aws.String(dstBucket),
+				Key:             aws.String(dstKey),
+				UploadId:        upload.UploadId,
+				PartNumber:      aws.Int64(number),
+				CopySource:      aws.String(copySource),
+				CopySourceRange: aws.String(copyRange),
+			})
+			if err != nil {
+				return nil, err
+			}
+			return out.CopyPartResult.ETag, nil
+		})
+		if !started {
+			break
+		}
+	}
+
+	parts, err := transfer.wait()
+	return s.completeUpload(ctx, dstBucket, dstKey, upload.UploadId, parts, err)
+}
+
+// completeUpload completes a multipart upload of the given parts, or aborts it if the transfer of the parts failed.
+func (s *S3Store) completeUpload(ctx context.Context, bucket, key string, uploadID *string,
+	parts []*s32.CompletedPart, transferErr error) error {
+	err := transferErr
+	if err == nil {
+		_, err = s.client.CompleteMultipartUploadWithContext(ctx, &s32.CompleteMultipartUploadInput{
+			Bucket:          aws.String(bucket),
+			Key:             aws.String(key),
+			UploadId:        uploadID,
+			MultipartUpload: &s32.CompletedMultipartUpload{Parts: parts},
+		})
+	}
+
+	if err != nil {
+		// Abort even when the transfer was cancelled, so that the parts don't linger in the bucket.
+		_, abortErr := s.client.AbortMultipartUploadWithContext(context.WithoutCancel(ctx), &s32.AbortMultipartUploadInput{
+			Bucket:   aws.String(bucket),
+			Key:      aws.String(key),
+			UploadId: uploadID,
+		})
+		if abortErr != nil {
+			logger.Warnf(ctx, "Failed to abort multipart upload [%v] of [%v]. Error: %v", aws.StringValue(uploadID), key,
+				abortErr)
+		}
+	}
+
+	return err
+}
+
+// Delete removes the referenced data from the blob store.
+func (s *S3Store) Delete(ctx context.Context, reference DataReference) error {
+	bucket, key, err := s.split(ctx, reference)
+	if err != nil {
+		return err
+	}
+
+	defer s.metrics.DeleteLatency.Start(ctx).Stop()
+	defer s.metrics.DeleteLatencyHist.Start(ctx).Stop()
+
+	if _, err := s.client.DeleteObjectWithContext(ctx, &s32.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}); err != nil {
+		incFailureCounterForError(ctx, s.metrics.DeleteFailure, err)
+		return errs.Wrapf(err, "failed to remove item at path %q from container", key)
+	}
+
+	return nil
+}
+
+func (s *S3Store) GetBaseContainerFQN(ctx context.Context) DataReference {
+	return s.baseContainerFQN
+}
+
+func (s *S3Store) CreateSignedURL(ctx context.Context, reference DataReference, properties SignedURLProperties) (SignedURLResponse, error) {
+	_, bucket, key, err := reference.Split()
+	if err != nil {
+		return SignedURLResponse{}, err
+	}
+
+	var req *request.Request
+	requestHeaders := map[string]string{}
+	switch properties.Scope {
+	case stow.ClientMethodGet:
+		req, _ = s.signedURLClient.GetObjectRequest(&s32.GetObjectInput{Bucket: aws.String(bucket), Key: aws.String(key)})
+	case stow.ClientMethodPut:
+		input := &s32.PutObjectInput{Bucket: aws.String(bucket), Key: aws.String(key)}
+		if len(properties.ContentMD5) > 0 {
+			input.ContentMD5 = aws.String(properties.ContentMD5)
+			requestHeaders["Content-MD5"] = properties.ContentMD5
+			if properties.AddContentMD5Metadata {
+				input.Metadata = map[string]*string{FlyteContentMD5: aws.String(properties.ContentMD5)}
+				requestHeaders[fmt.Sprintf("x-amz-meta-%s", FlyteContentMD5)] = properties.ContentMD5
+			}
+		}
+		req, _ = s.signedURLClient.PutObjectRequest(input)
+	default:
+		return SignedURLResponse{}, fmt.Errorf("unsupported client method [%v]", properties.Scope.String())
+	}
+
+	req.SetContext(ctx)
+	signedURL, err := req.Presign(properties.ExpiresIn)
+	if err != nil {
+		return SignedURLResponse{}, err
+	}
+
+	urlVal, err := url.Parse(signedURL)
+	if err != nil {
+		return SignedURLResponse{}, err
+	}
+
+	return SignedURLResponse{
+		URL:                    *urlVal,
+		RequiredRequestHeaders: requestHeaders,
+	}, nil
+}
+
+// partSizeFor returns the part size to upload data of the given size with, grown when needed to fit the data in the
+// maximum number of parts.
+func (s *S3Store) partSizeFor(size int64) int64 {
+	if minPartSize := (size + maxUploadParts - 1) / maxUploadParts; minPartSize > s.partSize {
+		return minPartSize
+	}
+
+	return s.partSize
+}
+
+// readPart reads up to partSize bytes. Fewer bytes are only returned at the end of the data.
+func readPart(reader io.Reader, partSize int64) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if _, err := io.CopyN(buf, reader, partSize); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// s3Metadata converts metadata to S3 user metadata, which only holds strings.
+func s3Metadata(metadata map[string]interface{}) (map[string]*string, error) {
+	m := make(map[string]*string, len(metadata))
+	for key, value := range metadata {
+		strValue, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("value of key [%s] in metadata must be of type string", key)
+		}
+		m[key] = aws.String(strValue)
+	}
+
+	return m, nil
+}
+
+// metadataValue looks up a metadata value. The keys of S3 user metadata are returned in canonical header form.
+func metadataValue(metadata map[string]*string, key string) string {
+	for k, v := range metadata {
+		if strings.EqualFold(k, key) {
+			return aws.StringValue(v)
+		}
+	}
+
+	return ""
+}
+
+// awsIsNotFound checks if the error is an AWS S3 not found error for an object or a bucket.
+func awsIsNotFound(err error) bool {
+	if reqErr, ok := errs.Cause(err).(awserr.RequestFailure); ok && reqErr.StatusCode() == http.StatusNotFound {
+		return true
+	}
+
+	if awsErr, ok := errs.Cause(err).(awserr.Error); ok {
+		switch awsErr.Code() {
+		case s32.ErrCodeNoSuchKey, s32.ErrCodeNoSuchBucket, "NotFound":
+			return true
+		}
+	}
+
+	return false
+}
+
+// partTransfer transfers the parts of a multipart upload in the background, at most concurrency at a time, and stops
+// at the first failure.
+type partTransfer struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	slots  chan struct{}
+	wg     sync.WaitGroup
+	mutex  sync.Mutex
+	parts  []*s32.CompletedPart
+	err    error
+}
+
+func newPartTransfer(ctx context.Context, concurrency int) *partTransfer {
+	ctx, cancel := context.WithCancel(ctx)
+	return &partTransfer{
+		ctx:    ctx,
+		cancel: cancel,
+		slots:  make(chan struct{}, concurrency),
+	}
+}
+
+// start transfers a part once a slot is free. It returns false, without transferring the part, once a transfer failed.
+func (t *partTransfer) start(number int64, transfer func(ctx context.Context) (etag *string, err error)) bool {
+	select {
+	case t.slots <- struct{}{}:
+	case <-t.ctx.Done():
+		t.fail(t.ctx.Err())
+		return false
+	}
+
+	if t.failed() {
+		<-t.slots
+		return false
+	}
+
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		defer func() { <-t.slots }()
+
+		etag, err := transfer(t.ctx)
+		if err != nil {
+			t.fail(fmt.Errorf("failed to transfer part [%v]: %w", number, err))
+			return
+		}
+
+		t.mutex.Lock()
+		defer t.mutex.Unlock()
+		t.parts = append(t.parts, &s32.CompletedPart{ETag: etag, PartNumber: aws.Int64(number)})
+	}()
+	return true
+}
+
+// fail records the first failure and cancels the transfers in flight.
+func (t *partTransfer) fail(err error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.err == nil {
+		t.err = err
+		t.cancel()
+	}
+}
+
+func (t *partTransfer) failed() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.err != nil
+}
+
+// wait waits for the started transfers and returns the transferred parts in order.
+func (t *partTransfer) wait() ([]*s32.CompletedPart, error) {
+	t.wg.Wait()
+	t.cancel()
+
+	sort.Slice(t.parts, func(i, j int) bool {
+		return aws.Int64Value(t.parts[i].PartNumber) < aws.Int64Value(t.parts[j].PartNumber)
+	})
+	return t.parts, t.err
+}
@@ -0,0 +1,667 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" // #nosec
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/flyteorg/stow"
+)
+
+type fakeObject struct {
+	data     []byte
+	metadata map[string]string
+}
+
+type fakeUpload struct {
+	bucket   string
+	key      string
+	metadata map[string]string
+	parts    map[int][]byte
+}
+
+// fakeS3 is an in-process stand-in for an S3 compatible endpoint, serving the path style requests of the S3 store.
+type fakeS3 struct {
+	mutex      sync.Mutex
+	buckets    map[string]map[string]fakeObject
+	uploads    map[string]*fakeUpload
+	nextUpload int
+	operations []string
+
+	// partDelay slows down part uploads so that they overlap.
+	partDelay time.Duration
+	// failPart fails the upload of the part with this number.
+	failPart    int
+	inFlight    int32
+	maxInFlight int32
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{
+		buckets: map[string]map[string]fakeObject{},
+		uploads: map[string]*fakeUpload{},
+	}
+}
+
+func etag(data []byte) string {
+	sum := md5.Sum(data) // #nosec
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+func writeXML(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_ = xml.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	writeXML(w, status, struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string
+		Message string
+	}{Code: code, Message: code})
+}
+
+func requestMetadata(r *http.Request) map[string]string {
+	metadata := map[string]string{}
+	for key, values := range r.Header {
+		if strings.HasPrefix(strings.ToLower(key), "x-amz-meta-") {
+			metadata[strings.ToLower(key[len("x-amz-meta-"):])] = values[0]
+		}
+	}
+	return metadata
+}
+
+func (f *fakeS3) record(operation string) {
+	f.operations = append(f.operations, operation)
+}
+
+// object returns the object referenced by a copy source header.
+func (f *fakeS3) copySource(r *http.Request) (fakeObject, bool) {
+	source, err := url.PathUnescape(r.Header.Get("X-Amz-Copy-Source"))
+	if err != nil {
+		return fakeObject{}, false
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(source, "/"), "/", 2)
+	object, ok := f.buckets[parts[0]][parts[1]]
+	return object, ok
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	bucket := path[0]
+	query := r.URL.Query()
+	body, _ := io.ReadAll(r.Body)
+
+	if r.Method == http.MethodPut && query.Get("partNumber") != "" && r.Header.Get("X-Amz-Copy-Source") == "" {
+		// Parts are uploaded without holding the lock, so that they can overlap.
+		f.uploadPart(w, r, body)
+		return
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(path) == 1 || path[1] == "" {
+		f.serveBucket(w, r, bucket)
+		return
+	}
+
+	objects, ok := f.buckets[bucket]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	key := path[1]
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		f.record("CreateMultipartUpload")
+		f.nextUpload++
+		id := strconv.Itoa(f.nextUpload)
+		f.uploads[id] = &fakeUpload{bucket: bucket, key: key, metadata: requestMetadata(r), parts: map[int][]byte{}}
+		writeXML(w, http.StatusOK, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			Bucket   string
+			Key      string
+			UploadId string // nolint
+		}{Bucket: bucket, Key: key, UploadId: id})
+
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		f.record("CompleteMultipartUpload")
+		upload, ok := f.uploads[query.Get("uploadId")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+
+		var complete struct {
+			Parts []struct {
+				ETag       string
+				PartNumber int
+			} `xml:"Part"`
+		}
+		if err := xml.Unmarshal(body, &complete); err != nil {
+			writeError(w, http.StatusBadRequest, "MalformedXML")
+			return
+		}
+
+		var data []byte
+		for i, part := range complete.Parts {
+			partData, ok := upload.parts[part.PartNumber]
+			if part.PartNumber != i+1 || !ok || etag(partData) != part.ETag {
+				writeError(w, http.StatusBadRequest, "InvalidPart")
+				return
+			}
+			data = append(data, partData...)
+		}
+
+		delete(f.uploads, query.Get("uploadId"))
+		objects[key] = fakeObject{data: data, metadata: upload.metadata}
+		writeXML(w, http.StatusOK, struct {
+			XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+			Bucket  string
+			Key     string
+			ETag    string
+		}{Bucket: bucket, Key: key, ETag: etag(data)})
+
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		f.record("AbortMultipartUpload")
+		delete(f.uploads, query.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+
+	case r.Method == http.MethodPut && r.Header.Get("X-Amz-Copy-Source") != "" && query.Has("uploadId"):
+		f.record("UploadPartCopy")
+		source, ok := f.copySource(r)
+		upload, uploadOk := f.uploads[query.Get("uploadId")]
+		if !ok || !uploadOk {
+			writeError(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+
+		var start, end int
+		if _, err := fmt.Sscanf(r.Header.Get("X-Amz-Copy-Source-Range"), "bytes=%d-%d", &start, &end); err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidArgument")
+			return
+		}
+
+		number, _ := strconv.Atoi(query.Get("partNumber"))
+		upload.parts[number] = append([]byte{}, source.data[start:end+1]...)
+		writeXML(w, http.StatusOK, struct {
+			XMLName xml.Name `xml:"CopyPartResult"`
+			ETag    string
+		}{ETag: etag(upload.parts[number])})
+
+	case r.Method == http.MethodPut && r.Header.Get("X-Amz-Copy-Source") != "":
+		f.record("CopyObject")
+		source, ok := f.copySource(r)
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+
+		metadata := source.metadata
+		if r.Header.Get("X-Amz-Metadata-Directive") == "REPLACE" {
+			metadata = requestMetadata(r)
+		}
+		objects[key] = fakeObject{data: source.data, metadata: metadata}
+		writeXML(w, http.StatusOK, struct {
+			XMLName xml.Name `xml:"CopyObjectResult"`
+			ETag    string
+		}{ETag: etag(source.data)})
+
+	case r.Method == http.MethodPut:
+		f.record("PutObject")
+		if contentMD5 := r.Header.Get("Content-MD5"); contentMD5 != "" && contentMD5 != base64MD5(string(body)) {
+			writeError(w, http.StatusBadRequest, "BadDigest")
+			return
+		}
+		objects[key] = fakeObject{data: body, metadata: requestMetadata(r)}
+		w.Header().Set("ETag", etag(body))
+
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		f.record(r.Method + "Object")
+		object, ok := objects[key]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+
+		for k, v := range object.metadata {
+			w.Header().Set("X-Amz-Meta-"+k, v)
+		}
+		w.Header().Set("ETag", etag(object.data))
+
+		data, status := object.data, http.StatusOK
+		if rng := r.Header.Get("Range"); rng != "" {
+			start, end := 0, len(data)-1
+			if _, err := fmt.Sscanf(rng, "bytes=%d-%d", &start, &end); err != nil && start == 0 {
+				writeError(w, http.StatusBadRequest, "InvalidArgument")
+				return
+			}
+			if start >= len(data) {
+				writeError(w, http.StatusRequestedRangeNotSatisfiable, "InvalidRange")
+				return
+			}
+			end = min(end, len(data)-1)
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+			data, status = data[start:end+1], http.StatusPartialContent
+		}
+
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(status)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+
+	case r.Method == http.MethodDelete:
+		f.record("DeleteObject")
+		delete(objects, key)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeError(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (f *fakeS3) serveBucket(w http.ResponseWriter, r *http.Request, bucket string) {
+	objects, ok := f.buckets[bucket]
+	switch {
+	case r.Method == http.MethodPut:
+		f.record("CreateBucket")
+		if !ok {
+			f.buckets[bucket] = map[string]fakeObject{}
+		}
+
+	case !ok:
+		writeError(w, http.StatusNotFound, "NoSuchBucket")
+
+	case r.Method == http.MethodHead:
+		f.record("HeadBucket")
+
+	case r.Method == http.MethodGet:
+		f.record("ListObjectsV2")
+		query := r.URL.Query()
+		var keys []string
+		for key := range objects {
+			if strings.HasPrefix(key, query.Get("prefix")) && key > query.Get("continuation-token") {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		type content struct {
+			Key  string
+			Size int
+		}
+		result := struct {
+			XMLName               xml.Name `xml:"ListBucketResult"`
+			Name                  string
+			IsTruncated           bool
+			NextContinuationToken string `xml:",omitempty"`
+			Contents              []content
+		}{Name: bucket}
+
+		if maxKeys, err := strconv.Atoi(query.Get("max-keys")); err == nil && len(keys) > maxKeys {
+			keys = keys[:maxKeys]
+			result.IsTruncated = true
+			result.NextContinuationToken = keys[maxKeys-1]
+		}
+		for _, key := range keys {
+			result.Contents = append(result.Contents, content{Key: key, Size: len(objects[key].data)})
+		}
+		writeXML(w, http.StatusOK, result)
+
+	default:
+		writeError(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (f *fakeS3) uploadPart(w http.ResponseWriter, r *http.Request, body []byte) {
+	inFlight := atomic.AddInt32(&f.inFlight, 1)
+	defer atomic.AddInt32(&f.inFlight, -1)
+	for {
+		maxInFlight := atomic.LoadInt32(&f.maxInFlight)
+		if inFlight <= maxInFlight || atomic.CompareAndSwapInt32(&f.maxInFlight, maxInFlight, inFlight) {
+			break
+		}
+	}
+	time.Sleep(f.partDelay)
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.record("UploadPart")
+
+	number, _ := strconv.Atoi(r.URL.Query().Get("partNumber"))
+	upload, ok := f.uploads[r.URL.Query().Get("uploadId")]
+	switch {
+	case !ok:
+		writeError(w, http.StatusNotFound, "NoSuchUpload")
+	case number == f.failPart:
+		writeError(w, http.StatusBadRequest, "InvalidRequest")
+	case r.Header.Get("Content-MD5") != base64MD5(string(body)):
+		writeError(w, http.StatusBadRequest, "BadDigest")
+	default:
+		upload.parts[number] = body
+		w.Header().Set("ETag", etag(body))
+	}
+}
+
+func (f *fakeS3) count(operation string) int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	count := 0
+	for _, o := range f.operations {
+		if o == operation {
+			count++
+		}
+	}
+	return count
+}
+
+func newTestS3Store(t *testing.T) (*S3Store, *fakeS3) {
+	fake := newFakeS3()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	store, err := newS3RawStore(context.Background(), &Config{
+		InitContainer: "bucket",
+		Limits:        LimitsConfig{GetLimitMegabytes: 1},
+		Native:        NativeConfig{Enabled: true, PartSizeMegabytes: 5, Concurrency: 4},
+	}, map[string]string{
+		"endpoint":      server.URL,
+		"auth_type":     "accesskey",
+		"access_key_id": "access",
+		"secret_key":    "secret",
+		"disable_ssl":   "true",
+	}, metrics)
+	require.NoError(t, err)
+
+	s3Store := store.(*S3Store)
+	// Keep the multipart tests small. Real endpoints require parts of at least 5MiB.
+	s3Store.partSize = 1024
+	return s3Store, fake
+}
+
+func TestS3Store(t *testing.T) {
+	ctx := context.Background()
+	store, fake := newTestS3Store(t)
+	read := contents(t)
+	assert.Equal(t, DataReference("s3://bucket"), store.GetBaseContainerFQN(ctx))
+	assert.Equal(t, 1, fake.count("CreateBucket"))
+
+	ref := DataReference("s3://bucket/a/b.txt")
+	t.Run("Write and read", func(t *testing.T) {
+		assert.NoError(t, store.WriteRaw(ctx, ref, 10, Options{}, strings.NewReader("0123456789")))
+		assert.Equal(t, 1, fake.count("PutObject"))
+
+		assert.Equal(t, "0123456789", read(store.ReadRaw(ctx, ref)))
+		assert.Equal(t, "234", read(store.ReadRawRange(ctx, ref, 2, 3)))
+		assert.Equal(t, "789", read(store.ReadRawRange(ctx, ref, 7, -1)))
+		assert.Equal(t, "89", read(store.ReadRawRange(ctx, ref, 8, 5)))
+		assert.Equal(t, "", read(store.ReadRawRange(ctx, ref, 20, 3)))
+
+		metadata, err := store.Head(ctx, ref)
+		assert.NoError(t, err)
+		assert.True(t, metadata.Exists())
+		assert.Equal(t, int64(10), metadata.Size())
+		// Like with the stow store, the content md5 is only stored when set in the options.
+		assert.Empty(t, metadata.ContentMD5())
+
+		opts := Options{Metadata: map[string]interface{}{FlyteContentMD5: base64MD5("0123456789")}}
+		assert.NoError(t, store.WriteRaw(ctx, ref, 10, opts, strings.NewReader("0123456789")))
+		metadata, err = store.Head(ctx, ref)
+		assert.NoError(t, err)
+		assert.Equal(t, base64MD5("0123456789"), metadata.ContentMD5())
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		metadata, err := store.Head(ctx, "s3://bucket/missing")
+		assert.NoError(t, err)
+		assert.False(t, metadata.Exists())
+
+		_, err = store.ReadRaw(ctx, "s3://bucket/missing")
+		assert.True(t, IsNotFound(err))
+
+		err = store.CopyRaw(ctx, "s3://bucket/missing", "s3://bucket/copy", Options{})
+		assert.True(t, IsNotFound(err))
+	})
+
+	t.Run("Checksum", func(t *testing.T) {
+		opts := Options{Metadata: map[string]interface{}{FlyteContentMD5: base64MD5("data")}}
+		err := store.WriteRaw(ctx, "s3://bucket/checked", 5, opts, strings.NewReader("other"))
+		assert.True(t, IsChecksumMismatch(err))
+
+		metadata, err := store.Head(ctx, "s3://bucket/checked")
+		assert.NoError(t, err)
+		assert.False(t, metadata.Exists())
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		large := DataReference("s3://bucket/large")
+		data := bytes.Repeat([]byte("x"), int(MiB)+1)
+		assert.NoError(t, store.WriteRaw(ctx, large, int64(len(data)), Options{}, bytes.NewReader(data)))
+
+		_, err := store.ReadRaw(ctx, large)
+		assert.True(t, IsExceedsLimit(err))
+		assert.Equal(t, "xx", read(store.ReadRawRange(ctx, large, 0, 2)))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		deleted := DataReference("s3://bucket/deleted")
+		assert.NoError(t, store.WriteRaw(ctx, deleted, 1, Options{}, strings.NewReader("x")))
+		assert.NoError(t, store.Delete(ctx, deleted))
+
+		metadata, err := store.Head(ctx, deleted)
+		assert.NoError(t, err)
+		assert.False(t, metadata.Exists())
+	})
+
+	t.Run("Containers", func(t *testing.T) {
+		_, err := store.ReadRaw(ctx, "s3://other/a")
+		assert.True(t, IsNotFound(err))
+	})
+
+	t.Run("Signed URL", func(t *testing.T) {
+		contentMD5 := base64MD5("data")
+		signed, err := store.CreateSignedURL(ctx, ref, SignedURLProperties{
+			Scope:                 stow.ClientMethodPut,
+			ExpiresIn:             time.Hour,
+			ContentMD5:            contentMD5,
+			AddContentMD5Metadata: true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "/bucket/a/b.txt", signed.URL.Path)
+		assert.NotEmpty(t, signed.URL.Query().Get("X-Amz-Signature"))
+		assert.Equal(t, map[string]string{
+			"Content-MD5":                contentMD5,
+			"x-amz-meta-flyteContentMD5": contentMD5,
+		}, signed.RequiredRequestHeaders)
+	})
+}
+
+func TestS3StoreSignedURLOverride(t *testing.T) {
+	server := httptest.NewServer(newFakeS3())
+	t.Cleanup(server.Close)
+
+	store, err := newS3RawStore(context.Background(), &Config{
+		InitContainer: "bucket",
+		Native:        NativeConfig{Enabled: true, PartSizeMegabytes: 5, Concurrency: 4},
+		SignedURL: SignedURLConfig{StowConfigOverride: map[string]string{
+			"endpoint": "http://signed.example.com",
+		}},
+	}, map[string]string{
+		"endpoint":      server.URL,
+		"auth_type":     "accesskey",
+		"access_key_id": "access",
+		"secret_key":    "secret",
+		"disable_ssl":   "true",
+	}, metrics)
+	require.NoError(t, err)
+
+	// Signed urls point at the overridden endpoint while the store keeps talking to the configured one.
+	signed, err := store.CreateSignedURL(context.Background(), "s3://bucket/a/b.txt", SignedURLProperties{
+		Scope:     stow.ClientMethodGet,
+		ExpiresIn: time.Hour,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "signed.example.com", signed.URL.Host)
+	assert.Equal(t, "/bucket/a/b.txt", signed.URL.Path)
+
+	metadata, err := store.Head(context.Background(), "s3://bucket/a/b.txt")
+	assert.NoError(t, err)
+	assert.False(t, metadata.Exists())
+}
+
+func TestS3StoreMultipartUpload(t *testing.T) {
+	ctx := context.Background()
+	read := contents(t)
+	data := bytes.Repeat([]byte("0123456789"), 1030)
+
+	t.Run("Parallel parts", func(t *testing.T) {
+		store, fake := newTestS3Store(t)
+		fake.partDelay = 20 * time.Millisecond
+		ref := DataReference("s3://bucket/large")
+		opts := Options{Metadata: map[string]interface{}{FlyteContentMD5: base64MD5(string(data)), "owner": "test"}}
+
+		assert.NoError(t, store.WriteRaw(ctx, ref, 0, opts, bytes.NewReader(data)))
+		assert.Equal(t, 11, fake.count("UploadPart"))
+		assert.Equal(t, 1, fake.count("CompleteMultipartUpload"))
+		assert.Greater(t, atomic.LoadInt32(&fake.maxInFlight), int32(1))
+		assert.LessOrEqual(t, atomic.LoadInt32(&fake.maxInFlight), int32(4))
+
+		assert.Equal(t, string(data), read(store.ReadRaw(ctx, ref)))
+		metadata, err := store.Head(ctx, ref)
+		assert.NoError(t, err)
+		assert.Equal(t, base64MD5(string(data)), metadata.ContentMD5())
+	})
+
+	t.Run("Without checksum", func(t *testing.T) {
+		store, _ := newTestS3Store(t)
+		ref := DataReference("s3://bucket/large")
+
+		assert.NoError(t, store.WriteRaw(ctx, ref, 0, Options{}, bytes.NewReader(data)))
+		metadata, err := store.Head(ctx, ref)
+		assert.NoError(t, err)
+		assert.Empty(t, metadata.ContentMD5())
+	})
+
+	t.Run("Checksum mismatch", func(t *testing.T) {
+		store, fake := newTestS3Store(t)
+		ref := DataReference("s3://bucket/large")
+		opts := Options{Metadata: map[string]interface{}{FlyteContentMD5: base64MD5("other")}}
+
+		err := store.WriteRaw(ctx, ref, 0, opts, bytes.NewReader(data))
+		assert.True(t, IsChecksumMismatch(err))
+		assert.Equal(t, 0, fake.count("CompleteMultipartUpload"))
+		assert.Equal(t, 1, fake.count("AbortMultipartUpload"))
+		assert.Empty(t, fake.uploads)
+
+		metadata, err := store.Head(ctx, ref)
+		assert.NoError(t, err)
+		assert.False(t, metadata.Exists())
+	})
+
+	t.Run("Part failure", func(t *testing.T) {
+		store, fake := newTestS3Store(t)
+		fake.failPart = 3
+		ref := DataReference("s3://bucket/large")
+
+		err := store.WriteRaw(ctx, ref, 0, Options{}, bytes.NewReader(data))
+		assert.Error(t, err)
+		assert.Equal(t, 1, fake.count("AbortMultipartUpload"))
+		assert.Empty(t, fake.uploads)
+
+		metadata, err := store.Head(ctx, ref)
+		assert.NoError(t, err)
+		assert.False(t, metadata.Exists())
+	})
+
+	t.Run("Part size grows with the size", func(t *testing.T) {
+		store, _ := newTestS3Store(t)
+		assert.Equal(t, int64(1024), store.partSizeFor(0))
+		assert.Equal(t, int64(1024), store.partSizeFor(1024*maxUploadParts))
+		assert.Equal(t, int64(1025), store.partSizeFor(1024*maxUploadParts+1))
+	})
+}
+
+func TestS3StoreCopy(t *testing.T) {
+	ctx := context.Background()
+	read := contents(t)
+	store, fake := newTestS3Store(t)
+	source := DataReference("s3://bucket/source")
+	data := bytes.Repeat([]byte("0123456789"), 300)
+	opts := Options{Metadata: map[string]interface{}{FlyteContentMD5: base64MD5(string(data)), "owner": "test"}}
+	require.NoError(t, store.WriteRaw(ctx, source, 0, opts, bytes.NewReader(data)))
+
+	t.Run("Single request", func(t *testing.T) {
+		destination := DataReference("s3://bucket/copy")
+		assert.NoError(t, store.CopyRaw(ctx, source, destination, Options{}))
+		assert.Equal(t, 1, fake.count("CopyObject"))
+		assert.Equal(t, 0, fake.count("GETObject"))
+
+		assert.Equal(t, string(data), read(store.ReadRaw(ctx, destination)))
+		metadata, err := store.Head(ctx, destination)
+		assert.NoError(t, err)
+		assert.Equal(t, base64MD5(string(data)), metadata.ContentMD5())
+	})
+
+	t.Run("Replace metadata", func(t *testing.T) {
+		destination := DataReference("s3://bucket/replaced")
+		assert.NoError(t, store.CopyRaw(ctx, source, destination,
+			Options{Metadata: map[string]interface{}{"owner": "other"}}))
+		assert.Equal(t, map[string]string{"owner": "other"}, fake.buckets["bucket"]["replaced"].metadata)
+	})
+
+	t.Run("Parts", func(t *testing.T) {
+		store.maxCopyObjectSize = 1024
+		defer func() { store.maxCopyObjectSize = maxCopyObjectSize }()
+
+		destination := DataReference("s3://bucket/parts")
+		assert.NoError(t, store.CopyRaw(ctx, source, destination, Options{}))
+		assert.Equal(t, 3, fake.count("UploadPartCopy"))
+
+		assert.Equal(t, string(data), read(store.ReadRaw(ctx, destination)))
+		assert.Equal(t, fake.buckets["bucket"]["source"].metadata, fake.buckets["bucket"]["parts"].metadata)
+	})
+}
+
+func TestS3StoreList(t *testing.T) {
+	ctx := context.Background()
+	store, _ := newTestS3Store(t)
+	for _, key := range []string{"a/b", "a.txt", "a/c/d", "b/e", "ab"} {
+		require.NoError(t, store.WriteRaw(ctx, NewDataReference("s3", "bucket", key), 1, Options{},
+			strings.NewReader("x")))
+	}
+
+	items, cursor, err := store.List(ctx, "s3://bucket/a", 3, NewCursorAtStart())
+	assert.NoError(t, err)
+	assert.Equal(t, []DataReference{"s3://bucket/a.txt", "s3://bucket/a/b", "s3://bucket/a/c/d"}, items)
+	assert.False(t, IsCursorEnd(cursor))
+
+	items, cursor, err = store.List(ctx, "s3://bucket/a", 3, cursor)
+	assert.NoError(t, err)
+	assert.Equal(t, []DataReference{"s3://bucket/ab"}, items)
+	assert.True(t, IsCursorEnd(cursor))
+
+	_, _, err = store.List(ctx, "s3://bucket/a", 3, cursor)
+	assert.Error(t, err)
+}
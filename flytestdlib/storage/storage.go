@@ -4,6 +4,8 @@
 // and In-Memory storage. Use NewCompositeDataStore to swap any portions of the DataStore interface with an external
 // implementation (e.g. a cached protobuf store). The underlying storage is provided by extensible "stow" library. You
 // can use NewStowRawStore(cfg) to create a Raw store based on any other stow-supported configs (e.g. Azure Blob Storage)
+// Local and S3 storage can instead be accessed natively by enabling the native config section, which adds ranged reads,
+// parallel multipart uploads and server side copies.
 package storage
 
 import (
@@ -134,6 +136,14 @@ type RawStore interface {
 	Delete(ctx context.Context, reference DataReference) error
 }
 
+// RangeReader is implemented by raw stores which natively support reading a byte range of the referenced data. Use
+// ReadRawRange to read a range from any raw store.
+type RangeReader interface {
+	// ReadRawRange retrieves length bytes of the referenced data starting at offset. A negative length reads up to the
+	// end of the data.
+	ReadRawRange(ctx context.Context, reference DataReference, offset, length int64) (io.ReadCloser, error)
+}
+
 //go:generate mockery --name ReferenceConstructor --case=underscore --with-expecter
 
 // ReferenceConstructor defines an interface for building data reference paths.
@@ -177,6 +187,12 @@ func (r DataReference) String() string {
 	return string(r)
 }
 
+// ReadRawRange retrieves length bytes of the referenced data starting at offset. A negative length reads up to the end
+// of the data.
+func (ds *DataStore) ReadRawRange(ctx context.Context, reference DataReference, offset, length int64) (io.ReadCloser, error) {
+	return ReadRawRange(ctx, ds.ComposedProtobufStore, reference, offset, length)
+}
+
 func NewDataReference(scheme string, container string, key string) DataReference {
 	return DataReference(fmt.Sprintf("%s://%s/%s", scheme, container, key))
 }
@@ -519,18 +519,7 @@ func newStowRawStore(_ context.Context, cfg *Config, metrics *dataStoreMetrics)
 		return nil, fmt.Errorf("initContainer is required even with `enable-multicontainer`")
 	}
 
-	var cfgMap stow.ConfigMap
-	var kind string
-	if len(cfg.Stow.Kind) > 0 && len(cfg.Stow.Config) > 0 {
-		kind = cfg.Stow.Kind
-		cfgMap = cfg.Stow.Config
-	} else {
-		logger.Warnf(context.TODO(), "stow configuration section missing, defaulting to legacy s3/minio connection config")
-		// This is for supporting legacy configurations which configure S3 via connection config
-		kind = s3.Kind
-		cfgMap = legacyS3ConfigMap(cfg.Connection)
-	}
-
+	kind, cfgMap := stowKindAndConfig(cfg)
 	fn, ok := fQNFn[kind]
 	if !ok {
 		return nil, errs.Errorf("unsupported stow.kind [%s], add support in flytestdlib?", kind)
@@ -554,6 +543,17 @@ func newStowRawStore(_ context.Context, cfg *Config, metrics *dataStoreMetrics)
 	return NewStowRawStore(fn(cfg.InitContainer), loc, signedURLLoc, cfg.MultiContainerEnabled, metrics)
 }
 
+// stowKindAndConfig returns the stow kind and config to access the storage with.
+func stowKindAndConfig(cfg *Config) (string, stow.ConfigMap) {
+	if len(cfg.Stow.Kind) > 0 && len(cfg.Stow.Config) > 0 {
+		return cfg.Stow.Kind, cfg.Stow.Config
+	}
+
+	logger.Warnf(context.TODO(), "stow configuration section missing, defaulting to legacy s3/minio connection config")
+	// This is for supporting legacy configurations which configure S3 via connection config
+	return s3.Kind, legacyS3ConfigMap(cfg.Connection)
+}
+
 func legacyS3ConfigMap(cfg ConnectionConfig) stow.ConfigMap {
 	// Non-nullable fields
 	stowConfig := stow.ConfigMap{
@@ -2,6 +2,8 @@ package storage
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"os"
 
 	"github.com/pkg/errors"
@@ -16,6 +18,7 @@ import (
 var (
 	ErrExceedsLimit       stdErrs.ErrorCode = "LIMIT_EXCEEDED"
 	ErrFailedToWriteCache stdErrs.ErrorCode = "CACHE_WRITE_FAILED"
+	ErrChecksumMismatch   stdErrs.ErrorCode = "CHECKSUM_MISMATCH"
 )
 
 const (
@@ -57,6 +60,49 @@ func IsFailedWriteToCache(err error) bool {
 	return stdErrs.IsCausedBy(err, ErrFailedToWriteCache)
 }
 
+// IsChecksumMismatch gets a value indicating whether the root cause of error is written data not matching its
+// expected checksum.
+func IsChecksumMismatch(err error) bool {
+	return stdErrs.IsCausedBy(err, ErrChecksumMismatch)
+}
+
+// ReadRawRange retrieves length bytes of the referenced data starting at offset. A negative length reads up to the end
+// of the data. Raw stores which do not implement RangeReader are read from the start, skipping over the data before
+// offset.
+func ReadRawRange(ctx context.Context, store RawStore, reference DataReference, offset, length int64) (io.ReadCloser, error) {
+	if offset < 0 {
+		return nil, fmt.Errorf("invalid negative offset [%v] reading [%v]", offset, reference)
+	}
+
+	if rangeReader, ok := store.(RangeReader); ok {
+		return rangeReader.ReadRawRange(ctx, reference, offset, length)
+	}
+
+	rc, err := store.ReadRaw(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := io.CopyN(io.Discard, rc, offset); err != nil && err != io.EOF {
+		_ = rc.Close()
+		return nil, err
+	}
+
+	return limitReadCloser(rc, length), nil
+}
+
+// limitReadCloser limits the reader to length bytes, or leaves it as is for a negative length.
+func limitReadCloser(rc io.ReadCloser, length int64) io.ReadCloser {
+	if length < 0 {
+		return rc
+	}
+
+	return struct {
+		io.Reader
+		io.Closer
+	}{Reader: io.LimitReader(rc, length), Closer: rc}
+}
+
 func MapStrings(mapper func(string) string, strings ...string) []string {
 	if strings == nil {
 		return []string{}
@@ -1,6 +1,9 @@
 package storage
 
 import (
+	"bytes"
+	"context"
+	"io"
 	"os"
 	"syscall"
 	"testing"
@@ -74,3 +77,36 @@ func TestMapStrings(t *testing.T) {
 		}, "something", "somesome"))
 	})
 }
+
+func TestReadRawRange(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewInMemoryRawStore(ctx, &Config{}, metrics)
+	assert.NoError(t, err)
+	ref := DataReference("mem://container/key")
+	assert.NoError(t, store.WriteRaw(ctx, ref, 10, Options{}, bytes.NewReader([]byte("0123456789"))))
+
+	read := func(offset, length int64) string {
+		rc, err := ReadRawRange(ctx, store, ref, offset, length)
+		if !assert.NoError(t, err) {
+			return ""
+		}
+		defer rc.Close()
+		data, err := io.ReadAll(rc)
+		assert.NoError(t, err)
+		return string(data)
+	}
+
+	assert.Equal(t, "234", read(2, 3))
+	assert.Equal(t, "89", read(8, -1))
+	assert.Equal(t, "89", read(8, 5))
+	assert.Equal(t, "", read(20, 5))
+
+	_, err = ReadRawRange(ctx, store, ref, -1, 5)
+	assert.Error(t, err)
+}
+
+func TestIsChecksumMismatch(t *testing.T) {
+	assert.True(t, IsChecksumMismatch(verifyContentMD5(Options{Metadata: map[string]interface{}{FlyteContentMD5: "a"}}, "b")))
+	assert.NoError(t, verifyContentMD5(Options{Metadata: map[string]interface{}{FlyteContentMD5: "a"}}, "a"))
+	assert.NoError(t, verifyContentMD5(Options{}, "b"))
+}